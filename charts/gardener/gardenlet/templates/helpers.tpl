@@ -348,6 +348,10 @@ etcdConfig:
 exposureClassHandlers:
 {{ toYaml .Values.config.exposureClassHandlers }}
 {{- end }}
+{{- if .Values.config.ociRegistry }}
+ociRegistry:
+{{ toYaml .Values.config.ociRegistry | indent 2 }}
+{{- end }}
 {{- if .Values.nodeToleration }}
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
@@ -49,7 +49,9 @@ import (
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -79,6 +81,8 @@ func NewCommand() *cobra.Command {
 	opts.addFlags(flags)
 
 	cmd.AddCommand(getBootstrapCommand(opts))
+	cmd.AddCommand(getStatusCommand())
+	cmd.AddCommand(getDiffCommand())
 	return cmd
 }
 
@@ -185,6 +189,20 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		}
 	}
 
+	statusRegistry := status.NewRegistry(clock.RealClock{})
+
+	log.Info("Adding status server to manager", "socket", nodeagentconfigv1alpha1.StatusSocketPath)
+	if err := mgr.Add(&status.Server{
+		Log:        log.WithName("status-server"),
+		SocketPath: nodeagentconfigv1alpha1.StatusSocketPath,
+		Registry:   statusRegistry,
+		Changes: func() (*status.Changes, error) {
+			return operatingsystemconfig.PendingChanges(fs, statusRegistry.Status().OperatingSystemConfig.Checksum)
+		},
+	}); err != nil {
+		return fmt.Errorf("failed adding status server to manager: %w", err)
+	}
+
 	log.Info("Adding runnables to manager")
 	if err := mgr.Add(&controllerutils.ControlledRunner{
 		Manager: mgr,
@@ -193,7 +211,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *n
 		},
 		ActualRunnables: []manager.Runnable{
 			manager.RunnableFunc(func(ctx context.Context) error {
-				return controller.AddToManager(ctx, cancel, mgr, cfg, hostName, machineName, nodeName)
+				return controller.AddToManager(ctx, cancel, mgr, cfg, hostName, machineName, nodeName, statusRegistry)
 			}),
 		},
 	}); err != nil {
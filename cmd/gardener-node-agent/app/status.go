@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+type statusOptions struct {
+	socketPath string
+	output     string
+}
+
+func (o *statusOptions) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.socketPath, "socket", nodeagentconfigv1alpha1.StatusSocketPath, "Path of the unix socket on which the running "+Name+" serves its status")
+	flags.StringVarP(&o.output, "output", "o", outputText, fmt.Sprintf("Output format, one of %q or %q", outputText, outputJSON))
+}
+
+func (o *statusOptions) validate() error {
+	if o.output != outputText && o.output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of %q or %q", o.output, outputText, outputJSON)
+	}
+	return nil
+}
+
+func getStatusCommand() *cobra.Command {
+	opts := &statusOptions{}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Print the status of the running " + Name,
+		Long: "Print the status of the running " + Name + ", i.e., the current and the applied operating system config " +
+			"revision, the results of the health checks and the access token syncs, and the last errors.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			s, err := status.NewClient(opts.socketPath).Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if opts.output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), s)
+			}
+			return status.PrintStatus(cmd.OutOrStdout(), s)
+		},
+	}
+
+	opts.addFlags(statusCmd.Flags())
+	return statusCmd
+}
+
+func getDiffCommand() *cobra.Command {
+	opts := &statusOptions{}
+
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Print the changes of the operating system config which are not yet applied by the running " + Name,
+		Long: "Print the files and units of the last computed operating system config revision which are not yet " +
+			"applied to the node by the running " + Name + ".",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			changes, err := status.NewClient(opts.socketPath).Changes(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			if opts.output == outputJSON {
+				return printJSON(cmd.OutOrStdout(), changes)
+			}
+			return status.PrintChanges(cmd.OutOrStdout(), changes)
+		},
+	}
+
+	opts.addFlags(diffCmd.Flags())
+	return diffCmd
+}
+
+func printJSON(w io.Writer, obj any) error {
+	out, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed marshalling output: %w", err)
+	}
+
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
@@ -70,6 +70,7 @@ func NewCommand() *cobra.Command {
 	verflag.AddFlags(flags)
 	opts.addFlags(flags)
 
+	cmd.AddCommand(getPreviewCommand(opts))
 	return cmd
 }
 
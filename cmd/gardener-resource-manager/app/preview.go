@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/cmd/gardener-resource-manager/app/bootstrappers"
+	"github.com/gardener/gardener/cmd/utils/initrun"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	resourcemanagerclient "github.com/gardener/gardener/pkg/resourcemanager/client"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
+	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+const (
+	previewOutputText = "text"
+	previewOutputJSON = "json"
+)
+
+type previewOptions struct {
+	*options
+
+	namespace string
+	name      string
+	output    string
+}
+
+var _ initrun.Options = &previewOptions{}
+
+func (o *previewOptions) addFlags(fs *pflag.FlagSet) {
+	o.options.addFlags(fs)
+	fs.StringVar(&o.namespace, "namespace", o.namespace, "Namespace of the ManagedResource.")
+	fs.StringVar(&o.name, "name", o.name, "Name of the ManagedResource.")
+	fs.StringVar(&o.output, "output", previewOutputText, "Output format of the preview, one of 'text' or 'json'.")
+}
+
+func (o *previewOptions) Validate() error {
+	if err := o.options.Validate(); err != nil {
+		return err
+	}
+
+	if len(o.namespace) == 0 || len(o.name) == 0 {
+		return fmt.Errorf("namespace and name of the ManagedResource must be provided")
+	}
+
+	if o.output != previewOutputText && o.output != previewOutputJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of %q or %q", o.output, previewOutputText, previewOutputJSON)
+	}
+
+	return nil
+}
+
+func getPreviewCommand(opts *options) *cobra.Command {
+	previewOpts := &previewOptions{options: opts}
+
+	previewCmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Preview the changes which the next reconciliation of a ManagedResource would apply",
+		Long: `Preview decodes the objects from the sources of a ManagedResource and prints the objects which the next
+reconciliation would create, update or delete in the target cluster. No object is changed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, err := initrun.InitRun(cmd, previewOpts, Name)
+			if err != nil {
+				return err
+			}
+			return preview(cmd.Context(), log, cmd.OutOrStdout(), previewOpts)
+		},
+	}
+
+	flags := previewCmd.Flags()
+	verflag.AddFlags(flags)
+	previewOpts.addFlags(flags)
+
+	return previewCmd
+}
+
+func preview(ctx context.Context, log logr.Logger, out io.Writer, opts *previewOptions) error {
+	cfg := opts.config
+
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.SourceClientConnection.Kubeconfig = kubeconfig
+	}
+
+	sourceRESTConfig, err := kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.SourceClientConnection.ClientConnectionConfiguration, nil, kubernetes.AuthTokenFile)
+	if err != nil {
+		return err
+	}
+
+	var (
+		targetRESTConfig = sourceRESTConfig
+		sourceScheme     = resourcemanagerclient.CombinedScheme
+	)
+
+	if cfg.TargetClientConnection != nil {
+		if kubeconfig := os.Getenv("TARGET_KUBECONFIG"); kubeconfig != "" {
+			cfg.TargetClientConnection.Kubeconfig = kubeconfig
+		}
+
+		targetRESTConfig, err = kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.TargetClientConnection.ClientConnectionConfiguration, nil, kubernetes.AuthTokenFile)
+		if err != nil {
+			return err
+		}
+
+		sourceScheme = resourcemanagerclient.SourceScheme
+	}
+
+	sourceClient, err := client.New(sourceRESTConfig, client.Options{Scheme: sourceScheme})
+	if err != nil {
+		return fmt.Errorf("could not create client for source cluster: %w", err)
+	}
+
+	targetClient, err := client.New(targetRESTConfig, client.Options{Scheme: resourcemanagerclient.TargetScheme})
+	if err != nil {
+		return fmt.Errorf("could not create client for target cluster: %w", err)
+	}
+
+	mr := &resourcesv1alpha1.ManagedResource{}
+	if err := sourceClient.Get(ctx, client.ObjectKey{Namespace: opts.namespace, Name: opts.name}, mr); err != nil {
+		return fmt.Errorf("could not read ManagedResource: %w", err)
+	}
+
+	// the cluster id is part of the origin annotation, hence it must be determined like in the manager
+	if err := (&bootstrappers.IdentityDeterminer{Logger: log, SourceClient: sourceClient, Config: cfg}).Start(ctx); err != nil {
+		return err
+	}
+
+	reconciler := newPreviewReconciler(cfg, sourceClient, targetClient)
+	if !reconciler.ClassFilter.Responsible(mr) {
+		log.Info("ManagedResource is not handled by this resource manager instance", "class", reconciler.ClassFilter.ResourceClass())
+	}
+
+	result, err := reconciler.Preview(ctx, log, mr)
+	if err != nil {
+		return fmt.Errorf("could not compute preview of ManagedResource: %w", err)
+	}
+
+	if opts.output == previewOutputJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+
+	_, err = fmt.Fprint(out, result.String())
+	return err
+}
+
+func newPreviewReconciler(cfg *resourcemanagerconfigv1alpha1.ResourceManagerConfiguration, sourceClient, targetClient client.Client) *managedresource.Reconciler {
+	return &managedresource.Reconciler{
+		SourceClient:              sourceClient,
+		TargetClient:              targetClient,
+		TargetScheme:              targetClient.Scheme(),
+		TargetRESTMapper:          targetClient.RESTMapper(),
+		Config:                    cfg.Controllers.ManagedResource,
+		ClassFilter:               resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		ClusterID:                 *cfg.Controllers.ClusterID,
+		GarbageCollectorActivated: cfg.Controllers.GarbageCollector.Enabled,
+		OCIRegistry:               oci.NewHelmRegistry(sourceClient),
+	}
+}
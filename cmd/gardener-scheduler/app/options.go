@@ -12,11 +12,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/cmd/utils/initrun"
 	"github.com/gardener/gardener/pkg/features"
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 	schedulervalidation "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1/validation"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 )
 
 var configDecoder runtime.Decoder
@@ -62,6 +64,12 @@ func (o *options) Validate() error {
 	if errs := schedulervalidation.ValidateConfiguration(o.config); len(errs) > 0 {
 		return errs.ToAggregate()
 	}
+
+	if o.config.Schedulers.Shoot != nil {
+		if errs := framework.NewInTreeRegistry().Validate(o.config.Schedulers.Shoot.Plugins, field.NewPath("schedulers", "shoot", "plugins")); len(errs) > 0 {
+			return errs.ToAggregate()
+		}
+	}
 	return nil
 }
 
@@ -19,6 +19,8 @@ type Quota struct {
 	metav1.ObjectMeta
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the most recently observed usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -42,6 +44,19 @@ type QuotaSpec struct {
 	Scope corev1.ObjectReference
 }
 
+// QuotaStatus holds the most recently observed status of the Quota.
+type QuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Quota.
+	ObservedGeneration int64
+	// Hard is the set of enforced limits for each metric, i.e. the metrics of the Quota specification at the time of the
+	// last observation.
+	Hard corev1.ResourceList
+	// Used is the amount of each metric which is currently consumed by the shoot clusters in the scope of the Quota.
+	// For Quotas with the 'project' scope, the usage is enforced per namespace, hence the highest usage of all
+	// namespaces referencing the Quota is reported.
+	Used corev1.ResourceList
+}
+
 const (
 	// QuotaMetricCPU is the constraint for the amount of CPUs
 	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
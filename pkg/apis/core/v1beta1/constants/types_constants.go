@@ -761,6 +761,12 @@ const (
 	// EventResourceReferenced indicates that the resource deletion is in waiting mode because the resource is still
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
 	EventResourceReferenced = "ResourceReferenced"
+	// EventQuotaUsageThresholdExceeded indicates that the usage of a metric of a Quota exceeds one of the configured
+	// warning thresholds.
+	EventQuotaUsageThresholdExceeded = "QuotaUsageThresholdExceeded"
+	// EventQuotaUsageIncomplete indicates that the usage of a Quota does not include the resources of some shoot clusters
+	// because they cannot be computed.
+	EventQuotaUsageIncomplete = "QuotaUsageIncomplete"
 
 	// ReferencedResourcesPrefix is the prefix used when copying referenced resources to the Shoot namespace in the Seed,
 	// to avoid naming collisions with resources managed by Gardener.
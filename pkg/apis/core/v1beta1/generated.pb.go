@@ -3907,10 +3907,38 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +3966,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +3994,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4022,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4050,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4078,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4106,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4134,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4162,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4190,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4190,7 +4218,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4218,7 +4246,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4274,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4302,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4302,7 +4330,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4358,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4386,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +4414,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4442,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4444,7 +4472,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4472,7 +4500,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4500,7 +4528,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4528,7 +4556,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4556,7 +4584,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4584,7 +4612,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4612,7 +4640,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4640,7 +4668,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4668,7 +4696,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4696,7 +4724,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4724,7 +4752,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4752,7 +4780,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4780,7 +4808,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4808,7 +4836,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4836,7 +4864,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4864,7 +4892,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4892,7 +4920,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4920,7 +4948,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4948,7 +4976,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5004,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5004,7 +5032,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5032,7 +5060,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5060,7 +5088,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5088,7 +5116,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5116,7 +5144,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5144,7 +5172,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5172,7 +5200,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5200,7 +5228,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5228,7 +5256,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5256,7 +5284,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5284,7 +5312,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5312,7 +5340,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5340,7 +5368,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5368,7 +5396,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5393,10 +5421,38 @@ func (m *Toleration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Toleration proto.InternalMessageInfo
 
+func (m *VersionUpdatePolicy) Reset()      { *m = VersionUpdatePolicy{} }
+func (*VersionUpdatePolicy) ProtoMessage() {}
+func (*VersionUpdatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+}
+func (m *VersionUpdatePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionUpdatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VersionUpdatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionUpdatePolicy.Merge(m, src)
+}
+func (m *VersionUpdatePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionUpdatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionUpdatePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionUpdatePolicy proto.InternalMessageInfo
+
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5424,7 +5480,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5452,7 +5508,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5480,7 +5536,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5508,7 +5564,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5533,10 +5589,38 @@ func (m *Worker) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Worker proto.InternalMessageInfo
 
+func (m *WorkerAutoUpdate) Reset()      { *m = WorkerAutoUpdate{} }
+func (*WorkerAutoUpdate) ProtoMessage() {}
+func (*WorkerAutoUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+}
+func (m *WorkerAutoUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerAutoUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkerAutoUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerAutoUpdate.Merge(m, src)
+}
+func (m *WorkerAutoUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerAutoUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerAutoUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerAutoUpdate proto.InternalMessageInfo
+
 func (m *WorkerControlPlane) Reset()      { *m = WorkerControlPlane{} }
 func (*WorkerControlPlane) ProtoMessage() {}
 func (*WorkerControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *WorkerControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5564,7 +5648,7 @@ var xxx_messageInfo_WorkerControlPlane proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5592,7 +5676,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5620,7 +5704,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5797,6 +5881,9 @@ func init() {
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.HardEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.UsedEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
@@ -5857,6 +5944,7 @@ func init() {
 	proto.RegisterType((*StructuredAuthorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthorization")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
 	proto.RegisterType((*Toleration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Toleration")
+	proto.RegisterType((*VersionUpdatePolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VersionUpdatePolicy")
 	proto.RegisterType((*VerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler")
 	proto.RegisterType((*Volume)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Volume")
 	proto.RegisterType((*VolumeType)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VolumeType")
@@ -5865,6 +5953,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.SysctlsEntry")
+	proto.RegisterType((*WorkerAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerAutoUpdate")
 	proto.RegisterType((*WorkerControlPlane)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerControlPlane")
 	proto.RegisterType((*WorkerKubernetes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes")
 	proto.RegisterType((*WorkerSystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents")
@@ -5876,899 +5965,913 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 14258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
-	0x59, 0x98, 0xfb, 0xea, 0xfd, 0xe9, 0x31, 0xd2, 0x99, 0x97, 0x56, 0xbb, 0x3b, 0x77, 0xdc, 0xbb,
-	0xeb, 0xec, 0xb2, 0xb6, 0x86, 0x1d, 0xaf, 0xbd, 0xde, 0x59, 0xf6, 0x21, 0xdd, 0xab, 0x99, 0xb9,
-	0x1e, 0x49, 0xa3, 0x3d, 0x57, 0xb3, 0xbb, 0xac, 0x61, 0x4d, 0xab, 0xef, 0xd1, 0x55, 0xef, 0xf4,
-	0xed, 0xbe, 0xdb, 0xdd, 0x57, 0x23, 0xed, 0xda, 0xf8, 0x01, 0x26, 0xb6, 0x31, 0x2e, 0x20, 0x04,
-	0xc7, 0x36, 0x54, 0x6c, 0x28, 0x20, 0x09, 0x89, 0x49, 0x91, 0x22, 0x29, 0x20, 0xa9, 0x4a, 0xa8,
-	0x4a, 0xb0, 0x29, 0xa0, 0x28, 0x1e, 0x15, 0x53, 0x01, 0x81, 0x15, 0x02, 0xa9, 0xca, 0xb3, 0x42,
-	0x25, 0x54, 0x26, 0x14, 0xa4, 0xce, 0xa3, 0xbb, 0xcf, 0xe9, 0xc7, 0xd5, 0x55, 0x5f, 0x49, 0xf6,
-	0x02, 0xbf, 0xa4, 0x7b, 0xbe, 0x73, 0xbe, 0xef, 0xbc, 0xfa, 0x3b, 0xdf, 0xf9, 0xce, 0xf7, 0x80,
-	0xc5, 0xa6, 0x15, 0x6c, 0x75, 0x36, 0xe6, 0x4d, 0xb7, 0x75, 0xa9, 0x69, 0x78, 0x0d, 0xe2, 0x10,
-	0x2f, 0xfe, 0xa7, 0x7d, 0xbb, 0x79, 0xc9, 0x68, 0x5b, 0xfe, 0x25, 0xd3, 0xf5, 0xc8, 0xa5, 0xed,
-	0xc7, 0x36, 0x48, 0x60, 0x3c, 0x76, 0xa9, 0x49, 0x61, 0x46, 0x40, 0x1a, 0xf3, 0x6d, 0xcf, 0x0d,
-	0x5c, 0x74, 0x39, 0xc6, 0x31, 0x1f, 0x36, 0x8d, 0xff, 0x69, 0xdf, 0x6e, 0xce, 0x53, 0x1c, 0xf3,
-	0x14, 0xc7, 0xbc, 0xc0, 0x31, 0xf7, 0x0e, 0x99, 0xae, 0xdb, 0x74, 0x2f, 0x31, 0x54, 0x1b, 0x9d,
-	0x4d, 0xf6, 0x8b, 0xfd, 0x60, 0xff, 0x71, 0x12, 0x73, 0x8f, 0xdc, 0x7e, 0x8f, 0x3f, 0x6f, 0xb9,
-	0xb4, 0x33, 0x97, 0x8c, 0x4e, 0xe0, 0xfa, 0xa6, 0x61, 0x5b, 0x4e, 0xf3, 0xd2, 0x76, 0xaa, 0x37,
-	0x73, 0xba, 0x54, 0x55, 0x74, 0xbb, 0x6b, 0x1d, 0x6f, 0xc3, 0x30, 0xb3, 0xea, 0x5c, 0x8f, 0xeb,
-	0x90, 0x9d, 0x80, 0x38, 0xbe, 0xe5, 0x3a, 0xfe, 0x3b, 0xe8, 0x48, 0x88, 0xb7, 0x2d, 0xcf, 0x8d,
-	0x52, 0x21, 0x0b, 0xd3, 0xe3, 0x31, 0xa6, 0x96, 0x61, 0x6e, 0x59, 0x0e, 0xf1, 0x76, 0xc3, 0xe6,
-	0x97, 0x3c, 0xe2, 0xbb, 0x1d, 0xcf, 0x24, 0x87, 0x6a, 0xe5, 0x5f, 0x6a, 0x91, 0xc0, 0xc8, 0xa2,
-	0x75, 0x29, 0xaf, 0x95, 0xd7, 0x71, 0x02, 0xab, 0x95, 0x26, 0xf3, 0xee, 0x83, 0x1a, 0xf8, 0xe6,
-	0x16, 0x69, 0x19, 0xa9, 0x76, 0xef, 0xcc, 0x6b, 0xd7, 0x09, 0x2c, 0xfb, 0x92, 0xe5, 0x04, 0x7e,
-	0xe0, 0x25, 0x1b, 0xe9, 0x9f, 0xd4, 0x60, 0x7a, 0x61, 0xad, 0x56, 0x67, 0x33, 0xb8, 0xec, 0x36,
-	0x9b, 0x96, 0xd3, 0x44, 0x8f, 0xc2, 0xd8, 0x36, 0xf1, 0x36, 0x5c, 0xdf, 0x0a, 0x76, 0x67, 0xb5,
-	0x8b, 0xda, 0xc3, 0x43, 0x8b, 0x93, 0xfb, 0x7b, 0xe5, 0xb1, 0x17, 0xc2, 0x42, 0x1c, 0xc3, 0x51,
-	0x0d, 0x4e, 0x6f, 0x05, 0x41, 0x7b, 0xc1, 0x34, 0x89, 0xef, 0x47, 0x35, 0x66, 0x4b, 0xac, 0xd9,
-	0xf9, 0xfd, 0xbd, 0xf2, 0xe9, 0xeb, 0xeb, 0xeb, 0x6b, 0x09, 0x30, 0xce, 0x6a, 0xa3, 0xff, 0xac,
-	0x06, 0x33, 0x51, 0x67, 0x30, 0x79, 0xad, 0x43, 0xfc, 0xc0, 0x47, 0x18, 0xce, 0xb5, 0x8c, 0x9d,
-	0x55, 0xd7, 0x59, 0xe9, 0x04, 0x46, 0x60, 0x39, 0xcd, 0x9a, 0xb3, 0x69, 0x5b, 0xcd, 0xad, 0x40,
-	0x74, 0x6d, 0x6e, 0x7f, 0xaf, 0x7c, 0x6e, 0x25, 0xb3, 0x06, 0xce, 0x69, 0x49, 0x3b, 0xdd, 0x32,
-	0x76, 0x52, 0x08, 0xa5, 0x4e, 0xaf, 0xa4, 0xc1, 0x38, 0xab, 0x8d, 0xfe, 0x2e, 0x98, 0xe1, 0xe3,
-	0xc0, 0xc4, 0x0f, 0x3c, 0xcb, 0x0c, 0x2c, 0xd7, 0x41, 0x17, 0x61, 0xd0, 0x31, 0x5a, 0x84, 0xf5,
-	0x70, 0x6c, 0x71, 0xe2, 0xcb, 0x7b, 0xe5, 0xb7, 0xec, 0xef, 0x95, 0x07, 0x57, 0x8d, 0x16, 0xc1,
-	0x0c, 0xa2, 0xff, 0x9f, 0x12, 0xdc, 0x97, 0x6a, 0xf7, 0xa2, 0x15, 0x6c, 0xdd, 0x6c, 0xd3, 0xff,
-	0x7c, 0xf4, 0x69, 0x0d, 0x66, 0x8c, 0x64, 0x05, 0x86, 0x70, 0xfc, 0xf2, 0xd2, 0xfc, 0xe1, 0x3f,
-	0xf0, 0xf9, 0x14, 0xb5, 0xc5, 0x7b, 0x44, 0xbf, 0xd2, 0x03, 0xc0, 0x69, 0xd2, 0xe8, 0xe3, 0x1a,
-	0x8c, 0xb8, 0xbc, 0x73, 0xb3, 0xa5, 0x8b, 0x03, 0x0f, 0x8f, 0x5f, 0xfe, 0xf6, 0x23, 0xe9, 0x86,
-	0x34, 0xe8, 0x79, 0xf1, 0x77, 0xc9, 0x09, 0xbc, 0xdd, 0xc5, 0x53, 0xa2, 0x7b, 0x23, 0xa2, 0x14,
-	0x87, 0xe4, 0xe7, 0xae, 0xc0, 0x84, 0x5c, 0x13, 0x4d, 0xc3, 0xc0, 0x6d, 0xc2, 0xb7, 0xea, 0x18,
-	0xa6, 0xff, 0xa2, 0x33, 0x30, 0xb4, 0x6d, 0xd8, 0x1d, 0xc2, 0x96, 0x74, 0x0c, 0xf3, 0x1f, 0x57,
-	0x4a, 0xef, 0xd1, 0xf4, 0xcb, 0x30, 0xb4, 0xd0, 0x68, 0xb8, 0x0e, 0x7a, 0x04, 0x46, 0x88, 0x63,
-	0x6c, 0xd8, 0xa4, 0xc1, 0x1a, 0x8e, 0xc6, 0xf4, 0x96, 0x78, 0x31, 0x0e, 0xe1, 0xfa, 0xdf, 0x2d,
-	0xc1, 0x30, 0x6b, 0xe4, 0xa3, 0x1f, 0xd4, 0xe0, 0xf4, 0xed, 0xce, 0x06, 0xf1, 0x1c, 0x12, 0x10,
-	0xbf, 0x6a, 0xf8, 0x5b, 0x1b, 0xae, 0xe1, 0x35, 0xc4, 0xc2, 0x5c, 0x2b, 0x32, 0x23, 0x37, 0xd2,
-	0xe8, 0xf8, 0x1e, 0xcc, 0x00, 0xe0, 0x2c, 0xe2, 0x68, 0x1b, 0x26, 0x9c, 0xa6, 0xe5, 0xec, 0xd4,
-	0x9c, 0xa6, 0x47, 0x7c, 0x9f, 0x0d, 0x7a, 0xfc, 0xf2, 0x73, 0x45, 0x3a, 0xb3, 0x2a, 0xe1, 0x59,
-	0x9c, 0xde, 0xdf, 0x2b, 0x4f, 0xc8, 0x25, 0x58, 0xa1, 0xa3, 0xff, 0x85, 0x06, 0xa7, 0x16, 0x1a,
-	0x2d, 0xcb, 0xa7, 0x9c, 0x76, 0xcd, 0xee, 0x34, 0xad, 0x1e, 0xb6, 0x3e, 0x7a, 0x1e, 0x86, 0x4d,
-	0xd7, 0xd9, 0xb4, 0x9a, 0xa2, 0x9f, 0xef, 0x98, 0xe7, 0x9c, 0x6b, 0x5e, 0xe6, 0x5c, 0xac, 0x7b,
-	0x82, 0xe3, 0xcd, 0x63, 0xe3, 0xce, 0x52, 0xc8, 0xd0, 0x17, 0x61, 0x7f, 0xaf, 0x3c, 0x5c, 0x61,
-	0x08, 0xb0, 0x40, 0x84, 0x1e, 0x86, 0xd1, 0x86, 0xe5, 0xf3, 0xc5, 0x1c, 0x60, 0x8b, 0x39, 0xb1,
-	0xbf, 0x57, 0x1e, 0xad, 0x8a, 0x32, 0x1c, 0x41, 0xd1, 0x32, 0x9c, 0xa1, 0x33, 0xc8, 0xdb, 0xd5,
-	0x89, 0xe9, 0x91, 0x80, 0x76, 0x6d, 0x76, 0x90, 0x75, 0x77, 0x76, 0x7f, 0xaf, 0x7c, 0xe6, 0x46,
-	0x06, 0x1c, 0x67, 0xb6, 0xd2, 0xaf, 0xc2, 0xe8, 0x82, 0x4d, 0x3c, 0xca, 0x10, 0xd0, 0x15, 0x98,
-	0x22, 0x2d, 0xc3, 0xb2, 0x31, 0x31, 0x89, 0xb5, 0x4d, 0x3c, 0x7f, 0x56, 0xbb, 0x38, 0xf0, 0xf0,
-	0xd8, 0x22, 0xda, 0xdf, 0x2b, 0x4f, 0x2d, 0x29, 0x10, 0x9c, 0xa8, 0xa9, 0x7f, 0x44, 0x83, 0xf1,
-	0x85, 0x4e, 0xc3, 0x0a, 0xf8, 0xb8, 0x90, 0x07, 0xe3, 0x06, 0xfd, 0xb9, 0xe6, 0xda, 0x96, 0xb9,
-	0x2b, 0x36, 0xd7, 0xb3, 0x85, 0x3e, 0xb7, 0x18, 0xcd, 0xe2, 0xa9, 0xfd, 0xbd, 0xf2, 0xb8, 0x54,
-	0x80, 0x65, 0x22, 0xfa, 0x16, 0xc8, 0x30, 0xf4, 0xad, 0x30, 0xc1, 0x87, 0xbb, 0x62, 0xb4, 0x31,
-	0xd9, 0x14, 0x7d, 0x78, 0x40, 0x5a, 0xab, 0x90, 0xd0, 0xfc, 0xcd, 0x8d, 0x57, 0x89, 0x19, 0x60,
-	0xb2, 0x49, 0x3c, 0xe2, 0x98, 0x84, 0x6f, 0x9b, 0x8a, 0xd4, 0x18, 0x2b, 0xa8, 0xf4, 0xbf, 0xa3,
-	0xc1, 0xfd, 0x0b, 0x9d, 0x60, 0xcb, 0xf5, 0xac, 0xd7, 0x89, 0x17, 0x4f, 0x77, 0x84, 0x01, 0x3d,
-	0x03, 0x53, 0x46, 0x54, 0x61, 0x35, 0xde, 0x4e, 0xe7, 0xc4, 0x76, 0x9a, 0x5a, 0x50, 0xa0, 0x38,
-	0x51, 0x1b, 0x5d, 0x06, 0xf0, 0xe3, 0xb5, 0x65, 0x3c, 0x60, 0x11, 0x89, 0xb6, 0x20, 0xad, 0xaa,
-	0x54, 0x4b, 0xff, 0x03, 0x7a, 0x14, 0x6e, 0x1b, 0x96, 0x6d, 0x6c, 0x58, 0xb6, 0x15, 0xec, 0xbe,
-	0xec, 0x3a, 0xa4, 0x87, 0xdd, 0x7c, 0x0b, 0xce, 0x77, 0x1c, 0x83, 0xb7, 0xb3, 0xc9, 0x0a, 0xdf,
-	0xbf, 0xeb, 0xbb, 0x6d, 0xc2, 0xb9, 0xe4, 0xd8, 0xe2, 0xbd, 0xfb, 0x7b, 0xe5, 0xf3, 0xb7, 0xb2,
-	0xab, 0xe0, 0xbc, 0xb6, 0xf4, 0xd4, 0x93, 0x40, 0x2f, 0xb8, 0x76, 0xa7, 0x25, 0xb0, 0x0e, 0x30,
-	0xac, 0xec, 0xd4, 0xbb, 0x95, 0x59, 0x03, 0xe7, 0xb4, 0xd4, 0xbf, 0x5c, 0x82, 0x89, 0x45, 0xc3,
-	0xbc, 0xdd, 0x69, 0x2f, 0x76, 0xcc, 0xdb, 0x24, 0x40, 0xdf, 0x01, 0xa3, 0x54, 0x6c, 0x69, 0x18,
-	0x81, 0x21, 0xd6, 0xf7, 0x9b, 0x73, 0xbf, 0x45, 0xb6, 0xb5, 0x68, 0xed, 0x78, 0xc5, 0x57, 0x48,
-	0x60, 0xc4, 0xd3, 0x1a, 0x97, 0xe1, 0x08, 0x2b, 0xda, 0x84, 0x41, 0xbf, 0x4d, 0x4c, 0xf1, 0xa5,
-	0x57, 0x8b, 0xec, 0x60, 0xb9, 0xc7, 0xf5, 0x36, 0x31, 0xe3, 0x55, 0xa0, 0xbf, 0x30, 0xc3, 0x8f,
-	0x1c, 0x18, 0xf6, 0x03, 0x23, 0xe8, 0xf8, 0xec, 0xf3, 0x1f, 0xbf, 0x7c, 0xb5, 0x6f, 0x4a, 0x0c,
-	0xdb, 0xe2, 0x94, 0xa0, 0x35, 0xcc, 0x7f, 0x63, 0x41, 0x45, 0xff, 0xf7, 0x1a, 0x4c, 0xcb, 0xd5,
-	0x97, 0x2d, 0x3f, 0x40, 0xdf, 0x96, 0x9a, 0xce, 0xf9, 0xde, 0xa6, 0x93, 0xb6, 0x66, 0x93, 0x39,
-	0x2d, 0xc8, 0x8d, 0x86, 0x25, 0xd2, 0x54, 0x12, 0x18, 0xb2, 0x02, 0xd2, 0x0a, 0x0f, 0xdf, 0xe7,
-	0xfa, 0x1d, 0xe1, 0xe2, 0xa4, 0x20, 0x36, 0x54, 0xa3, 0x68, 0x31, 0xc7, 0xae, 0x7f, 0x07, 0x9c,
-	0x91, 0x6b, 0xad, 0x79, 0xee, 0xb6, 0xd5, 0x20, 0x1e, 0xfd, 0x12, 0x82, 0xdd, 0x76, 0xea, 0x4b,
-	0xa0, 0x3b, 0x0b, 0x33, 0x08, 0x7a, 0x1b, 0x0c, 0x7b, 0xa4, 0x49, 0xa5, 0x14, 0xfe, 0xc1, 0x45,
-	0x73, 0x87, 0x59, 0x29, 0x16, 0x50, 0xfd, 0x7f, 0x97, 0xd4, 0xb9, 0xa3, 0xcb, 0x88, 0xb6, 0x61,
-	0xb4, 0x2d, 0x48, 0x89, 0xb9, 0xbb, 0xde, 0xef, 0x00, 0xc3, 0xae, 0xc7, 0xb3, 0x1a, 0x96, 0xe0,
-	0x88, 0x16, 0xb2, 0x60, 0x2a, 0xfc, 0xbf, 0xd2, 0xc7, 0xa1, 0xc4, 0x98, 0xfc, 0x9a, 0x82, 0x08,
-	0x27, 0x10, 0xa3, 0x75, 0x18, 0xe3, 0xec, 0x86, 0xb2, 0xd3, 0x81, 0x7c, 0x76, 0x5a, 0x0f, 0x2b,
-	0x09, 0x76, 0x3a, 0x23, 0xba, 0x3f, 0x16, 0x01, 0x70, 0x8c, 0x88, 0x1e, 0x7d, 0x3e, 0x21, 0x0d,
-	0xe9, 0x10, 0x63, 0x47, 0x5f, 0x5d, 0x94, 0xe1, 0x08, 0xaa, 0x7f, 0x61, 0x10, 0x50, 0x7a, 0x8b,
-	0xcb, 0x33, 0xc0, 0x4b, 0xc4, 0xfc, 0xf7, 0x33, 0x03, 0xe2, 0x6b, 0x49, 0x20, 0x46, 0xaf, 0xc3,
-	0xa4, 0x6d, 0xf8, 0xc1, 0xcd, 0x36, 0xbd, 0x83, 0x84, 0x1b, 0x65, 0xfc, 0xf2, 0x42, 0x91, 0x95,
-	0x5e, 0x96, 0x11, 0x2d, 0xce, 0xec, 0xef, 0x95, 0x27, 0x95, 0x22, 0xac, 0x92, 0x42, 0xaf, 0xc2,
-	0x18, 0x2d, 0x58, 0xf2, 0x3c, 0xd7, 0x13, 0xb3, 0xff, 0x74, 0x51, 0xba, 0x0c, 0x09, 0xbf, 0x13,
-	0x45, 0x3f, 0x71, 0x8c, 0x1e, 0xbd, 0x17, 0x90, 0xbb, 0xc1, 0x6e, 0xa5, 0x8d, 0x6b, 0xfc, 0xc2,
-	0x45, 0x07, 0x4b, 0x57, 0x67, 0x60, 0x71, 0x4e, 0xac, 0x26, 0xba, 0x99, 0xaa, 0x81, 0x33, 0x5a,
-	0xa1, 0xdb, 0x80, 0xa2, 0x4b, 0x5b, 0xb4, 0x01, 0x66, 0x87, 0x7a, 0xdf, 0x3e, 0xe7, 0x28, 0xb1,
-	0x6b, 0x29, 0x14, 0x38, 0x03, 0xad, 0xfe, 0x6f, 0x4b, 0x30, 0xce, 0xb7, 0x08, 0x17, 0xac, 0x8f,
-	0xff, 0x80, 0x20, 0xca, 0x01, 0x51, 0x29, 0xfe, 0xcd, 0xb3, 0x0e, 0xe7, 0x9e, 0x0f, 0xad, 0xc4,
-	0xf9, 0xb0, 0xd4, 0x2f, 0xa1, 0xee, 0xc7, 0xc3, 0xef, 0x68, 0x70, 0x4a, 0xaa, 0x7d, 0x02, 0xa7,
-	0x43, 0x43, 0x3d, 0x1d, 0x9e, 0xed, 0x73, 0x7c, 0x39, 0x87, 0x83, 0xab, 0x0c, 0x8b, 0x31, 0xee,
-	0xcb, 0x00, 0x1b, 0x8c, 0x9d, 0x48, 0x62, 0x5a, 0xb4, 0xe4, 0x8b, 0x11, 0x04, 0x4b, 0xb5, 0x14,
-	0x9e, 0x55, 0xea, 0xca, 0xb3, 0xfe, 0xd3, 0x00, 0xcc, 0xa4, 0xa6, 0x3d, 0xcd, 0x47, 0xb4, 0xaf,
-	0x13, 0x1f, 0x29, 0x7d, 0x3d, 0xf8, 0xc8, 0x40, 0x21, 0x3e, 0xd2, 0xf3, 0x39, 0x81, 0x3c, 0x40,
-	0x2d, 0xab, 0xc9, 0x9b, 0xd5, 0x03, 0xc3, 0x0b, 0xd6, 0xad, 0x16, 0x11, 0x1c, 0xe7, 0x9b, 0x7a,
-	0xdb, 0xb2, 0xb4, 0x05, 0x67, 0x3c, 0x2b, 0x29, 0x4c, 0x38, 0x03, 0xbb, 0xfe, 0x5d, 0x25, 0x18,
-	0x59, 0x34, 0x7c, 0xd6, 0xd3, 0x0f, 0xc2, 0x84, 0x40, 0x5d, 0x6b, 0x19, 0x4d, 0xd2, 0xcf, 0xd5,
-	0x5a, 0xa0, 0x5c, 0x91, 0xd0, 0xf1, 0xdb, 0x89, 0x5c, 0x82, 0x15, 0x72, 0x68, 0x17, 0xc6, 0x5b,
-	0xb1, 0x24, 0x2e, 0x96, 0xf8, 0x6a, 0xff, 0xd4, 0x29, 0x36, 0x7e, 0x05, 0x93, 0x0a, 0xb0, 0x4c,
-	0x4b, 0x7f, 0x05, 0x4e, 0x67, 0xf4, 0xb8, 0x87, 0x4b, 0xc8, 0x43, 0x30, 0x42, 0xef, 0x91, 0xb1,
-	0xec, 0x35, 0xbe, 0xbf, 0x57, 0x1e, 0x79, 0x81, 0x17, 0xe1, 0x10, 0xa6, 0xbf, 0x9b, 0x0a, 0x00,
-	0xc9, 0x3e, 0xf5, 0xa2, 0xac, 0x1a, 0x02, 0xa8, 0x2c, 0x60, 0x37, 0xe0, 0x5b, 0xe9, 0x59, 0x18,
-	0x6a, 0x6f, 0x19, 0x7e, 0xd8, 0xe2, 0x91, 0x90, 0x55, 0xac, 0xd1, 0xc2, 0xbb, 0x7b, 0xe5, 0xd9,
-	0x8a, 0x47, 0x1a, 0xc4, 0x09, 0x2c, 0xc3, 0xf6, 0xc3, 0x46, 0x0c, 0x86, 0x79, 0x3b, 0xba, 0xc3,
-	0xe8, 0x26, 0xaf, 0xb8, 0xad, 0xb6, 0x4d, 0x28, 0x94, 0xed, 0xb0, 0x52, 0xb1, 0x1d, 0xb6, 0x9c,
-	0xc2, 0x84, 0x33, 0xb0, 0x87, 0x34, 0x6b, 0x8e, 0x15, 0x58, 0x46, 0x44, 0x73, 0xa0, 0x38, 0x4d,
-	0x15, 0x13, 0xce, 0xc0, 0x8e, 0x3e, 0xa9, 0xc1, 0x9c, 0x5a, 0x7c, 0xd5, 0x72, 0x2c, 0x7f, 0x8b,
-	0x34, 0x18, 0xf1, 0xc1, 0x43, 0x13, 0xbf, 0xb0, 0xbf, 0x57, 0x9e, 0x5b, 0xce, 0xc5, 0x88, 0xbb,
-	0x50, 0x43, 0xdf, 0xa7, 0xc1, 0xbd, 0x89, 0x79, 0xf1, 0xac, 0x66, 0x93, 0x78, 0xa2, 0x37, 0x87,
-	0xff, 0xc0, 0xcb, 0xfb, 0x7b, 0xe5, 0x7b, 0x97, 0xf3, 0x51, 0xe2, 0x6e, 0xf4, 0xd0, 0x17, 0x35,
-	0x38, 0xd7, 0x26, 0x4e, 0xc3, 0x72, 0x9a, 0x2f, 0xba, 0xde, 0x6d, 0xe2, 0xf9, 0xd8, 0xb5, 0x6d,
-	0xb7, 0x13, 0xf8, 0xb3, 0xc3, 0xec, 0x0c, 0xab, 0x15, 0xf9, 0xe6, 0xd6, 0xb2, 0x30, 0x2e, 0x5e,
-	0x10, 0x5b, 0xf4, 0x5c, 0x26, 0xd8, 0xc7, 0x39, 0x1d, 0xd1, 0x7f, 0x49, 0x83, 0x81, 0x0a, 0xae,
-	0xa1, 0x47, 0x95, 0x4f, 0xe4, 0xbc, 0xfc, 0x89, 0xdc, 0xdd, 0x2b, 0x8f, 0x54, 0x70, 0x4d, 0xfa,
-	0x18, 0xbf, 0x4f, 0x83, 0x19, 0xd3, 0x75, 0x02, 0x83, 0xce, 0x1d, 0xe6, 0xb2, 0x72, 0x78, 0x2e,
-	0x17, 0xba, 0x01, 0x57, 0x12, 0xc8, 0x62, 0xc5, 0x6d, 0x12, 0xe2, 0xe3, 0x34, 0x65, 0xfd, 0xc7,
-	0x34, 0x38, 0x53, 0x31, 0xda, 0x42, 0xad, 0x51, 0x25, 0x9b, 0x16, 0xdd, 0x20, 0xbd, 0x68, 0xa9,
-	0xd1, 0x16, 0x0c, 0x33, 0xcd, 0xa9, 0xdf, 0xcf, 0x05, 0x3e, 0xa6, 0xfd, 0x02, 0xc3, 0xc5, 0x35,
-	0x78, 0xfc, 0x7f, 0x2c, 0xf0, 0xeb, 0xff, 0xa2, 0x04, 0x93, 0x71, 0xc5, 0x3a, 0x09, 0xd0, 0x8f,
-	0x6a, 0x30, 0x61, 0x86, 0x25, 0x16, 0xe1, 0xea, 0xb4, 0xf1, 0xcb, 0xf5, 0xfe, 0xba, 0x50, 0x27,
-	0x41, 0xfc, 0xcb, 0x22, 0x42, 0xd5, 0xfc, 0xa0, 0x18, 0xfb, 0x84, 0x0c, 0xba, 0x9b, 0xf8, 0x8d,
-	0x95, 0xee, 0xcc, 0x7d, 0x4c, 0x83, 0x99, 0x14, 0xa6, 0x0c, 0x55, 0xf4, 0xcb, 0xb2, 0x2a, 0xfa,
-	0x88, 0xa6, 0x50, 0x56, 0x68, 0x3f, 0x0d, 0xd3, 0x49, 0x30, 0x2a, 0x87, 0xd2, 0x20, 0x57, 0x41,
-	0x8e, 0x25, 0x05, 0xb9, 0x2b, 0xa3, 0x7f, 0xef, 0x0b, 0xe5, 0xb7, 0x7c, 0xf8, 0xf7, 0x2e, 0xbe,
-	0x45, 0xff, 0xaa, 0x06, 0x13, 0x15, 0xdb, 0xed, 0x34, 0xd6, 0x3c, 0x77, 0xd3, 0xb2, 0xc9, 0x9b,
-	0x43, 0x29, 0x24, 0xf7, 0x38, 0x4f, 0xe8, 0x67, 0x4a, 0x1a, 0xb9, 0xe2, 0x9b, 0x44, 0x49, 0x23,
-	0x77, 0x39, 0x47, 0x0e, 0x7f, 0x1f, 0x9c, 0x95, 0x6b, 0xc5, 0x8a, 0xd3, 0x8b, 0x30, 0x78, 0xdb,
-	0x72, 0x1a, 0xc9, 0x4f, 0xfa, 0x86, 0xe5, 0x34, 0x30, 0x83, 0x44, 0x1f, 0x7d, 0x29, 0xf7, 0xb4,
-	0xdf, 0x1b, 0x53, 0xa7, 0x8d, 0x89, 0xf9, 0x0f, 0xc3, 0xa8, 0x69, 0x2c, 0x76, 0x9c, 0x86, 0x1d,
-	0xf1, 0x0b, 0x3a, 0x05, 0x95, 0x05, 0x5e, 0x86, 0x23, 0x28, 0x7a, 0x1d, 0x20, 0x7e, 0xa3, 0xe8,
-	0x47, 0x7c, 0x8a, 0x9f, 0x3f, 0xea, 0x24, 0x08, 0x2c, 0xa7, 0xe9, 0xc7, 0xfb, 0x2a, 0x86, 0x61,
-	0x89, 0x1a, 0xfa, 0x20, 0x4c, 0xca, 0xb2, 0x1c, 0x57, 0x96, 0x16, 0x5c, 0x06, 0x45, 0x68, 0x3c,
-	0x2b, 0x08, 0x4f, 0xca, 0xa5, 0x3e, 0x56, 0xa9, 0xa1, 0xdd, 0x48, 0x72, 0xe5, 0xaa, 0xda, 0xc1,
-	0xe2, 0x77, 0x31, 0x59, 0x68, 0x3c, 0x13, 0x72, 0x27, 0x45, 0x75, 0xac, 0x90, 0xca, 0xd0, 0x63,
-	0x0d, 0x1d, 0x97, 0x1e, 0x8b, 0xc0, 0x08, 0xd7, 0xe4, 0x85, 0x07, 0xf5, 0x95, 0x22, 0x03, 0xe4,
-	0x4a, 0xc1, 0xf8, 0xd1, 0x8d, 0xff, 0xf6, 0x71, 0x88, 0x1b, 0x6d, 0xc3, 0x04, 0xbd, 0x92, 0xd4,
-	0x89, 0x4d, 0xcc, 0xc0, 0xf5, 0x66, 0x47, 0x8a, 0x3f, 0x6a, 0xd5, 0x25, 0x3c, 0x5c, 0xfe, 0x97,
-	0x4b, 0xb0, 0x42, 0x27, 0x52, 0x74, 0x8e, 0xe6, 0x2a, 0x3a, 0x3b, 0x30, 0xbe, 0x2d, 0x29, 0xe4,
-	0xc7, 0xd8, 0x24, 0x3c, 0x53, 0xa4, 0x63, 0xb1, 0x76, 0x7e, 0xf1, 0xb4, 0x20, 0x34, 0x2e, 0x6b,
-	0xf2, 0x65, 0x3a, 0x68, 0x03, 0x46, 0x36, 0xb8, 0xf4, 0x3e, 0x0b, 0x6c, 0x2e, 0x9e, 0xea, 0xe3,
-	0x52, 0xc2, 0x6f, 0x08, 0xe2, 0x07, 0x0e, 0x11, 0xa3, 0x57, 0x60, 0xd8, 0xb6, 0x5a, 0x56, 0xe0,
-	0xcf, 0x8e, 0x33, 0x12, 0x85, 0x96, 0x76, 0x99, 0x61, 0xe0, 0xc7, 0x3c, 0xff, 0x1f, 0x0b, 0xac,
-	0xe8, 0xa3, 0xc9, 0x43, 0x7d, 0x82, 0x4d, 0xde, 0xf5, 0xfe, 0x0e, 0xc5, 0x58, 0xa6, 0x89, 0xbf,
-	0x95, 0xfc, 0x93, 0x5b, 0xff, 0xd1, 0x09, 0x98, 0xa9, 0xd8, 0x1d, 0x3f, 0x20, 0xde, 0x82, 0x30,
-	0x5d, 0x21, 0x1e, 0xed, 0xda, 0x39, 0xf6, 0x6f, 0xd5, 0xbd, 0xe3, 0x54, 0x89, 0x6d, 0xec, 0x2e,
-	0x6c, 0xd2, 0x1a, 0x8d, 0xc6, 0xe1, 0xce, 0x89, 0x6a, 0x47, 0xe8, 0x12, 0xd8, 0x13, 0x4d, 0x3d,
-	0x13, 0x23, 0xce, 0xa1, 0x84, 0xbe, 0x57, 0x83, 0x7b, 0x32, 0x40, 0x55, 0x62, 0x93, 0x20, 0x94,
-	0x20, 0x0e, 0xdb, 0x8f, 0xfb, 0xf7, 0xf7, 0xca, 0xf7, 0xd4, 0xf3, 0x90, 0xe2, 0x7c, 0x7a, 0xe8,
-	0xd3, 0x1a, 0xcc, 0x65, 0x40, 0xaf, 0x1a, 0x96, 0xdd, 0xf1, 0xc2, 0xcb, 0xd3, 0x61, 0xbb, 0xc3,
-	0xee, 0x30, 0xf5, 0x5c, 0xac, 0xb8, 0x0b, 0x45, 0xf4, 0x21, 0x38, 0x1b, 0x41, 0x6f, 0x39, 0x0e,
-	0x21, 0x0d, 0xe5, 0x2a, 0x75, 0xd8, 0xae, 0xdc, 0xb3, 0xbf, 0x57, 0x3e, 0x5b, 0xcf, 0x42, 0x88,
-	0xb3, 0xe9, 0xa0, 0x26, 0xdc, 0x1f, 0x03, 0x02, 0xcb, 0xb6, 0x5e, 0xe7, 0xb7, 0xbd, 0x2d, 0x8f,
-	0xf8, 0x5b, 0xae, 0xdd, 0x60, 0x5c, 0x57, 0x5b, 0x7c, 0xeb, 0xfe, 0x5e, 0xf9, 0xfe, 0x7a, 0xb7,
-	0x8a, 0xb8, 0x3b, 0x1e, 0xd4, 0x80, 0x09, 0xdf, 0x34, 0x9c, 0x9a, 0x13, 0x10, 0x6f, 0xdb, 0xb0,
-	0x67, 0x87, 0x0b, 0x0d, 0x90, 0xf3, 0x3a, 0x09, 0x0f, 0x56, 0xb0, 0xa2, 0xf7, 0xc0, 0x28, 0xd9,
-	0x69, 0x1b, 0x4e, 0x83, 0x70, 0xfe, 0x3a, 0xb6, 0x78, 0x1f, 0x3d, 0xd5, 0x97, 0x44, 0x19, 0x95,
-	0x80, 0xc3, 0xff, 0x57, 0xdc, 0x06, 0xc1, 0x51, 0x6d, 0xf4, 0x01, 0x38, 0xc3, 0x6c, 0x6b, 0x1a,
-	0x84, 0x9d, 0x16, 0x7e, 0x78, 0xa1, 0x1e, 0x2d, 0xd4, 0x4f, 0xf6, 0xee, 0xbe, 0x92, 0x81, 0x0f,
-	0x67, 0x52, 0xa1, 0xcb, 0xd0, 0x32, 0x76, 0xae, 0x79, 0x86, 0x49, 0x36, 0x3b, 0xf6, 0x3a, 0xf1,
-	0x5a, 0x96, 0xc3, 0x35, 0x4a, 0xc4, 0x74, 0x9d, 0x06, 0xe5, 0xc9, 0xda, 0xc3, 0x43, 0x7c, 0x19,
-	0x56, 0xba, 0x55, 0xc4, 0xdd, 0xf1, 0xa0, 0xc7, 0x61, 0xc2, 0x6a, 0x3a, 0xae, 0x47, 0xd6, 0x0d,
-	0xcb, 0x09, 0xfc, 0x59, 0x60, 0xf2, 0x34, 0x9b, 0xd6, 0x9a, 0x54, 0x8e, 0x95, 0x5a, 0x68, 0x1b,
-	0x90, 0x43, 0xee, 0xac, 0xb9, 0x0d, 0xb6, 0x05, 0x6e, 0xb5, 0xd9, 0x46, 0x16, 0x1c, 0xf5, 0xb0,
-	0x53, 0xc3, 0xf4, 0x0d, 0xab, 0x29, 0x6c, 0x38, 0x83, 0x02, 0xba, 0x0a, 0xa8, 0x65, 0xec, 0x2c,
-	0xb5, 0xda, 0xc1, 0xee, 0x62, 0xc7, 0xbe, 0x2d, 0xb8, 0xc6, 0x04, 0x9b, 0x0b, 0xae, 0x8d, 0x4b,
-	0x41, 0x71, 0x46, 0x0b, 0x64, 0xc0, 0xbd, 0x7c, 0x3c, 0x55, 0x83, 0xb4, 0x5c, 0xc7, 0x27, 0x81,
-	0x2f, 0x6d, 0xd2, 0xd9, 0x49, 0x66, 0x61, 0xc1, 0x6e, 0xff, 0xb5, 0xfc, 0x6a, 0xb8, 0x1b, 0x0e,
-	0xd5, 0xc6, 0x6c, 0xea, 0x00, 0x1b, 0xb3, 0x27, 0x60, 0xd2, 0x0f, 0x0c, 0x2f, 0xe8, 0xb4, 0xc5,
-	0x32, 0x9c, 0x62, 0xcb, 0xc0, 0x94, 0xb5, 0x75, 0x19, 0x80, 0xd5, 0x7a, 0x74, 0xf9, 0xb8, 0x46,
-	0x5e, 0xb4, 0x9b, 0x8e, 0x97, 0xaf, 0x2e, 0x95, 0x63, 0xa5, 0x96, 0xfe, 0xbf, 0x06, 0x61, 0x36,
-	0x75, 0x3e, 0x84, 0x76, 0x59, 0x07, 0x72, 0x00, 0xed, 0x88, 0x38, 0x40, 0x1b, 0x2e, 0x46, 0x15,
-	0xae, 0xb5, 0x3b, 0x99, 0xb4, 0x4a, 0x8c, 0xd6, 0x83, 0xfb, 0x7b, 0xe5, 0x8b, 0xf5, 0x03, 0xea,
-	0xe2, 0x03, 0xb1, 0xe5, 0x73, 0xd7, 0x81, 0x13, 0xe2, 0xae, 0x1f, 0x80, 0x33, 0x12, 0xc0, 0x23,
-	0x46, 0x63, 0xb7, 0x0f, 0xee, 0xce, 0x98, 0x4a, 0x3d, 0x03, 0x1f, 0xce, 0xa4, 0x92, 0xcb, 0xd2,
-	0x86, 0x4e, 0x82, 0xa5, 0xe9, 0x7b, 0x03, 0x30, 0x56, 0x71, 0x9d, 0x06, 0x57, 0xcd, 0x3c, 0xa6,
-	0xbc, 0xb6, 0xdf, 0x2f, 0x0b, 0xa1, 0x77, 0xf7, 0xca, 0x93, 0x51, 0x45, 0x49, 0x2a, 0x7d, 0x32,
-	0x7a, 0xe2, 0xe2, 0x57, 0xbb, 0xb7, 0xaa, 0x6f, 0x53, 0x77, 0xf7, 0xca, 0xa7, 0xa2, 0x66, 0xea,
-	0x73, 0x15, 0xe5, 0x57, 0xb6, 0xe1, 0x07, 0xeb, 0x9e, 0xe1, 0xf8, 0x56, 0x1f, 0xba, 0xd1, 0xe8,
-	0x4d, 0x62, 0x39, 0x85, 0x0d, 0x67, 0x50, 0x40, 0xaf, 0xc2, 0x14, 0x2d, 0xbd, 0xd5, 0x6e, 0x18,
-	0x01, 0x29, 0xa8, 0x12, 0x8d, 0x4c, 0x82, 0x96, 0x15, 0x4c, 0x38, 0x81, 0x99, 0x5b, 0x27, 0x18,
-	0xbe, 0xeb, 0xb0, 0xf5, 0x54, 0xac, 0x13, 0x68, 0x29, 0x16, 0x50, 0xf4, 0x08, 0x8c, 0xb4, 0x88,
-	0xef, 0x1b, 0x4d, 0xc2, 0xce, 0xdc, 0xb1, 0xf8, 0x86, 0xb2, 0xc2, 0x8b, 0x71, 0x08, 0x47, 0x6f,
-	0x87, 0x21, 0xd3, 0x6d, 0x10, 0x7f, 0x76, 0x84, 0xb1, 0x15, 0xca, 0x61, 0x87, 0x2a, 0xb4, 0xe0,
-	0xee, 0x5e, 0x79, 0x8c, 0xbd, 0xe0, 0xd0, 0x5f, 0x98, 0x57, 0xd2, 0xff, 0xbe, 0x06, 0xd3, 0x49,
-	0x7d, 0x5d, 0x0f, 0x56, 0x15, 0x27, 0x67, 0xa0, 0xa0, 0x7f, 0x46, 0x83, 0x09, 0xda, 0x43, 0xcf,
-	0xb5, 0xd7, 0x6c, 0xc3, 0x21, 0xe8, 0x7b, 0x34, 0x98, 0xde, 0xb2, 0x9a, 0x5b, 0xb2, 0x59, 0x94,
-	0x10, 0x86, 0x0b, 0x69, 0x6d, 0xae, 0x27, 0x70, 0x2d, 0x9e, 0xd9, 0xdf, 0x2b, 0x4f, 0x27, 0x4b,
-	0x71, 0x8a, 0xa6, 0xfe, 0x87, 0x25, 0x38, 0x2f, 0xf7, 0x6c, 0x21, 0xb6, 0x38, 0x47, 0xbf, 0xa3,
-	0x01, 0xb4, 0x2c, 0x67, 0xc1, 0xb6, 0xdd, 0x3b, 0xcc, 0x96, 0x93, 0x5e, 0x28, 0xde, 0x57, 0x54,
-	0xcf, 0x9a, 0x41, 0x61, 0x7e, 0x25, 0xc2, 0xce, 0xb5, 0x85, 0x2f, 0x85, 0x5a, 0x88, 0x18, 0x70,
-	0x77, 0xaf, 0x5c, 0x4e, 0x9b, 0xb9, 0xcf, 0x63, 0x61, 0x4b, 0xbe, 0x6c, 0xf9, 0xc1, 0x47, 0xff,
-	0xa0, 0x6b, 0x15, 0xfe, 0x48, 0x1a, 0x0f, 0x64, 0xae, 0x05, 0xa7, 0x12, 0x84, 0x33, 0x94, 0x8b,
-	0x55, 0x55, 0xb9, 0xd8, 0x95, 0x49, 0xcd, 0x87, 0x96, 0xed, 0xf3, 0xcf, 0x77, 0x0c, 0x27, 0xa0,
-	0x33, 0x2d, 0xa9, 0x11, 0x7f, 0xbf, 0x04, 0x67, 0xc4, 0x04, 0xd8, 0xf4, 0x02, 0xd0, 0xb6, 0xdd,
-	0xdd, 0x16, 0x71, 0x4e, 0xc2, 0x48, 0x2c, 0xfc, 0x08, 0x4a, 0xb9, 0x1f, 0x41, 0x2b, 0xf5, 0x11,
-	0x0c, 0x14, 0xf9, 0x08, 0x22, 0x5e, 0x71, 0x80, 0x86, 0x03, 0xc3, 0x39, 0xcb, 0xa1, 0x1d, 0xbd,
-	0xc6, 0x36, 0x4c, 0x6c, 0xa1, 0xc8, 0xf8, 0xd3, 0x28, 0xbf, 0xd9, 0xd5, 0x32, 0x6b, 0xe0, 0x9c,
-	0x96, 0xfa, 0x9f, 0x68, 0x30, 0x9b, 0x35, 0xbf, 0x27, 0xa0, 0x94, 0x6c, 0xa9, 0x4a, 0xc9, 0xeb,
-	0x7d, 0x7c, 0x1b, 0x4a, 0xd7, 0x73, 0x94, 0x93, 0x7f, 0x5c, 0x82, 0x73, 0x71, 0xf5, 0x9a, 0xe3,
-	0x07, 0x86, 0x6d, 0x73, 0xa9, 0xef, 0xf8, 0xf7, 0x52, 0x5b, 0xd1, 0x2d, 0xaf, 0xf6, 0x37, 0x54,
-	0xb9, 0xef, 0xb9, 0xa6, 0x25, 0x3b, 0x09, 0xd3, 0x92, 0xb5, 0x23, 0xa4, 0xd9, 0xdd, 0xca, 0xe4,
-	0xbf, 0x68, 0x30, 0x97, 0xdd, 0xf0, 0x04, 0x36, 0x95, 0xab, 0x6e, 0xaa, 0xf7, 0x1e, 0xdd, 0xa8,
-	0x73, 0xb6, 0xd5, 0xcf, 0x96, 0xf2, 0x46, 0xcb, 0x14, 0xd4, 0x9b, 0x70, 0xca, 0x23, 0x4d, 0xcb,
-	0x0f, 0x84, 0x0d, 0xc4, 0xe1, 0x4c, 0x96, 0xc3, 0x27, 0xbd, 0x53, 0x58, 0xc5, 0x81, 0x93, 0x48,
-	0xd1, 0x2a, 0x8c, 0xf8, 0x84, 0x34, 0x28, 0xfe, 0x52, 0xef, 0xf8, 0x23, 0x21, 0xa2, 0xce, 0xdb,
-	0xe2, 0x10, 0x09, 0xfa, 0x36, 0x98, 0x6c, 0x44, 0x5f, 0xd4, 0x01, 0x96, 0x81, 0x49, 0xac, 0xec,
-	0x02, 0x54, 0x95, 0x5b, 0x63, 0x15, 0x99, 0xfe, 0xe7, 0x1a, 0xdc, 0xd7, 0x6d, 0x6f, 0xa1, 0xd7,
-	0x00, 0xcc, 0x50, 0x2a, 0x0c, 0x9f, 0xd8, 0x9e, 0x2e, 0xb8, 0x96, 0x1c, 0x4b, 0xfc, 0x81, 0x46,
-	0x45, 0x3e, 0x96, 0x88, 0x64, 0x18, 0x1c, 0x96, 0x8e, 0xc9, 0xe0, 0x50, 0xff, 0xaf, 0x9a, 0xcc,
-	0x8a, 0xe4, 0xb5, 0x7d, 0xb3, 0xb1, 0x22, 0xb9, 0xef, 0xb9, 0x0f, 0x5e, 0xbf, 0x55, 0x82, 0x8b,
-	0xd9, 0x4d, 0xa4, 0xf3, 0xfc, 0x39, 0x18, 0x6e, 0x73, 0xb7, 0x82, 0x01, 0x76, 0xde, 0x3e, 0x4c,
-	0x39, 0x0b, 0x37, 0xfa, 0xbf, 0xbb, 0x57, 0x9e, 0xcb, 0x62, 0xf4, 0xc2, 0x5d, 0x40, 0xb4, 0x43,
-	0x56, 0x42, 0x33, 0xcf, 0x85, 0xf6, 0x77, 0xf6, 0xc8, 0x5c, 0x8c, 0x0d, 0x62, 0xf7, 0xac, 0x8c,
-	0xff, 0x88, 0x06, 0x53, 0xca, 0x8e, 0xf6, 0x67, 0x87, 0xd8, 0x1e, 0x2d, 0x64, 0xeb, 0xa5, 0x7c,
-	0x2a, 0xb1, 0x34, 0xa0, 0x14, 0xfb, 0x38, 0x41, 0x30, 0xc1, 0x66, 0xe5, 0x59, 0x7d, 0xd3, 0xb1,
-	0x59, 0xb9, 0xf3, 0x39, 0x6c, 0xf6, 0x47, 0x4a, 0x79, 0xa3, 0x65, 0x6c, 0xf6, 0x0e, 0x8c, 0x85,
-	0x62, 0x64, 0xc8, 0x2e, 0xae, 0xf6, 0xdb, 0x27, 0x8e, 0x2e, 0xb6, 0x73, 0x0e, 0x4b, 0x7c, 0x1c,
-	0xd3, 0x42, 0xdf, 0xad, 0x01, 0xc4, 0x0b, 0x23, 0x3e, 0xaa, 0xf5, 0xa3, 0x9b, 0x0e, 0x49, 0xac,
-	0x99, 0xa2, 0x9f, 0xb4, 0xb4, 0x29, 0x24, 0xba, 0xfa, 0xff, 0x1d, 0x00, 0x94, 0xee, 0x7b, 0x6f,
-	0xef, 0xae, 0x07, 0x08, 0xb9, 0x4f, 0xc3, 0xa9, 0xa6, 0xed, 0x6e, 0x18, 0xb6, 0xbd, 0x2b, 0x3c,
-	0xd0, 0x84, 0x2f, 0xd3, 0x69, 0x7a, 0x30, 0x5d, 0x53, 0x41, 0x38, 0x59, 0x17, 0xb5, 0x61, 0xda,
-	0xa3, 0xc2, 0xa6, 0x69, 0xd9, 0xec, 0xc6, 0xeb, 0x76, 0x82, 0x82, 0x8a, 0x13, 0x76, 0x2b, 0xc3,
-	0x09, 0x5c, 0x38, 0x85, 0x1d, 0x3d, 0x04, 0x23, 0x6d, 0xcf, 0x6a, 0x19, 0xde, 0x2e, 0xbb, 0x53,
-	0x8f, 0xf2, 0x37, 0xa5, 0x35, 0x5e, 0x84, 0x43, 0x18, 0xfa, 0x00, 0x8c, 0xd9, 0xd6, 0x26, 0x31,
-	0x77, 0x4d, 0x9b, 0x08, 0x3d, 0xf6, 0xcd, 0xa3, 0xd9, 0x32, 0xcb, 0x21, 0x5a, 0x61, 0x43, 0x19,
-	0xfe, 0xc4, 0x31, 0x41, 0x54, 0x83, 0xd3, 0x77, 0x98, 0x55, 0x8f, 0x4d, 0x7c, 0xbf, 0xde, 0x69,
-	0xb7, 0x5d, 0x2f, 0x20, 0x0d, 0xa6, 0xed, 0x1e, 0xe5, 0x6e, 0x76, 0x2f, 0xa6, 0xc1, 0x38, 0xab,
-	0x8d, 0xfe, 0xc9, 0x12, 0xdc, 0xdb, 0xa5, 0x13, 0x08, 0xd3, 0x6f, 0x43, 0xcc, 0x91, 0xd8, 0x09,
-	0x8f, 0xf3, 0xfd, 0x2c, 0x0a, 0xef, 0xee, 0x95, 0x1f, 0xe8, 0x82, 0xa0, 0x4e, 0xb7, 0x22, 0x69,
-	0xee, 0xe2, 0x18, 0x0d, 0xaa, 0xc1, 0x70, 0x23, 0x7e, 0xfc, 0x19, 0x5b, 0x7c, 0x8c, 0x72, 0x6b,
-	0xae, 0xa6, 0xed, 0x15, 0x9b, 0x40, 0x80, 0x96, 0x61, 0x84, 0x5b, 0x5e, 0x12, 0xc1, 0xf9, 0x2f,
-	0x33, 0xad, 0x06, 0x2f, 0xea, 0x15, 0x59, 0x88, 0x42, 0xff, 0x33, 0x0d, 0x46, 0x2a, 0xae, 0x47,
-	0xaa, 0xab, 0x75, 0xb4, 0x0b, 0xe3, 0x92, 0x0f, 0xb8, 0xe0, 0x82, 0x05, 0xd9, 0x02, 0xc3, 0x28,
-	0xdd, 0xbe, 0x43, 0xaf, 0xb5, 0xa8, 0x00, 0xcb, 0xb4, 0xd0, 0x6b, 0x74, 0xce, 0xef, 0x78, 0x56,
-	0x40, 0x09, 0xf7, 0x63, 0x50, 0xc2, 0x09, 0xe3, 0x10, 0x17, 0xdf, 0x51, 0xd1, 0x4f, 0x1c, 0x53,
-	0xd1, 0xd7, 0x28, 0x07, 0x48, 0x76, 0x13, 0x5d, 0x81, 0xc1, 0x96, 0xdb, 0x08, 0xd7, 0xfd, 0x6d,
-	0xe1, 0xf7, 0xbd, 0xe2, 0x36, 0xe8, 0xdc, 0x9e, 0x4b, 0xb7, 0x60, 0x0f, 0x2a, 0xac, 0x8d, 0xbe,
-	0x0a, 0xd3, 0x49, 0xfa, 0xe8, 0x0a, 0x4c, 0x99, 0x6e, 0xab, 0xe5, 0x3a, 0xf5, 0xce, 0xe6, 0xa6,
-	0xb5, 0x43, 0x14, 0x77, 0xc2, 0x8a, 0x02, 0xc1, 0x89, 0x9a, 0xfa, 0xe7, 0x35, 0x18, 0xa0, 0xeb,
-	0xa2, 0xc3, 0x70, 0xc3, 0x6d, 0x19, 0x96, 0x23, 0x7a, 0xc5, 0x5e, 0x64, 0xab, 0xac, 0x04, 0x0b,
-	0x08, 0x6a, 0xc3, 0x58, 0x28, 0x34, 0xf5, 0x65, 0x3c, 0x5e, 0x5d, 0xad, 0x47, 0x0e, 0x37, 0x11,
-	0x27, 0x0f, 0x4b, 0x7c, 0x1c, 0x13, 0xd1, 0x0d, 0x98, 0xa9, 0xae, 0xd6, 0x6b, 0x8e, 0x69, 0x77,
-	0x1a, 0x64, 0x69, 0x87, 0xfd, 0xa1, 0xbc, 0xc4, 0xe2, 0x25, 0x62, 0x9c, 0x8c, 0x97, 0x88, 0x4a,
-	0x38, 0x84, 0xd1, 0x6a, 0x84, 0xb7, 0x10, 0xde, 0x75, 0xac, 0x9a, 0x40, 0x82, 0x43, 0x98, 0xfe,
-	0xd5, 0x12, 0x8c, 0x4b, 0x1d, 0x42, 0x36, 0x8c, 0xf0, 0xe1, 0xfa, 0xfd, 0x78, 0x50, 0xa7, 0x7a,
-	0xcd, 0xa9, 0xf3, 0x09, 0xf5, 0x71, 0x48, 0x42, 0xe6, 0x8b, 0xa5, 0x2e, 0x7c, 0x71, 0x5e, 0x71,
-	0x52, 0xe4, 0x9f, 0xe4, 0x54, 0xbe, 0x83, 0x22, 0xba, 0x4f, 0x9c, 0x20, 0xdc, 0x7a, 0x7b, 0x34,
-	0x71, 0x7a, 0x6c, 0xc2, 0xd0, 0xeb, 0xae, 0x43, 0x7c, 0xa1, 0xae, 0x3e, 0xa2, 0x01, 0x32, 0xcb,
-	0xb1, 0x97, 0x29, 0x5e, 0xcc, 0xd1, 0xeb, 0x5f, 0xd4, 0x00, 0xaa, 0x46, 0x60, 0x70, 0x33, 0x85,
-	0x1e, 0x6c, 0x08, 0xef, 0x53, 0x0e, 0xbe, 0xd1, 0x94, 0xd3, 0xd8, 0xa0, 0x6f, 0xbd, 0x1e, 0x0e,
-	0x3f, 0x12, 0xa8, 0x39, 0xf6, 0xba, 0xf5, 0x3a, 0xc1, 0x0c, 0x8e, 0x1e, 0x85, 0x31, 0xe2, 0x98,
-	0xde, 0x6e, 0x9b, 0x32, 0x6f, 0xae, 0x85, 0x61, 0x5f, 0xe8, 0x52, 0x58, 0x88, 0x63, 0xb8, 0xfe,
-	0x18, 0xa8, 0xb7, 0xa2, 0x1e, 0x4c, 0x9c, 0xff, 0x42, 0x83, 0xf3, 0xd5, 0x8e, 0x61, 0x2f, 0xb4,
-	0xe9, 0x46, 0x35, 0xec, 0xab, 0x2e, 0x7f, 0x04, 0xa7, 0x57, 0x85, 0xb7, 0xc3, 0x68, 0x28, 0x87,
-	0x08, 0x0c, 0x91, 0xc4, 0x16, 0x32, 0x4a, 0x1c, 0xd5, 0x40, 0x06, 0x8c, 0xfa, 0xa1, 0x64, 0x5c,
-	0xea, 0x43, 0x32, 0x0e, 0x49, 0x44, 0x92, 0x71, 0x84, 0x96, 0xeb, 0xa7, 0xd8, 0x02, 0xd5, 0x89,
-	0xb7, 0x6d, 0x99, 0x64, 0xc1, 0x34, 0xdd, 0x8e, 0x13, 0xf8, 0x42, 0x60, 0x10, 0xfa, 0xa9, 0xac,
-	0x1a, 0x38, 0xa7, 0xa5, 0xfe, 0x65, 0x0d, 0x06, 0x97, 0xd6, 0x2b, 0x55, 0xf4, 0x6d, 0x30, 0x18,
-	0xb1, 0x8c, 0x82, 0x66, 0x2d, 0x14, 0x0f, 0x57, 0xa5, 0xf1, 0xf5, 0x5e, 0xa1, 0x0c, 0x87, 0x61,
-	0x45, 0x1b, 0x30, 0x4c, 0xb6, 0x09, 0xed, 0x6a, 0xe9, 0x48, 0xf0, 0x33, 0x96, 0xb6, 0xc4, 0x30,
-	0x62, 0x81, 0x59, 0xff, 0x94, 0x06, 0x10, 0x57, 0x41, 0xdf, 0x99, 0x75, 0x3a, 0xdd, 0x38, 0x42,
-	0x05, 0x71, 0xf7, 0x23, 0x4a, 0xff, 0xda, 0x20, 0xdc, 0x43, 0xbb, 0x23, 0xb6, 0xaa, 0xe5, 0x3a,
-	0x37, 0xc8, 0xee, 0xdf, 0x18, 0xd3, 0xff, 0x8d, 0x31, 0xfd, 0xd1, 0x19, 0xd3, 0xeb, 0xcf, 0xc2,
-	0x74, 0xbc, 0xbd, 0xc4, 0xbe, 0x7f, 0x34, 0x79, 0x55, 0x1b, 0x0b, 0x85, 0x9a, 0xf4, 0xf5, 0x4a,
-	0xbf, 0xab, 0xc1, 0xf4, 0xd2, 0x4e, 0xdb, 0xf2, 0x98, 0xd3, 0x38, 0xf7, 0x17, 0x41, 0x8f, 0xc4,
-	0x6e, 0x25, 0x9a, 0xfa, 0x16, 0x96, 0x74, 0x2d, 0x41, 0x9b, 0x30, 0x45, 0x58, 0x73, 0x76, 0x97,
-	0x32, 0x82, 0x22, 0x3b, 0x90, 0x47, 0x4a, 0x50, 0xb0, 0xe0, 0x04, 0x56, 0x54, 0x87, 0x29, 0xd3,
-	0x36, 0x7c, 0xdf, 0xda, 0xb4, 0xcc, 0xd8, 0x1d, 0x6a, 0x6c, 0xf1, 0x51, 0x26, 0x16, 0x29, 0x90,
-	0xbb, 0x7b, 0xe5, 0xb3, 0xa2, 0x9f, 0x2a, 0x00, 0x27, 0x50, 0xe8, 0x9f, 0x2d, 0xc1, 0xe4, 0xd2,
-	0x4e, 0xdb, 0xf5, 0x3b, 0x1e, 0x61, 0x55, 0x4f, 0x40, 0x3b, 0xf4, 0x08, 0x8c, 0x6c, 0x19, 0x4e,
-	0xc3, 0x26, 0x9e, 0x38, 0x19, 0xa3, 0xb9, 0xbd, 0xce, 0x8b, 0x71, 0x08, 0x47, 0x6f, 0x00, 0xf8,
-	0xe6, 0x16, 0x69, 0x74, 0x18, 0xff, 0x1a, 0x28, 0xce, 0xbf, 0x94, 0x31, 0xd6, 0x23, 0x94, 0x42,
-	0xea, 0x88, 0x7e, 0x63, 0x89, 0x9c, 0xfe, 0xbb, 0x1a, 0xcc, 0x28, 0xed, 0x4e, 0x40, 0xe9, 0xb1,
-	0xa9, 0x2a, 0x3d, 0x16, 0xfa, 0x1e, 0x6b, 0x8e, 0xae, 0xe3, 0xe3, 0x25, 0x38, 0x9f, 0x33, 0x27,
-	0x29, 0xf3, 0x53, 0xed, 0x84, 0xcc, 0x4f, 0x3b, 0x30, 0x1e, 0xb8, 0xb6, 0xf0, 0xda, 0x0b, 0x67,
-	0xa0, 0xd0, 0x29, 0xb9, 0x1e, 0xa1, 0x89, 0x8d, 0x4b, 0xe3, 0x32, 0x1f, 0xcb, 0x74, 0xf4, 0x5f,
-	0xd2, 0x60, 0x2c, 0xd2, 0xad, 0x7e, 0x43, 0x3d, 0x4b, 0xf7, 0x1e, 0xdc, 0x45, 0xff, 0xd5, 0x12,
-	0x9c, 0x8b, 0x70, 0x87, 0x6c, 0xae, 0x1e, 0x50, 0xbe, 0x71, 0xb0, 0x82, 0xe6, 0x3e, 0xc5, 0x30,
-	0x7e, 0x34, 0xed, 0x61, 0xd7, 0xee, 0x78, 0x6d, 0xd7, 0x0f, 0x45, 0x55, 0x2e, 0xd3, 0xf3, 0x22,
-	0x1c, 0xc2, 0xd0, 0x2a, 0x0c, 0xf9, 0x94, 0x9e, 0x38, 0x8e, 0x0e, 0x39, 0x1b, 0x4c, 0xda, 0x66,
-	0xfd, 0xc5, 0x1c, 0x0d, 0x7a, 0x43, 0xe6, 0xe1, 0x43, 0xc5, 0x55, 0x80, 0x74, 0x24, 0x8d, 0x48,
-	0x58, 0x4d, 0x87, 0x16, 0xc8, 0x3c, 0x13, 0x96, 0x61, 0x5a, 0x18, 0x5e, 0xf2, 0x6d, 0xe3, 0x98,
-	0x04, 0xbd, 0x47, 0xd9, 0x19, 0x0f, 0x26, 0x0c, 0x53, 0xce, 0x24, 0xeb, 0xc7, 0x3b, 0x46, 0xf7,
-	0x61, 0xf4, 0x9a, 0xe8, 0x24, 0x9a, 0x83, 0x92, 0x15, 0xae, 0x05, 0x08, 0x1c, 0xa5, 0x5a, 0x15,
-	0x97, 0xac, 0x1e, 0x1c, 0x14, 0xe4, 0x63, 0x69, 0xa0, 0xfb, 0xb1, 0xa4, 0xff, 0x51, 0x09, 0xce,
-	0x84, 0x54, 0xc3, 0x31, 0x56, 0xc5, 0x9b, 0xf3, 0x01, 0xf7, 0x96, 0x83, 0x15, 0x76, 0x37, 0x61,
-	0x90, 0x31, 0xc0, 0x42, 0x6f, 0xd1, 0x11, 0x42, 0xda, 0x1d, 0xcc, 0x10, 0xa1, 0x0f, 0xc0, 0xb0,
-	0x4d, 0x2f, 0x01, 0xa1, 0xe7, 0x40, 0x21, 0xf5, 0x66, 0xd6, 0x70, 0xf9, 0xdd, 0x42, 0x38, 0x3b,
-	0x45, 0xcf, 0x89, 0xbc, 0x10, 0x0b, 0x9a, 0x73, 0x4f, 0xc2, 0xb8, 0x54, 0xed, 0x50, 0x41, 0xb5,
-	0x3e, 0x5f, 0x82, 0xd9, 0xeb, 0xc4, 0x6e, 0x65, 0x1a, 0x10, 0x94, 0x61, 0xc8, 0xdc, 0x32, 0x3c,
-	0x1e, 0xaf, 0x6d, 0x82, 0x6f, 0xf2, 0x0a, 0x2d, 0xc0, 0xbc, 0x9c, 0xde, 0x09, 0x14, 0x2f, 0xb3,
-	0x67, 0xa4, 0x99, 0x8c, 0x03, 0xf9, 0xbd, 0x3f, 0x8a, 0xf4, 0x17, 0x0f, 0x5c, 0xa9, 0x40, 0x8f,
-	0x97, 0xf7, 0xd6, 0x6f, 0xae, 0x66, 0xf9, 0x97, 0xa1, 0xd7, 0x61, 0xd2, 0x35, 0x2d, 0x4c, 0xda,
-	0xae, 0x6f, 0x05, 0xae, 0xb7, 0x2b, 0x16, 0xad, 0xd0, 0xd1, 0x72, 0xb3, 0x52, 0x8b, 0x11, 0xf1,
-	0x47, 0x38, 0xa5, 0x08, 0xab, 0xa4, 0xf4, 0x2f, 0x69, 0x30, 0x7e, 0xdd, 0xda, 0x20, 0x1e, 0xb7,
-	0x2d, 0x65, 0x4a, 0x0c, 0x25, 0xf2, 0xd8, 0x78, 0x56, 0xd4, 0x31, 0xb4, 0x03, 0x63, 0xe2, 0x1c,
-	0x8e, 0xdc, 0x07, 0xaf, 0x15, 0xb3, 0xba, 0x89, 0x48, 0x8b, 0xf3, 0x4d, 0x8e, 0x29, 0x12, 0x52,
-	0xc0, 0x31, 0x31, 0xfd, 0x0d, 0x38, 0x9d, 0xd1, 0x88, 0x2e, 0x24, 0x33, 0xaf, 0x14, 0x1f, 0x4d,
-	0xc8, 0xad, 0xe8, 0x42, 0xb2, 0x72, 0x74, 0x0f, 0x0c, 0x10, 0xa7, 0x21, 0xbe, 0x98, 0x91, 0xfd,
-	0xbd, 0xf2, 0xc0, 0x92, 0xd3, 0xc0, 0xb4, 0x8c, 0x32, 0x71, 0xdb, 0x55, 0x24, 0x36, 0xc6, 0xc4,
-	0x97, 0x45, 0x19, 0x8e, 0xa0, 0xcc, 0x4e, 0x2a, 0x69, 0x12, 0x44, 0x85, 0xff, 0xe9, 0xcd, 0x04,
-	0x6f, 0xe9, 0xc7, 0x12, 0x29, 0xc9, 0xa7, 0x16, 0x67, 0xc5, 0x84, 0xa4, 0x38, 0x1e, 0x4e, 0xd1,
-	0xd5, 0x7f, 0x61, 0x10, 0xee, 0xbf, 0xee, 0x7a, 0xd6, 0xeb, 0xae, 0x13, 0x18, 0xf6, 0x9a, 0xdb,
-	0x88, 0xad, 0x44, 0xc5, 0x91, 0xf5, 0x31, 0x0d, 0xce, 0x9b, 0xed, 0x0e, 0xbf, 0x3c, 0x84, 0x86,
-	0x96, 0x6b, 0xc4, 0xb3, 0xdc, 0xa2, 0xce, 0x04, 0x2c, 0x8a, 0x54, 0x65, 0xed, 0x56, 0x16, 0x4a,
-	0x9c, 0x47, 0x8b, 0xf9, 0x34, 0x34, 0xdc, 0x3b, 0x0e, 0xeb, 0x5c, 0x3d, 0x60, 0xb3, 0xf9, 0x7a,
-	0xbc, 0x08, 0x05, 0x7d, 0x1a, 0xaa, 0x99, 0x18, 0x71, 0x0e, 0x25, 0xf4, 0x21, 0x38, 0x6b, 0xf1,
-	0xce, 0x61, 0x62, 0x34, 0x2c, 0x87, 0xf8, 0x3e, 0x37, 0x88, 0xee, 0xc3, 0x68, 0xbf, 0x96, 0x85,
-	0x10, 0x67, 0xd3, 0x41, 0xaf, 0x00, 0xf8, 0xbb, 0x8e, 0x29, 0xe6, 0xbf, 0x98, 0x39, 0x27, 0x17,
-	0x91, 0x23, 0x2c, 0x58, 0xc2, 0x48, 0x2f, 0x5a, 0x41, 0xb4, 0x29, 0x87, 0x99, 0x49, 0x2e, 0xbb,
-	0x68, 0xc5, 0x7b, 0x28, 0x86, 0xeb, 0x9f, 0xd2, 0x60, 0xaa, 0xe6, 0xac, 0xd9, 0x86, 0x49, 0xb8,
-	0x75, 0xa2, 0x8f, 0x2e, 0xc1, 0x98, 0x1f, 0x3d, 0x4c, 0x70, 0x8e, 0x10, 0x7f, 0x9f, 0xd1, 0x93,
-	0x44, 0x5c, 0x87, 0x85, 0xaf, 0xb4, 0x1c, 0x71, 0xd8, 0x5d, 0x75, 0x3d, 0x8e, 0x48, 0x7c, 0x77,
-	0x3c, 0x7c, 0x65, 0x1a, 0x8c, 0xb3, 0xda, 0xe8, 0x3f, 0xa3, 0xc1, 0x19, 0xb5, 0x3b, 0xc2, 0x30,
-	0xe0, 0x87, 0x35, 0x38, 0xa3, 0x78, 0x45, 0x0b, 0x70, 0x3f, 0xd1, 0x99, 0xd6, 0x32, 0xf0, 0x71,
-	0x43, 0xd9, 0x2c, 0x08, 0xce, 0xa4, 0xaf, 0xff, 0x63, 0x0d, 0x46, 0x44, 0x00, 0x42, 0xf4, 0xb6,
-	0x84, 0x82, 0x3b, 0x3a, 0xda, 0x12, 0x4a, 0xee, 0x5d, 0x66, 0xe5, 0x20, 0x8e, 0x26, 0x71, 0xca,
-	0x14, 0xd2, 0x90, 0x0a, 0xc2, 0xf1, 0x39, 0xa7, 0x58, 0x3b, 0x84, 0xaf, 0x27, 0x12, 0x31, 0xfd,
-	0x0b, 0x1a, 0xcc, 0xa4, 0x5a, 0xf5, 0x20, 0x8e, 0x9e, 0xa0, 0xdd, 0xe7, 0x6f, 0x0d, 0xd2, 0x2d,
-	0x19, 0x50, 0x7e, 0x6f, 0x73, 0xdd, 0xf3, 0x09, 0xdc, 0x7f, 0x1f, 0x85, 0x31, 0xab, 0xd5, 0xea,
-	0x04, 0xf4, 0xac, 0x13, 0xcf, 0x87, 0xec, 0xa3, 0xa9, 0x85, 0x85, 0x38, 0x86, 0x23, 0x47, 0x48,
-	0x5a, 0xfc, 0x14, 0x5c, 0x2e, 0xb6, 0x72, 0xf2, 0x00, 0xe7, 0xa9, 0x54, 0xc4, 0xc5, 0xa1, 0x2c,
-	0x41, 0xec, 0x7b, 0x34, 0x00, 0x3f, 0xf0, 0x2c, 0xa7, 0x49, 0x0b, 0x85, 0x34, 0x86, 0x8f, 0x80,
-	0x6c, 0x3d, 0x42, 0xca, 0x89, 0xc7, 0x41, 0x09, 0x23, 0x00, 0x96, 0x28, 0xa3, 0x05, 0x21, 0x84,
-	0xf2, 0x23, 0xf3, 0x1d, 0x09, 0x71, 0xfb, 0xfe, 0x0c, 0x7b, 0x52, 0x4e, 0x28, 0x96, 0x52, 0xe7,
-	0x9e, 0x80, 0xb1, 0x88, 0xde, 0x41, 0x42, 0xdd, 0x84, 0x24, 0xd4, 0xcd, 0x3d, 0x0d, 0xa7, 0x12,
-	0xdd, 0x3d, 0x94, 0x4c, 0xf8, 0x1f, 0x34, 0x40, 0xea, 0xe8, 0x4f, 0x40, 0x73, 0xd0, 0x54, 0x35,
-	0x07, 0x8b, 0xfd, 0x2f, 0x59, 0x8e, 0xea, 0xe0, 0xa7, 0x10, 0xb0, 0xf8, 0xac, 0x51, 0xbc, 0x62,
-	0x71, 0xf2, 0x53, 0x41, 0x25, 0x76, 0x48, 0x16, 0x5f, 0x6e, 0x1f, 0x82, 0xca, 0x8d, 0x04, 0xae,
-	0x58, 0x50, 0x49, 0x42, 0x70, 0x8a, 0x2e, 0xfa, 0x84, 0x06, 0xd3, 0x86, 0x1a, 0x9f, 0x35, 0x9c,
-	0x99, 0x42, 0x91, 0xb6, 0x12, 0xb1, 0x5e, 0xe3, 0xbe, 0x24, 0x00, 0x3e, 0x4e, 0x91, 0x45, 0x8f,
-	0xc3, 0x84, 0xd1, 0xb6, 0x16, 0x3a, 0x0d, 0x8b, 0xde, 0x3c, 0xc3, 0x30, 0x96, 0x4c, 0x1b, 0xb2,
-	0xb0, 0x56, 0x8b, 0xca, 0xb1, 0x52, 0x2b, 0x0a, 0x84, 0x5a, 0x89, 0xcd, 0x6f, 0xfb, 0x09, 0x84,
-	0x2a, 0xe6, 0x30, 0x0e, 0x84, 0x2a, 0xa6, 0x4e, 0x26, 0x82, 0x1c, 0x00, 0xd7, 0x6a, 0x98, 0x82,
-	0xe4, 0x70, 0xf1, 0x67, 0x8a, 0x9b, 0xb5, 0x6a, 0x45, 0x50, 0x64, 0xe2, 0x43, 0xfc, 0x1b, 0x4b,
-	0x14, 0xd0, 0x67, 0x34, 0x98, 0x14, 0xbc, 0x5b, 0xd0, 0x1c, 0x61, 0x4b, 0xf4, 0x72, 0xd1, 0xfd,
-	0x92, 0xd8, 0x93, 0xf3, 0x58, 0x46, 0xce, 0xf9, 0x4e, 0xe4, 0xcf, 0xae, 0xc0, 0xb0, 0xda, 0x0f,
-	0x26, 0x03, 0xf8, 0xca, 0x3b, 0x91, 0xe8, 0xe0, 0x68, 0x71, 0x19, 0xa0, 0x9e, 0x81, 0x4f, 0xb8,
-	0xea, 0x64, 0x40, 0x70, 0x26, 0x7d, 0x2a, 0xd7, 0x9e, 0xba, 0x63, 0x04, 0xe6, 0x56, 0xc5, 0x30,
-	0xb7, 0xd8, 0x33, 0x21, 0x77, 0xf9, 0x2b, 0xb8, 0xaf, 0x5f, 0x54, 0x51, 0x71, 0x83, 0x9b, 0x44,
-	0x21, 0x4e, 0x12, 0x44, 0x2e, 0x8c, 0x7a, 0x22, 0x48, 0xb9, 0x70, 0xc8, 0x2e, 0x16, 0x97, 0x3b,
-	0x19, 0xf1, 0x9c, 0xdf, 0x8c, 0xc2, 0x5f, 0x38, 0x22, 0x82, 0x9a, 0x70, 0x3f, 0xbf, 0x1b, 0x2e,
-	0x38, 0xae, 0xb3, 0xdb, 0x72, 0x3b, 0xfe, 0x42, 0x27, 0xd8, 0x22, 0x4e, 0x10, 0xaa, 0xc2, 0xc7,
-	0xd9, 0x31, 0xca, 0x5c, 0xcf, 0x96, 0xba, 0x55, 0xc4, 0xdd, 0xf1, 0xa0, 0x97, 0x60, 0x94, 0x3d,
-	0xa5, 0xad, 0xaf, 0x2f, 0x33, 0xef, 0xc1, 0xc3, 0x8b, 0xcb, 0x6c, 0x08, 0x4b, 0x02, 0x07, 0x8e,
-	0xb0, 0xa1, 0xdb, 0x30, 0x62, 0xf3, 0x28, 0xf3, 0xcc, 0x8b, 0xb0, 0x20, 0x53, 0x4c, 0x46, 0xac,
-	0xe7, 0x17, 0x68, 0xf1, 0x03, 0x87, 0x14, 0x50, 0x1b, 0x2e, 0x36, 0xc8, 0xa6, 0xd1, 0xb1, 0x83,
-	0x55, 0x37, 0xc0, 0xcc, 0xcf, 0x2b, 0xd2, 0x78, 0x86, 0x8e, 0xa2, 0x53, 0x2c, 0x98, 0x1a, 0xf3,
-	0xa0, 0xab, 0x1e, 0x50, 0x17, 0x1f, 0x88, 0x0d, 0xed, 0xc2, 0x03, 0xa2, 0x0e, 0x73, 0x2c, 0x33,
-	0xb7, 0xe8, 0x2c, 0xa7, 0x89, 0x9e, 0x62, 0x44, 0xff, 0xd6, 0xfe, 0x5e, 0xf9, 0x81, 0xea, 0xc1,
-	0xd5, 0x71, 0x2f, 0x38, 0x99, 0xaf, 0x0e, 0x49, 0x3c, 0x01, 0xcd, 0x4e, 0x17, 0x9f, 0xe3, 0xe4,
-	0x73, 0x12, 0xb7, 0x0a, 0x4b, 0x96, 0xe2, 0x14, 0x4d, 0xf4, 0x93, 0x1a, 0xcc, 0xfa, 0x81, 0xd7,
-	0x31, 0x83, 0x8e, 0x47, 0x1a, 0x89, 0x1d, 0x3a, 0xc3, 0x3a, 0x54, 0x48, 0x80, 0xab, 0xe7, 0xe0,
-	0x64, 0x2e, 0xcb, 0xb3, 0x79, 0x50, 0x9c, 0xdb, 0x17, 0xf4, 0x63, 0x1a, 0x9c, 0x57, 0x81, 0xf4,
-	0x4e, 0xcf, 0xfb, 0x89, 0x8a, 0x3f, 0xb2, 0xd4, 0xb3, 0x51, 0xf2, 0x1b, 0x7c, 0x0e, 0x10, 0xe7,
-	0x75, 0x24, 0xf9, 0x78, 0x7d, 0xfa, 0x84, 0x1f, 0xaf, 0xe7, 0x9e, 0x03, 0x94, 0x3e, 0x3e, 0x0e,
-	0x92, 0x03, 0x47, 0x65, 0x39, 0xf0, 0x73, 0x43, 0x70, 0x2f, 0x3d, 0x95, 0xe2, 0xdb, 0xcf, 0x8a,
-	0xe1, 0x18, 0xcd, 0x6f, 0x4c, 0x89, 0xe9, 0x4b, 0x1a, 0x9c, 0xdf, 0xca, 0x56, 0xed, 0x88, 0xfb,
-	0xd7, 0xf3, 0x85, 0x54, 0x70, 0xdd, 0xb4, 0x45, 0x9c, 0x61, 0x77, 0xad, 0x82, 0xf3, 0x3a, 0x85,
-	0x9e, 0x83, 0x69, 0xc7, 0x6d, 0x90, 0x4a, 0xad, 0x8a, 0x57, 0x0c, 0xff, 0x76, 0x3d, 0xb4, 0xa5,
-	0x19, 0xe2, 0xdf, 0xeb, 0x6a, 0x02, 0x86, 0x53, 0xb5, 0xd1, 0x36, 0xa0, 0xb6, 0xdb, 0x58, 0xda,
-	0xe6, 0xd9, 0x18, 0xfa, 0xb3, 0x1c, 0x65, 0xef, 0xf9, 0x6b, 0x29, 0x6c, 0x38, 0x83, 0x02, 0xd3,
-	0x4d, 0xd1, 0xce, 0xac, 0xb8, 0x8e, 0x15, 0xb8, 0x1e, 0x73, 0xc2, 0xef, 0x4b, 0x45, 0xc3, 0x74,
-	0x53, 0xab, 0x99, 0x18, 0x71, 0x0e, 0x25, 0xfd, 0x7f, 0x6a, 0x70, 0x8a, 0x6e, 0x8b, 0x35, 0xcf,
-	0xdd, 0xd9, 0xfd, 0x46, 0xdc, 0x90, 0x8f, 0x08, 0xb3, 0x42, 0xae, 0xdb, 0x39, 0x2b, 0x99, 0x14,
-	0x8e, 0xb1, 0x3e, 0xc7, 0x56, 0x84, 0xb2, 0x5a, 0x79, 0x20, 0x5f, 0xad, 0xac, 0x7f, 0xa6, 0xc4,
-	0x6f, 0x2e, 0xa1, 0x5a, 0xf7, 0x1b, 0xf2, 0x3b, 0x7c, 0x02, 0x26, 0x69, 0xd9, 0x8a, 0xb1, 0xb3,
-	0x56, 0x7d, 0xc1, 0xb5, 0x43, 0x9f, 0x66, 0xa6, 0x6b, 0xbf, 0x21, 0x03, 0xb0, 0x5a, 0x0f, 0x5d,
-	0x81, 0x91, 0x36, 0x0f, 0x5b, 0x25, 0xee, 0xcc, 0x17, 0xb9, 0xed, 0x1d, 0x2b, 0xba, 0xbb, 0x57,
-	0x9e, 0x89, 0x9f, 0x78, 0xc3, 0xe0, 0x59, 0x61, 0x03, 0xfd, 0x2f, 0x4f, 0x03, 0x43, 0x6e, 0x93,
-	0xe0, 0x1b, 0x71, 0x4e, 0x1e, 0x83, 0x71, 0xb3, 0xdd, 0xa9, 0x5c, 0xad, 0x3f, 0xdf, 0x71, 0x99,
-	0x2e, 0x84, 0x25, 0x2d, 0xa1, 0xdc, 0xbb, 0xb2, 0x76, 0x2b, 0x2c, 0xc6, 0x72, 0x1d, 0xca, 0x1d,
-	0xcc, 0x76, 0x47, 0xf0, 0xdb, 0x35, 0xd9, 0xeb, 0x83, 0x71, 0x87, 0xca, 0xda, 0x2d, 0x05, 0x86,
-	0x53, 0xb5, 0xd1, 0x87, 0x60, 0x82, 0x88, 0x0f, 0xf7, 0xba, 0xe1, 0x35, 0x04, 0x5f, 0xa8, 0x15,
-	0x1d, 0x7c, 0x34, 0xb5, 0x21, 0x37, 0xe0, 0x37, 0xc0, 0x25, 0x89, 0x04, 0x56, 0x08, 0xa2, 0xf7,
-	0xc1, 0x3d, 0xe1, 0x6f, 0xba, 0xca, 0x6e, 0x23, 0xc9, 0x28, 0x86, 0x78, 0x80, 0x9b, 0xa5, 0xbc,
-	0x4a, 0x38, 0xbf, 0x3d, 0xfa, 0x69, 0x0d, 0xce, 0x45, 0x50, 0xcb, 0xb1, 0x5a, 0x9d, 0x16, 0x26,
-	0xa6, 0x6d, 0x58, 0x2d, 0x71, 0xef, 0x7b, 0xf1, 0xc8, 0x06, 0xaa, 0xa2, 0xe7, 0xcc, 0x2a, 0x1b,
-	0x86, 0x73, 0xba, 0x84, 0xbe, 0xa0, 0xc1, 0xc5, 0x10, 0xb4, 0xe6, 0x11, 0xdf, 0xef, 0x78, 0x24,
-	0xf6, 0xa8, 0x17, 0x53, 0x32, 0x52, 0x88, 0x77, 0x32, 0x01, 0x78, 0xe9, 0x00, 0xdc, 0xf8, 0x40,
-	0xea, 0xf2, 0x76, 0xa9, 0xbb, 0x9b, 0x81, 0xb8, 0x28, 0x1e, 0xd7, 0x76, 0xa1, 0x24, 0xb0, 0x42,
-	0x10, 0xfd, 0x8c, 0x06, 0xe7, 0xe5, 0x02, 0x79, 0xb7, 0xf0, 0x1b, 0xe2, 0x4b, 0x47, 0xd6, 0x99,
-	0x04, 0x7e, 0x2e, 0xe1, 0xe5, 0x00, 0x71, 0x5e, 0xaf, 0x28, 0xdb, 0x6e, 0xb1, 0x8d, 0xc9, 0x6f,
-	0x91, 0x43, 0x9c, 0x6d, 0xf3, 0xbd, 0xea, 0xe3, 0x10, 0x86, 0x1e, 0x87, 0x89, 0xb6, 0xdb, 0x58,
-	0xb3, 0x1a, 0x3e, 0x0b, 0xa9, 0xc5, 0xee, 0x7a, 0x03, 0x7c, 0x3a, 0xd6, 0xdc, 0xc6, 0x5a, 0xad,
-	0xca, 0xcb, 0xb1, 0x52, 0x0b, 0xcd, 0x03, 0x6c, 0x1a, 0x96, 0x5d, 0xbf, 0x63, 0xb4, 0x6f, 0x86,
-	0x81, 0x5b, 0x98, 0x2e, 0xe2, 0x6a, 0x54, 0x8a, 0xa5, 0x1a, 0x74, 0xfd, 0x28, 0xdf, 0xc1, 0x84,
-	0xc7, 0x8f, 0x66, 0xd7, 0xa3, 0xa3, 0x58, 0xbf, 0x10, 0x21, 0xef, 0xf0, 0x0d, 0x89, 0x04, 0x56,
-	0x08, 0xa2, 0x8f, 0x69, 0x30, 0xe5, 0xef, 0xfa, 0x01, 0x69, 0x45, 0x7d, 0x38, 0x75, 0xd4, 0x7d,
-	0x60, 0x3a, 0xf1, 0xba, 0x42, 0x04, 0x27, 0x88, 0xb2, 0x10, 0x38, 0x2d, 0xa3, 0x49, 0xae, 0x55,
-	0xae, 0x5b, 0xcd, 0xad, 0x28, 0x46, 0xca, 0x1a, 0xf1, 0x4c, 0xe2, 0x04, 0xec, 0x62, 0x35, 0x24,
-	0x42, 0xe0, 0xe4, 0x57, 0xc3, 0xdd, 0x70, 0xa0, 0x57, 0x60, 0x4e, 0x80, 0x97, 0xdd, 0x3b, 0x29,
-	0x0a, 0x33, 0x8c, 0x02, 0xb3, 0x51, 0xac, 0xe5, 0xd6, 0xc2, 0x5d, 0x30, 0xa0, 0x1a, 0x9c, 0xf6,
-	0x89, 0xc7, 0xde, 0x04, 0x79, 0x80, 0xc2, 0xb5, 0x8e, 0x6d, 0xfb, 0xec, 0x6a, 0x23, 0x3c, 0x5f,
-	0xea, 0x69, 0x30, 0xce, 0x6a, 0x83, 0x9e, 0x8e, 0x9c, 0x6b, 0x77, 0x69, 0xc1, 0xf3, 0x6b, 0x75,
-	0x76, 0x13, 0x19, 0xe2, 0x9a, 0x12, 0xac, 0x82, 0x70, 0xb2, 0x2e, 0x3d, 0xcd, 0xc3, 0xa2, 0xc5,
-	0x8e, 0xe7, 0x07, 0xb3, 0x67, 0x58, 0x63, 0x76, 0x9a, 0x63, 0x19, 0x80, 0xd5, 0x7a, 0xe8, 0x0a,
-	0x4c, 0xf9, 0xc4, 0x34, 0xdd, 0x56, 0x5b, 0xdc, 0x93, 0x67, 0xcf, 0xb2, 0xde, 0xf3, 0x15, 0x54,
-	0x20, 0x38, 0x51, 0x13, 0xed, 0xc2, 0xe9, 0x28, 0x16, 0xee, 0xb2, 0xdb, 0x5c, 0x31, 0x76, 0x98,
-	0x70, 0x7c, 0xae, 0x48, 0xa0, 0x04, 0x3e, 0x5d, 0x95, 0x34, 0x3a, 0x9c, 0x45, 0x03, 0x2d, 0xc3,
-	0x99, 0x44, 0xf1, 0x55, 0xcb, 0x26, 0xfe, 0xec, 0x79, 0x36, 0x6c, 0xa6, 0xec, 0xaa, 0x64, 0xc0,
-	0x71, 0x66, 0x2b, 0x74, 0x13, 0xce, 0xb6, 0x3d, 0x37, 0x20, 0x66, 0x70, 0x83, 0x0a, 0x04, 0xb6,
-	0x18, 0xa0, 0x3f, 0x3b, 0xcb, 0xe6, 0x82, 0xbd, 0x87, 0xae, 0x65, 0x55, 0xc0, 0xd9, 0xed, 0xd0,
-	0xe7, 0x34, 0xb8, 0xe0, 0x07, 0x1e, 0x31, 0x5a, 0x96, 0xd3, 0xac, 0xb8, 0x8e, 0x43, 0x18, 0x63,
-	0xaa, 0x35, 0x62, 0xc7, 0xb1, 0x7b, 0x0a, 0x9d, 0x22, 0xfa, 0xfe, 0x5e, 0xf9, 0x42, 0xbd, 0x2b,
-	0x66, 0x7c, 0x00, 0x65, 0xf4, 0x06, 0x40, 0x8b, 0xb4, 0x5c, 0x6f, 0x97, 0x72, 0xa4, 0xd9, 0xb9,
-	0xe2, 0xf7, 0xdd, 0x95, 0x08, 0x0b, 0xff, 0xfc, 0x95, 0x97, 0xdc, 0x18, 0x88, 0x25, 0x72, 0xfa,
-	0x5e, 0x09, 0xce, 0x66, 0xb2, 0x7a, 0xfa, 0x05, 0xf0, 0x7a, 0x0b, 0x61, 0x66, 0x25, 0xf1, 0x76,
-	0xc7, 0xbe, 0x80, 0x15, 0x15, 0x84, 0x93, 0x75, 0xa9, 0x20, 0xc6, 0xbe, 0xd4, 0xab, 0xf5, 0xb8,
-	0x7d, 0x29, 0x16, 0xc4, 0x6a, 0x09, 0x18, 0x4e, 0xd5, 0x46, 0x15, 0x98, 0x11, 0x65, 0x35, 0x7a,
-	0x97, 0xf1, 0xaf, 0x7a, 0x24, 0x14, 0x71, 0xe9, 0xad, 0x60, 0xa6, 0x96, 0x04, 0xe2, 0x74, 0x7d,
-	0x3a, 0x0a, 0xfa, 0x43, 0xee, 0xc5, 0x60, 0x3c, 0x8a, 0x55, 0x15, 0x84, 0x93, 0x75, 0xc3, 0xcb,
-	0xa6, 0xd2, 0x85, 0xa1, 0x78, 0x14, 0xab, 0x09, 0x18, 0x4e, 0xd5, 0xd6, 0x7f, 0x6f, 0x10, 0x1e,
-	0xe8, 0x41, 0x3c, 0x42, 0xad, 0xec, 0xe9, 0x3e, 0xfc, 0x87, 0xdb, 0xdb, 0xf2, 0xb4, 0x73, 0x96,
-	0xe7, 0xf0, 0xf4, 0x7a, 0x5d, 0x4e, 0x3f, 0x6f, 0x39, 0x0f, 0x4f, 0xb2, 0xf7, 0xe5, 0x6f, 0x65,
-	0x2f, 0x7f, 0xc1, 0x59, 0x3d, 0x70, 0xbb, 0xb4, 0x73, 0xb6, 0x4b, 0xc1, 0x59, 0xed, 0x61, 0x7b,
-	0xfd, 0xfe, 0x20, 0x3c, 0xd8, 0x8b, 0xa8, 0x56, 0x70, 0x7f, 0x65, 0xb0, 0xbc, 0x63, 0xdd, 0x5f,
-	0x79, 0xbe, 0xb9, 0xc7, 0xb8, 0xbf, 0x32, 0x48, 0x1e, 0xf7, 0xfe, 0xca, 0x9b, 0xd5, 0xe3, 0xda,
-	0x5f, 0x79, 0xb3, 0xda, 0xc3, 0xfe, 0xfa, 0xd3, 0xe4, 0xf9, 0x10, 0xc9, 0x8b, 0x35, 0x18, 0x30,
-	0xdb, 0x9d, 0x82, 0x4c, 0x8a, 0x99, 0xca, 0x55, 0xd6, 0x6e, 0x61, 0x8a, 0x03, 0x61, 0x18, 0xe6,
-	0xfb, 0xa7, 0x20, 0x0b, 0x62, 0xe6, 0x8f, 0x7c, 0x4b, 0x62, 0x81, 0x89, 0x4e, 0x15, 0x69, 0x6f,
-	0x91, 0x16, 0xf1, 0x0c, 0xbb, 0x1e, 0xb8, 0x9e, 0xd1, 0x2c, 0xca, 0x6d, 0xf8, 0x33, 0x40, 0x02,
-	0x17, 0x4e, 0x61, 0xa7, 0x13, 0xd2, 0xb6, 0x1a, 0x05, 0xf9, 0x0b, 0x9b, 0x90, 0xb5, 0x5a, 0x15,
-	0x53, 0x1c, 0xfa, 0x17, 0xc7, 0x40, 0x0a, 0xf8, 0x8d, 0x3e, 0xa9, 0xc1, 0x8c, 0x99, 0x0c, 0xcf,
-	0xd8, 0x8f, 0x51, 0x4f, 0x2a, 0xd6, 0x23, 0xdf, 0xf2, 0xa9, 0x62, 0x9c, 0x26, 0x8b, 0x3e, 0xac,
-	0x71, 0x4d, 0x55, 0xf4, 0x24, 0x25, 0xa6, 0xf5, 0xda, 0x11, 0x3d, 0xde, 0xc6, 0x2a, 0xaf, 0xf8,
-	0x9d, 0x50, 0x25, 0x88, 0xbe, 0xa0, 0xc1, 0xd9, 0xdb, 0x59, 0x0a, 0x76, 0x31, 0xf9, 0x37, 0x8b,
-	0x76, 0x25, 0x47, 0x63, 0xcf, 0x25, 0xce, 0xcc, 0x0a, 0x38, 0xbb, 0x23, 0xd1, 0x2c, 0x45, 0x3a,
-	0x47, 0xf1, 0x9d, 0x16, 0x9e, 0xa5, 0x84, 0xf2, 0x32, 0x9e, 0xa5, 0x08, 0x80, 0x55, 0x82, 0xa8,
-	0x0d, 0x63, 0xb7, 0x43, 0x45, 0xaf, 0x50, 0xee, 0x54, 0x8a, 0x52, 0x97, 0xb4, 0xc5, 0xdc, 0x68,
-	0x29, 0x2a, 0xc4, 0x31, 0x11, 0xb4, 0x05, 0x23, 0xb7, 0x39, 0xaf, 0x10, 0x4a, 0x99, 0x85, 0xbe,
-	0xaf, 0xb0, 0x5c, 0x37, 0x20, 0x8a, 0x70, 0x88, 0x5e, 0x36, 0x88, 0x1f, 0x3d, 0xc0, 0x4f, 0xeb,
-	0x73, 0x1a, 0x9c, 0xdd, 0x26, 0x5e, 0x60, 0x99, 0xc9, 0xe7, 0x8d, 0xb1, 0xe2, 0xd7, 0xec, 0x17,
-	0xb2, 0x10, 0xf2, 0x6d, 0x92, 0x09, 0xc2, 0xd9, 0x5d, 0xa0, 0x97, 0x6e, 0xae, 0xa5, 0xae, 0x07,
-	0x46, 0x60, 0x99, 0xeb, 0xee, 0x6d, 0x25, 0xf8, 0x1a, 0xc4, 0x71, 0x67, 0x97, 0xf2, 0xab, 0xe1,
-	0x6e, 0x38, 0xd0, 0x0b, 0x30, 0x48, 0x02, 0xb3, 0x21, 0x82, 0xf1, 0xbe, 0xa7, 0xa8, 0xf7, 0x29,
-	0xf7, 0x0f, 0xa1, 0xff, 0x61, 0x86, 0x4f, 0xff, 0x63, 0x0d, 0x52, 0x3a, 0x5c, 0xf4, 0xfd, 0x1a,
-	0x4c, 0x6c, 0x12, 0x23, 0xe8, 0x78, 0xe4, 0x9a, 0xb0, 0x9d, 0x1c, 0x78, 0x78, 0xfc, 0xf2, 0x0b,
-	0x47, 0xa1, 0x3a, 0x9e, 0xbf, 0x2a, 0x21, 0xe6, 0x46, 0x1d, 0x51, 0xec, 0x73, 0x19, 0x84, 0x95,
-	0x1e, 0xcc, 0x3d, 0x0b, 0x33, 0xa9, 0x86, 0x87, 0x7a, 0xce, 0xfb, 0x57, 0x1a, 0x64, 0x25, 0xa6,
-	0x46, 0xaf, 0xc0, 0x90, 0xd1, 0x68, 0x44, 0x39, 0x1d, 0x9f, 0x2c, 0x66, 0x5f, 0xd4, 0x90, 0xe3,
-	0xd2, 0xb0, 0x9f, 0x98, 0xa3, 0x45, 0x57, 0x01, 0x19, 0xca, 0xfb, 0xed, 0x4a, 0x1c, 0x6d, 0x81,
-	0x3d, 0x3b, 0x2d, 0xa4, 0xa0, 0x38, 0xa3, 0x85, 0xfe, 0x71, 0x0d, 0x50, 0x3a, 0xb3, 0x04, 0xf2,
-	0x60, 0x54, 0x7c, 0x22, 0xe1, 0x2a, 0x55, 0x0b, 0x7a, 0x9d, 0x29, 0x2e, 0x94, 0xb1, 0xb1, 0x9a,
-	0x28, 0xf0, 0x71, 0x44, 0x47, 0xff, 0x73, 0x0d, 0xe2, 0xbc, 0x6f, 0xe8, 0x5d, 0x30, 0xde, 0x20,
-	0xbe, 0xe9, 0x59, 0xed, 0x20, 0x76, 0xb8, 0x8c, 0x1c, 0xb7, 0xaa, 0x31, 0x08, 0xcb, 0xf5, 0x90,
-	0x0e, 0xc3, 0x81, 0xe1, 0xdf, 0xae, 0x55, 0xc5, 0x7d, 0x92, 0x9d, 0xfe, 0xeb, 0xac, 0x04, 0x0b,
-	0x48, 0x1c, 0xa8, 0x74, 0xa0, 0x87, 0x40, 0xa5, 0x68, 0xf3, 0x08, 0xa2, 0xb2, 0xa2, 0x83, 0x23,
-	0xb2, 0xea, 0x3f, 0x51, 0x82, 0x53, 0xb4, 0xca, 0x8a, 0x61, 0x39, 0x01, 0x71, 0x98, 0x7b, 0x51,
-	0xc1, 0x49, 0x68, 0xc2, 0x64, 0xa0, 0xf8, 0xdf, 0x1e, 0xde, 0xf9, 0x34, 0xb2, 0x88, 0x52, 0xbd,
-	0x6e, 0x55, 0xbc, 0xe8, 0xc9, 0xd0, 0xbf, 0x8b, 0xdf, 0xbc, 0x1f, 0x08, 0xb7, 0x2a, 0x73, 0xda,
-	0xba, 0x2b, 0x9c, 0x99, 0xa3, 0x64, 0x81, 0x8a, 0x2b, 0xd7, 0x13, 0x30, 0x29, 0x3c, 0x09, 0x78,
-	0xc4, 0x59, 0x71, 0xf3, 0x66, 0x27, 0xd7, 0x55, 0x19, 0x80, 0xd5, 0x7a, 0xfa, 0x6f, 0x96, 0x40,
-	0x4d, 0x49, 0x58, 0x74, 0x96, 0xd2, 0xe1, 0x76, 0x4b, 0xc7, 0x16, 0x6e, 0xf7, 0xed, 0x2c, 0x9f,
-	0x2f, 0x4f, 0x47, 0xcf, 0xdf, 0xa3, 0xe5, 0x2c, 0xbc, 0x3c, 0x99, 0x7c, 0x54, 0x23, 0x9e, 0xd6,
-	0xc1, 0x43, 0x4f, 0xeb, 0xbb, 0x84, 0x85, 0xec, 0x90, 0x12, 0xf4, 0x38, 0xb4, 0x90, 0x9d, 0x51,
-	0x1a, 0x4a, 0xde, 0x68, 0x0b, 0x20, 0x52, 0x53, 0xd0, 0x75, 0x11, 0x01, 0x99, 0xfd, 0x75, 0x37,
-	0x30, 0x6c, 0x36, 0x9d, 0x42, 0x39, 0xb9, 0x22, 0x03, 0xb0, 0x5a, 0x4f, 0x5f, 0x85, 0xb7, 0x2e,
-	0xbb, 0x46, 0x63, 0xd1, 0xb0, 0xe9, 0xd6, 0xf5, 0x84, 0xf9, 0x9a, 0xcf, 0x0e, 0xff, 0x35, 0xcf,
-	0x0d, 0x5c, 0xd3, 0xb5, 0xe9, 0xd1, 0x6c, 0x44, 0x91, 0x69, 0xd9, 0x83, 0x5d, 0x78, 0x34, 0x8b,
-	0xc8, 0xae, 0x38, 0x84, 0xeb, 0x5f, 0xd1, 0x60, 0x44, 0x64, 0x78, 0xe9, 0xc1, 0x01, 0x73, 0x13,
-	0x86, 0xd8, 0x05, 0xac, 0x1f, 0xc1, 0xb7, 0xbe, 0xe5, 0xba, 0x81, 0x92, 0xe7, 0x86, 0x67, 0x8a,
-	0x62, 0x59, 0x11, 0x39, 0x7a, 0x66, 0xb7, 0xe9, 0x99, 0x5b, 0x56, 0x40, 0x98, 0x79, 0x8a, 0xd8,
-	0xf8, 0xdc, 0x6e, 0x53, 0x2a, 0xc7, 0x4a, 0x2d, 0xfd, 0x7f, 0x0c, 0xc1, 0x45, 0x81, 0x38, 0x25,
-	0x0d, 0x46, 0x3c, 0x77, 0x17, 0x4e, 0x8b, 0xed, 0x56, 0xf5, 0x0c, 0x2b, 0x32, 0x3d, 0x28, 0x76,
-	0x11, 0xe7, 0x6e, 0x0f, 0x69, 0x74, 0x38, 0x8b, 0x06, 0x8f, 0xf5, 0xcd, 0x8a, 0xaf, 0x13, 0xc3,
-	0x0e, 0xb6, 0x42, 0xda, 0xa5, 0x7e, 0x62, 0x7d, 0xa7, 0xf1, 0xe1, 0x4c, 0x2a, 0xcc, 0xf4, 0x41,
-	0x00, 0x2a, 0x1e, 0x31, 0x64, 0xbb, 0x8b, 0x3e, 0xdc, 0x72, 0x56, 0x32, 0x31, 0xe2, 0x1c, 0x4a,
-	0x4c, 0xa3, 0x69, 0xec, 0x30, 0x05, 0x09, 0x26, 0x81, 0x67, 0xb1, 0x7c, 0x45, 0x91, 0x4e, 0x7f,
-	0x45, 0x05, 0xe1, 0x64, 0x5d, 0x74, 0x05, 0xa6, 0x98, 0x29, 0x49, 0x1c, 0x3c, 0x72, 0x28, 0x8e,
-	0x4f, 0xb4, 0xaa, 0x40, 0x70, 0xa2, 0x26, 0xfa, 0x2e, 0x0d, 0xce, 0x58, 0xb2, 0xd3, 0x49, 0x38,
-	0xfa, 0x62, 0x59, 0x2e, 0x98, 0x44, 0x18, 0x6e, 0xe3, 0x0c, 0xb4, 0x38, 0x93, 0x18, 0x5a, 0x86,
-	0x33, 0xc2, 0x73, 0x58, 0xdd, 0x03, 0x3c, 0x34, 0x18, 0x5b, 0xd3, 0x6a, 0x06, 0x1c, 0x67, 0xb6,
-	0xd2, 0x3f, 0x52, 0x82, 0x89, 0x43, 0x66, 0xed, 0xec, 0x48, 0x32, 0x47, 0x1f, 0xfe, 0x7d, 0x32,
-	0xd5, 0x1e, 0xc4, 0x0e, 0xf4, 0x12, 0x4c, 0x75, 0xd8, 0x44, 0x84, 0x41, 0xbd, 0xc4, 0x37, 0xfd,
-	0xcd, 0x74, 0xe5, 0x6e, 0x29, 0x90, 0xbb, 0x7b, 0xe5, 0x39, 0x19, 0xbd, 0x0a, 0xc5, 0x09, 0x3c,
-	0xfa, 0x0f, 0x0c, 0xc1, 0xe9, 0x8c, 0xde, 0x30, 0x33, 0x0a, 0x92, 0x90, 0x8c, 0xfa, 0x31, 0xa3,
-	0x48, 0x49, 0x59, 0x91, 0x19, 0x45, 0x12, 0x82, 0x53, 0x74, 0xd1, 0x0b, 0x30, 0x60, 0x7a, 0x96,
-	0x98, 0xf0, 0x27, 0x0a, 0xe9, 0x0b, 0x70, 0x6d, 0x71, 0x5c, 0x50, 0x1c, 0xa8, 0xe0, 0x1a, 0xa6,
-	0x08, 0xe9, 0x39, 0x22, 0xb3, 0xc0, 0x50, 0xd8, 0x62, 0xe7, 0x88, 0xcc, 0x29, 0x7d, 0xac, 0xd6,
-	0x43, 0x2f, 0xc1, 0xac, 0xb8, 0xc8, 0x85, 0xd1, 0x2a, 0x5c, 0xc7, 0x0f, 0x28, 0xb7, 0x0a, 0xc4,
-	0x79, 0xc8, 0x2c, 0x1c, 0x6f, 0xe4, 0xd4, 0xc1, 0xb9, 0xad, 0xd1, 0x77, 0xc2, 0x94, 0xb2, 0xf3,
-	0xc3, 0xe0, 0x50, 0x05, 0xdd, 0x22, 0x64, 0x4c, 0xfc, 0x3b, 0x57, 0xcb, 0x70, 0x82, 0x1a, 0x8b,
-	0xde, 0x69, 0xca, 0xa9, 0x17, 0xc3, 0x8c, 0x61, 0x0b, 0x7d, 0x27, 0x71, 0x8c, 0x05, 0x11, 0xa5,
-	0xd8, 0xc7, 0x09, 0x82, 0xfa, 0x0f, 0x0d, 0x83, 0x9c, 0x70, 0x17, 0xad, 0xf4, 0xa3, 0xa8, 0x8b,
-	0x57, 0x3d, 0x54, 0xd6, 0xad, 0xc0, 0x40, 0xb3, 0xdd, 0x29, 0xa8, 0xa9, 0x8b, 0xd0, 0x5d, 0xa3,
-	0xe8, 0x9a, 0xed, 0x0e, 0x7a, 0x21, 0xd2, 0xfd, 0x15, 0xd3, 0xce, 0x45, 0x0e, 0x70, 0x09, 0xfd,
-	0x5f, 0xc8, 0x8c, 0x06, 0x73, 0x99, 0x51, 0x0b, 0x46, 0x7c, 0xa1, 0x18, 0x1c, 0x2a, 0x1e, 0xbf,
-	0x4f, 0x9a, 0x69, 0xa1, 0x08, 0xe4, 0x2a, 0x8b, 0x50, 0x4f, 0x18, 0xd2, 0xa0, 0xd7, 0x96, 0x0e,
-	0xe3, 0xa2, 0x8c, 0xe7, 0x8f, 0xf2, 0x6b, 0xcb, 0x2d, 0x56, 0x82, 0x05, 0x24, 0x25, 0x7a, 0x8c,
-	0xf4, 0x22, 0x7a, 0xa0, 0xcf, 0x27, 0x53, 0x8c, 0x8d, 0xb2, 0x2d, 0xf7, 0x7c, 0x9f, 0xc3, 0xf9,
-	0x2b, 0x96, 0x35, 0xf4, 0x6f, 0x97, 0x00, 0xa5, 0x17, 0x0b, 0x3d, 0x00, 0x43, 0x2c, 0x36, 0x8e,
-	0x38, 0xb5, 0xa2, 0xab, 0x38, 0x8b, 0x8e, 0x82, 0x39, 0x0c, 0xd5, 0x45, 0xcc, 0xb6, 0x62, 0x9b,
-	0x9e, 0x59, 0xac, 0x09, 0x7a, 0x52, 0x80, 0xb7, 0x8b, 0x8a, 0xa7, 0x5b, 0x96, 0xc4, 0x7b, 0x0b,
-	0x46, 0x5a, 0x96, 0xc3, 0x1e, 0xf1, 0x8b, 0x69, 0x95, 0xb9, 0x61, 0x0d, 0x47, 0x81, 0x43, 0x5c,
-	0xfa, 0xef, 0x97, 0x28, 0x83, 0x88, 0xaf, 0xa0, 0xbb, 0x00, 0x46, 0x27, 0x70, 0x85, 0x4f, 0xad,
-	0x56, 0x5c, 0x2b, 0x26, 0x21, 0x5d, 0x88, 0x10, 0xf2, 0xe7, 0xe7, 0xf8, 0x37, 0x96, 0x88, 0x51,
-	0xd2, 0x81, 0xd5, 0x22, 0x2f, 0x5a, 0x4e, 0xc3, 0xbd, 0x23, 0xa6, 0xb7, 0x5f, 0xd2, 0xeb, 0x11,
-	0x42, 0x4e, 0x3a, 0xfe, 0x8d, 0x25, 0x62, 0xf4, 0x10, 0x62, 0x1a, 0x32, 0x87, 0xa5, 0xfb, 0x14,
-	0x7d, 0xe3, 0x69, 0x90, 0x85, 0x35, 0x29, 0x3b, 0x84, 0x2a, 0x39, 0x75, 0x70, 0x6e, 0x6b, 0xfd,
-	0xa7, 0x35, 0x38, 0x9b, 0x39, 0x15, 0xe8, 0x1a, 0xcc, 0xc4, 0x46, 0x8e, 0xb2, 0x58, 0x30, 0x1a,
-	0x67, 0x38, 0xbe, 0x91, 0xac, 0x80, 0xd3, 0x6d, 0x98, 0x3f, 0x74, 0x5a, 0xec, 0x10, 0x16, 0x92,
-	0xf2, 0xc5, 0x40, 0x06, 0xe3, 0xac, 0x36, 0xfa, 0xfb, 0x94, 0xce, 0xc6, 0x93, 0x45, 0xbf, 0x8c,
-	0x0d, 0xd2, 0x8c, 0x3c, 0x8d, 0xa3, 0x2f, 0x63, 0x91, 0x16, 0x62, 0x0e, 0x43, 0xf7, 0xcb, 0x01,
-	0x10, 0x22, 0xee, 0x1e, 0x06, 0x41, 0xd0, 0xdf, 0x0f, 0xe7, 0x73, 0xac, 0x12, 0x50, 0x15, 0x26,
-	0xfc, 0x3b, 0x46, 0x7b, 0x91, 0x6c, 0x19, 0xdb, 0x96, 0x08, 0x37, 0xc4, 0x8d, 0x57, 0x27, 0xea,
-	0x52, 0xf9, 0xdd, 0xc4, 0x6f, 0xac, 0xb4, 0xd2, 0x03, 0x00, 0x61, 0xe4, 0x6c, 0x39, 0x4d, 0xb4,
-	0x09, 0xa3, 0x86, 0x4d, 0xbc, 0x20, 0x8e, 0x7a, 0xf7, 0x2d, 0x85, 0xb4, 0x72, 0x02, 0x07, 0x77,
-	0xea, 0x09, 0x7f, 0xe1, 0x08, 0xb7, 0xfe, 0x0f, 0x35, 0x38, 0x97, 0x1d, 0x60, 0xa6, 0x07, 0x21,
-	0xb8, 0x05, 0xe3, 0x5e, 0xdc, 0x4c, 0x6c, 0xfa, 0x77, 0xcb, 0xd1, 0xef, 0x25, 0x77, 0x04, 0x2a,
-	0xf6, 0x57, 0x3c, 0xd7, 0x0f, 0x57, 0x3e, 0x19, 0x10, 0x3f, 0xd2, 0x81, 0x48, 0x3d, 0xc1, 0x32,
-	0x7e, 0x96, 0x9c, 0x82, 0x52, 0xf7, 0xdb, 0x86, 0x49, 0x1a, 0x27, 0x9c, 0xf8, 0xf8, 0x08, 0x22,
-	0xc2, 0x67, 0xf7, 0xfd, 0x78, 0x93, 0x53, 0xe4, 0xd0, 0x3c, 0x38, 0x39, 0x45, 0x76, 0xc3, 0x37,
-	0x49, 0xd4, 0xf4, 0xec, 0xce, 0xe7, 0xb8, 0x03, 0xff, 0xb7, 0xe1, 0xbc, 0xd1, 0x1e, 0x32, 0x7b,
-	0xf2, 0xf6, 0x31, 0x66, 0x4f, 0x9e, 0xfa, 0x9b, 0xcc, 0xc9, 0x19, 0x99, 0x93, 0x13, 0xd9, 0x7c,
-	0x87, 0x4f, 0x28, 0x9b, 0xef, 0x6b, 0x30, 0xdc, 0x36, 0x3c, 0xe2, 0x84, 0x8f, 0x83, 0xb5, 0x7e,
-	0x53, 0x85, 0xc7, 0x5c, 0x30, 0xfa, 0x24, 0xd7, 0x18, 0x01, 0x2c, 0x08, 0x65, 0x84, 0x94, 0x18,
-	0x3d, 0xae, 0x98, 0x6d, 0x71, 0x1e, 0xe1, 0xb1, 0xe3, 0xc8, 0x23, 0xac, 0xff, 0x99, 0x06, 0xf7,
-	0x75, 0x63, 0x4b, 0x4c, 0xe5, 0x60, 0x26, 0x3e, 0xc3, 0x7e, 0x54, 0x0e, 0x29, 0x6e, 0x1b, 0xa9,
-	0x1c, 0x92, 0x10, 0x9c, 0xa2, 0x8b, 0xde, 0x0b, 0xc8, 0xdd, 0xe0, 0x86, 0x22, 0xd7, 0x28, 0x0d,
-	0xee, 0x63, 0x58, 0x62, 0x16, 0xdc, 0x51, 0xce, 0xbc, 0x9b, 0xa9, 0x1a, 0x38, 0xa3, 0x95, 0xfe,
-	0x0b, 0x25, 0x80, 0x55, 0x12, 0xdc, 0x71, 0xbd, 0xdb, 0xf4, 0x8c, 0xbf, 0x4f, 0x51, 0x14, 0x8f,
-	0x7e, 0xfd, 0xa2, 0xf4, 0xdd, 0x07, 0x83, 0x6d, 0xb7, 0xe1, 0x0b, 0xf9, 0x9d, 0x75, 0x84, 0x19,
-	0xb0, 0xb3, 0x52, 0x54, 0x86, 0x21, 0x66, 0x45, 0x23, 0x2e, 0xa0, 0x4c, 0xcd, 0xcc, 0x54, 0xe9,
-	0x98, 0x97, 0x53, 0x0e, 0x29, 0x3c, 0xbd, 0x7d, 0xa1, 0xca, 0x9f, 0xe0, 0xc1, 0x8f, 0x79, 0x19,
-	0x8e, 0xa0, 0xe8, 0x0a, 0x80, 0xd5, 0xbe, 0x6a, 0xb4, 0x2c, 0xdb, 0x12, 0x9f, 0xeb, 0x18, 0xd3,
-	0x7f, 0x42, 0x6d, 0x2d, 0x2c, 0xbd, 0xbb, 0x57, 0x1e, 0x15, 0xbf, 0x76, 0xb1, 0x54, 0x5b, 0xff,
-	0x92, 0x06, 0xd3, 0xf1, 0xe4, 0x89, 0xad, 0x12, 0xf6, 0x9c, 0x87, 0x48, 0xcd, 0xed, 0x39, 0x8f,
-	0x37, 0xde, 0xbd, 0xe7, 0x5c, 0xe5, 0x93, 0xd7, 0xf3, 0xc7, 0x60, 0x9c, 0xf0, 0x40, 0x30, 0xb5,
-	0x2a, 0xe6, 0x3c, 0x6e, 0x8c, 0x5f, 0x87, 0x96, 0xe2, 0x62, 0x2c, 0xd7, 0xd1, 0xff, 0x62, 0x00,
-	0x26, 0x56, 0x9b, 0x96, 0xb3, 0x13, 0x46, 0xbc, 0x89, 0x9e, 0x59, 0xb5, 0xe3, 0x79, 0x66, 0x7d,
-	0x09, 0x66, 0x6d, 0xf9, 0x51, 0x83, 0x0b, 0x4e, 0x86, 0xd3, 0x8c, 0x66, 0x80, 0xdd, 0x03, 0x96,
-	0x73, 0xea, 0xe0, 0xdc, 0xd6, 0x28, 0x80, 0x61, 0x33, 0xcc, 0xdd, 0x56, 0x38, 0x8a, 0x8b, 0x3c,
-	0x17, 0xf3, 0x72, 0x40, 0x83, 0x88, 0xe7, 0x89, 0xed, 0x29, 0x68, 0xa1, 0x8f, 0x6a, 0x70, 0x96,
-	0xec, 0xf0, 0x80, 0x1e, 0xeb, 0x9e, 0xb1, 0xb9, 0x69, 0x99, 0xc2, 0x0f, 0x8a, 0xef, 0xc4, 0xe5,
-	0xfd, 0xbd, 0xf2, 0xd9, 0xa5, 0xac, 0x0a, 0x77, 0xf7, 0xca, 0x97, 0x32, 0xe3, 0xab, 0xb0, 0xd5,
-	0xcc, 0x6c, 0x82, 0xb3, 0x49, 0xcd, 0x3d, 0x09, 0xe3, 0x87, 0xf0, 0x9e, 0x55, 0xa2, 0xa8, 0xfc,
-	0x62, 0x09, 0x26, 0xe8, 0x76, 0x5b, 0x76, 0x4d, 0xc3, 0xae, 0xae, 0xd6, 0xd1, 0x23, 0xc9, 0xe0,
-	0x71, 0xd1, 0x83, 0x52, 0x2a, 0x80, 0xdc, 0x32, 0x9c, 0xd9, 0x74, 0x3d, 0x93, 0xac, 0x57, 0xd6,
-	0xd6, 0x5d, 0x61, 0xcd, 0x54, 0x5d, 0xad, 0x8b, 0x7b, 0x11, 0x53, 0x70, 0x5f, 0xcd, 0x80, 0xe3,
-	0xcc, 0x56, 0xe8, 0x26, 0x9c, 0x8d, 0xcb, 0x6f, 0xb5, 0xb9, 0x19, 0x37, 0x45, 0x37, 0x10, 0x9b,
-	0xa1, 0x5f, 0xcd, 0xaa, 0x80, 0xb3, 0xdb, 0x21, 0x03, 0xee, 0x15, 0x9a, 0xf4, 0xab, 0xae, 0x77,
-	0xc7, 0xf0, 0x1a, 0x2a, 0xda, 0xc1, 0xd8, 0xda, 0xa3, 0x9a, 0x5f, 0x0d, 0x77, 0xc3, 0xa1, 0xff,
-	0xb9, 0x06, 0x6a, 0x68, 0x3e, 0x74, 0x0f, 0x0c, 0x78, 0x22, 0x35, 0x98, 0x08, 0x51, 0x47, 0xaf,
-	0x08, 0xb4, 0x0c, 0xcd, 0x03, 0x78, 0x71, 0x7c, 0xc0, 0x52, 0x1c, 0x8f, 0x5f, 0x8a, 0xec, 0x27,
-	0xd5, 0xa0, 0xa8, 0x02, 0xa3, 0x29, 0x18, 0x1e, 0x43, 0xb5, 0x6e, 0x34, 0x31, 0x2d, 0x63, 0x89,
-	0x17, 0xac, 0x26, 0xf1, 0x43, 0x05, 0x2e, 0x4f, 0xbc, 0xc0, 0x4a, 0xb0, 0x80, 0x20, 0x03, 0x26,
-	0xdb, 0x1d, 0x5b, 0x44, 0x99, 0xa1, 0x57, 0x1f, 0xae, 0x76, 0x7b, 0x38, 0x2b, 0xf1, 0x17, 0x5b,
-	0xfd, 0xcc, 0xec, 0x5f, 0x6b, 0x32, 0x0a, 0xac, 0x62, 0xd4, 0x7f, 0x64, 0x18, 0xa4, 0xa0, 0x23,
-	0x87, 0x90, 0x42, 0x7f, 0x5c, 0x83, 0x33, 0xa6, 0x6d, 0x11, 0x27, 0x48, 0xf8, 0xef, 0xf3, 0xe3,
-	0xe3, 0x56, 0xa1, 0x68, 0x28, 0x6d, 0xe2, 0xd4, 0xaa, 0xc2, 0xe8, 0xbf, 0x92, 0x81, 0x5c, 0x38,
-	0x46, 0x64, 0x40, 0x70, 0x66, 0x67, 0xd8, 0x78, 0x58, 0x79, 0xad, 0x2a, 0xc7, 0x14, 0xac, 0x88,
-	0x32, 0x1c, 0x41, 0x29, 0xe7, 0x6d, 0x7a, 0x6e, 0xa7, 0xed, 0x57, 0x98, 0x6f, 0x1f, 0x5f, 0x14,
-	0xc6, 0x79, 0xaf, 0xc5, 0xc5, 0x58, 0xae, 0x83, 0x1e, 0x87, 0x09, 0xfe, 0x73, 0xcd, 0x23, 0x9b,
-	0xd6, 0x8e, 0x38, 0x94, 0x98, 0xf2, 0xf1, 0x9a, 0x54, 0x8e, 0x95, 0x5a, 0x2c, 0xaa, 0x95, 0xef,
-	0x77, 0x88, 0x77, 0x0b, 0x2f, 0x8b, 0xfc, 0xb1, 0x3c, 0xaa, 0x55, 0x58, 0x88, 0x63, 0x38, 0xfa,
-	0x41, 0x0d, 0xa6, 0x3c, 0xf2, 0x5a, 0xc7, 0xf2, 0xa8, 0x08, 0x63, 0x58, 0x2d, 0x5f, 0x44, 0x7e,
-	0xc1, 0xfd, 0x45, 0x9b, 0x99, 0xc7, 0x0a, 0x52, 0xce, 0x20, 0x23, 0x7d, 0xb9, 0x0a, 0xc4, 0x89,
-	0x1e, 0xd0, 0xa9, 0xf2, 0xad, 0xa6, 0x63, 0x39, 0xcd, 0x05, 0xbb, 0xc9, 0x95, 0xa7, 0x62, 0xaa,
-	0xea, 0x71, 0x31, 0x96, 0xeb, 0xa0, 0x27, 0x60, 0xb2, 0xe3, 0x53, 0xb6, 0xd7, 0x22, 0x7c, 0x7e,
-	0xc7, 0x62, 0xcb, 0x86, 0x5b, 0x32, 0x00, 0xab, 0xf5, 0xd0, 0x15, 0x98, 0x0a, 0x0b, 0xc4, 0x2c,
-	0x03, 0x4f, 0x03, 0xc1, 0x5e, 0xa2, 0x14, 0x08, 0x4e, 0xd4, 0x9c, 0x5b, 0x80, 0xd3, 0x19, 0xc3,
-	0x3c, 0x14, 0x6f, 0xfd, 0x4b, 0x0d, 0xce, 0x72, 0xa9, 0x2b, 0xcc, 0x3c, 0x1b, 0x06, 0xe5, 0xcf,
-	0x8e, 0x6f, 0xaf, 0x1d, 0x6b, 0x7c, 0xfb, 0xaf, 0x43, 0x1c, 0x7f, 0xfd, 0xa7, 0x4a, 0xf0, 0xd6,
-	0x03, 0xbf, 0x4b, 0xf4, 0xa3, 0x1a, 0x8c, 0x93, 0x9d, 0xc0, 0x33, 0x22, 0x07, 0x68, 0xba, 0x49,
-	0x37, 0x8f, 0x85, 0x09, 0xcc, 0x2f, 0xc5, 0x84, 0xf8, 0xc6, 0x8d, 0xae, 0x52, 0x12, 0x04, 0xcb,
-	0xfd, 0xa1, 0xdc, 0x96, 0xa7, 0x49, 0x91, 0x4d, 0xa0, 0x04, 0x17, 0x14, 0x90, 0xb9, 0x67, 0x60,
-	0x3a, 0x89, 0xf9, 0x50, 0x7b, 0xe5, 0x27, 0x35, 0xc8, 0x0c, 0x52, 0x88, 0x2a, 0x30, 0x63, 0x74,
-	0x02, 0x57, 0x79, 0x09, 0x13, 0xa2, 0x24, 0xb3, 0x22, 0x5e, 0x48, 0x02, 0x71, 0xba, 0x3e, 0x57,
-	0x60, 0x3a, 0x1d, 0xc3, 0x56, 0xd1, 0x70, 0x81, 0x4b, 0x28, 0x30, 0x53, 0x60, 0x9c, 0xd5, 0x46,
-	0xff, 0x27, 0x1a, 0x9c, 0x55, 0x3a, 0xea, 0x0b, 0x45, 0x6c, 0x0f, 0xba, 0xb8, 0xec, 0x6d, 0x5f,
-	0x3a, 0xce, 0x6d, 0xaf, 0xff, 0x7c, 0x09, 0x46, 0xd6, 0x3c, 0x97, 0x1e, 0x6d, 0x27, 0xa0, 0x81,
-	0x33, 0x14, 0x0d, 0x5c, 0x21, 0xfd, 0x82, 0xe8, 0x6c, 0xae, 0xca, 0xcd, 0x4a, 0xa8, 0xdc, 0x16,
-	0xfa, 0x21, 0xd2, 0x5d, 0xc7, 0xf6, 0x6b, 0x1a, 0x8c, 0x8b, 0x9a, 0x27, 0xa0, 0x54, 0xfb, 0x0e,
-	0x55, 0xa9, 0xf6, 0x54, 0x1f, 0xe3, 0xca, 0xd1, 0xa2, 0x7d, 0x4e, 0x83, 0x49, 0x51, 0x63, 0x85,
-	0xb4, 0x36, 0x88, 0x87, 0xae, 0xc2, 0x88, 0xdf, 0x61, 0x0b, 0x29, 0x06, 0x74, 0xaf, 0x2c, 0x1e,
-	0x79, 0x1b, 0x86, 0x49, 0xbb, 0x5f, 0xe7, 0x55, 0xa4, 0x2c, 0xab, 0xbc, 0x00, 0x87, 0x8d, 0xe9,
-	0xde, 0xf7, 0x5c, 0x3b, 0x15, 0xcc, 0x1b, 0xbb, 0x36, 0xc1, 0x0c, 0x42, 0xef, 0x79, 0xf4, 0x6f,
-	0x78, 0x87, 0x63, 0xf7, 0x3c, 0x0a, 0xf6, 0x31, 0x2f, 0xd7, 0xbf, 0x34, 0x14, 0x4d, 0x36, 0xbb,
-	0xd4, 0x5f, 0x87, 0x31, 0xd3, 0x23, 0x46, 0x40, 0x1a, 0x8b, 0xbb, 0xbd, 0x74, 0x8e, 0xc9, 0x01,
-	0x95, 0xb0, 0x05, 0x8e, 0x1b, 0xd3, 0x23, 0x57, 0x36, 0xe7, 0x2b, 0xc5, 0xd2, 0x49, 0xae, 0x29,
-	0xdf, 0xb7, 0xc0, 0x90, 0x7b, 0xc7, 0x89, 0xbc, 0x0d, 0xba, 0x12, 0x66, 0x43, 0xb9, 0x49, 0x6b,
-	0x63, 0xde, 0x48, 0x0e, 0x66, 0x3f, 0xd8, 0x25, 0x98, 0xbd, 0x0d, 0x23, 0x2d, 0xb6, 0x0c, 0x7d,
-	0x25, 0xdd, 0x54, 0x16, 0x54, 0xce, 0xa6, 0xcf, 0x30, 0xe3, 0x90, 0x04, 0x15, 0x9d, 0x9c, 0x50,
-	0xa3, 0x23, 0x8b, 0x4e, 0x91, 0x9a, 0x07, 0xc7, 0x70, 0xb4, 0xab, 0x66, 0x49, 0x18, 0x29, 0xae,
-	0x27, 0x15, 0xdd, 0x93, 0x12, 0x23, 0xf0, 0xa9, 0xcf, 0xcb, 0x94, 0x80, 0x7e, 0x52, 0x83, 0xf3,
-	0x8d, 0xec, 0x4c, 0x51, 0xe2, 0xa9, 0xb9, 0x90, 0xbb, 0x6a, 0x4e, 0xf2, 0xa9, 0xc5, 0xb2, 0x98,
-	0xb0, 0xbc, 0xec, 0x54, 0x38, 0xaf, 0x33, 0xfa, 0xf7, 0x0e, 0x46, 0x5f, 0x93, 0xd0, 0x74, 0x64,
-	0xeb, 0xa1, 0xb4, 0x22, 0x7a, 0x28, 0xf4, 0xce, 0x30, 0x6f, 0x11, 0xdf, 0xae, 0xf7, 0x27, 0xf3,
-	0x16, 0x4d, 0x08, 0xd2, 0x4a, 0xae, 0xa2, 0x0e, 0x9c, 0xf6, 0x03, 0xc3, 0x26, 0x75, 0x4b, 0x3c,
-	0xae, 0xf9, 0x81, 0xd1, 0x6a, 0x17, 0x48, 0x1c, 0xc4, 0xdd, 0xd7, 0xd3, 0xa8, 0x70, 0x16, 0x7e,
-	0xf4, 0xdd, 0x2c, 0x24, 0x99, 0x61, 0xb3, 0xc7, 0x47, 0x9e, 0x3c, 0x31, 0x26, 0x7e, 0x78, 0xe3,
-	0x66, 0x11, 0x70, 0x2c, 0x1b, 0x1f, 0xce, 0xa5, 0x84, 0xde, 0x80, 0xb3, 0xf4, 0x00, 0x5c, 0x30,
-	0x03, 0x6b, 0xdb, 0x0a, 0x76, 0xe3, 0x2e, 0x1c, 0x3e, 0x5b, 0x10, 0xbb, 0x6d, 0x2f, 0x67, 0x21,
-	0xc3, 0xd9, 0x34, 0xf4, 0x3f, 0xd5, 0x00, 0xa5, 0xf7, 0x3a, 0xb2, 0x61, 0xb4, 0x11, 0xfa, 0x93,
-	0x6b, 0x47, 0x92, 0x6b, 0x24, 0x3a, 0x42, 0x22, 0x37, 0xf4, 0x88, 0x02, 0x72, 0x61, 0xec, 0xce,
-	0x96, 0x15, 0x10, 0xdb, 0xf2, 0x83, 0x23, 0x4a, 0x6d, 0x12, 0x45, 0xca, 0x7e, 0x31, 0x44, 0x8c,
-	0x63, 0x1a, 0xfa, 0xa7, 0x06, 0x61, 0x34, 0xca, 0x02, 0x78, 0xb0, 0x51, 0x6d, 0x07, 0x90, 0x29,
-	0x85, 0x49, 0xeb, 0x47, 0x67, 0xca, 0x64, 0xa0, 0x4a, 0x0a, 0x19, 0xce, 0x20, 0x80, 0xde, 0x80,
-	0x33, 0x96, 0xb3, 0xe9, 0x19, 0x51, 0x10, 0xb8, 0x4a, 0xa8, 0x28, 0x2b, 0x40, 0x98, 0xdd, 0xa2,
-	0x6b, 0x19, 0xe8, 0x70, 0x26, 0x11, 0x44, 0x60, 0x84, 0x27, 0x3b, 0x0d, 0x5f, 0x5d, 0x0a, 0xe9,
-	0xea, 0xb9, 0xac, 0x19, 0xb3, 0xf7, 0x50, 0xf6, 0x0c, 0x71, 0xf3, 0x90, 0x9d, 0xfc, 0xff, 0xf0,
-	0x41, 0x4a, 0xec, 0xfb, 0x4a, 0x71, 0x7a, 0xf1, 0xdb, 0x16, 0x0f, 0xd9, 0xa9, 0x16, 0xe2, 0x24,
-	0x41, 0xfd, 0x57, 0x34, 0x18, 0xe2, 0x91, 0x91, 0x8e, 0x5f, 0xd4, 0x7c, 0xbf, 0x22, 0x6a, 0x16,
-	0xca, 0xa9, 0xce, 0xba, 0x9a, 0x9b, 0xed, 0xfb, 0x2b, 0x1a, 0x8c, 0xb1, 0x1a, 0x27, 0x20, 0xfb,
-	0xbd, 0xa2, 0xca, 0x7e, 0x4f, 0x16, 0x1e, 0x4d, 0x8e, 0xe4, 0xf7, 0x2b, 0x03, 0x62, 0x2c, 0x4c,
-	0xb4, 0xaa, 0xc1, 0x69, 0xe1, 0x69, 0xb9, 0x6c, 0x6d, 0x12, 0xba, 0xc5, 0xab, 0xc6, 0xae, 0x2f,
-	0x0c, 0xf3, 0x79, 0x28, 0x8e, 0x34, 0x18, 0x67, 0xb5, 0x41, 0xbf, 0xa8, 0x51, 0x21, 0x26, 0xf0,
-	0x2c, 0xb3, 0xaf, 0xc7, 0xe0, 0xa8, 0x6f, 0xf3, 0x2b, 0x1c, 0x19, 0xbf, 0x9b, 0xde, 0x8a, 0xa5,
-	0x19, 0x56, 0x7a, 0x77, 0xaf, 0x5c, 0xce, 0xd0, 0x19, 0xc7, 0xe9, 0x74, 0xfd, 0xe0, 0xa3, 0x7f,
-	0xd0, 0xb5, 0x0a, 0xbb, 0x8d, 0x85, 0x3d, 0x46, 0xd7, 0x61, 0xc8, 0x37, 0xdd, 0x76, 0xe8, 0xab,
-	0xfb, 0x40, 0x96, 0x6e, 0x30, 0xa9, 0x16, 0x8c, 0x26, 0xb8, 0x4e, 0x5b, 0x62, 0x8e, 0x60, 0xee,
-	0x55, 0x98, 0x90, 0x7b, 0x9e, 0x71, 0xf7, 0xad, 0xaa, 0x16, 0x67, 0x87, 0x34, 0xae, 0x92, 0xef,
-	0xca, 0xbf, 0x3d, 0x00, 0xc3, 0x98, 0x34, 0x45, 0x22, 0xa9, 0x03, 0xee, 0x9c, 0x56, 0x98, 0xb7,
-	0xb4, 0x54, 0xdc, 0xeb, 0x4a, 0x4e, 0x15, 0xf2, 0xb2, 0xeb, 0x48, 0x73, 0x20, 0xa7, 0x2e, 0x45,
-	0x4e, 0x94, 0x5e, 0x67, 0xa0, 0x78, 0xe2, 0x72, 0x3e, 0xb0, 0x5e, 0x12, 0xea, 0xa0, 0x1f, 0xd0,
-	0x00, 0x19, 0xa6, 0x49, 0x7c, 0x1f, 0x13, 0x9f, 0xce, 0x3d, 0x17, 0x56, 0x39, 0x97, 0x2d, 0x16,
-	0x2b, 0x38, 0x89, 0x2d, 0x16, 0xdb, 0x52, 0x20, 0x1f, 0x67, 0x10, 0xef, 0x27, 0xc9, 0xcf, 0xaf,
-	0x6b, 0x30, 0xa1, 0xe4, 0x50, 0x6a, 0xc5, 0xba, 0xf4, 0xe2, 0x26, 0x3b, 0xa1, 0xaf, 0xcf, 0xbd,
-	0x5d, 0x2a, 0x71, 0xfd, 0xfc, 0xcd, 0x28, 0x09, 0xc0, 0xd1, 0xa4, 0x5b, 0xd2, 0x3f, 0xa3, 0xc1,
-	0xb9, 0x70, 0x40, 0x6a, 0xb4, 0x67, 0xf4, 0x30, 0x8c, 0x1a, 0x6d, 0x8b, 0x29, 0x7a, 0x65, 0x55,
-	0xf9, 0xc2, 0x5a, 0x8d, 0x95, 0xe1, 0x08, 0xaa, 0x24, 0x87, 0x2d, 0x1d, 0x98, 0x1c, 0xf6, 0x21,
-	0x29, 0xdd, 0xed, 0x50, 0x2c, 0xbb, 0x44, 0x84, 0xb9, 0x31, 0xa4, 0xfe, 0x6e, 0x18, 0xab, 0xd7,
-	0xaf, 0xf3, 0x25, 0x3d, 0xc4, 0x8b, 0x8f, 0xfe, 0x89, 0x01, 0x98, 0x14, 0x61, 0xeb, 0x2d, 0xa6,
-	0x02, 0x3a, 0x81, 0x73, 0x6e, 0x1d, 0xc6, 0xfc, 0xe8, 0x0d, 0xa3, 0x94, 0xcf, 0xa7, 0xa2, 0x67,
-	0x89, 0x64, 0xee, 0xb1, 0xf8, 0xf9, 0x22, 0x46, 0x84, 0x6e, 0xc0, 0xf0, 0x6b, 0x94, 0xe7, 0x86,
-	0xdf, 0x6a, 0x4f, 0xac, 0x2f, 0xfa, 0x10, 0x19, 0xbb, 0xf6, 0xb1, 0x40, 0x81, 0x7c, 0xe6, 0x8c,
-	0xc6, 0x84, 0xc0, 0x7e, 0x02, 0x18, 0x2a, 0x33, 0x1b, 0x25, 0xbb, 0x9e, 0x10, 0x3e, 0x6d, 0xec,
-	0x17, 0x8e, 0x08, 0xb1, 0xc4, 0x89, 0x4a, 0x8b, 0x37, 0x49, 0xe2, 0x44, 0xa5, 0xcf, 0x39, 0xc7,
-	0xf5, 0x93, 0x70, 0x36, 0x73, 0x32, 0x0e, 0x16, 0xb1, 0xf5, 0x7f, 0x5a, 0x82, 0xc1, 0x3a, 0x21,
-	0x8d, 0x13, 0xd8, 0x99, 0xaf, 0x28, 0x12, 0xd8, 0xb7, 0x14, 0x4e, 0xdd, 0x98, 0xa7, 0xe9, 0xdb,
-	0x4c, 0x68, 0xfa, 0x9e, 0x29, 0x4c, 0xa1, 0xbb, 0x9a, 0xef, 0xc3, 0x03, 0x00, 0xb4, 0xda, 0xa2,
-	0x61, 0xde, 0xe6, 0x1c, 0x27, 0xda, 0xcd, 0x89, 0x74, 0xd4, 0xe9, 0x6d, 0x78, 0x92, 0x26, 0x20,
-	0x3a, 0x0c, 0x7b, 0xec, 0x74, 0x14, 0xaf, 0x71, 0x4c, 0x0f, 0xcf, 0xcf, 0x4b, 0x2c, 0x20, 0x2a,
-	0xb7, 0x18, 0x3c, 0x2a, 0x6e, 0xf1, 0x7e, 0x98, 0x32, 0xa5, 0x34, 0xc9, 0xd1, 0x63, 0x6a, 0x4f,
-	0x5c, 0x83, 0x67, 0xd4, 0x57, 0x9a, 0xe3, 0x04, 0x3a, 0x7d, 0x07, 0x46, 0xe8, 0x0a, 0x54, 0x57,
-	0xeb, 0xa8, 0x25, 0x4d, 0x7f, 0xa9, 0xf8, 0x05, 0x46, 0xa0, 0x3b, 0x90, 0x8d, 0x7c, 0x42, 0x83,
-	0x53, 0x89, 0xba, 0x3d, 0x5c, 0x64, 0x8f, 0x85, 0x29, 0xeb, 0xbf, 0xac, 0xc1, 0x28, 0xed, 0xcb,
-	0x09, 0x70, 0xb2, 0x6f, 0x57, 0x39, 0xd9, 0x7b, 0x8a, 0x4e, 0x71, 0x0e, 0x03, 0xfb, 0x93, 0x12,
-	0xb0, 0x24, 0xac, 0xc2, 0x18, 0x48, 0x32, 0xf3, 0xd1, 0x72, 0x0c, 0x94, 0x2e, 0x0a, 0x2b, 0xa1,
-	0x84, 0x06, 0x59, 0xb2, 0x14, 0x7a, 0xbb, 0x62, 0x08, 0xa4, 0x7c, 0x97, 0x19, 0xc6, 0x40, 0xaf,
-	0xc3, 0xa4, 0xbf, 0xe5, 0xba, 0x41, 0x14, 0xcd, 0x6f, 0xb0, 0xf8, 0x6b, 0x01, 0xf3, 0xe3, 0x0d,
-	0x87, 0xc2, 0xdf, 0x5d, 0xeb, 0x32, 0x6e, 0xac, 0x92, 0x42, 0xf3, 0x00, 0x1b, 0xb6, 0x6b, 0xde,
-	0xe6, 0x76, 0x48, 0xdc, 0x6f, 0x93, 0x59, 0x3a, 0x2c, 0x46, 0xa5, 0x58, 0xaa, 0xd1, 0x97, 0xc9,
-	0xd5, 0x1f, 0x69, 0x7c, 0xa6, 0x0f, 0xb1, 0x79, 0x4f, 0x90, 0x65, 0xbd, 0x2d, 0xc1, 0xb2, 0x22,
-	0x16, 0x9c, 0x60, 0x5b, 0xe5, 0xf0, 0x96, 0x32, 0x18, 0xbf, 0x0e, 0xc8, 0x77, 0x0b, 0xfd, 0xe7,
-	0xc5, 0x30, 0xa3, 0x3c, 0xbe, 0x6d, 0x98, 0xb4, 0xe5, 0x84, 0xfe, 0xe2, 0x1b, 0x79, 0x67, 0x8f,
-	0xdf, 0x88, 0xdc, 0x34, 0xb6, 0xa1, 0x55, 0x8a, 0xb1, 0x4a, 0x00, 0x3d, 0x01, 0x93, 0xe1, 0xe8,
-	0xb8, 0x29, 0x6b, 0x29, 0x76, 0x40, 0x5c, 0x93, 0x01, 0x58, 0xad, 0xa7, 0x7f, 0xb6, 0x04, 0xf7,
-	0xf3, 0xbe, 0x33, 0x35, 0x49, 0x95, 0xb4, 0x89, 0xd3, 0x20, 0x8e, 0xb9, 0xcb, 0x84, 0xe2, 0x86,
-	0xdb, 0x44, 0x6f, 0xc0, 0xf0, 0x1d, 0x42, 0x1a, 0xd1, 0x7b, 0xc3, 0x8b, 0xc5, 0xd3, 0x20, 0xe7,
-	0x90, 0x78, 0x91, 0xa1, 0xe7, 0x47, 0x06, 0xff, 0x1f, 0x0b, 0x92, 0x94, 0x78, 0xdb, 0x73, 0x37,
-	0x22, 0xd9, 0xed, 0xe8, 0x89, 0xaf, 0x31, 0xf4, 0x9c, 0x38, 0xff, 0x1f, 0x0b, 0x92, 0xfa, 0x1a,
-	0x3c, 0xd0, 0x43, 0xd3, 0xc3, 0xc8, 0xe8, 0x07, 0x61, 0xe4, 0xa3, 0x3f, 0x0c, 0xc6, 0xdf, 0xd5,
-	0xe0, 0x41, 0x09, 0xe5, 0xd2, 0x0e, 0xbd, 0x36, 0x54, 0x8c, 0xb6, 0x61, 0xd2, 0x8b, 0x39, 0x8b,
-	0x50, 0x76, 0xa8, 0xc4, 0xa3, 0x9f, 0xd0, 0x60, 0x84, 0x9b, 0xcf, 0x85, 0xec, 0xf7, 0x95, 0x3e,
-	0xa7, 0x3c, 0xb7, 0x4b, 0x61, 0x42, 0xa6, 0x70, 0x6c, 0xfc, 0xb7, 0x8f, 0x43, 0xfa, 0xfa, 0xbf,
-	0x1b, 0x82, 0x6f, 0xea, 0x1d, 0x11, 0xfa, 0x23, 0x2d, 0x99, 0xf4, 0x7e, 0xfc, 0x72, 0xeb, 0x78,
-	0x3b, 0x1f, 0xa9, 0x6e, 0x84, 0x36, 0xe0, 0xc5, 0x54, 0x4e, 0xe5, 0x23, 0xd2, 0x0a, 0xc5, 0x03,
-	0x43, 0xff, 0x48, 0x83, 0x09, 0x7a, 0x2c, 0x45, 0xcc, 0x85, 0x2f, 0x53, 0xfb, 0x98, 0x47, 0xba,
-	0x2a, 0x91, 0x4c, 0x84, 0x1c, 0x92, 0x41, 0x58, 0xe9, 0x1b, 0xba, 0xa5, 0xbe, 0xd5, 0xf1, 0xfb,
-	0xdc, 0x85, 0x2c, 0x69, 0xe4, 0x30, 0x19, 0xcb, 0xe7, 0x6c, 0x98, 0x52, 0x67, 0xfe, 0x38, 0x75,
-	0x5a, 0x73, 0xcf, 0xc2, 0x4c, 0x6a, 0xf4, 0x87, 0xd2, 0x9e, 0xfc, 0xd0, 0x10, 0x94, 0xa5, 0xa9,
-	0xce, 0x8a, 0x1c, 0x82, 0x3e, 0xaf, 0xc1, 0xb8, 0xe1, 0x38, 0xc2, 0x0a, 0x29, 0xdc, 0xbf, 0x8d,
-	0x3e, 0x57, 0x35, 0x8b, 0xd4, 0xfc, 0x42, 0x4c, 0x26, 0x61, 0x66, 0x23, 0x41, 0xb0, 0xdc, 0x9b,
-	0x2e, 0xa6, 0xb4, 0xa5, 0x13, 0x33, 0xa5, 0x45, 0x1f, 0x0c, 0x0f, 0x62, 0xbe, 0x8d, 0x5e, 0x3a,
-	0x86, 0xb9, 0x61, 0xe7, 0x7a, 0x8e, 0x0a, 0xf1, 0xd3, 0x1a, 0x3b, 0x64, 0xe3, 0x00, 0x2f, 0xe2,
-	0x4c, 0x2a, 0x64, 0x11, 0x79, 0x60, 0xf4, 0x98, 0xe8, 0xec, 0x8e, 0x8b, 0xb0, 0x4a, 0x7e, 0xee,
-	0x19, 0x98, 0x4e, 0x2e, 0xe5, 0xa1, 0xb6, 0xe5, 0xbf, 0x19, 0x54, 0xce, 0x8e, 0xdc, 0xf9, 0xe8,
-	0x41, 0x93, 0xfb, 0x85, 0xc4, 0xee, 0xe5, 0x3c, 0xc9, 0x3a, 0xae, 0x15, 0x3a, 0xda, 0x2d, 0x3c,
-	0x70, 0x72, 0x5b, 0xf8, 0xaf, 0xdc, 0x1e, 0x5a, 0x84, 0xb3, 0xd2, 0x82, 0xc5, 0x09, 0x56, 0x58,
-	0x5c, 0x42, 0xcb, 0xb7, 0xc2, 0xe8, 0xba, 0x92, 0x0c, 0xf3, 0x02, 0x2f, 0xc6, 0x21, 0x5c, 0x5f,
-	0x56, 0xb8, 0xe3, 0xba, 0xdb, 0x76, 0x6d, 0xb7, 0xb9, 0xbb, 0x70, 0xc7, 0xf0, 0x08, 0x76, 0x3b,
-	0x81, 0xc0, 0xd6, 0xab, 0x44, 0xb4, 0x02, 0x17, 0x25, 0x6c, 0x99, 0x31, 0x08, 0x0f, 0x83, 0xee,
-	0xd7, 0x46, 0x42, 0xe1, 0x5e, 0x44, 0x2e, 0xfa, 0x39, 0x0d, 0xee, 0x21, 0x79, 0x87, 0xa5, 0x90,
-	0xf4, 0x5f, 0x3a, 0xae, 0xc3, 0x58, 0xe4, 0x3b, 0xc9, 0x03, 0xe3, 0xfc, 0x9e, 0xa1, 0x5d, 0x00,
-	0x3f, 0x5a, 0x9e, 0x7e, 0x1c, 0xcc, 0x33, 0xd7, 0x5b, 0x24, 0xc9, 0x8e, 0x7e, 0x63, 0x89, 0x18,
-	0xfa, 0xa2, 0x06, 0x67, 0xec, 0x8c, 0xcd, 0x2a, 0x36, 0x7f, 0xfd, 0x18, 0xd8, 0x04, 0x7f, 0x0a,
-	0xcf, 0x82, 0xe0, 0xcc, 0xae, 0xa0, 0x9f, 0xc8, 0x0d, 0x8e, 0xc9, 0xd5, 0x49, 0xeb, 0x7d, 0x76,
-	0xf2, 0xa8, 0xe2, 0x64, 0x7e, 0x56, 0x03, 0xd4, 0x48, 0x5d, 0x1c, 0x84, 0x15, 0xd4, 0xf3, 0x47,
-	0x7e, 0x3d, 0xe2, 0xb6, 0x0c, 0xe9, 0x72, 0x9c, 0xd1, 0x09, 0xb6, 0xce, 0x41, 0xc6, 0xe7, 0x2b,
-	0x7c, 0x0d, 0xfb, 0x5d, 0xe7, 0x2c, 0xce, 0xc0, 0xd7, 0x39, 0x0b, 0x82, 0x33, 0xbb, 0xa2, 0x7f,
-	0x65, 0x8c, 0xeb, 0xb1, 0xd8, 0x63, 0xf3, 0x06, 0x0c, 0x6f, 0x30, 0xc5, 0xaa, 0xf8, 0x6e, 0x0b,
-	0x6b, 0x71, 0xb9, 0x7a, 0x96, 0xdf, 0x22, 0xf9, 0xff, 0x58, 0x60, 0x46, 0x2f, 0xc3, 0x40, 0xc3,
-	0x09, 0xdd, 0x79, 0x9f, 0xea, 0x43, 0x5d, 0x18, 0x07, 0x15, 0xa8, 0xae, 0xd6, 0x31, 0x45, 0x8a,
-	0x1c, 0x18, 0x75, 0x84, 0xea, 0x47, 0xdc, 0xce, 0x9f, 0x2b, 0x4a, 0x20, 0x52, 0x21, 0x45, 0x8a,
-	0xab, 0xb0, 0x04, 0x47, 0x34, 0x28, 0xbd, 0xc4, 0x63, 0x4a, 0x61, 0x7a, 0x91, 0xf2, 0xb3, 0x9b,
-	0x02, 0x9b, 0xc0, 0x70, 0x60, 0x58, 0x4e, 0x14, 0x3b, 0xe8, 0xe9, 0xa2, 0xd4, 0xd6, 0x29, 0x96,
-	0x58, 0xc3, 0xc3, 0x7e, 0xfa, 0x58, 0x20, 0xa7, 0xdb, 0x80, 0xbb, 0xe7, 0x8a, 0xcf, 0xa8, 0xf0,
-	0x36, 0xe0, 0x1e, 0xbf, 0x7c, 0x1b, 0xf0, 0xff, 0xb1, 0xc0, 0x8c, 0x5e, 0x85, 0x51, 0x3f, 0xb4,
-	0x7d, 0x19, 0xed, 0x6f, 0xea, 0x22, 0xc3, 0x17, 0xe1, 0x6c, 0x28, 0x2c, 0x5e, 0x22, 0xfc, 0x68,
-	0x03, 0x46, 0x2c, 0xee, 0x27, 0x27, 0x5c, 0x70, 0x9f, 0xea, 0x23, 0xdf, 0x3d, 0x57, 0x14, 0x88,
-	0x1f, 0x38, 0x44, 0x9c, 0xf7, 0xc0, 0x0d, 0x5f, 0xc7, 0x07, 0x6e, 0xf4, 0x1a, 0x00, 0x09, 0x55,
-	0x80, 0xfe, 0xec, 0x78, 0xf1, 0x2d, 0x23, 0x29, 0x12, 0xc3, 0x57, 0xa6, 0xa8, 0xc8, 0xc7, 0x12,
-	0x11, 0xf4, 0x86, 0xac, 0x73, 0x98, 0xe8, 0x2f, 0xe2, 0x40, 0x3a, 0x86, 0x46, 0xac, 0x93, 0x8f,
-	0xae, 0xbb, 0x92, 0x2a, 0x40, 0xff, 0x35, 0xe0, 0x8f, 0x43, 0xc2, 0xc4, 0x73, 0x13, 0x46, 0x43,
-	0x2a, 0xfd, 0xc4, 0xfc, 0xb8, 0x26, 0xc0, 0x7c, 0x7b, 0x85, 0xbf, 0x70, 0x84, 0x1b, 0x55, 0xb2,
-	0x62, 0xb7, 0xc4, 0x49, 0x0a, 0x7b, 0x8b, 0xdb, 0xf2, 0x1a, 0x80, 0x19, 0xc7, 0x0f, 0x1c, 0x28,
-	0xbe, 0x56, 0x51, 0x6c, 0xc1, 0x78, 0xad, 0xa4, 0xf0, 0x83, 0x12, 0x91, 0x1c, 0x13, 0xd8, 0xc1,
-	0x42, 0x26, 0xb0, 0x4f, 0xc3, 0x29, 0x61, 0x72, 0x54, 0x63, 0x6f, 0x45, 0xc1, 0xae, 0xf0, 0x12,
-	0x63, 0xc6, 0x68, 0x15, 0x15, 0x84, 0x93, 0x75, 0xd1, 0xbf, 0xd6, 0x60, 0xd4, 0x14, 0x42, 0x9a,
-	0xe0, 0x6d, 0xcb, 0xfd, 0xbd, 0x20, 0xce, 0x87, 0x32, 0x1f, 0xbf, 0x0f, 0xbd, 0x10, 0x72, 0xd5,
-	0xb0, 0xf8, 0x88, 0x14, 0x51, 0x51, 0xaf, 0xd1, 0xaf, 0xd2, 0x2b, 0x9f, 0x6d, 0xbb, 0xa6, 0x11,
-	0xb0, 0x58, 0x5e, 0xdc, 0x7d, 0xed, 0x66, 0x9f, 0xa3, 0x58, 0x88, 0x31, 0xf2, 0x81, 0x7c, 0x6b,
-	0x74, 0xb1, 0x8b, 0x21, 0x47, 0x34, 0x16, 0xb9, 0xfb, 0xe8, 0x1f, 0x68, 0xf0, 0x20, 0xf7, 0x19,
-	0xac, 0x50, 0xb9, 0x6b, 0xd3, 0x32, 0x8d, 0x80, 0xf0, 0x90, 0x82, 0xa1, 0xbf, 0x0a, 0x37, 0xd8,
-	0x1d, 0x3d, 0xb4, 0xc1, 0xee, 0xc3, 0xfb, 0x7b, 0xe5, 0x07, 0x2b, 0x3d, 0xe0, 0xc6, 0x3d, 0xf5,
-	0x00, 0xbd, 0x0e, 0x93, 0xb6, 0x1c, 0xda, 0x56, 0x30, 0xf9, 0x42, 0xcf, 0x47, 0x4a, 0x8c, 0x5c,
-	0x7e, 0x5f, 0x54, 0x8a, 0xb0, 0x4a, 0x6a, 0xee, 0x36, 0x4c, 0x2a, 0x1b, 0xed, 0x58, 0x15, 0x6f,
-	0x0e, 0x4c, 0x27, 0xf7, 0xc3, 0xb1, 0x1a, 0xaf, 0xdd, 0x80, 0xb1, 0x48, 0x58, 0x40, 0xf7, 0x4b,
-	0x84, 0x62, 0xd1, 0xeb, 0x06, 0xd9, 0xe5, 0x54, 0xcb, 0xca, 0x95, 0x98, 0xbf, 0x0a, 0xb1, 0x50,
-	0x6b, 0x02, 0xa1, 0xfe, 0x1b, 0xe2, 0x55, 0x68, 0x9d, 0xb4, 0xda, 0xb6, 0x11, 0x90, 0x37, 0xbf,
-	0xd1, 0x83, 0xfe, 0x9f, 0x35, 0x7e, 0xde, 0x70, 0xd1, 0x06, 0x19, 0x30, 0xde, 0xe2, 0xa9, 0x9b,
-	0x58, 0x60, 0x36, 0xad, 0x78, 0x48, 0xb8, 0x95, 0x18, 0x0d, 0x96, 0x71, 0xa2, 0x3b, 0x30, 0x16,
-	0x0a, 0x83, 0xa1, 0x52, 0xe9, 0x6a, 0x7f, 0xc2, 0x59, 0x24, 0x77, 0x46, 0x47, 0x6b, 0x58, 0xe2,
-	0xe3, 0x98, 0x96, 0x6e, 0x00, 0x4a, 0xb7, 0x41, 0x8f, 0xc4, 0xce, 0x33, 0x9a, 0x9a, 0x6c, 0x21,
-	0xe5, 0x40, 0x13, 0xea, 0xcc, 0x4a, 0x79, 0x3a, 0x33, 0xfd, 0x97, 0x4a, 0x70, 0x46, 0x5c, 0x3f,
-	0x17, 0x4c, 0xd3, 0xed, 0x38, 0x41, 0x6c, 0x4b, 0xc1, 0x1d, 0x85, 0x05, 0x11, 0x26, 0x4e, 0x72,
-	0x2f, 0x62, 0x2c, 0x20, 0xe8, 0x26, 0x57, 0x66, 0x39, 0x0d, 0x96, 0xe4, 0x20, 0xe6, 0x12, 0xb2,
-	0x47, 0xfe, 0x52, 0x56, 0x05, 0x9c, 0xdd, 0x0e, 0x6d, 0x03, 0x6a, 0x19, 0x3b, 0x49, 0x6c, 0x7d,
-	0xa4, 0x82, 0x5e, 0x49, 0x61, 0xc3, 0x19, 0x14, 0xe8, 0x41, 0x4a, 0x25, 0xb9, 0x76, 0x40, 0x1a,
-	0x7c, 0x88, 0xe1, 0xa3, 0x34, 0x3b, 0x48, 0x17, 0x54, 0x10, 0x4e, 0xd6, 0xd5, 0x3f, 0x36, 0x0c,
-	0xf7, 0xa8, 0x93, 0x48, 0xbf, 0xd0, 0xd0, 0x97, 0xf7, 0xd9, 0xd0, 0x51, 0x85, 0x4f, 0xe4, 0x23,
-	0x49, 0x47, 0x95, 0x59, 0xd9, 0xf4, 0x43, 0x34, 0x52, 0x9c, 0x56, 0xbe, 0x0e, 0x8e, 0xb9, 0x39,
-	0x9e, 0x98, 0x03, 0xc7, 0xea, 0x80, 0xfc, 0x49, 0x0d, 0xe6, 0xd4, 0xe2, 0xab, 0x96, 0x63, 0xf9,
-	0x5b, 0x22, 0xa4, 0xfe, 0xe1, 0xfd, 0x64, 0x58, 0xf2, 0xca, 0xe5, 0x5c, 0x8c, 0xb8, 0x0b, 0x35,
-	0xf4, 0x7d, 0x1a, 0xdc, 0x9b, 0x98, 0x17, 0x25, 0xc0, 0xff, 0xe1, 0x5d, 0x66, 0x58, 0x24, 0x89,
-	0xe5, 0x7c, 0x94, 0xb8, 0x1b, 0x3d, 0xf4, 0x45, 0x0d, 0xce, 0xb5, 0xb3, 0xdc, 0x6a, 0xc3, 0x6b,
-	0x69, 0x21, 0x35, 0x5a, 0xa6, 0xa3, 0xee, 0xe2, 0x05, 0xb1, 0x45, 0xcf, 0x65, 0x82, 0x7d, 0x9c,
-	0xd3, 0x11, 0xfd, 0x9f, 0x95, 0x60, 0x88, 0xd9, 0x7d, 0xbc, 0x39, 0xbc, 0x1b, 0x58, 0x57, 0x73,
-	0x8d, 0xeb, 0x9a, 0x09, 0xe3, 0xba, 0x67, 0x8b, 0x93, 0xe8, 0x6e, 0x5d, 0xf7, 0xad, 0x70, 0x8e,
-	0x55, 0x5b, 0x68, 0x30, 0x65, 0x9b, 0x4f, 0x1a, 0x0b, 0x8d, 0x06, 0xbb, 0xde, 0x1e, 0xfc, 0xe4,
-	0x71, 0x3f, 0x0c, 0x74, 0x3c, 0x3b, 0x19, 0xef, 0xf1, 0x16, 0x5e, 0xc6, 0xb4, 0x5c, 0xff, 0xa4,
-	0x06, 0xd3, 0x0c, 0xb7, 0xc4, 0x62, 0xd0, 0x36, 0x8c, 0x7a, 0x82, 0xcd, 0x88, 0xb5, 0x59, 0x2e,
-	0x3c, 0xb4, 0x0c, 0xd6, 0xc5, 0x6f, 0x6c, 0xe1, 0x2f, 0x1c, 0xd1, 0xd2, 0xbf, 0x3a, 0x0c, 0xb3,
-	0x79, 0x8d, 0xd0, 0x0f, 0x6a, 0x70, 0xce, 0x8c, 0x25, 0xce, 0x85, 0x4e, 0xb0, 0xe5, 0x7a, 0x3c,
-	0x7c, 0x6e, 0x1f, 0x5a, 0xb1, 0xca, 0x42, 0xd4, 0x2b, 0x16, 0xa1, 0xbe, 0x92, 0x49, 0x01, 0xe7,
-	0x50, 0x46, 0x6f, 0xf0, 0x58, 0x78, 0xa6, 0x6c, 0x03, 0x74, 0xa3, 0xf0, 0x5c, 0x49, 0x19, 0x82,
-	0xc2, 0x4e, 0x45, 0x01, 0xf1, 0x44, 0xb9, 0x44, 0x8e, 0x12, 0xf7, 0xfd, 0xad, 0x1b, 0x64, 0xb7,
-	0x6d, 0x58, 0xa1, 0xd9, 0x4b, 0x71, 0xe2, 0xf5, 0xfa, 0x75, 0x81, 0x4a, 0x25, 0x2e, 0x95, 0x4b,
-	0xe4, 0xd0, 0x47, 0x35, 0x98, 0x74, 0xe5, 0xc8, 0x14, 0xfd, 0x98, 0x2d, 0x67, 0x86, 0xb8, 0xe0,
-	0x62, 0xbe, 0x0a, 0x52, 0x49, 0xd2, 0x3d, 0x31, 0xe3, 0x27, 0x8f, 0x55, 0xc1, 0x78, 0x57, 0x8a,
-	0x09, 0x60, 0x39, 0x67, 0x34, 0x57, 0x19, 0xa4, 0xc1, 0x69, 0xf2, 0xac, 0x53, 0x24, 0x30, 0x1b,
-	0x4b, 0x8e, 0xe9, 0xed, 0x32, 0x5f, 0x68, 0xda, 0xa9, 0xe1, 0xe2, 0x9d, 0x5a, 0x5a, 0xaf, 0x54,
-	0x15, 0x64, 0x6a, 0xa7, 0xd2, 0xe0, 0x34, 0x79, 0xfd, 0x23, 0x25, 0x38, 0x9f, 0xb3, 0xc7, 0xfe,
-	0xda, 0x84, 0x12, 0xf9, 0x8a, 0x06, 0x63, 0x6c, 0x0e, 0xde, 0x24, 0xde, 0x68, 0xac, 0xaf, 0x39,
-	0xd6, 0xa1, 0xbf, 0xac, 0xc1, 0x4c, 0x2a, 0x37, 0x4a, 0x4f, 0xbe, 0x4c, 0x27, 0x66, 0xb8, 0xf8,
-	0x50, 0x9c, 0xf2, 0x6d, 0x20, 0x76, 0xe1, 0x4f, 0xa6, 0x7b, 0xd3, 0x5f, 0x84, 0x49, 0xc5, 0x38,
-	0x54, 0x0a, 0x76, 0x97, 0x15, 0xa6, 0x4f, 0x8e, 0x65, 0x57, 0xea, 0x16, 0x85, 0x2f, 0xde, 0xf2,
-	0x69, 0xce, 0xf6, 0xd7, 0x67, 0xcb, 0x23, 0xb1, 0xe5, 0xd9, 0x3b, 0xd2, 0x2b, 0x30, 0xcc, 0x42,
-	0xe8, 0x85, 0x27, 0xe6, 0x95, 0xc2, 0xa1, 0xf9, 0x44, 0xc8, 0x4b, 0xfe, 0x3f, 0x16, 0x58, 0xd1,
-	0x73, 0x6a, 0x40, 0xcb, 0xd5, 0xf8, 0x62, 0x79, 0x26, 0x19, 0x86, 0x92, 0x6d, 0xc9, 0x54, 0x6d,
-	0x84, 0xf9, 0x2b, 0x14, 0x3f, 0xcb, 0x0a, 0x65, 0xbe, 0xa8, 0xae, 0xd6, 0x79, 0xa4, 0xb3, 0xe8,
-	0xf5, 0x49, 0x55, 0xb7, 0x0f, 0x9e, 0x84, 0xba, 0xdd, 0x83, 0xf1, 0x2d, 0x6b, 0x83, 0x78, 0x0e,
-	0x97, 0xa1, 0x86, 0x8a, 0x8b, 0x87, 0xd7, 0x63, 0x34, 0x5c, 0x07, 0x21, 0x15, 0x60, 0x99, 0x08,
-	0xf2, 0x94, 0xb0, 0xbc, 0xc3, 0xc5, 0x45, 0xa2, 0x58, 0x2f, 0x1e, 0x8f, 0x33, 0x27, 0x24, 0xaf,
-	0x03, 0xe0, 0x44, 0xb1, 0x2a, 0xfb, 0x79, 0x95, 0x8a, 0x23, 0x5e, 0x72, 0xa1, 0x23, 0xfe, 0x8d,
-	0x25, 0x0a, 0x74, 0x5e, 0x5b, 0x71, 0xe8, 0x73, 0xa1, 0xe3, 0x7c, 0xb6, 0xcf, 0xf0, 0xf3, 0x42,
-	0xb7, 0x13, 0x17, 0x60, 0x99, 0x08, 0x1d, 0x63, 0x2b, 0x0a, 0x58, 0x2e, 0x74, 0x98, 0x85, 0xc6,
-	0x18, 0x87, 0x3d, 0x17, 0x09, 0xde, 0xa3, 0xdf, 0x58, 0xa2, 0x80, 0x5e, 0x95, 0x1e, 0x2f, 0xa1,
-	0xb8, 0x86, 0xac, 0xa7, 0x87, 0xcb, 0x77, 0xc5, 0x8a, 0xa2, 0x71, 0xf6, 0x9d, 0xde, 0x2b, 0x29,
-	0x89, 0x58, 0x20, 0x77, 0xca, 0x3b, 0x52, 0x4a, 0xa3, 0xd8, 0x24, 0x7d, 0xa2, 0xab, 0x49, 0x7a,
-	0x85, 0x4a, 0x67, 0x92, 0x0f, 0x16, 0x63, 0x08, 0x93, 0xf1, 0x0b, 0x4c, 0x3d, 0x09, 0xc4, 0xe9,
-	0xfa, 0x9c, 0xe1, 0x93, 0x06, 0x6b, 0x3b, 0x25, 0x33, 0x7c, 0x5e, 0x86, 0x23, 0x28, 0xda, 0x86,
-	0x09, 0x5f, 0xb2, 0x6f, 0x9f, 0x3d, 0xd5, 0xef, 0xfb, 0xa5, 0xb0, 0x6d, 0x67, 0x11, 0xf5, 0xe4,
-	0x12, 0xac, 0xd0, 0x51, 0x1f, 0xd7, 0xa6, 0x4f, 0xf6, 0x71, 0x0d, 0x75, 0x54, 0xd3, 0xd5, 0x99,
-	0x23, 0x89, 0x58, 0x71, 0xa0, 0x69, 0x2b, 0x5d, 0x5a, 0xb2, 0xd3, 0x76, 0xfd, 0x8e, 0x47, 0x58,
-	0xe2, 0x0d, 0xb6, 0x3c, 0x28, 0x5e, 0xda, 0xa5, 0x24, 0x10, 0xa7, 0xeb, 0xa3, 0xef, 0xd1, 0x60,
-	0xda, 0xdf, 0xf5, 0x03, 0xd2, 0xa2, 0xc7, 0x96, 0xeb, 0x10, 0x27, 0xf0, 0x67, 0x4f, 0x17, 0x8f,
-	0x80, 0x5c, 0x4f, 0xe0, 0xe2, 0xc7, 0x4e, 0xb2, 0x14, 0xa7, 0x68, 0xd2, 0x9d, 0x23, 0xc7, 0xbc,
-	0x98, 0x3d, 0x53, 0x7c, 0xe7, 0xc8, 0xf1, 0x34, 0xf8, 0xce, 0x91, 0x4b, 0xb0, 0x42, 0x07, 0x3d,
-	0x01, 0x93, 0x7e, 0x98, 0xfe, 0x97, 0xcd, 0xe0, 0xd9, 0x38, 0x2c, 0x61, 0x5d, 0x06, 0x60, 0xb5,
-	0x1e, 0xfa, 0x10, 0x4c, 0xc8, 0x67, 0xe7, 0xec, 0xb9, 0xa3, 0x0e, 0xd0, 0xcd, 0x7b, 0x2e, 0x83,
-	0x14, 0x82, 0x08, 0xc3, 0x39, 0xc9, 0xff, 0x4c, 0xfe, 0xbe, 0xcf, 0xb3, 0x21, 0xf0, 0xcb, 0x74,
-	0x66, 0x0d, 0x9c, 0xd3, 0x12, 0xfd, 0x48, 0xf6, 0x5b, 0xfd, 0x2c, 0xdb, 0xd2, 0x6b, 0x47, 0xf2,
-	0x56, 0xff, 0xa2, 0x15, 0x6c, 0xdd, 0x6c, 0xf3, 0x18, 0x4a, 0x87, 0x7c, 0xb6, 0xd7, 0x7f, 0x5b,
-	0x03, 0x88, 0xb4, 0x35, 0x27, 0xf1, 0x4e, 0xd2, 0x50, 0x14, 0x58, 0x8b, 0x7d, 0x69, 0x97, 0x72,
-	0xf3, 0x2f, 0xe8, 0xbf, 0xa5, 0xc1, 0x54, 0x5c, 0xed, 0x04, 0xae, 0x46, 0xa6, 0x7a, 0x35, 0x7a,
-	0xa6, 0xbf, 0x71, 0xe5, 0xdc, 0x8f, 0xfe, 0x5f, 0x49, 0x1e, 0x15, 0x93, 0x7e, 0xb7, 0x15, 0xbb,
-	0x03, 0x4a, 0xfa, 0x7a, 0x3f, 0x76, 0x07, 0x72, 0x9c, 0x81, 0x78, 0xbc, 0x19, 0x76, 0x08, 0xdf,
-	0xa9, 0xc8, 0x9f, 0x7d, 0x44, 0xf8, 0x88, 0x84, 0xcd, 0x90, 0x34, 0x9f, 0x80, 0x83, 0x84, 0xd1,
-	0xd7, 0xe4, 0xe3, 0xa9, 0x8f, 0x9c, 0x09, 0xca, 0x80, 0xbb, 0x5b, 0x7c, 0x7c, 0x7a, 0x1a, 0xc6,
-	0x25, 0xc5, 0x66, 0xc2, 0x8a, 0x42, 0x3b, 0x09, 0x2b, 0x8a, 0x00, 0xc6, 0xcd, 0x28, 0xcd, 0x5c,
-	0x38, 0xed, 0x7d, 0xd2, 0x8c, 0x8e, 0xc5, 0x38, 0x81, 0x9d, 0x8f, 0x65, 0x32, 0x54, 0x78, 0x8b,
-	0xf6, 0xd8, 0xc0, 0x11, 0xd8, 0xb6, 0x74, 0xdb, 0x57, 0x8f, 0x03, 0x84, 0xf2, 0x3f, 0x69, 0x88,
-	0x58, 0xd4, 0x91, 0xb3, 0x4b, 0xcd, 0xbf, 0x1e, 0xc1, 0xb0, 0x54, 0x2f, 0xfd, 0x2a, 0x3f, 0x74,
-	0x62, 0xaf, 0xf2, 0x74, 0x1b, 0xd8, 0x61, 0x32, 0xe9, 0xbe, 0x6c, 0xe5, 0xa2, 0x94, 0xd4, 0xf1,
-	0x36, 0x88, 0x8a, 0x7c, 0x2c, 0x11, 0xc9, 0x31, 0xa6, 0x19, 0x29, 0x64, 0x4c, 0xd3, 0x81, 0xd3,
-	0x1e, 0x09, 0xbc, 0xdd, 0xca, 0xae, 0xc9, 0x92, 0x38, 0x78, 0x01, 0xbb, 0xc1, 0x8f, 0x16, 0x0b,
-	0x0d, 0x87, 0xd3, 0xa8, 0x70, 0x16, 0x7e, 0x45, 0x00, 0x1e, 0xeb, 0x2a, 0x00, 0xbf, 0x0b, 0xc6,
-	0x03, 0x62, 0x6e, 0x39, 0x96, 0x69, 0xd8, 0xb5, 0xaa, 0x88, 0x54, 0x1c, 0xcb, 0x72, 0x31, 0x08,
-	0xcb, 0xf5, 0xd0, 0x22, 0x0c, 0x74, 0xac, 0x86, 0xb8, 0x01, 0x7c, 0x73, 0xf4, 0x44, 0x50, 0xab,
-	0xde, 0xdd, 0x2b, 0xbf, 0x35, 0xb6, 0x4e, 0x89, 0x46, 0x75, 0xa9, 0x7d, 0xbb, 0x79, 0x29, 0xd8,
-	0x6d, 0x13, 0x7f, 0xfe, 0x56, 0xad, 0x8a, 0x69, 0xe3, 0x2c, 0x43, 0xa3, 0x89, 0x43, 0x18, 0x1a,
-	0x7d, 0x56, 0x83, 0xd3, 0x46, 0xf2, 0x75, 0x83, 0xf8, 0xb3, 0x93, 0xc5, 0xb9, 0x65, 0xf6, 0x8b,
-	0xc9, 0xe2, 0xbd, 0x62, 0x7c, 0xa7, 0x17, 0xd2, 0xe4, 0x70, 0x56, 0x1f, 0x90, 0x07, 0xa8, 0x65,
-	0x35, 0xa3, 0xbc, 0xce, 0x62, 0xd5, 0xa7, 0x8a, 0xe9, 0x6d, 0x56, 0x52, 0x98, 0x70, 0x06, 0x76,
-	0x74, 0x07, 0xc6, 0x25, 0x21, 0x49, 0xdc, 0x64, 0xaa, 0x47, 0xf1, 0x08, 0xc3, 0x6f, 0xbb, 0xf2,
-	0x03, 0x8b, 0x4c, 0x29, 0x7a, 0x61, 0x95, 0xd4, 0x0c, 0xe2, 0x95, 0x91, 0x8d, 0x7a, 0xba, 0xf8,
-	0x0b, 0x6b, 0x36, 0x46, 0xdc, 0x85, 0x1a, 0x0b, 0xc8, 0x66, 0xab, 0xe9, 0xd7, 0x67, 0x67, 0x8a,
-	0xc7, 0x33, 0x48, 0x64, 0x72, 0xe7, 0x5b, 0x33, 0x51, 0x88, 0x93, 0x04, 0xd1, 0x55, 0x40, 0x84,
-	0xab, 0xd2, 0xe3, 0xcb, 0x99, 0x3f, 0x8b, 0xa2, 0x34, 0xf5, 0x68, 0x29, 0x05, 0xc5, 0x19, 0x2d,
-	0x50, 0xa0, 0xe8, 0x4a, 0xfa, 0xb8, 0xe5, 0x24, 0xb3, 0x83, 0x74, 0xd5, 0x98, 0x7c, 0xb7, 0x96,
-	0x4a, 0xb0, 0xca, 0x2f, 0x37, 0xd7, 0xfb, 0x4f, 0xb0, 0x2a, 0xc8, 0xf7, 0x90, 0x66, 0x55, 0xff,
-	0x4d, 0x4d, 0x68, 0x79, 0x4f, 0xd0, 0xcc, 0xe8, 0xb8, 0xdf, 0x7f, 0xf5, 0x17, 0x61, 0xb6, 0x1e,
-	0x46, 0x2a, 0x6c, 0x24, 0x02, 0x92, 0x3f, 0x05, 0x93, 0xfc, 0x95, 0x65, 0xc5, 0x68, 0xaf, 0xc6,
-	0x2a, 0xf9, 0xc8, 0x4d, 0xbe, 0x22, 0x03, 0xb1, 0x5a, 0x57, 0xff, 0x9a, 0x06, 0xe7, 0x55, 0xcc,
-	0xae, 0x67, 0xbd, 0xde, 0x3f, 0x62, 0xf4, 0x71, 0x0d, 0xc6, 0xe3, 0x07, 0xc4, 0x50, 0x2a, 0x2a,
-	0xe4, 0x8e, 0x11, 0xf6, 0x8a, 0x78, 0xd2, 0x8b, 0x52, 0x3a, 0xcb, 0x5d, 0x0c, 0xf4, 0xb1, 0x4c,
-	0x5a, 0xff, 0xef, 0x1a, 0xa4, 0x6e, 0xe6, 0x68, 0x03, 0x46, 0x28, 0x91, 0xea, 0x6a, 0x5d, 0xec,
-	0x89, 0xa7, 0x8a, 0x09, 0x6c, 0x0c, 0x05, 0x7f, 0x6f, 0x10, 0x3f, 0x70, 0x88, 0x98, 0xde, 0xf5,
-	0x1d, 0x29, 0x5b, 0x89, 0xd8, 0x1e, 0x85, 0x24, 0x62, 0x39, 0xeb, 0x09, 0xbf, 0x31, 0xcb, 0x25,
-	0x58, 0xa1, 0xa3, 0x2f, 0x03, 0xc4, 0xda, 0x94, 0xbe, 0xcd, 0xf6, 0xfe, 0xe5, 0x29, 0x38, 0xdb,
-	0xaf, 0xd3, 0x18, 0x4b, 0xf2, 0x4e, 0xb6, 0x2d, 0x33, 0x58, 0xd8, 0x0c, 0x88, 0x77, 0xf3, 0xe6,
-	0xca, 0xfa, 0x96, 0x47, 0xfc, 0x2d, 0xd7, 0x6e, 0x14, 0xcc, 0x32, 0xcf, 0x6e, 0xfd, 0x4b, 0x99,
-	0x18, 0x71, 0x0e, 0x25, 0xa6, 0x49, 0xda, 0xe6, 0x77, 0x6c, 0x4c, 0xaf, 0x33, 0x1d, 0xcf, 0x0f,
-	0x44, 0xf0, 0x31, 0xae, 0x49, 0x4a, 0x02, 0x71, 0xba, 0x7e, 0x12, 0x09, 0x4b, 0xc5, 0xc5, 0x44,
-	0x62, 0x2d, 0x8d, 0x84, 0x01, 0x71, 0xba, 0xbe, 0x8c, 0x84, 0xaf, 0x14, 0x3d, 0x6f, 0x86, 0xd2,
-	0x48, 0x22, 0x20, 0x4e, 0xd7, 0x47, 0x0d, 0xb8, 0xcf, 0x23, 0xa6, 0xdb, 0x6a, 0x11, 0xa7, 0xc1,
-	0x26, 0x65, 0xc5, 0xf0, 0x9a, 0x96, 0x73, 0xd5, 0x33, 0x58, 0x45, 0xa6, 0x98, 0xd7, 0x58, 0xd6,
-	0xcc, 0xfb, 0x70, 0x97, 0x7a, 0xb8, 0x2b, 0x16, 0xd4, 0x82, 0x53, 0x3c, 0xb1, 0xb9, 0x57, 0x73,
-	0x02, 0xe2, 0x6d, 0x1b, 0xb6, 0xd0, 0xbe, 0x1f, 0x76, 0xc5, 0xd8, 0x19, 0x78, 0x4b, 0x45, 0x85,
-	0x93, 0xb8, 0xd1, 0x2e, 0x95, 0x7c, 0x45, 0x77, 0x24, 0x92, 0xa3, 0x85, 0x48, 0x0a, 0xe9, 0x37,
-	0x85, 0x0e, 0x67, 0xd1, 0x40, 0x35, 0x38, 0x1d, 0x18, 0x5e, 0x93, 0x04, 0x95, 0xb5, 0x5b, 0x6b,
-	0xc4, 0x33, 0x29, 0x8f, 0xb5, 0xb9, 0x20, 0xac, 0x71, 0x54, 0xeb, 0x69, 0x30, 0xce, 0x6a, 0x83,
-	0x3e, 0x04, 0x0f, 0xa9, 0x93, 0xba, 0xec, 0xde, 0x21, 0xde, 0xa2, 0xdb, 0x71, 0x1a, 0x2a, 0x72,
-	0x60, 0xc8, 0x1f, 0xd9, 0xdf, 0x2b, 0x3f, 0x84, 0x7b, 0x69, 0x80, 0x7b, 0xc3, 0x9b, 0xee, 0xc0,
-	0xad, 0x76, 0x3b, 0xb3, 0x03, 0xe3, 0x79, 0x1d, 0xc8, 0x69, 0x80, 0x7b, 0xc3, 0x8b, 0x30, 0x9c,
-	0xe3, 0x13, 0xc3, 0x73, 0xbc, 0x4a, 0x14, 0x27, 0x18, 0x45, 0xf6, 0xfd, 0xae, 0x67, 0xd6, 0xc0,
-	0x39, 0x2d, 0xe9, 0x99, 0xf2, 0x70, 0xde, 0xf0, 0x53, 0x64, 0x26, 0x19, 0x99, 0xb7, 0xef, 0xef,
-	0x95, 0x1f, 0xc6, 0x3d, 0xb6, 0xc1, 0x3d, 0x63, 0xcf, 0xe8, 0x4a, 0x3c, 0x11, 0xa9, 0xae, 0x4c,
-	0xe5, 0x75, 0x25, 0xbf, 0x0d, 0xee, 0x19, 0x3b, 0xfa, 0x5e, 0x0d, 0xee, 0x31, 0xdb, 0x9d, 0xeb,
-	0x96, 0x1f, 0xb8, 0x4d, 0xcf, 0x68, 0x55, 0x89, 0x69, 0xec, 0x5e, 0x37, 0xec, 0xcd, 0x65, 0x6b,
-	0x93, 0x08, 0x79, 0xfe, 0xb0, 0x1f, 0x0e, 0x73, 0xaa, 0xad, 0xac, 0xdd, 0xca, 0x46, 0x8a, 0xf3,
-	0xe9, 0xa1, 0x1f, 0xd2, 0xe0, 0x3e, 0x9e, 0x66, 0x3d, 0xa7, 0x43, 0xd3, 0x85, 0x3a, 0xc4, 0xb8,
-	0xd8, 0x4a, 0x17, 0xbc, 0xb8, 0x2b, 0x55, 0x36, 0x49, 0xbc, 0xc2, 0x42, 0xb3, 0xe9, 0x91, 0x26,
-	0xc3, 0x1a, 0x71, 0x97, 0x99, 0xe2, 0x93, 0xb4, 0x92, 0x87, 0x14, 0xe7, 0xd3, 0x43, 0xaf, 0xc2,
-	0x85, 0x5c, 0x60, 0xc5, 0xed, 0x38, 0x01, 0x7b, 0xdf, 0x18, 0x58, 0xd4, 0xf7, 0xf7, 0xca, 0x17,
-	0x56, 0xba, 0xd6, 0xc4, 0x07, 0x60, 0xd2, 0x3f, 0xab, 0x81, 0xf0, 0xbc, 0x43, 0xf7, 0x29, 0x36,
-	0x1b, 0xa3, 0x09, 0x7b, 0x8d, 0x30, 0x79, 0x62, 0x29, 0x33, 0x79, 0xe2, 0xdb, 0xa4, 0x58, 0x9d,
-	0x63, 0xb1, 0x38, 0xcc, 0x31, 0x4b, 0x99, 0xcb, 0x1f, 0x85, 0xb1, 0xe8, 0x46, 0x22, 0x34, 0x45,
-	0x2c, 0x71, 0x41, 0x7c, 0x75, 0x89, 0xe1, 0xfa, 0xaf, 0x6b, 0x00, 0x71, 0x4e, 0xd0, 0xde, 0xf2,
-	0xad, 0x1f, 0x68, 0x46, 0x2e, 0x65, 0xd3, 0x1f, 0xc8, 0xcd, 0xa6, 0x7f, 0x4c, 0xe9, 0xd3, 0x7f,
-	0x4e, 0x83, 0x53, 0x6a, 0xf0, 0x54, 0x1f, 0x3d, 0x04, 0x23, 0x22, 0xe4, 0xbb, 0x88, 0xd9, 0xcc,
-	0x9a, 0x8a, 0xf0, 0x63, 0x38, 0x84, 0xa9, 0x4f, 0x7b, 0x7d, 0xa8, 0x6e, 0xb3, 0x63, 0xb8, 0x1e,
-	0xa0, 0x45, 0xfd, 0xe1, 0xd3, 0x30, 0xcc, 0x0d, 0x68, 0xa9, 0xa4, 0x96, 0x11, 0x76, 0xe5, 0x46,
-	0xf1, 0xb0, 0xe4, 0x45, 0x42, 0x53, 0xc8, 0xc9, 0xd9, 0x4a, 0x5d, 0x93, 0xb3, 0x61, 0x18, 0x30,
-	0x3d, 0xab, 0x1f, 0x33, 0x8e, 0x0a, 0xae, 0x71, 0x33, 0x8e, 0x0a, 0xae, 0x61, 0x8a, 0x8c, 0xde,
-	0x9f, 0x25, 0xfb, 0x86, 0xc1, 0xe2, 0xf7, 0x67, 0x3e, 0x01, 0x92, 0x95, 0xc3, 0x54, 0x57, 0x0b,
-	0x87, 0x30, 0x20, 0xf3, 0x50, 0x71, 0xb7, 0x0e, 0x31, 0xe5, 0xbd, 0x04, 0x64, 0x0e, 0x3f, 0xa4,
-	0xe1, 0xdc, 0x0f, 0x69, 0x13, 0x46, 0xc4, 0xa7, 0x20, 0x44, 0xbe, 0xa7, 0xfa, 0x48, 0x41, 0x2c,
-	0x25, 0x3b, 0xe1, 0x05, 0x38, 0x44, 0x4e, 0xef, 0x11, 0x2d, 0x63, 0xc7, 0x6a, 0x75, 0x5a, 0x4c,
-	0xce, 0x1b, 0x92, 0xab, 0xb2, 0x62, 0x1c, 0xc2, 0x59, 0x55, 0xee, 0x0d, 0xc3, 0xe4, 0x32, 0xb9,
-	0x2a, 0x2f, 0xc6, 0x21, 0x1c, 0xbd, 0x0c, 0xa3, 0x2d, 0x63, 0xa7, 0xde, 0xf1, 0x9a, 0x44, 0x58,
-	0x37, 0xe4, 0x5f, 0xfb, 0x3b, 0x81, 0x65, 0xcf, 0x5b, 0x4e, 0xe0, 0x07, 0xde, 0x7c, 0xcd, 0x09,
-	0x6e, 0x7a, 0xf5, 0xc0, 0x8b, 0x92, 0xbc, 0xaf, 0x08, 0x2c, 0x38, 0xc2, 0x87, 0x6c, 0x98, 0x6a,
-	0x19, 0x3b, 0xb7, 0x1c, 0x83, 0xc7, 0xda, 0x16, 0x72, 0x54, 0x11, 0x0a, 0x4c, 0xa5, 0xb1, 0xa2,
-	0xe0, 0xc2, 0x09, 0xdc, 0x19, 0x96, 0x74, 0x13, 0xc7, 0x65, 0x49, 0xb7, 0x10, 0xf9, 0x97, 0x73,
-	0x7d, 0xe8, 0x3d, 0x99, 0x91, 0xa9, 0xba, 0xfa, 0x8e, 0xbf, 0x12, 0xf9, 0x8e, 0x4f, 0x15, 0x37,
-	0xfd, 0xea, 0xe2, 0x37, 0xde, 0x81, 0xf1, 0x86, 0x11, 0x18, 0xbc, 0xd4, 0x9f, 0x3d, 0x55, 0xfc,
-	0x69, 0xaf, 0x1a, 0xa1, 0x89, 0x59, 0x52, 0x5c, 0xe6, 0x63, 0x99, 0x0e, 0xba, 0x09, 0x67, 0xe9,
-	0xc7, 0x6a, 0x93, 0x20, 0xae, 0xc2, 0xb4, 0x22, 0xd3, 0xec, 0xfb, 0x61, 0xfe, 0x45, 0x37, 0xb2,
-	0x2a, 0xe0, 0xec, 0x76, 0x71, 0x14, 0xc5, 0x99, 0xec, 0x28, 0x8a, 0xe8, 0x53, 0x59, 0x36, 0x0b,
-	0x88, 0xcd, 0xe9, 0x7b, 0x8b, 0xf3, 0x86, 0xc2, 0x96, 0x0b, 0xff, 0x5c, 0x83, 0x59, 0xb1, 0xcb,
-	0x84, 0x9d, 0x81, 0x4d, 0xbc, 0x15, 0xc3, 0x31, 0x9a, 0xc4, 0x13, 0x4a, 0xc6, 0xf5, 0x3e, 0xf8,
-	0x43, 0x0a, 0x67, 0xe4, 0xd4, 0xff, 0xe0, 0xfe, 0x5e, 0xf9, 0xe2, 0x41, 0xb5, 0x70, 0x6e, 0xdf,
-	0x90, 0x07, 0x23, 0xfe, 0xae, 0x6f, 0x06, 0xb6, 0x3f, 0x7b, 0x86, 0x6d, 0x96, 0x6b, 0x7d, 0x70,
-	0xd6, 0x3a, 0xc7, 0xc4, 0x59, 0x6b, 0x9c, 0x62, 0x8b, 0x97, 0xe2, 0x90, 0x10, 0xfa, 0x01, 0x0d,
-	0x66, 0xc4, 0xcb, 0x83, 0x14, 0x38, 0xe5, 0x6c, 0x71, 0x0f, 0x87, 0x4a, 0x12, 0x59, 0x68, 0x5b,
-	0xc0, 0xf4, 0x05, 0x29, 0x28, 0x4e, 0x53, 0xa7, 0x87, 0x6a, 0xdb, 0xb3, 0x5c, 0xcf, 0x0a, 0x76,
-	0x99, 0x15, 0xc7, 0x50, 0x18, 0x66, 0x97, 0x97, 0xe1, 0x08, 0x8a, 0xea, 0x30, 0xc5, 0xef, 0xe5,
-	0xf5, 0xc0, 0x33, 0x02, 0xd2, 0xdc, 0x15, 0xa6, 0x16, 0x8f, 0xb2, 0x54, 0x94, 0x0a, 0xe4, 0xee,
-	0x5e, 0xf9, 0xac, 0x58, 0x1b, 0x15, 0x80, 0x13, 0x28, 0xd0, 0x07, 0x12, 0x96, 0x2f, 0xb3, 0xc5,
-	0xd3, 0x54, 0xf1, 0xb5, 0x38, 0x8c, 0xfd, 0x4b, 0xbf, 0x61, 0x9d, 0xfa, 0x48, 0x15, 0x30, 0x77,
-	0x05, 0x26, 0xe4, 0x5d, 0x73, 0xa8, 0x68, 0x52, 0x67, 0x00, 0xa5, 0x07, 0xab, 0xff, 0xb8, 0x06,
-	0xd3, 0x49, 0xd9, 0x02, 0x6d, 0xc1, 0x88, 0x60, 0x34, 0x42, 0xa3, 0xb9, 0x50, 0xd4, 0x24, 0xd3,
-	0x26, 0xc2, 0xf1, 0x92, 0x8b, 0xaa, 0xa2, 0x08, 0x87, 0xe8, 0x65, 0x73, 0xeb, 0x52, 0x17, 0x73,
-	0xeb, 0xa7, 0xe1, 0x5c, 0x36, 0xcb, 0xa1, 0x82, 0xbe, 0x61, 0xdb, 0xee, 0x1d, 0xa1, 0x36, 0x8c,
-	0x93, 0x6f, 0xd3, 0x42, 0xcc, 0x61, 0xfa, 0x07, 0x21, 0x99, 0xc2, 0x06, 0xbd, 0x0a, 0x63, 0xbe,
-	0xbf, 0xc5, 0x8d, 0x68, 0xc4, 0x20, 0x8b, 0x29, 0xdb, 0xc3, 0x74, 0x02, 0xfc, 0x6e, 0x12, 0xfd,
-	0xc4, 0x31, 0xfa, 0xc5, 0x97, 0xbe, 0xfc, 0xb5, 0x0b, 0x6f, 0xf9, 0x8d, 0xaf, 0x5d, 0x78, 0xcb,
-	0x57, 0xbf, 0x76, 0xe1, 0x2d, 0x1f, 0xde, 0xbf, 0xa0, 0x7d, 0x79, 0xff, 0x82, 0xf6, 0x1b, 0xfb,
-	0x17, 0xb4, 0xaf, 0xee, 0x5f, 0xd0, 0xfe, 0x70, 0xff, 0x82, 0xf6, 0xfd, 0xff, 0xf1, 0xc2, 0x5b,
-	0x5e, 0xbe, 0x1c, 0x53, 0xbf, 0x14, 0x12, 0x8d, 0xff, 0x69, 0xdf, 0x6e, 0x5e, 0xa2, 0xd4, 0x43,
-	0x6f, 0x7b, 0x46, 0xfd, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x17, 0x33, 0x0c, 0xd5, 0x1a,
-	0x01, 0x00,
+	// 14481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x59, 0x70, 0x24, 0xc9,
+	0x75, 0xd8, 0x56, 0xe3, 0x7e, 0x38, 0x06, 0xc8, 0xb9, 0x7a, 0xb0, 0xbb, 0x83, 0x61, 0xed, 0x92,
+	0xde, 0x15, 0x49, 0x8c, 0x76, 0x79, 0x2f, 0xb5, 0x5c, 0x02, 0x0d, 0xcc, 0x0c, 0x38, 0xc0, 0x0c,
+	0xf8, 0x1a, 0xd8, 0x59, 0x91, 0xd2, 0x52, 0x85, 0xee, 0x44, 0xa3, 0x76, 0xba, 0xab, 0x7a, 0xab,
+	0xaa, 0x67, 0x80, 0x25, 0x29, 0x52, 0xa7, 0x49, 0x8a, 0x52, 0xe8, 0xb2, 0x69, 0x52, 0x87, 0x49,
+	0x9d, 0x3e, 0x25, 0x5b, 0x0e, 0xd9, 0x21, 0xc9, 0x8e, 0xb0, 0x15, 0x61, 0x8b, 0x72, 0x48, 0x0e,
+	0x85, 0x8e, 0x30, 0x65, 0x4b, 0x90, 0x08, 0xcb, 0x92, 0x1d, 0x3e, 0xc3, 0x0a, 0xdb, 0xe1, 0xb1,
+	0x43, 0x72, 0xe4, 0x51, 0x55, 0x99, 0x75, 0x34, 0x1a, 0xd5, 0x00, 0x96, 0x2b, 0xe9, 0x0b, 0xe8,
+	0x7c, 0x99, 0xef, 0xe5, 0x55, 0x2f, 0x5f, 0xbe, 0x7c, 0x07, 0x2c, 0x36, 0xec, 0x60, 0xa7, 0xb3,
+	0x35, 0x5f, 0x73, 0x5b, 0x57, 0x1b, 0x96, 0x57, 0xa7, 0x0e, 0xf5, 0xe2, 0x7f, 0xda, 0x77, 0x1b,
+	0x57, 0xad, 0xb6, 0xed, 0x5f, 0xad, 0xb9, 0x1e, 0xbd, 0x7a, 0xef, 0xa9, 0x2d, 0x1a, 0x58, 0x4f,
+	0x5d, 0x6d, 0x30, 0x98, 0x15, 0xd0, 0xfa, 0x7c, 0xdb, 0x73, 0x03, 0x97, 0x3c, 0x1d, 0xe3, 0x98,
+	0x0f, 0x9b, 0xc6, 0xff, 0xb4, 0xef, 0x36, 0xe6, 0x19, 0x8e, 0x79, 0x86, 0x63, 0x5e, 0xe2, 0x98,
+	0x7d, 0xb3, 0x4a, 0xd7, 0x6d, 0xb8, 0x57, 0x39, 0xaa, 0xad, 0xce, 0x36, 0xff, 0xc5, 0x7f, 0xf0,
+	0xff, 0x04, 0x89, 0xd9, 0x27, 0xef, 0xbe, 0xd3, 0x9f, 0xb7, 0x5d, 0xd6, 0x99, 0xab, 0x56, 0x27,
+	0x70, 0xfd, 0x9a, 0xd5, 0xb4, 0x9d, 0xc6, 0xd5, 0x7b, 0xa9, 0xde, 0xcc, 0x9a, 0x4a, 0x55, 0xd9,
+	0xed, 0xae, 0x75, 0xbc, 0x2d, 0xab, 0x96, 0x55, 0xe7, 0x46, 0x5c, 0x87, 0xee, 0x06, 0xd4, 0xf1,
+	0x6d, 0xd7, 0xf1, 0xdf, 0xcc, 0x46, 0x42, 0xbd, 0x7b, 0xea, 0xdc, 0x68, 0x15, 0xb2, 0x30, 0xbd,
+	0x35, 0xc6, 0xd4, 0xb2, 0x6a, 0x3b, 0xb6, 0x43, 0xbd, 0xbd, 0xb0, 0xf9, 0x55, 0x8f, 0xfa, 0x6e,
+	0xc7, 0xab, 0xd1, 0x23, 0xb5, 0xf2, 0xaf, 0xb6, 0x68, 0x60, 0x65, 0xd1, 0xba, 0x9a, 0xd7, 0xca,
+	0xeb, 0x38, 0x81, 0xdd, 0x4a, 0x93, 0x79, 0xfb, 0x61, 0x0d, 0xfc, 0xda, 0x0e, 0x6d, 0x59, 0xa9,
+	0x76, 0x6f, 0xc9, 0x6b, 0xd7, 0x09, 0xec, 0xe6, 0x55, 0xdb, 0x09, 0xfc, 0xc0, 0x4b, 0x36, 0x32,
+	0x3f, 0x65, 0xc0, 0xf4, 0xc2, 0xfa, 0x4a, 0x95, 0xcf, 0xe0, 0xaa, 0xdb, 0x68, 0xd8, 0x4e, 0x83,
+	0xbc, 0x11, 0xc6, 0xee, 0x51, 0x6f, 0xcb, 0xf5, 0xed, 0x60, 0xaf, 0x6c, 0x5c, 0x31, 0x9e, 0x18,
+	0x5a, 0x9c, 0x3c, 0xd8, 0x9f, 0x1b, 0x7b, 0x3e, 0x2c, 0xc4, 0x18, 0x4e, 0x56, 0xe0, 0xec, 0x4e,
+	0x10, 0xb4, 0x17, 0x6a, 0x35, 0xea, 0xfb, 0x51, 0x8d, 0x72, 0x89, 0x37, 0xbb, 0x78, 0xb0, 0x3f,
+	0x77, 0xf6, 0xc6, 0xc6, 0xc6, 0x7a, 0x02, 0x8c, 0x59, 0x6d, 0xcc, 0x9f, 0x31, 0x60, 0x26, 0xea,
+	0x0c, 0xd2, 0x97, 0x3b, 0xd4, 0x0f, 0x7c, 0x82, 0x70, 0xa1, 0x65, 0xed, 0xde, 0x72, 0x9d, 0xb5,
+	0x4e, 0x60, 0x05, 0xb6, 0xd3, 0x58, 0x71, 0xb6, 0x9b, 0x76, 0x63, 0x27, 0x90, 0x5d, 0x9b, 0x3d,
+	0xd8, 0x9f, 0xbb, 0xb0, 0x96, 0x59, 0x03, 0x73, 0x5a, 0xb2, 0x4e, 0xb7, 0xac, 0xdd, 0x14, 0x42,
+	0xa5, 0xd3, 0x6b, 0x69, 0x30, 0x66, 0xb5, 0x31, 0xdf, 0x06, 0x33, 0x62, 0x1c, 0x48, 0xfd, 0xc0,
+	0xb3, 0x6b, 0x81, 0xed, 0x3a, 0xe4, 0x0a, 0x0c, 0x3a, 0x56, 0x8b, 0xf2, 0x1e, 0x8e, 0x2d, 0x4e,
+	0x7c, 0x71, 0x7f, 0xee, 0xa1, 0x83, 0xfd, 0xb9, 0xc1, 0x5b, 0x56, 0x8b, 0x22, 0x87, 0x98, 0xff,
+	0xab, 0x04, 0x8f, 0xa4, 0xda, 0xdd, 0xb1, 0x83, 0x9d, 0xdb, 0x6d, 0xf6, 0x9f, 0x4f, 0xbe, 0xcb,
+	0x80, 0x19, 0x2b, 0x59, 0x81, 0x23, 0x1c, 0x7f, 0x7a, 0x79, 0xfe, 0xe8, 0x1f, 0xf8, 0x7c, 0x8a,
+	0xda, 0xe2, 0x25, 0xd9, 0xaf, 0xf4, 0x00, 0x30, 0x4d, 0x9a, 0x7c, 0xc2, 0x80, 0x11, 0x57, 0x74,
+	0xae, 0x5c, 0xba, 0x32, 0xf0, 0xc4, 0xf8, 0xd3, 0x5f, 0x7f, 0x2c, 0xdd, 0x50, 0x06, 0x3d, 0x2f,
+	0xff, 0x2e, 0x3b, 0x81, 0xb7, 0xb7, 0x78, 0x46, 0x76, 0x6f, 0x44, 0x96, 0x62, 0x48, 0x7e, 0xf6,
+	0x19, 0x98, 0x50, 0x6b, 0x92, 0x69, 0x18, 0xb8, 0x4b, 0xc5, 0x56, 0x1d, 0x43, 0xf6, 0x2f, 0x39,
+	0x07, 0x43, 0xf7, 0xac, 0x66, 0x87, 0xf2, 0x25, 0x1d, 0x43, 0xf1, 0xe3, 0x99, 0xd2, 0x3b, 0x0d,
+	0xf3, 0x69, 0x18, 0x5a, 0xa8, 0xd7, 0x5d, 0x87, 0x3c, 0x09, 0x23, 0xd4, 0xb1, 0xb6, 0x9a, 0xb4,
+	0xce, 0x1b, 0x8e, 0xc6, 0xf4, 0x96, 0x45, 0x31, 0x86, 0x70, 0xf3, 0xaf, 0x94, 0x60, 0x98, 0x37,
+	0xf2, 0xc9, 0xf7, 0x1a, 0x70, 0xf6, 0x6e, 0x67, 0x8b, 0x7a, 0x0e, 0x0d, 0xa8, 0xbf, 0x64, 0xf9,
+	0x3b, 0x5b, 0xae, 0xe5, 0xd5, 0xe5, 0xc2, 0x5c, 0x2f, 0x32, 0x23, 0x37, 0xd3, 0xe8, 0xc4, 0x1e,
+	0xcc, 0x00, 0x60, 0x16, 0x71, 0x72, 0x0f, 0x26, 0x9c, 0x86, 0xed, 0xec, 0xae, 0x38, 0x0d, 0x8f,
+	0xfa, 0x3e, 0x1f, 0xf4, 0xf8, 0xd3, 0xef, 0x2d, 0xd2, 0x99, 0x5b, 0x0a, 0x9e, 0xc5, 0xe9, 0x83,
+	0xfd, 0xb9, 0x09, 0xb5, 0x04, 0x35, 0x3a, 0xe6, 0x9f, 0x18, 0x70, 0x66, 0xa1, 0xde, 0xb2, 0x7d,
+	0xc6, 0x69, 0xd7, 0x9b, 0x9d, 0x86, 0xdd, 0xc3, 0xd6, 0x27, 0xef, 0x87, 0xe1, 0x9a, 0xeb, 0x6c,
+	0xdb, 0x0d, 0xd9, 0xcf, 0x37, 0xcf, 0x0b, 0xce, 0x35, 0xaf, 0x72, 0x2e, 0xde, 0x3d, 0xc9, 0xf1,
+	0xe6, 0xd1, 0xba, 0xbf, 0x1c, 0x32, 0xf4, 0x45, 0x38, 0xd8, 0x9f, 0x1b, 0xae, 0x70, 0x04, 0x28,
+	0x11, 0x91, 0x27, 0x60, 0xb4, 0x6e, 0xfb, 0x62, 0x31, 0x07, 0xf8, 0x62, 0x4e, 0x1c, 0xec, 0xcf,
+	0x8d, 0x2e, 0xc9, 0x32, 0x8c, 0xa0, 0x64, 0x15, 0xce, 0xb1, 0x19, 0x14, 0xed, 0xaa, 0xb4, 0xe6,
+	0xd1, 0x80, 0x75, 0xad, 0x3c, 0xc8, 0xbb, 0x5b, 0x3e, 0xd8, 0x9f, 0x3b, 0x77, 0x33, 0x03, 0x8e,
+	0x99, 0xad, 0xcc, 0x6b, 0x30, 0xba, 0xd0, 0xa4, 0x1e, 0x63, 0x08, 0xe4, 0x19, 0x98, 0xa2, 0x2d,
+	0xcb, 0x6e, 0x22, 0xad, 0x51, 0xfb, 0x1e, 0xf5, 0xfc, 0xb2, 0x71, 0x65, 0xe0, 0x89, 0xb1, 0x45,
+	0x72, 0xb0, 0x3f, 0x37, 0xb5, 0xac, 0x41, 0x30, 0x51, 0xd3, 0xfc, 0x26, 0x03, 0xc6, 0x17, 0x3a,
+	0x75, 0x3b, 0x10, 0xe3, 0x22, 0x1e, 0x8c, 0x5b, 0xec, 0xe7, 0xba, 0xdb, 0xb4, 0x6b, 0x7b, 0x72,
+	0x73, 0x3d, 0x57, 0xe8, 0x73, 0x8b, 0xd1, 0x2c, 0x9e, 0x39, 0xd8, 0x9f, 0x1b, 0x57, 0x0a, 0x50,
+	0x25, 0x62, 0xee, 0x80, 0x0a, 0x23, 0x5f, 0x0b, 0x13, 0x62, 0xb8, 0x6b, 0x56, 0x1b, 0xe9, 0xb6,
+	0xec, 0xc3, 0x63, 0xca, 0x5a, 0x85, 0x84, 0xe6, 0x6f, 0x6f, 0xbd, 0x44, 0x6b, 0x01, 0xd2, 0x6d,
+	0xea, 0x51, 0xa7, 0x46, 0xc5, 0xb6, 0xa9, 0x28, 0x8d, 0x51, 0x43, 0x65, 0x7e, 0x9f, 0x01, 0x8f,
+	0x2e, 0x74, 0x82, 0x1d, 0xd7, 0xb3, 0x5f, 0xa1, 0x5e, 0x3c, 0xdd, 0x11, 0x06, 0xf2, 0x1e, 0x98,
+	0xb2, 0xa2, 0x0a, 0xb7, 0xe2, 0xed, 0x74, 0x41, 0x6e, 0xa7, 0xa9, 0x05, 0x0d, 0x8a, 0x89, 0xda,
+	0xe4, 0x69, 0x00, 0x3f, 0x5e, 0x5b, 0xce, 0x03, 0x16, 0x89, 0x6c, 0x0b, 0xca, 0xaa, 0x2a, 0xb5,
+	0xcc, 0xdf, 0x63, 0x47, 0xe1, 0x3d, 0xcb, 0x6e, 0x5a, 0x5b, 0x76, 0xd3, 0x0e, 0xf6, 0x3e, 0xe0,
+	0x3a, 0xb4, 0x87, 0xdd, 0xbc, 0x09, 0x17, 0x3b, 0x8e, 0x25, 0xda, 0x35, 0xe9, 0x9a, 0xd8, 0xbf,
+	0x1b, 0x7b, 0x6d, 0x2a, 0xb8, 0xe4, 0xd8, 0xe2, 0xc3, 0x07, 0xfb, 0x73, 0x17, 0x37, 0xb3, 0xab,
+	0x60, 0x5e, 0x5b, 0x76, 0xea, 0x29, 0xa0, 0xe7, 0xdd, 0x66, 0xa7, 0x25, 0xb1, 0x0e, 0x70, 0xac,
+	0xfc, 0xd4, 0xdb, 0xcc, 0xac, 0x81, 0x39, 0x2d, 0xcd, 0x2f, 0x96, 0x60, 0x62, 0xd1, 0xaa, 0xdd,
+	0xed, 0xb4, 0x17, 0x3b, 0xb5, 0xbb, 0x34, 0x20, 0xdf, 0x00, 0xa3, 0x4c, 0x6c, 0xa9, 0x5b, 0x81,
+	0x25, 0xd7, 0xf7, 0xab, 0x73, 0xbf, 0x45, 0xbe, 0xb5, 0x58, 0xed, 0x78, 0xc5, 0xd7, 0x68, 0x60,
+	0xc5, 0xd3, 0x1a, 0x97, 0x61, 0x84, 0x95, 0x6c, 0xc3, 0xa0, 0xdf, 0xa6, 0x35, 0xf9, 0xa5, 0x2f,
+	0x15, 0xd9, 0xc1, 0x6a, 0x8f, 0xab, 0x6d, 0x5a, 0x8b, 0x57, 0x81, 0xfd, 0x42, 0x8e, 0x9f, 0x38,
+	0x30, 0xec, 0x07, 0x56, 0xd0, 0xf1, 0xf9, 0xe7, 0x3f, 0xfe, 0xf4, 0xb5, 0xbe, 0x29, 0x71, 0x6c,
+	0x8b, 0x53, 0x92, 0xd6, 0xb0, 0xf8, 0x8d, 0x92, 0x8a, 0xf9, 0xaf, 0x0d, 0x98, 0x56, 0xab, 0xaf,
+	0xda, 0x7e, 0x40, 0xbe, 0x2e, 0x35, 0x9d, 0xf3, 0xbd, 0x4d, 0x27, 0x6b, 0xcd, 0x27, 0x73, 0x5a,
+	0x92, 0x1b, 0x0d, 0x4b, 0x94, 0xa9, 0xa4, 0x30, 0x64, 0x07, 0xb4, 0x15, 0x1e, 0xbe, 0xef, 0xed,
+	0x77, 0x84, 0x8b, 0x93, 0x92, 0xd8, 0xd0, 0x0a, 0x43, 0x8b, 0x02, 0xbb, 0xf9, 0x0d, 0x70, 0x4e,
+	0xad, 0xb5, 0xee, 0xb9, 0xf7, 0xec, 0x3a, 0xf5, 0xd8, 0x97, 0x10, 0xec, 0xb5, 0x53, 0x5f, 0x02,
+	0xdb, 0x59, 0xc8, 0x21, 0xe4, 0x0d, 0x30, 0xec, 0xd1, 0x06, 0x93, 0x52, 0xc4, 0x07, 0x17, 0xcd,
+	0x1d, 0xf2, 0x52, 0x94, 0x50, 0xf3, 0x7f, 0x96, 0xf4, 0xb9, 0x63, 0xcb, 0x48, 0xee, 0xc1, 0x68,
+	0x5b, 0x92, 0x92, 0x73, 0x77, 0xa3, 0xdf, 0x01, 0x86, 0x5d, 0x8f, 0x67, 0x35, 0x2c, 0xc1, 0x88,
+	0x16, 0xb1, 0x61, 0x2a, 0xfc, 0xbf, 0xd2, 0xc7, 0xa1, 0xc4, 0x99, 0xfc, 0xba, 0x86, 0x08, 0x13,
+	0x88, 0xc9, 0x06, 0x8c, 0x09, 0x76, 0xc3, 0xd8, 0xe9, 0x40, 0x3e, 0x3b, 0xad, 0x86, 0x95, 0x24,
+	0x3b, 0x9d, 0x91, 0xdd, 0x1f, 0x8b, 0x00, 0x18, 0x23, 0x62, 0x47, 0x9f, 0x4f, 0x69, 0x5d, 0x39,
+	0xc4, 0xf8, 0xd1, 0x57, 0x95, 0x65, 0x18, 0x41, 0xcd, 0xcf, 0x0f, 0x02, 0x49, 0x6f, 0x71, 0x75,
+	0x06, 0x44, 0x49, 0xd9, 0xe8, 0x7b, 0x06, 0xe4, 0xd7, 0x92, 0x40, 0x4c, 0x5e, 0x81, 0xc9, 0xa6,
+	0xe5, 0x07, 0xb7, 0xdb, 0xd4, 0xb3, 0x82, 0x70, 0xa3, 0x8c, 0x3f, 0xbd, 0x50, 0x64, 0xa5, 0x57,
+	0x55, 0x44, 0x8b, 0x33, 0x07, 0xfb, 0x73, 0x93, 0x5a, 0x11, 0xea, 0xa4, 0xc8, 0x4b, 0x30, 0xc6,
+	0x0a, 0x96, 0x3d, 0xcf, 0xf5, 0xe4, 0xec, 0x3f, 0x5b, 0x94, 0x2e, 0x47, 0x22, 0xee, 0x44, 0xd1,
+	0x4f, 0x8c, 0xd1, 0x93, 0xf7, 0x01, 0x71, 0xb7, 0xf8, 0xad, 0xb4, 0x7e, 0x9d, 0x3a, 0xe1, 0x60,
+	0xd9, 0xea, 0x0c, 0x2c, 0xce, 0xca, 0xd5, 0x24, 0xb7, 0x53, 0x35, 0x30, 0xa3, 0x15, 0xb9, 0x0b,
+	0x24, 0xba, 0xb4, 0x45, 0x1b, 0xa0, 0x3c, 0xd4, 0xfb, 0xf6, 0xb9, 0xc0, 0x88, 0x5d, 0x4f, 0xa1,
+	0xc0, 0x0c, 0xb4, 0xe6, 0x3f, 0x2f, 0xc1, 0xb8, 0xd8, 0x22, 0x42, 0xb0, 0x3e, 0xf9, 0x03, 0x82,
+	0x6a, 0x07, 0x44, 0xa5, 0xf8, 0x37, 0xcf, 0x3b, 0x9c, 0x7b, 0x3e, 0xb4, 0x12, 0xe7, 0xc3, 0x72,
+	0xbf, 0x84, 0xba, 0x1f, 0x0f, 0xbf, 0x65, 0xc0, 0x19, 0xa5, 0xf6, 0x29, 0x9c, 0x0e, 0x75, 0xfd,
+	0x74, 0x78, 0xae, 0xcf, 0xf1, 0xe5, 0x1c, 0x0e, 0xae, 0x36, 0x2c, 0xce, 0xb8, 0x9f, 0x06, 0xd8,
+	0xe2, 0xec, 0x44, 0x11, 0xd3, 0xa2, 0x25, 0x5f, 0x8c, 0x20, 0xa8, 0xd4, 0xd2, 0x78, 0x56, 0xa9,
+	0x2b, 0xcf, 0xfa, 0xf7, 0x03, 0x30, 0x93, 0x9a, 0xf6, 0x34, 0x1f, 0x31, 0x5e, 0x25, 0x3e, 0x52,
+	0x7a, 0x35, 0xf8, 0xc8, 0x40, 0x21, 0x3e, 0xd2, 0xf3, 0x39, 0x41, 0x3c, 0x20, 0x2d, 0xbb, 0x21,
+	0x9a, 0x55, 0x03, 0xcb, 0x0b, 0x36, 0xec, 0x16, 0x95, 0x1c, 0xe7, 0xab, 0x7a, 0xdb, 0xb2, 0xac,
+	0x85, 0x60, 0x3c, 0x6b, 0x29, 0x4c, 0x98, 0x81, 0xdd, 0xfc, 0x96, 0x12, 0x8c, 0x2c, 0x5a, 0x3e,
+	0xef, 0xe9, 0x47, 0x61, 0x42, 0xa2, 0x5e, 0x69, 0x59, 0x0d, 0xda, 0xcf, 0xd5, 0x5a, 0xa2, 0x5c,
+	0x53, 0xd0, 0x89, 0xdb, 0x89, 0x5a, 0x82, 0x1a, 0x39, 0xb2, 0x07, 0xe3, 0xad, 0x58, 0x12, 0x2f,
+	0x97, 0xfa, 0x91, 0x27, 0x55, 0xea, 0x0c, 0x9b, 0xb8, 0x82, 0x29, 0x05, 0xa8, 0xd2, 0x32, 0x5f,
+	0x84, 0xb3, 0x19, 0x3d, 0xee, 0xe1, 0x12, 0xf2, 0x7a, 0x18, 0x61, 0xf7, 0xc8, 0x58, 0xf6, 0x1a,
+	0x67, 0x7a, 0x8c, 0xe7, 0x45, 0x11, 0x86, 0x30, 0xf3, 0xed, 0x40, 0x74, 0xfc, 0x8c, 0x6a, 0x2f,
+	0xca, 0xaa, 0x21, 0x80, 0xca, 0x02, 0xba, 0x81, 0xd8, 0x4a, 0xcf, 0xc1, 0x50, 0x7b, 0xc7, 0xf2,
+	0xc3, 0x16, 0x4f, 0x86, 0xac, 0x62, 0x9d, 0x15, 0x3e, 0xd8, 0x9f, 0x2b, 0x57, 0x3c, 0x5a, 0xa7,
+	0x4e, 0x60, 0x5b, 0x4d, 0x3f, 0x6c, 0xc4, 0x61, 0x28, 0xda, 0xb1, 0x1d, 0xc6, 0x36, 0x79, 0xc5,
+	0x6d, 0xb5, 0x9b, 0x94, 0x41, 0xf9, 0x0e, 0x2b, 0x15, 0xdb, 0x61, 0xab, 0x29, 0x4c, 0x98, 0x81,
+	0x3d, 0xa4, 0xb9, 0xe2, 0xd8, 0x81, 0x6d, 0x45, 0x34, 0x07, 0x8a, 0xd3, 0xd4, 0x31, 0x61, 0x06,
+	0x76, 0xf2, 0x29, 0x03, 0x66, 0xf5, 0xe2, 0x6b, 0xb6, 0x63, 0xfb, 0x3b, 0xb4, 0xbe, 0x61, 0xcb,
+	0xcf, 0xf0, 0x68, 0xc4, 0x2f, 0x1f, 0xec, 0xcf, 0xcd, 0xae, 0xe6, 0x62, 0xc4, 0x2e, 0xd4, 0xc8,
+	0x77, 0x1a, 0xf0, 0x70, 0x62, 0x5e, 0x3c, 0xbb, 0xd1, 0xa0, 0x1e, 0xad, 0x17, 0xfc, 0xc0, 0xe7,
+	0x0e, 0xf6, 0xe7, 0x1e, 0x5e, 0xcd, 0x47, 0x89, 0xdd, 0xe8, 0x91, 0x2f, 0x18, 0x70, 0xa1, 0x4d,
+	0x9d, 0xba, 0xed, 0x34, 0xee, 0xb8, 0xde, 0x5d, 0xa6, 0x16, 0x71, 0x9b, 0x4d, 0xb7, 0x13, 0xf8,
+	0xe5, 0x61, 0x7e, 0x86, 0xad, 0x14, 0xf9, 0xe6, 0xd6, 0xb3, 0x30, 0x2e, 0x5e, 0x96, 0x5b, 0xf4,
+	0x42, 0x26, 0xd8, 0xc7, 0x9c, 0x8e, 0x98, 0xbf, 0x68, 0xc0, 0x40, 0x05, 0x57, 0xc8, 0x1b, 0xb5,
+	0x4f, 0xe4, 0xa2, 0xfa, 0x89, 0x3c, 0xd8, 0x9f, 0x1b, 0xa9, 0xe0, 0x8a, 0xf2, 0x31, 0x7e, 0xa7,
+	0x01, 0x33, 0x35, 0xd7, 0x09, 0x2c, 0x36, 0x77, 0x28, 0x64, 0xe5, 0xf0, 0x5c, 0x2e, 0x74, 0x03,
+	0xae, 0x24, 0x90, 0xc5, 0x8a, 0xdb, 0x24, 0xc4, 0xc7, 0x34, 0x65, 0xf3, 0x47, 0x0c, 0x38, 0x57,
+	0xb1, 0xda, 0x52, 0xad, 0xb1, 0x44, 0xb7, 0x6d, 0xc7, 0xee, 0x4d, 0x4b, 0x4d, 0x76, 0x60, 0x98,
+	0x6b, 0x4e, 0xfd, 0x7e, 0x2e, 0xf0, 0x31, 0xed, 0xe7, 0x39, 0x2e, 0xa1, 0xc1, 0x13, 0xff, 0xa3,
+	0xc4, 0x6f, 0xfe, 0xa3, 0x12, 0x4c, 0xc6, 0x15, 0xab, 0x34, 0x20, 0x3f, 0x64, 0xc0, 0x44, 0x2d,
+	0x2c, 0xb1, 0xa9, 0x50, 0xa7, 0x8d, 0x3f, 0x5d, 0xed, 0xaf, 0x0b, 0x55, 0x1a, 0xc4, 0xbf, 0x6c,
+	0x2a, 0x55, 0xcd, 0x8f, 0xcb, 0xb1, 0x4f, 0xa8, 0xa0, 0x07, 0x89, 0xdf, 0xa8, 0x75, 0x67, 0xf6,
+	0xdb, 0x0c, 0x98, 0x49, 0x61, 0xca, 0x50, 0x45, 0x7f, 0x40, 0x55, 0x45, 0x1f, 0xd3, 0x14, 0xaa,
+	0x0a, 0xed, 0x67, 0x61, 0x3a, 0x09, 0x26, 0x73, 0xa1, 0x34, 0x28, 0x54, 0x90, 0x63, 0x49, 0x41,
+	0xee, 0x99, 0xd1, 0xbf, 0xf6, 0xf9, 0xb9, 0x87, 0x3e, 0xfe, 0x3b, 0x57, 0x1e, 0x32, 0xbf, 0x64,
+	0xc0, 0x44, 0xa5, 0xe9, 0x76, 0xea, 0xeb, 0x9e, 0xbb, 0x6d, 0x37, 0xe9, 0x6b, 0x43, 0x29, 0xa4,
+	0xf6, 0x38, 0x4f, 0xe8, 0xe7, 0x4a, 0x1a, 0xb5, 0xe2, 0x6b, 0x44, 0x49, 0xa3, 0x76, 0x39, 0x47,
+	0x0e, 0xff, 0x20, 0x9c, 0x57, 0x6b, 0xc5, 0x8a, 0xd3, 0x2b, 0x30, 0x78, 0xd7, 0x76, 0xea, 0xc9,
+	0x4f, 0xfa, 0xa6, 0xed, 0xd4, 0x91, 0x43, 0xa2, 0x8f, 0xbe, 0x94, 0x7b, 0xda, 0xef, 0x8f, 0xe9,
+	0xd3, 0xc6, 0xc5, 0xfc, 0x27, 0x60, 0xb4, 0x66, 0x2d, 0x76, 0x9c, 0x7a, 0x33, 0xe2, 0x17, 0x6c,
+	0x0a, 0x2a, 0x0b, 0xa2, 0x0c, 0x23, 0x28, 0x79, 0x05, 0x20, 0x7e, 0xa3, 0xe8, 0x47, 0x7c, 0x8a,
+	0x9f, 0x3f, 0xaa, 0x34, 0x08, 0x6c, 0xa7, 0xe1, 0xc7, 0xfb, 0x2a, 0x86, 0xa1, 0x42, 0x8d, 0x7c,
+	0x14, 0x26, 0x55, 0x59, 0x4e, 0x28, 0x4b, 0x0b, 0x2e, 0x83, 0x26, 0x34, 0x9e, 0x97, 0x84, 0x27,
+	0xd5, 0x52, 0x1f, 0x75, 0x6a, 0x64, 0x2f, 0x92, 0x5c, 0x85, 0xaa, 0x76, 0xb0, 0xf8, 0x5d, 0x4c,
+	0x15, 0x1a, 0xcf, 0x85, 0xdc, 0x49, 0x53, 0x1d, 0x6b, 0xa4, 0x32, 0xf4, 0x58, 0x43, 0x27, 0xa5,
+	0xc7, 0xa2, 0x30, 0x22, 0x34, 0x79, 0xe1, 0x41, 0xfd, 0x4c, 0x91, 0x01, 0x0a, 0xa5, 0x60, 0xfc,
+	0xe8, 0x26, 0x7e, 0xfb, 0x18, 0xe2, 0x66, 0x8f, 0x5a, 0xec, 0x4a, 0x52, 0xa5, 0x4d, 0x5a, 0x0b,
+	0x5c, 0xaf, 0x3c, 0x52, 0xfc, 0x51, 0xab, 0xaa, 0xe0, 0x11, 0xf2, 0xbf, 0x5a, 0x82, 0x1a, 0x9d,
+	0x48, 0xd1, 0x39, 0x9a, 0xab, 0xe8, 0xec, 0xc0, 0xf8, 0x3d, 0x45, 0x21, 0x3f, 0xc6, 0x27, 0xe1,
+	0x3d, 0x45, 0x3a, 0x16, 0x6b, 0xe7, 0x17, 0xcf, 0x4a, 0x42, 0xe3, 0xaa, 0x26, 0x5f, 0xa5, 0x43,
+	0xb6, 0x60, 0x64, 0x4b, 0x48, 0xef, 0x65, 0xe0, 0x73, 0xf1, 0xee, 0x3e, 0x2e, 0x25, 0xe2, 0x86,
+	0x20, 0x7f, 0x60, 0x88, 0x98, 0xbc, 0x08, 0xc3, 0x4d, 0xbb, 0x65, 0x07, 0x7e, 0x79, 0xfc, 0x8a,
+	0x51, 0x74, 0x69, 0x57, 0x39, 0x06, 0x71, 0xcc, 0x8b, 0xff, 0x51, 0x62, 0x25, 0xdf, 0x9c, 0x3c,
+	0xd4, 0x27, 0xae, 0x0c, 0x14, 0xd5, 0xf5, 0x66, 0xc9, 0x34, 0xf1, 0xb7, 0x92, 0x7f, 0x72, 0x9b,
+	0x3f, 0x34, 0x01, 0x33, 0x95, 0x66, 0xc7, 0x0f, 0xa8, 0xb7, 0x20, 0x4d, 0x57, 0xa8, 0xc7, 0xba,
+	0x76, 0x81, 0xff, 0xbb, 0xe4, 0xde, 0x77, 0x96, 0x68, 0xd3, 0xda, 0x5b, 0xd8, 0x66, 0x35, 0xea,
+	0xf5, 0xa3, 0x9d, 0x13, 0x4b, 0x1d, 0xa9, 0x4b, 0xe0, 0x4f, 0x34, 0xd5, 0x4c, 0x8c, 0x98, 0x43,
+	0x89, 0x7c, 0x87, 0x01, 0x97, 0x32, 0x40, 0x4b, 0xb4, 0x49, 0x83, 0x50, 0x82, 0x38, 0x6a, 0x3f,
+	0x1e, 0x3d, 0xd8, 0x9f, 0xbb, 0x54, 0xcd, 0x43, 0x8a, 0xf9, 0xf4, 0x98, 0x0d, 0xc2, 0x6c, 0x06,
+	0xf4, 0x9a, 0x65, 0x37, 0x3b, 0x5e, 0x78, 0x79, 0x3a, 0x6a, 0x77, 0xf8, 0x1d, 0xa6, 0x9a, 0x8b,
+	0x15, 0xbb, 0x50, 0x24, 0x1f, 0x83, 0xf3, 0x11, 0x74, 0xd3, 0x71, 0x28, 0xad, 0x6b, 0x57, 0xa9,
+	0xa3, 0x76, 0xe5, 0xd2, 0xc1, 0xfe, 0xdc, 0xf9, 0x6a, 0x16, 0x42, 0xcc, 0xa6, 0x43, 0x1a, 0xf0,
+	0x68, 0x0c, 0x08, 0xec, 0xa6, 0xfd, 0x8a, 0xb8, 0xed, 0xed, 0x78, 0xd4, 0xdf, 0x71, 0x9b, 0x75,
+	0xce, 0x75, 0x8d, 0xc5, 0xd7, 0x1d, 0xec, 0xcf, 0x3d, 0x5a, 0xed, 0x56, 0x11, 0xbb, 0xe3, 0x21,
+	0x75, 0x98, 0xf0, 0x6b, 0x96, 0xb3, 0xe2, 0x04, 0xd4, 0xbb, 0x67, 0x35, 0xcb, 0xc3, 0x85, 0x06,
+	0x28, 0x78, 0x9d, 0x82, 0x07, 0x35, 0xac, 0xe4, 0x9d, 0x30, 0x4a, 0x77, 0xdb, 0x96, 0x53, 0xa7,
+	0x82, 0xbf, 0x8e, 0x2d, 0x3e, 0xc2, 0x4e, 0xf5, 0x65, 0x59, 0xc6, 0x24, 0xe0, 0xf0, 0xff, 0x35,
+	0xb7, 0x4e, 0x31, 0xaa, 0x4d, 0x3e, 0x02, 0xe7, 0xb8, 0x6d, 0x4d, 0x9d, 0xf2, 0xd3, 0xc2, 0x0f,
+	0x2f, 0xd4, 0xa3, 0x85, 0xfa, 0xc9, 0xdf, 0xdd, 0xd7, 0x32, 0xf0, 0x61, 0x26, 0x15, 0xb6, 0x0c,
+	0x2d, 0x6b, 0xf7, 0xba, 0x67, 0xd5, 0xe8, 0x76, 0xa7, 0xb9, 0x41, 0xbd, 0x96, 0xed, 0x08, 0x8d,
+	0x12, 0x7b, 0x4a, 0xae, 0x33, 0x9e, 0xcc, 0x2c, 0x79, 0xf8, 0x32, 0xac, 0x75, 0xab, 0x88, 0xdd,
+	0xf1, 0x90, 0xb7, 0xc2, 0x84, 0xdd, 0x70, 0x5c, 0x8f, 0x6e, 0x58, 0xb6, 0x13, 0xf8, 0x65, 0xe0,
+	0xf2, 0x34, 0x9f, 0xd6, 0x15, 0xa5, 0x1c, 0xb5, 0x5a, 0xe4, 0x1e, 0x10, 0x87, 0xde, 0x5f, 0x77,
+	0xeb, 0x7c, 0x0b, 0x6c, 0xb6, 0xf9, 0x46, 0x2e, 0x8f, 0x17, 0x9a, 0x1a, 0xae, 0x6f, 0xb8, 0x95,
+	0xc2, 0x86, 0x19, 0x14, 0xc8, 0x35, 0x20, 0x2d, 0x6b, 0x77, 0xb9, 0xd5, 0x0e, 0xf6, 0x16, 0x3b,
+	0xcd, 0xbb, 0x92, 0x6b, 0x4c, 0xf0, 0xb9, 0x10, 0xda, 0xb8, 0x14, 0x14, 0x33, 0x5a, 0x10, 0x0b,
+	0x1e, 0x16, 0xe3, 0x59, 0xb2, 0x68, 0xcb, 0x75, 0x7c, 0x1a, 0xf8, 0xca, 0x26, 0x2d, 0x4f, 0x72,
+	0x0b, 0x0b, 0x7e, 0xfb, 0x5f, 0xc9, 0xaf, 0x86, 0xdd, 0x70, 0xe8, 0x36, 0x66, 0x53, 0x87, 0xd8,
+	0x98, 0xbd, 0x03, 0x26, 0xfd, 0xc0, 0xf2, 0x82, 0x4e, 0x5b, 0x2e, 0xc3, 0x19, 0xbe, 0x0c, 0x5c,
+	0x59, 0x5b, 0x55, 0x01, 0xa8, 0xd7, 0x63, 0xcb, 0x27, 0x34, 0xf2, 0xb2, 0xdd, 0x74, 0xbc, 0x7c,
+	0x55, 0xa5, 0x1c, 0xb5, 0x5a, 0xe6, 0xff, 0x18, 0x84, 0x72, 0xea, 0x7c, 0x08, 0xed, 0xb2, 0x0e,
+	0xe5, 0x00, 0xc6, 0x31, 0x71, 0x80, 0x36, 0x5c, 0x89, 0x2a, 0x5c, 0x6f, 0x77, 0x32, 0x69, 0x95,
+	0x38, 0xad, 0xc7, 0x0f, 0xf6, 0xe7, 0xae, 0x54, 0x0f, 0xa9, 0x8b, 0x87, 0x62, 0xcb, 0xe7, 0xae,
+	0x03, 0xa7, 0xc4, 0x5d, 0x3f, 0x02, 0xe7, 0x14, 0x80, 0x47, 0xad, 0xfa, 0x5e, 0x1f, 0xdc, 0x9d,
+	0x33, 0x95, 0x6a, 0x06, 0x3e, 0xcc, 0xa4, 0x92, 0xcb, 0xd2, 0x86, 0x4e, 0x83, 0xa5, 0x99, 0xfb,
+	0x03, 0x30, 0x56, 0x71, 0x9d, 0xba, 0x50, 0xcd, 0x3c, 0xa5, 0xbd, 0xb6, 0x3f, 0xaa, 0x0a, 0xa1,
+	0x0f, 0xf6, 0xe7, 0x26, 0xa3, 0x8a, 0x8a, 0x54, 0xfa, 0xae, 0xe8, 0x89, 0x4b, 0x5c, 0xed, 0x5e,
+	0xa7, 0xbf, 0x4d, 0x3d, 0xd8, 0x9f, 0x3b, 0x13, 0x35, 0xd3, 0x9f, 0xab, 0x18, 0xbf, 0x62, 0x9a,
+	0xba, 0x0d, 0xcf, 0x72, 0x7c, 0xbb, 0x0f, 0xdd, 0x68, 0xf4, 0x26, 0xb1, 0x9a, 0xc2, 0x86, 0x19,
+	0x14, 0xc8, 0x4b, 0x30, 0xc5, 0x4a, 0x37, 0xdb, 0x75, 0x2b, 0xa0, 0x05, 0x55, 0xa2, 0x91, 0x49,
+	0xd0, 0xaa, 0x86, 0x09, 0x13, 0x98, 0x85, 0x75, 0x82, 0xe5, 0xbb, 0x4e, 0x79, 0x28, 0x69, 0x9d,
+	0x60, 0xf9, 0xc2, 0x3a, 0xc1, 0xf2, 0x85, 0x59, 0x60, 0x8b, 0xfa, 0x3e, 0x7b, 0x78, 0x18, 0xe6,
+	0x15, 0xa3, 0x1b, 0xca, 0x9a, 0x28, 0xc6, 0x10, 0x4e, 0xde, 0x04, 0x43, 0x35, 0xb7, 0x4e, 0xfd,
+	0xf2, 0x08, 0x67, 0x2b, 0x8c, 0xc3, 0x0e, 0x55, 0x58, 0xc1, 0x83, 0xfd, 0xb9, 0x31, 0xfe, 0x82,
+	0xc3, 0x7e, 0xa1, 0xa8, 0x64, 0xfe, 0x75, 0xa6, 0x8d, 0x48, 0x28, 0xe7, 0x7a, 0xb0, 0xaa, 0x38,
+	0x3d, 0x03, 0x05, 0xf3, 0x33, 0x4c, 0x15, 0xe4, 0x3a, 0x81, 0xe7, 0x36, 0xd7, 0x9b, 0x96, 0x43,
+	0xc9, 0xb7, 0x1b, 0x30, 0xbd, 0x63, 0x37, 0x76, 0x54, 0xb3, 0xa8, 0xb2, 0x51, 0x5c, 0x6b, 0x73,
+	0x23, 0x81, 0x6b, 0xf1, 0xdc, 0xc1, 0xfe, 0xdc, 0x74, 0xb2, 0x14, 0x53, 0x34, 0xcd, 0xdf, 0x2f,
+	0xc1, 0x45, 0xb5, 0x67, 0x0b, 0xb1, 0xc5, 0x39, 0xf9, 0x2d, 0x03, 0xa0, 0x65, 0x3b, 0x0b, 0xcd,
+	0xa6, 0x7b, 0x9f, 0xdb, 0x72, 0xb2, 0x0b, 0xc5, 0x07, 0x8b, 0xea, 0x59, 0x33, 0x28, 0xcc, 0xaf,
+	0x45, 0xd8, 0x85, 0xb6, 0xf0, 0x85, 0x50, 0x0b, 0x11, 0x03, 0x1e, 0xec, 0xcf, 0xcd, 0xa5, 0xcd,
+	0xdc, 0xe7, 0x51, 0xda, 0x92, 0x33, 0x4d, 0xd1, 0x37, 0xff, 0x5e, 0xd7, 0x2a, 0xe2, 0x91, 0x34,
+	0x1e, 0xc8, 0x6c, 0x0b, 0xce, 0x24, 0x08, 0x67, 0x28, 0x17, 0x97, 0x74, 0xe5, 0x62, 0x57, 0x26,
+	0x35, 0x1f, 0x5a, 0xb6, 0xcf, 0xbf, 0xbf, 0x63, 0x39, 0x01, 0x9b, 0x69, 0x45, 0x8d, 0xf8, 0xbb,
+	0x25, 0x38, 0x27, 0x27, 0xa0, 0xc9, 0x2e, 0x00, 0xed, 0xa6, 0xbb, 0xd7, 0xa2, 0xce, 0x69, 0x18,
+	0x89, 0x85, 0x1f, 0x41, 0x29, 0xf7, 0x23, 0x68, 0xa5, 0x3e, 0x82, 0x81, 0x22, 0x1f, 0x41, 0xc4,
+	0x2b, 0x0e, 0xd1, 0x70, 0x20, 0x5c, 0xb0, 0x1d, 0xd6, 0xd1, 0xeb, 0x7c, 0xc3, 0xc4, 0x16, 0x8a,
+	0x9c, 0x3f, 0x8d, 0x8a, 0x9b, 0xdd, 0x4a, 0x66, 0x0d, 0xcc, 0x69, 0x69, 0xfe, 0x91, 0x01, 0xe5,
+	0xac, 0xf9, 0x3d, 0x05, 0xa5, 0x64, 0x4b, 0x57, 0x4a, 0xde, 0xe8, 0xe3, 0xdb, 0xd0, 0xba, 0x9e,
+	0xa3, 0x9c, 0xfc, 0xc3, 0x12, 0x5c, 0x88, 0xab, 0xaf, 0x38, 0x7e, 0x60, 0x35, 0x9b, 0x42, 0xea,
+	0x3b, 0xf9, 0xbd, 0xd4, 0xd6, 0x74, 0xcb, 0xb7, 0xfa, 0x1b, 0xaa, 0xda, 0xf7, 0x5c, 0xd3, 0x92,
+	0xdd, 0x84, 0x69, 0xc9, 0xfa, 0x31, 0xd2, 0xec, 0x6e, 0x65, 0xf2, 0x9f, 0x0c, 0x98, 0xcd, 0x6e,
+	0x78, 0x0a, 0x9b, 0xca, 0xd5, 0x37, 0xd5, 0xfb, 0x8e, 0x6f, 0xd4, 0x39, 0xdb, 0xea, 0x67, 0x4a,
+	0x79, 0xa3, 0xe5, 0x0a, 0xea, 0x6d, 0x38, 0xe3, 0xd1, 0x86, 0xed, 0x07, 0xd2, 0x06, 0xe2, 0x68,
+	0x26, 0xcb, 0xe1, 0x93, 0xde, 0x19, 0xd4, 0x71, 0x60, 0x12, 0x29, 0xb9, 0x05, 0x23, 0x4c, 0x5d,
+	0xc8, 0xf0, 0x97, 0x7a, 0xc7, 0x1f, 0x09, 0x11, 0x55, 0xd1, 0x16, 0x43, 0x24, 0xe4, 0xeb, 0x60,
+	0xb2, 0x1e, 0x7d, 0x51, 0x87, 0x58, 0x06, 0x26, 0xb1, 0xf2, 0x0b, 0xd0, 0x92, 0xda, 0x1a, 0x75,
+	0x64, 0xe6, 0xff, 0x33, 0xe0, 0x91, 0x6e, 0x7b, 0x8b, 0xbc, 0x0c, 0x50, 0x0b, 0xa5, 0xc2, 0xf0,
+	0x89, 0xed, 0xd9, 0x82, 0x6b, 0x29, 0xb0, 0xc4, 0x1f, 0x68, 0x54, 0xe4, 0xa3, 0x42, 0x24, 0xc3,
+	0xe0, 0xb0, 0x74, 0x42, 0x06, 0x87, 0xe6, 0x7f, 0x36, 0x54, 0x56, 0xa4, 0xae, 0xed, 0x6b, 0x8d,
+	0x15, 0xa9, 0x7d, 0xcf, 0x7d, 0xf0, 0xfa, 0x8d, 0x12, 0x5c, 0xc9, 0x6e, 0xa2, 0x9c, 0xe7, 0xef,
+	0x85, 0xe1, 0xb6, 0x70, 0x2b, 0x18, 0xe0, 0xe7, 0xed, 0x13, 0x8c, 0xb3, 0x08, 0xa3, 0xff, 0x07,
+	0xfb, 0x73, 0xb3, 0x59, 0x8c, 0x5e, 0x40, 0x51, 0xb6, 0x23, 0x76, 0x42, 0x33, 0x2f, 0x84, 0xf6,
+	0xb7, 0xf4, 0xc8, 0x5c, 0xac, 0x2d, 0xda, 0xec, 0x59, 0x19, 0xff, 0x4d, 0x06, 0x4c, 0x69, 0x3b,
+	0xda, 0x2f, 0x0f, 0x5d, 0x19, 0x28, 0x6a, 0xeb, 0xa5, 0x7d, 0x2a, 0xb1, 0x34, 0xa0, 0x15, 0xfb,
+	0x98, 0x20, 0x98, 0x60, 0xb3, 0xea, 0xac, 0xbe, 0xe6, 0xd8, 0xac, 0xda, 0xf9, 0x1c, 0x36, 0xfb,
+	0x83, 0xa5, 0xbc, 0xd1, 0x72, 0x36, 0x7b, 0x1f, 0xc6, 0x42, 0x31, 0x32, 0x64, 0x17, 0xd7, 0xfa,
+	0xed, 0x93, 0x40, 0x17, 0xdb, 0x39, 0x87, 0x25, 0x3e, 0xc6, 0xb4, 0xc8, 0xb7, 0x1a, 0x00, 0xf1,
+	0xc2, 0xc8, 0x8f, 0x6a, 0xe3, 0xf8, 0xa6, 0x43, 0x11, 0x6b, 0xa6, 0xd8, 0x27, 0x1d, 0xff, 0x46,
+	0x85, 0xae, 0xf9, 0x7f, 0x06, 0x80, 0xa4, 0xfb, 0xde, 0xdb, 0xbb, 0xeb, 0x21, 0x42, 0xee, 0xb3,
+	0x70, 0xa6, 0xd1, 0x74, 0xb7, 0xac, 0x66, 0x73, 0x4f, 0x7a, 0xa0, 0x49, 0x5f, 0xa6, 0xb3, 0xec,
+	0x60, 0xba, 0xae, 0x83, 0x30, 0x59, 0x97, 0xb4, 0x61, 0xda, 0x63, 0xc2, 0x66, 0xcd, 0x6e, 0xf2,
+	0x1b, 0xaf, 0xdb, 0x09, 0x0a, 0x2a, 0x4e, 0xf8, 0xad, 0x0c, 0x13, 0xb8, 0x30, 0x85, 0x9d, 0x59,
+	0x9d, 0xb5, 0x3d, 0xbb, 0x65, 0x79, 0x7b, 0xfc, 0x4e, 0x3d, 0x2a, 0xde, 0x94, 0xd6, 0x45, 0x11,
+	0x86, 0x30, 0xf2, 0x11, 0x18, 0x6b, 0xda, 0xdb, 0xb4, 0xb6, 0x57, 0x6b, 0x52, 0xa9, 0xc7, 0xbe,
+	0x7d, 0x3c, 0x5b, 0x66, 0x35, 0x44, 0x2b, 0x6d, 0x28, 0xc3, 0x9f, 0x18, 0x13, 0x64, 0xae, 0x9e,
+	0xf7, 0xb9, 0x55, 0x4f, 0x93, 0xfa, 0x7e, 0xb5, 0xd3, 0x6e, 0xbb, 0x5e, 0x40, 0xeb, 0x5c, 0xdb,
+	0x3d, 0x2a, 0xdc, 0xec, 0xee, 0xa4, 0xc1, 0x98, 0xd5, 0xc6, 0xfc, 0x54, 0x09, 0x1e, 0xee, 0xd2,
+	0x09, 0x82, 0x30, 0x16, 0xcd, 0x91, 0xdc, 0x09, 0x6f, 0x15, 0xfb, 0x59, 0x16, 0x3e, 0xd8, 0x9f,
+	0x7b, 0xac, 0x0b, 0x82, 0x2a, 0xdb, 0x8a, 0xb4, 0xb1, 0x87, 0x31, 0x1a, 0xb2, 0x02, 0xc3, 0xf5,
+	0xf8, 0xf1, 0x67, 0x6c, 0xf1, 0x29, 0xc6, 0xad, 0x85, 0x9a, 0xb6, 0x57, 0x6c, 0x12, 0x01, 0x59,
+	0x85, 0x11, 0x61, 0x79, 0x49, 0x25, 0xe7, 0x7f, 0x9a, 0x6b, 0x35, 0x44, 0x51, 0xaf, 0xc8, 0x42,
+	0x14, 0xe6, 0xff, 0x36, 0x60, 0xa4, 0xc2, 0xd4, 0xbb, 0xb7, 0xaa, 0xcc, 0x64, 0x52, 0xf1, 0x01,
+	0x97, 0x5c, 0xb0, 0x20, 0x5b, 0xe0, 0x18, 0x95, 0xdb, 0x77, 0xe8, 0xb5, 0x16, 0x15, 0xa0, 0x4a,
+	0x8b, 0xbc, 0xcc, 0xe6, 0xfc, 0xbe, 0x67, 0x07, 0x8c, 0x70, 0x3f, 0x06, 0x25, 0x82, 0x30, 0x86,
+	0xb8, 0xc4, 0x8e, 0x8a, 0x7e, 0x62, 0x4c, 0xc5, 0x5c, 0x07, 0x22, 0x6b, 0xab, 0x6a, 0x88, 0x67,
+	0x60, 0xb0, 0xe5, 0xd6, 0xc3, 0x75, 0x7f, 0x43, 0xf8, 0x7d, 0xb3, 0x67, 0x93, 0x07, 0xfb, 0x73,
+	0x17, 0xd2, 0x2d, 0x18, 0x04, 0x79, 0x1b, 0xf3, 0x16, 0x4c, 0x4b, 0x78, 0x44, 0x90, 0xb9, 0x13,
+	0xd6, 0xdc, 0x56, 0xcb, 0x75, 0xaa, 0x9d, 0xed, 0x6d, 0x7b, 0x97, 0x6a, 0xee, 0x84, 0x15, 0x0d,
+	0x82, 0x89, 0x9a, 0xe6, 0x0f, 0x18, 0x30, 0xc0, 0xd6, 0xc5, 0x84, 0xe1, 0xba, 0xdb, 0xb2, 0x6c,
+	0x47, 0xf6, 0x8a, 0xbf, 0xc8, 0x2e, 0xf1, 0x12, 0x94, 0x10, 0xd2, 0x86, 0xb1, 0x50, 0x68, 0xea,
+	0xcb, 0x78, 0x7c, 0xe9, 0x56, 0x35, 0x72, 0xb8, 0x89, 0x38, 0x79, 0x58, 0xe2, 0x63, 0x4c, 0xc4,
+	0xb4, 0x60, 0x66, 0xe9, 0x56, 0x75, 0xc5, 0xa9, 0x35, 0x3b, 0x75, 0xba, 0xbc, 0xcb, 0xff, 0x30,
+	0x5e, 0x62, 0x8b, 0x12, 0x39, 0x4e, 0xce, 0x4b, 0x64, 0x25, 0x0c, 0x61, 0xac, 0x1a, 0x15, 0x2d,
+	0xca, 0xa5, 0xb8, 0x9a, 0x44, 0x82, 0x21, 0xcc, 0xfc, 0x52, 0x09, 0xc6, 0x95, 0x0e, 0x91, 0x26,
+	0x8c, 0x88, 0xe1, 0xfa, 0xfd, 0x78, 0x50, 0xa7, 0x7a, 0x2d, 0xa8, 0x8b, 0x09, 0xf5, 0x31, 0x24,
+	0xa1, 0xf2, 0xc5, 0x52, 0x17, 0xbe, 0x38, 0xaf, 0x39, 0x29, 0x8a, 0x4f, 0x72, 0x2a, 0xdf, 0x41,
+	0x91, 0x3c, 0x22, 0x4f, 0x10, 0x61, 0xbd, 0x3d, 0x9a, 0x38, 0x3d, 0xb6, 0x61, 0xe8, 0x15, 0xd7,
+	0xa1, 0x7e, 0x79, 0xe8, 0x38, 0x07, 0xc8, 0x2d, 0xc7, 0x98, 0x27, 0xa4, 0x8f, 0x02, 0xbd, 0xf9,
+	0x05, 0x03, 0x60, 0xc9, 0x0a, 0x2c, 0x61, 0xa6, 0xd0, 0x83, 0x0d, 0xe1, 0x23, 0xda, 0xc1, 0x37,
+	0x9a, 0x72, 0x1a, 0x1b, 0xf4, 0xed, 0x57, 0xc2, 0xe1, 0x47, 0x02, 0xb5, 0xc0, 0x5e, 0xb5, 0x5f,
+	0xa1, 0xc8, 0xe1, 0xec, 0xbd, 0x88, 0x3a, 0x35, 0x6f, 0xaf, 0xcd, 0x98, 0xb7, 0xd0, 0xc2, 0xf0,
+	0x2f, 0x74, 0x39, 0x2c, 0xc4, 0x18, 0x6e, 0x3e, 0x05, 0xfa, 0xad, 0xa8, 0x07, 0x13, 0xe7, 0x3f,
+	0x31, 0xe0, 0xe2, 0x52, 0xc7, 0x6a, 0x2e, 0xb4, 0xd9, 0x46, 0xb5, 0x9a, 0xd7, 0x5c, 0xf1, 0x08,
+	0xce, 0xae, 0x0a, 0x6f, 0x82, 0xd1, 0x50, 0x0e, 0x91, 0x18, 0x22, 0x89, 0x2d, 0x64, 0x94, 0x18,
+	0xd5, 0x20, 0x16, 0x33, 0xb4, 0x97, 0x92, 0x71, 0xa9, 0x0f, 0xc9, 0x38, 0x24, 0x11, 0x96, 0x60,
+	0x84, 0x56, 0xe8, 0xa7, 0xf8, 0x02, 0xb1, 0x58, 0x09, 0x76, 0x8d, 0x2e, 0xd4, 0x6a, 0x6e, 0x87,
+	0x3d, 0x70, 0x0d, 0xa8, 0xfa, 0xa9, 0xac, 0x1a, 0x98, 0xd3, 0xd2, 0xfc, 0xa2, 0x01, 0x83, 0xcb,
+	0x1b, 0x95, 0x25, 0xf2, 0x75, 0x30, 0x18, 0xb1, 0x8c, 0x82, 0x66, 0x2d, 0x0c, 0x8f, 0x50, 0xa5,
+	0x89, 0xf5, 0x5e, 0x63, 0x0c, 0x87, 0x63, 0x25, 0x5b, 0x30, 0x4c, 0xef, 0x51, 0xd6, 0xd5, 0xd2,
+	0xb1, 0xe0, 0xe7, 0x2c, 0x6d, 0x99, 0x63, 0x44, 0x89, 0xd9, 0xfc, 0xb4, 0x01, 0x10, 0x57, 0x21,
+	0xdf, 0x98, 0x75, 0x3a, 0xdd, 0x3c, 0x46, 0x05, 0x71, 0xf7, 0x23, 0xca, 0xfc, 0xf2, 0x20, 0x5c,
+	0x62, 0xdd, 0x91, 0x5b, 0xd5, 0x76, 0x9d, 0x9b, 0x74, 0xef, 0x2f, 0x8c, 0xe9, 0xff, 0xc2, 0x98,
+	0xfe, 0xf8, 0x8c, 0xe9, 0xcd, 0xe7, 0x60, 0x3a, 0xde, 0x5e, 0x72, 0xdf, 0xbf, 0x31, 0x79, 0x55,
+	0x1b, 0x0b, 0x85, 0x9a, 0xf4, 0xf5, 0xca, 0x7c, 0x60, 0xc0, 0xf4, 0xf2, 0x6e, 0xdb, 0xf6, 0xb8,
+	0xd3, 0xb8, 0xf0, 0x17, 0x61, 0x6f, 0x61, 0xa1, 0x5b, 0x89, 0xa1, 0xbf, 0x85, 0x25, 0x5d, 0x4b,
+	0xc8, 0x36, 0x4c, 0x51, 0xde, 0x9c, 0xdf, 0xa5, 0xac, 0xa0, 0xc8, 0x0e, 0x14, 0x91, 0x12, 0x34,
+	0x2c, 0x98, 0xc0, 0x4a, 0xaa, 0x30, 0x55, 0x6b, 0x5a, 0xbe, 0x6f, 0x6f, 0xdb, 0xb5, 0xd8, 0x1d,
+	0x6a, 0x6c, 0xf1, 0x8d, 0x5c, 0x2c, 0xd2, 0x20, 0x0f, 0xf6, 0xe7, 0xce, 0xcb, 0x7e, 0xea, 0x00,
+	0x4c, 0xa0, 0x30, 0x3f, 0x5b, 0x82, 0xc9, 0xe5, 0xdd, 0xb6, 0xeb, 0x77, 0x3c, 0xca, 0xab, 0x9e,
+	0x82, 0x76, 0xe8, 0x49, 0x18, 0xd9, 0xb1, 0x98, 0xc1, 0xac, 0x57, 0x2e, 0xe9, 0x73, 0x7b, 0x43,
+	0x14, 0x63, 0x08, 0x27, 0x1f, 0x06, 0x60, 0x31, 0x7f, 0xea, 0x1d, 0xce, 0xbf, 0x06, 0x8a, 0xf3,
+	0x2f, 0x6d, 0x8c, 0xd5, 0x08, 0xa5, 0x94, 0x3a, 0xa2, 0xdf, 0xa8, 0x90, 0x33, 0x7f, 0xdb, 0x80,
+	0x19, 0xad, 0xdd, 0x29, 0x28, 0x3d, 0xb6, 0x75, 0xa5, 0xc7, 0x42, 0xdf, 0x63, 0xcd, 0xd1, 0x75,
+	0x7c, 0xa2, 0x04, 0x17, 0x73, 0xe6, 0x24, 0x65, 0x7e, 0x6a, 0x9c, 0x92, 0xf9, 0x69, 0x07, 0xc6,
+	0x03, 0xb7, 0x29, 0xbd, 0xf6, 0xc2, 0x19, 0x28, 0x74, 0x4a, 0x6e, 0x44, 0x68, 0x62, 0xe3, 0xd2,
+	0xb8, 0xcc, 0x47, 0x95, 0x0e, 0xf3, 0x74, 0x19, 0x8b, 0x74, 0xab, 0x5f, 0x51, 0xcf, 0xd2, 0xbd,
+	0x07, 0x77, 0x31, 0x7f, 0xa5, 0x04, 0x17, 0x22, 0xdc, 0x21, 0x9b, 0x63, 0xaa, 0xe0, 0x5e, 0x14,
+	0x34, 0x8f, 0x68, 0x86, 0xf1, 0xa3, 0x69, 0x0f, 0xbb, 0x76, 0xc7, 0x6b, 0xbb, 0x7e, 0x28, 0xaa,
+	0x0a, 0x99, 0x5e, 0x14, 0x61, 0x08, 0x23, 0xb7, 0x60, 0xc8, 0x67, 0xf4, 0xca, 0x83, 0x45, 0x66,
+	0x83, 0x4b, 0xdb, 0xbc, 0xbf, 0x28, 0xd0, 0x90, 0x0f, 0xab, 0x3c, 0x7c, 0xa8, 0xb8, 0x0a, 0x90,
+	0x8d, 0xa4, 0x1e, 0x09, 0xab, 0xe9, 0xd0, 0x02, 0x99, 0x67, 0xc2, 0x2a, 0x4c, 0x4b, 0xc3, 0x4b,
+	0xb1, 0x6d, 0x98, 0x83, 0xc1, 0x3b, 0xb5, 0x9d, 0xf1, 0x78, 0xc2, 0x30, 0xe5, 0x5c, 0xb2, 0x7e,
+	0xbc, 0x63, 0x4c, 0x1f, 0x46, 0xaf, 0xcb, 0x4e, 0x92, 0x59, 0x28, 0xd9, 0xe1, 0x5a, 0x80, 0xc4,
+	0x51, 0x5a, 0x59, 0xc2, 0x92, 0xdd, 0x83, 0x83, 0x82, 0x7a, 0x2c, 0x0d, 0x74, 0x3f, 0x96, 0xcc,
+	0x3f, 0x28, 0xc1, 0xb9, 0x90, 0x6a, 0x38, 0xc6, 0x25, 0xf9, 0xe6, 0x7c, 0xc8, 0xbd, 0xe5, 0x70,
+	0x85, 0xdd, 0x6d, 0x18, 0xe4, 0x0c, 0xb0, 0xd0, 0x5b, 0x74, 0x84, 0x90, 0x75, 0x07, 0x39, 0x22,
+	0xf2, 0x11, 0x18, 0x6e, 0xb2, 0x4b, 0x40, 0xe8, 0x39, 0x50, 0x48, 0xbd, 0x99, 0x35, 0x5c, 0x71,
+	0xb7, 0x90, 0xce, 0x4e, 0xd1, 0x73, 0xa2, 0x28, 0x44, 0x49, 0x73, 0xf6, 0x5d, 0x30, 0xae, 0x54,
+	0x3b, 0x52, 0x50, 0xad, 0x1f, 0x28, 0x41, 0xf9, 0x06, 0x6d, 0xb6, 0x32, 0x0d, 0x08, 0xe6, 0x60,
+	0xa8, 0xb6, 0x63, 0x79, 0x22, 0x5e, 0xdb, 0x84, 0xd8, 0xe4, 0x15, 0x56, 0x80, 0xa2, 0x9c, 0xdd,
+	0x09, 0x34, 0x2f, 0xb3, 0xf7, 0x28, 0x33, 0x19, 0x07, 0xf2, 0xfb, 0x50, 0x14, 0xe9, 0x2f, 0x1e,
+	0xb8, 0x56, 0x81, 0x1d, 0x2f, 0xef, 0xab, 0xde, 0xbe, 0x95, 0xe5, 0x5f, 0xc6, 0x5c, 0xc6, 0xdd,
+	0x9a, 0x8d, 0xb4, 0xed, 0xfa, 0x76, 0xe0, 0x7a, 0x7b, 0x72, 0xd1, 0x0a, 0x1d, 0x2d, 0xb7, 0x2b,
+	0x2b, 0x31, 0x22, 0xf1, 0x08, 0xa7, 0x15, 0xa1, 0x4e, 0xca, 0xfc, 0x29, 0x03, 0xc6, 0x6f, 0xd8,
+	0x5b, 0xd4, 0x13, 0xb6, 0xa5, 0x5c, 0x89, 0xa1, 0x45, 0x1e, 0x1b, 0xcf, 0x8a, 0x3a, 0x46, 0x76,
+	0x61, 0x4c, 0x9e, 0xc3, 0x91, 0xfb, 0xe0, 0xf5, 0x62, 0x56, 0x37, 0x11, 0x69, 0x79, 0xbe, 0xa9,
+	0x31, 0x45, 0x42, 0x0a, 0x18, 0x13, 0x33, 0x3f, 0x0c, 0x67, 0x33, 0x1a, 0xb1, 0x85, 0xe4, 0xe6,
+	0x95, 0xf2, 0xa3, 0x09, 0xb9, 0x15, 0x5b, 0x48, 0x5e, 0x4e, 0x2e, 0xc1, 0x00, 0x75, 0xea, 0xf2,
+	0x8b, 0x19, 0x39, 0xd8, 0x9f, 0x1b, 0x58, 0x76, 0xea, 0xc8, 0xca, 0x18, 0x13, 0x6f, 0xba, 0x9a,
+	0xc4, 0xc6, 0x99, 0xf8, 0xaa, 0x2c, 0xc3, 0x08, 0xca, 0xed, 0xa4, 0x92, 0x26, 0x41, 0x4c, 0xf8,
+	0x9f, 0xde, 0x4e, 0xf0, 0x96, 0x7e, 0x2c, 0x91, 0x92, 0x7c, 0x6a, 0xb1, 0x2c, 0x27, 0x24, 0xc5,
+	0xf1, 0x30, 0x45, 0xd7, 0xfc, 0xf9, 0x41, 0x78, 0xf4, 0x06, 0x8b, 0x36, 0xe5, 0x3a, 0x81, 0xd5,
+	0x5c, 0x77, 0xeb, 0xb1, 0x95, 0xa8, 0x3c, 0xb2, 0xbe, 0xcd, 0x80, 0x8b, 0xb5, 0x76, 0x47, 0x5c,
+	0x1e, 0x42, 0x43, 0xcb, 0x75, 0xea, 0xd9, 0x6e, 0x51, 0x67, 0x02, 0x1e, 0x45, 0xaa, 0xb2, 0xbe,
+	0x99, 0x85, 0x12, 0xf3, 0x68, 0x71, 0x9f, 0x86, 0xba, 0x7b, 0xdf, 0xe1, 0x9d, 0xab, 0x06, 0x7c,
+	0x36, 0x5f, 0x89, 0x17, 0xa1, 0xa0, 0x4f, 0xc3, 0x52, 0x26, 0x46, 0xcc, 0xa1, 0xc4, 0xcc, 0x4a,
+	0x6d, 0xd1, 0x39, 0xa4, 0x56, 0xdd, 0x76, 0xa8, 0xef, 0x0b, 0x83, 0xe8, 0x3e, 0x8c, 0xf6, 0x57,
+	0xb2, 0x10, 0x62, 0x36, 0x1d, 0xf2, 0x22, 0x80, 0xbf, 0xe7, 0xd4, 0xe4, 0xfc, 0x17, 0x33, 0xe7,
+	0x14, 0x22, 0x72, 0x84, 0x05, 0x15, 0x8c, 0xec, 0xa2, 0x15, 0x44, 0x9b, 0x72, 0x98, 0x9b, 0xe4,
+	0xf2, 0x8b, 0x56, 0xbc, 0x87, 0x62, 0x38, 0x53, 0x4e, 0x4c, 0xad, 0x38, 0xeb, 0x4d, 0xab, 0x46,
+	0x85, 0x75, 0xa2, 0x4f, 0xae, 0xc2, 0x98, 0x1f, 0x3d, 0x4c, 0x08, 0x8e, 0x10, 0x7f, 0x9f, 0x21,
+	0x00, 0xe3, 0x3a, 0x3c, 0x7c, 0xa5, 0xed, 0xc8, 0xc3, 0xee, 0x9a, 0xeb, 0x09, 0x44, 0xf2, 0xbb,
+	0x13, 0xe1, 0x2b, 0xd3, 0x60, 0xcc, 0x6a, 0x63, 0xfe, 0xb4, 0x01, 0xe7, 0xf4, 0xee, 0x48, 0xc3,
+	0x80, 0xbf, 0x6a, 0xc0, 0x39, 0xcd, 0x2b, 0x5a, 0x82, 0xfb, 0x89, 0xce, 0xb4, 0x9e, 0x81, 0x4f,
+	0x18, 0xca, 0x66, 0x41, 0x30, 0x93, 0xbe, 0xf9, 0x77, 0x0c, 0x18, 0x91, 0x01, 0x08, 0x99, 0x51,
+	0xa7, 0xa6, 0xe0, 0x8e, 0x8e, 0xb6, 0x84, 0x92, 0x7b, 0x8f, 0x5b, 0x39, 0xc8, 0xa3, 0x49, 0x9e,
+	0x32, 0x85, 0x34, 0xa4, 0x92, 0x70, 0x7c, 0xce, 0x69, 0xd6, 0x0e, 0xb2, 0x0c, 0x15, 0x62, 0xe6,
+	0xe7, 0x0d, 0x98, 0x49, 0xb5, 0xea, 0x41, 0x1c, 0x3d, 0x45, 0xbb, 0xcf, 0xdf, 0x18, 0x64, 0x5b,
+	0x32, 0x60, 0xfc, 0xbe, 0x29, 0x74, 0xcf, 0xa7, 0x70, 0xff, 0x7d, 0x23, 0x8c, 0xd9, 0xad, 0x56,
+	0x27, 0x60, 0x67, 0x9d, 0x7c, 0x3e, 0xe4, 0x1f, 0xcd, 0x4a, 0x58, 0x88, 0x31, 0x9c, 0x38, 0x52,
+	0xd2, 0x12, 0xa7, 0xe0, 0x6a, 0xb1, 0x95, 0x53, 0x07, 0x38, 0xcf, 0xa4, 0x22, 0x21, 0x0e, 0x65,
+	0x09, 0x62, 0xdf, 0x6e, 0x00, 0xf8, 0x81, 0x67, 0x3b, 0x0d, 0x56, 0x28, 0xa5, 0x31, 0x3c, 0x06,
+	0xb2, 0xd5, 0x08, 0xa9, 0x20, 0x1e, 0x07, 0x25, 0x8c, 0x00, 0xa8, 0x50, 0x26, 0x0b, 0x52, 0x08,
+	0x15, 0x47, 0xe6, 0x9b, 0x13, 0xe2, 0xf6, 0xa3, 0x19, 0xf6, 0xa4, 0x82, 0x50, 0x2c, 0xa5, 0xce,
+	0xbe, 0x03, 0xc6, 0x22, 0x7a, 0x87, 0x09, 0x75, 0x13, 0x8a, 0x50, 0x37, 0xfb, 0x2c, 0x9c, 0x49,
+	0x74, 0xf7, 0x48, 0x32, 0xe1, 0xbf, 0x35, 0x80, 0xe8, 0xa3, 0x3f, 0x05, 0xcd, 0x41, 0x43, 0xd7,
+	0x1c, 0x2c, 0xf6, 0xbf, 0x64, 0x39, 0xaa, 0x83, 0x9f, 0x24, 0xc0, 0xe3, 0xb3, 0x46, 0xf1, 0x8a,
+	0xe5, 0xc9, 0xcf, 0x04, 0x95, 0xd8, 0x21, 0x59, 0x7e, 0xb9, 0x7d, 0x08, 0x2a, 0x37, 0x13, 0xb8,
+	0x62, 0x41, 0x25, 0x09, 0xc1, 0x14, 0x5d, 0xf2, 0x49, 0x03, 0xa6, 0x2d, 0x3d, 0x3e, 0x6b, 0x38,
+	0x33, 0x85, 0x22, 0x6d, 0x25, 0x62, 0xbd, 0xc6, 0x7d, 0x49, 0x00, 0x7c, 0x4c, 0x91, 0x65, 0xae,
+	0x38, 0x56, 0xdb, 0x66, 0x11, 0x46, 0xd9, 0xcd, 0x33, 0x0c, 0x63, 0xc9, 0xb5, 0x21, 0x0b, 0xeb,
+	0x2b, 0x51, 0x39, 0x6a, 0xb5, 0xa2, 0x40, 0xa8, 0x95, 0xd8, 0xfc, 0xb6, 0x9f, 0x40, 0xa8, 0x72,
+	0x0e, 0xe3, 0x40, 0xa8, 0x72, 0xea, 0x54, 0x22, 0xc4, 0x01, 0x70, 0xed, 0x7a, 0x4d, 0x92, 0x1c,
+	0x2e, 0xfe, 0x4c, 0x71, 0x7b, 0x65, 0xa9, 0x22, 0x29, 0x72, 0xf1, 0x21, 0xfe, 0x8d, 0x0a, 0x05,
+	0xf2, 0x19, 0x03, 0x26, 0x25, 0xef, 0x96, 0x34, 0x47, 0xf8, 0x12, 0x7d, 0xa0, 0xe8, 0x7e, 0x49,
+	0xec, 0xc9, 0x79, 0x54, 0x91, 0x0b, 0xbe, 0x13, 0xf9, 0xb3, 0x6b, 0x30, 0xd4, 0xfb, 0xc1, 0x65,
+	0x00, 0x5f, 0x7b, 0x27, 0x92, 0x1d, 0x1c, 0x2d, 0x2e, 0x03, 0x54, 0x33, 0xf0, 0x49, 0x57, 0x9d,
+	0x0c, 0x08, 0x66, 0xd2, 0x67, 0x72, 0xed, 0x99, 0xfb, 0x56, 0x50, 0xdb, 0xa9, 0x58, 0xb5, 0x1d,
+	0xfe, 0x4c, 0x28, 0x5c, 0xfe, 0x0a, 0xee, 0xeb, 0x3b, 0x3a, 0x2a, 0x61, 0x70, 0x93, 0x28, 0xc4,
+	0x24, 0x41, 0xe2, 0xb2, 0x67, 0x41, 0x11, 0xa4, 0xbc, 0x0c, 0xc5, 0x45, 0x8a, 0x54, 0xc4, 0x73,
+	0x71, 0x33, 0x0a, 0x7f, 0x61, 0x44, 0x84, 0xb9, 0x9e, 0x89, 0xbb, 0xe1, 0x82, 0xe3, 0x3a, 0x7b,
+	0x2d, 0xb7, 0xe3, 0xb3, 0x30, 0xb8, 0xd4, 0x09, 0x42, 0x55, 0xf8, 0x38, 0x3f, 0x46, 0xb9, 0xeb,
+	0xd9, 0x72, 0xb7, 0x8a, 0xd8, 0x1d, 0x0f, 0x79, 0x01, 0x46, 0xf9, 0x53, 0xda, 0xc6, 0xc6, 0x6a,
+	0x79, 0xe2, 0x28, 0x3c, 0x3a, 0x12, 0x97, 0xf9, 0x10, 0x96, 0x25, 0x0e, 0x8c, 0xb0, 0x91, 0xbb,
+	0x30, 0xd2, 0x14, 0x51, 0xe6, 0xcb, 0x93, 0xc5, 0x99, 0x62, 0x32, 0x62, 0xbd, 0xb8, 0x40, 0xcb,
+	0x1f, 0x18, 0x52, 0x60, 0x1e, 0x74, 0x75, 0xba, 0x6d, 0x75, 0x9a, 0xc1, 0x2d, 0x37, 0x40, 0xee,
+	0xe7, 0x15, 0x69, 0x3c, 0x43, 0x47, 0xd1, 0x29, 0x1e, 0x4c, 0x8d, 0x7b, 0xd0, 0x2d, 0x1d, 0x52,
+	0x17, 0x0f, 0xc5, 0x46, 0xf6, 0xe0, 0x31, 0x59, 0x87, 0x3b, 0x96, 0xd5, 0x76, 0xd8, 0x2c, 0xa7,
+	0x89, 0x9e, 0xe1, 0x44, 0xff, 0xd2, 0xc1, 0xfe, 0xdc, 0x63, 0x4b, 0x87, 0x57, 0xc7, 0x5e, 0x70,
+	0x72, 0x5f, 0x1d, 0x9a, 0x78, 0x02, 0x2a, 0x4f, 0x17, 0x9f, 0xe3, 0xe4, 0x73, 0x92, 0xb0, 0x0a,
+	0x4b, 0x96, 0x62, 0x8a, 0x26, 0xf9, 0x09, 0x03, 0xca, 0x7e, 0xe0, 0x75, 0x6a, 0x41, 0xc7, 0xa3,
+	0xf5, 0xc4, 0x0e, 0x9d, 0xb9, 0x62, 0x14, 0x15, 0xe0, 0xaa, 0x39, 0x38, 0xb9, 0xcb, 0x72, 0x39,
+	0x0f, 0x8a, 0xb9, 0x7d, 0x21, 0x3f, 0x62, 0xc0, 0x45, 0x1d, 0xc8, 0xee, 0xf4, 0xa2, 0x9f, 0xa4,
+	0xf8, 0x23, 0x4b, 0x35, 0x1b, 0xa5, 0xb8, 0xc1, 0xe7, 0x00, 0x31, 0xaf, 0x23, 0xc9, 0xc7, 0xeb,
+	0xb3, 0xa7, 0xfc, 0x78, 0x3d, 0xfb, 0x5e, 0x20, 0xe9, 0xe3, 0xe3, 0x30, 0x39, 0x70, 0x54, 0x95,
+	0x03, 0x3f, 0x37, 0x04, 0x0f, 0xb3, 0x53, 0x29, 0xbe, 0xfd, 0xac, 0x59, 0x8e, 0xd5, 0xf8, 0xca,
+	0x94, 0x98, 0x7e, 0xca, 0x80, 0x8b, 0x3b, 0xd9, 0xaa, 0x1d, 0x79, 0xff, 0x7a, 0x7f, 0x21, 0x15,
+	0x5c, 0x37, 0x6d, 0x91, 0x60, 0xd8, 0x5d, 0xab, 0x60, 0x5e, 0xa7, 0xc8, 0x7b, 0x61, 0xda, 0x71,
+	0xeb, 0xb4, 0xb2, 0xb2, 0x84, 0x6b, 0x96, 0x7f, 0xb7, 0x1a, 0xda, 0xd2, 0x0c, 0x89, 0xef, 0xf5,
+	0x56, 0x02, 0x86, 0xa9, 0xda, 0xcc, 0xf9, 0xb3, 0xed, 0xd6, 0x97, 0xef, 0x89, 0x6c, 0x0c, 0xfd,
+	0x59, 0x8e, 0xf2, 0xf7, 0xfc, 0xf5, 0x14, 0x36, 0xcc, 0xa0, 0xc0, 0x75, 0x53, 0xac, 0x33, 0x6b,
+	0xae, 0x63, 0x07, 0xae, 0xc7, 0x9d, 0xf0, 0xfb, 0x52, 0xd1, 0x70, 0xdd, 0xd4, 0xad, 0x4c, 0x8c,
+	0x98, 0x43, 0xc9, 0xfc, 0xef, 0x06, 0x9c, 0x61, 0xdb, 0x62, 0xdd, 0x73, 0x77, 0xf7, 0xbe, 0x12,
+	0x37, 0xe4, 0x93, 0xd2, 0xac, 0x50, 0xe8, 0x76, 0xce, 0x2b, 0x26, 0x85, 0x63, 0xbc, 0xcf, 0xb1,
+	0x15, 0xa1, 0xaa, 0x56, 0x1e, 0xc8, 0x57, 0x2b, 0x9b, 0x9f, 0x29, 0x89, 0x9b, 0x4b, 0xa8, 0xd6,
+	0xfd, 0x8a, 0xfc, 0x0e, 0xdf, 0x01, 0x93, 0xac, 0x6c, 0xcd, 0xda, 0x5d, 0x5f, 0x7a, 0xde, 0x6d,
+	0x86, 0x3e, 0xcd, 0x5c, 0xd7, 0x7e, 0x53, 0x05, 0xa0, 0x5e, 0x8f, 0x3c, 0xc3, 0x6c, 0xef, 0x78,
+	0xd8, 0x2a, 0x79, 0x67, 0xbe, 0x22, 0x6c, 0xef, 0x78, 0xd1, 0x83, 0xfd, 0xb9, 0x99, 0xf8, 0x89,
+	0x57, 0x16, 0x62, 0xd8, 0xc0, 0xfc, 0xd3, 0xb3, 0xc0, 0x91, 0x37, 0x69, 0xf0, 0x95, 0x38, 0x27,
+	0x4f, 0xc1, 0x78, 0xad, 0xdd, 0xa9, 0x5c, 0xab, 0xbe, 0xbf, 0xe3, 0x72, 0x5d, 0x08, 0x4f, 0x5a,
+	0xc2, 0xb8, 0x77, 0x65, 0x7d, 0x33, 0x2c, 0x46, 0xb5, 0x0e, 0xe3, 0x0e, 0xb5, 0x76, 0x47, 0xf2,
+	0xdb, 0x75, 0xd5, 0xeb, 0x83, 0x73, 0x87, 0xca, 0xfa, 0xa6, 0x06, 0xc3, 0x54, 0x6d, 0xf2, 0x31,
+	0x98, 0xa0, 0xf2, 0xc3, 0xbd, 0xc1, 0xf2, 0x9c, 0x08, 0xbe, 0xb0, 0x52, 0x74, 0xf0, 0xd1, 0xd4,
+	0x86, 0xdc, 0x40, 0xdc, 0x00, 0x97, 0x15, 0x12, 0xa8, 0x11, 0x24, 0x1f, 0x84, 0x4b, 0xe1, 0x6f,
+	0xb6, 0xca, 0x6e, 0x3d, 0xc9, 0x28, 0x86, 0x44, 0x80, 0x9b, 0xe5, 0xbc, 0x4a, 0x98, 0xdf, 0x9e,
+	0xfc, 0x6d, 0x03, 0x2e, 0x44, 0x50, 0xdb, 0xb1, 0x5b, 0x9d, 0x16, 0xd2, 0x5a, 0xd3, 0xb2, 0x5b,
+	0xf2, 0xde, 0x77, 0xe7, 0xd8, 0x06, 0xaa, 0xa3, 0x17, 0xcc, 0x2a, 0x1b, 0x86, 0x39, 0x5d, 0x22,
+	0x9f, 0x37, 0xe0, 0x4a, 0x08, 0x5a, 0xf7, 0xa8, 0xef, 0xb3, 0x57, 0x89, 0xc8, 0xa3, 0x5e, 0x4e,
+	0xc9, 0x48, 0x21, 0xde, 0xc9, 0x05, 0xe0, 0xe5, 0x43, 0x70, 0xe3, 0xa1, 0xd4, 0xd5, 0xed, 0x52,
+	0x75, 0xb7, 0x83, 0xf2, 0xe8, 0x89, 0x6e, 0x17, 0x46, 0x02, 0x35, 0x82, 0xe4, 0xa7, 0x0d, 0xb8,
+	0xa8, 0x16, 0xa8, 0xbb, 0x45, 0xdc, 0x10, 0x5f, 0x38, 0xb6, 0xce, 0x24, 0xf0, 0x0b, 0x09, 0x2f,
+	0x07, 0x88, 0x79, 0xbd, 0x62, 0x6c, 0xbb, 0xc5, 0x37, 0xa6, 0xb8, 0x45, 0x0e, 0x09, 0xb6, 0x2d,
+	0xf6, 0xaa, 0x8f, 0x21, 0x8c, 0xe9, 0x4f, 0xda, 0x6e, 0x7d, 0xdd, 0xae, 0xfb, 0x3c, 0xa4, 0x16,
+	0xbf, 0xeb, 0x0d, 0x88, 0xe9, 0x58, 0x77, 0xeb, 0xeb, 0x2b, 0x4b, 0xa2, 0x1c, 0xb5, 0x5a, 0xcc,
+	0xc6, 0x98, 0x3d, 0x5f, 0x55, 0xef, 0x5b, 0xed, 0xdb, 0x61, 0xe0, 0x16, 0xae, 0x8b, 0xb8, 0x16,
+	0x95, 0xa2, 0x52, 0x83, 0xad, 0x1f, 0xe3, 0x3b, 0x48, 0x45, 0xfc, 0xe8, 0xf2, 0xd4, 0x31, 0xad,
+	0x5f, 0x88, 0x50, 0x74, 0xf8, 0xa6, 0x42, 0x02, 0x35, 0x82, 0xec, 0xe5, 0x6c, 0xca, 0xdf, 0xf3,
+	0x03, 0xda, 0x8a, 0xfa, 0x70, 0xe6, 0xb8, 0xfb, 0xc0, 0x75, 0xe2, 0x55, 0x8d, 0x08, 0x26, 0x88,
+	0xf2, 0x10, 0x38, 0x2d, 0xab, 0x41, 0xaf, 0x57, 0xd8, 0x5b, 0x64, 0x14, 0x23, 0x65, 0x9d, 0x7a,
+	0x35, 0xe6, 0x7e, 0x34, 0xcd, 0x57, 0x4a, 0x84, 0xc0, 0xc9, 0xaf, 0x86, 0xdd, 0x70, 0x90, 0x17,
+	0x61, 0x56, 0x82, 0x57, 0xdd, 0xfb, 0x29, 0x0a, 0x33, 0x9c, 0x02, 0xb7, 0x51, 0x5c, 0xc9, 0xad,
+	0x85, 0x5d, 0x30, 0xb0, 0x57, 0x22, 0x9f, 0x7a, 0xfc, 0x4d, 0x50, 0x04, 0x28, 0x5c, 0xef, 0x34,
+	0x9b, 0x7e, 0x99, 0xc4, 0x9e, 0x2f, 0xd5, 0x34, 0x18, 0xb3, 0xda, 0x30, 0xd7, 0x24, 0xe9, 0x07,
+	0xbb, 0xc7, 0x0a, 0xde, 0xbf, 0x5e, 0xe5, 0x37, 0x91, 0x21, 0xa1, 0x29, 0x41, 0x1d, 0x84, 0xc9,
+	0xba, 0xec, 0x34, 0x0f, 0x8b, 0x16, 0x3b, 0x9e, 0x1f, 0x94, 0xcf, 0xf1, 0xc6, 0xfc, 0x34, 0x47,
+	0x15, 0x80, 0x7a, 0x3d, 0xe6, 0x04, 0xe1, 0xd3, 0x5a, 0xcd, 0x6d, 0xb5, 0xe5, 0x3d, 0xb9, 0x7c,
+	0x9e, 0xf7, 0x5e, 0xac, 0xa0, 0x06, 0xc1, 0x44, 0x4d, 0xb2, 0x07, 0x67, 0xa3, 0x58, 0xb8, 0xab,
+	0x6e, 0x63, 0xcd, 0xda, 0xe5, 0xc2, 0xf1, 0x85, 0x22, 0x81, 0x12, 0xc4, 0x74, 0x55, 0xd2, 0xe8,
+	0x30, 0x8b, 0x06, 0x4b, 0x32, 0x95, 0x28, 0xbe, 0x66, 0xb3, 0x47, 0xfc, 0x8b, 0x7c, 0xd8, 0x5c,
+	0xd9, 0x55, 0xc9, 0x80, 0x63, 0x66, 0x2b, 0x72, 0x1b, 0xce, 0xb7, 0x3d, 0x37, 0xa0, 0xb5, 0xe0,
+	0x26, 0xf5, 0x1c, 0xda, 0x94, 0x03, 0xf4, 0xcb, 0x65, 0x3e, 0x17, 0xfc, 0x3d, 0x74, 0x3d, 0xab,
+	0x02, 0x66, 0xb7, 0x23, 0x9f, 0x33, 0xe0, 0xb2, 0x1f, 0x78, 0xd4, 0x6a, 0xd9, 0x4e, 0xa3, 0xe2,
+	0x3a, 0x0e, 0xe5, 0x8c, 0x69, 0xa5, 0x1e, 0x3b, 0x8e, 0x5d, 0x2a, 0x74, 0x8a, 0x98, 0x07, 0xfb,
+	0x73, 0x97, 0xab, 0x5d, 0x31, 0xe3, 0x21, 0x94, 0x99, 0xb1, 0x63, 0x8b, 0xb6, 0x5c, 0x6f, 0x8f,
+	0x71, 0xa4, 0xf2, 0x6c, 0xf1, 0xfb, 0xee, 0x5a, 0x84, 0x45, 0x7c, 0xfe, 0xda, 0x4b, 0x6e, 0x0c,
+	0x44, 0x85, 0x9c, 0xb9, 0x5f, 0x82, 0xf3, 0x99, 0xac, 0x9e, 0x7d, 0x01, 0xa2, 0xde, 0x42, 0x98,
+	0x59, 0x49, 0xbe, 0xdd, 0xf1, 0x2f, 0x60, 0x4d, 0x07, 0x61, 0xb2, 0x2e, 0x13, 0xc4, 0xf8, 0x97,
+	0x7a, 0xad, 0x1a, 0xb7, 0x2f, 0xc5, 0x82, 0xd8, 0x4a, 0x02, 0x86, 0xa9, 0xda, 0xa4, 0x02, 0x33,
+	0xb2, 0x6c, 0x85, 0xdd, 0x65, 0xfc, 0x6b, 0x1e, 0x0d, 0x45, 0x5c, 0x76, 0x2b, 0x98, 0x59, 0x49,
+	0x02, 0x31, 0x5d, 0x9f, 0x8d, 0x82, 0xfd, 0x50, 0x7b, 0x31, 0x18, 0x8f, 0xe2, 0x96, 0x0e, 0xc2,
+	0x64, 0xdd, 0xf0, 0xb2, 0xa9, 0x75, 0x61, 0x28, 0x1e, 0xc5, 0xad, 0x04, 0x0c, 0x53, 0xb5, 0xcd,
+	0xdf, 0x19, 0x84, 0xc7, 0x7a, 0x10, 0x8f, 0x48, 0x2b, 0x7b, 0xba, 0x8f, 0xfe, 0xe1, 0xf6, 0xb6,
+	0x3c, 0xed, 0x9c, 0xe5, 0x39, 0x3a, 0xbd, 0x5e, 0x97, 0xd3, 0xcf, 0x5b, 0xce, 0xa3, 0x93, 0xec,
+	0x7d, 0xf9, 0x5b, 0xd9, 0xcb, 0x5f, 0x70, 0x56, 0x0f, 0xdd, 0x2e, 0xed, 0x9c, 0xed, 0x52, 0x70,
+	0x56, 0x7b, 0xd8, 0x5e, 0xbf, 0x3b, 0x08, 0x8f, 0xf7, 0x22, 0xaa, 0x15, 0xdc, 0x5f, 0x19, 0x2c,
+	0xef, 0x44, 0xf7, 0x57, 0x9e, 0x6f, 0xee, 0x09, 0xee, 0xaf, 0x0c, 0x92, 0x27, 0xbd, 0xbf, 0xf2,
+	0x66, 0xf5, 0xa4, 0xf6, 0x57, 0xde, 0xac, 0xf6, 0xb0, 0xbf, 0xfe, 0x38, 0x79, 0x3e, 0x44, 0xf2,
+	0xe2, 0x0a, 0x0c, 0xd4, 0xda, 0x9d, 0x82, 0x4c, 0x8a, 0x9b, 0xca, 0x55, 0xd6, 0x37, 0x91, 0xe1,
+	0x20, 0x08, 0xc3, 0x62, 0xff, 0x14, 0x64, 0x41, 0xdc, 0xfc, 0x51, 0x6c, 0x49, 0x94, 0x98, 0xd8,
+	0x54, 0xd1, 0xf6, 0x0e, 0x6d, 0x51, 0xcf, 0x6a, 0x56, 0x03, 0xd7, 0xb3, 0x1a, 0x45, 0xb9, 0x8d,
+	0x78, 0x06, 0x48, 0xe0, 0xc2, 0x14, 0x76, 0x36, 0x21, 0x6d, 0xbb, 0x5e, 0x1e, 0x2c, 0x3e, 0x21,
+	0xeb, 0x2b, 0x4b, 0xc8, 0x70, 0x98, 0x5f, 0x18, 0x03, 0x25, 0xe0, 0x37, 0x53, 0xca, 0xcc, 0xd4,
+	0x92, 0xe1, 0x19, 0xfb, 0x31, 0xea, 0x49, 0xc5, 0x7a, 0x14, 0x5b, 0x3e, 0x55, 0x8c, 0x69, 0xb2,
+	0xe4, 0xe3, 0x86, 0xd0, 0x54, 0x45, 0x4f, 0x52, 0x72, 0x5a, 0xaf, 0x1f, 0xd3, 0xe3, 0x6d, 0xac,
+	0xf2, 0x8a, 0x00, 0xa8, 0x13, 0x64, 0x6a, 0x81, 0xf3, 0x77, 0xb3, 0x14, 0xec, 0xe5, 0xc1, 0xe2,
+	0xce, 0xf6, 0x5d, 0x34, 0xf6, 0x42, 0xe2, 0xcc, 0xac, 0x80, 0xd9, 0x1d, 0x89, 0x66, 0x29, 0xd2,
+	0x39, 0x96, 0x87, 0xfa, 0x9b, 0xa5, 0x84, 0xf2, 0x32, 0x9e, 0xa5, 0x08, 0x80, 0x3a, 0x41, 0xe6,
+	0xe7, 0x7c, 0x37, 0x54, 0xf4, 0x96, 0x87, 0x8b, 0xbf, 0x15, 0x27, 0xb4, 0xc5, 0xc2, 0x68, 0x29,
+	0x2a, 0xc4, 0x98, 0x08, 0xd9, 0x81, 0x91, 0xbb, 0x82, 0x57, 0x94, 0x47, 0x8a, 0x1b, 0x1b, 0x6b,
+	0xec, 0x46, 0xe8, 0x06, 0x64, 0x11, 0x86, 0xe8, 0x55, 0x83, 0xf8, 0xd1, 0x43, 0xfc, 0xb4, 0x3e,
+	0x67, 0xc0, 0xf9, 0x7b, 0xd4, 0x0b, 0xec, 0x5a, 0xf2, 0x79, 0x63, 0xac, 0xf8, 0x35, 0xfb, 0xf9,
+	0x2c, 0x84, 0x62, 0x9b, 0x64, 0x82, 0x30, 0xbb, 0x0b, 0xec, 0xd2, 0x2d, 0xb4, 0xd4, 0xd5, 0xc0,
+	0x0a, 0xec, 0xda, 0x86, 0x7b, 0x57, 0x0b, 0xbe, 0x06, 0x71, 0xdc, 0xd9, 0xe5, 0xfc, 0x6a, 0xd8,
+	0x0d, 0x07, 0x79, 0x1e, 0x06, 0x69, 0x50, 0xab, 0xcb, 0x60, 0xbc, 0xef, 0x2c, 0xea, 0x7d, 0x2a,
+	0xfc, 0x43, 0xd8, 0x7f, 0xc8, 0xf1, 0x99, 0x7f, 0x68, 0x40, 0x4a, 0x87, 0x4b, 0xbe, 0xdb, 0x80,
+	0x89, 0x6d, 0x6a, 0x05, 0x1d, 0x8f, 0x5e, 0x97, 0xb6, 0x93, 0xcc, 0xb0, 0xe3, 0xf9, 0xe3, 0x50,
+	0x1d, 0xcf, 0x5f, 0x53, 0x10, 0x0b, 0xa3, 0x8e, 0x28, 0xf6, 0xb9, 0x0a, 0x42, 0xad, 0x07, 0xb3,
+	0xcf, 0xc1, 0x4c, 0xaa, 0xe1, 0x91, 0x9e, 0xf3, 0xfe, 0x89, 0x01, 0x59, 0x89, 0xa9, 0xc9, 0x8b,
+	0x30, 0x64, 0xb1, 0x14, 0xd9, 0x92, 0x11, 0xbf, 0xab, 0x98, 0x7d, 0x51, 0x5d, 0x8d, 0x4b, 0xc3,
+	0x7f, 0xa2, 0x40, 0xcb, 0x62, 0x1b, 0x5b, 0xda, 0xfb, 0xed, 0x5a, 0x1c, 0x6d, 0x81, 0x3f, 0x3b,
+	0x2d, 0xa4, 0xa0, 0x98, 0xd1, 0xc2, 0xfc, 0x84, 0x01, 0x24, 0x9d, 0x59, 0x82, 0x78, 0x30, 0x2a,
+	0x3f, 0x91, 0x70, 0x95, 0x96, 0x0a, 0x7a, 0x9d, 0x69, 0x2e, 0x94, 0xb1, 0xb1, 0x9a, 0x2c, 0xf0,
+	0x31, 0xa2, 0xc3, 0x82, 0x73, 0xc5, 0x79, 0xdf, 0xc8, 0xdb, 0x60, 0xbc, 0x4e, 0xfd, 0x9a, 0x67,
+	0xb7, 0x83, 0xd8, 0xe1, 0x32, 0x72, 0xdc, 0x5a, 0x8a, 0x41, 0xa8, 0xd6, 0x63, 0x31, 0x1e, 0x02,
+	0xcb, 0xbf, 0xbb, 0xb2, 0x24, 0xef, 0x93, 0xfc, 0xf4, 0xdf, 0xe0, 0x25, 0x28, 0x21, 0x71, 0xa0,
+	0xd2, 0x81, 0x1e, 0x02, 0x95, 0x32, 0x57, 0xce, 0xbe, 0xa3, 0xb2, 0x92, 0xc3, 0x23, 0xb2, 0x9a,
+	0x3f, 0x5e, 0x82, 0x33, 0xac, 0x0a, 0xf3, 0x0e, 0x0f, 0xa8, 0xc3, 0xdd, 0x8b, 0x0a, 0x4e, 0x42,
+	0x03, 0x26, 0x03, 0xcd, 0xff, 0xf6, 0xe8, 0xce, 0xa7, 0x91, 0x45, 0x94, 0xee, 0x75, 0xab, 0xe3,
+	0x25, 0xef, 0x0a, 0xfd, 0xbb, 0xc4, 0xcd, 0xfb, 0xb1, 0x70, 0xab, 0x72, 0xa7, 0xad, 0x07, 0xd2,
+	0x99, 0x39, 0x4a, 0x16, 0xa8, 0xb9, 0x72, 0xbd, 0x03, 0x26, 0xa5, 0x27, 0x81, 0x88, 0x38, 0x2b,
+	0x6f, 0xde, 0xfc, 0xe4, 0xba, 0xa6, 0x02, 0x50, 0xaf, 0x67, 0xfe, 0x7a, 0x09, 0xf4, 0x94, 0x84,
+	0x45, 0x67, 0x29, 0x1d, 0x6e, 0xb7, 0x74, 0x62, 0xe1, 0x76, 0xdf, 0xc4, 0xf3, 0xf9, 0x8a, 0x74,
+	0xf4, 0xe2, 0x3d, 0x5a, 0xcd, 0xc2, 0xcb, 0xcb, 0x31, 0xaa, 0x11, 0x4f, 0xeb, 0xe0, 0x91, 0xa7,
+	0xf5, 0x6d, 0xd2, 0x42, 0x76, 0x48, 0x0b, 0x7a, 0x1c, 0x5a, 0xc8, 0xce, 0x68, 0x0d, 0x15, 0x6f,
+	0xb4, 0x05, 0x90, 0xa9, 0x29, 0xd8, 0xba, 0xc8, 0x80, 0xcc, 0xfe, 0x86, 0x1b, 0x58, 0xcd, 0xb2,
+	0x11, 0x2b, 0x27, 0xd7, 0x54, 0x00, 0xea, 0xf5, 0xcc, 0x5b, 0xf0, 0xba, 0x55, 0xd7, 0xaa, 0x2f,
+	0x5a, 0x4d, 0xb6, 0x75, 0x3d, 0x69, 0xbe, 0xe6, 0xf3, 0xc3, 0x9f, 0xe9, 0xe3, 0xdc, 0x9a, 0xdb,
+	0x64, 0x47, 0xb3, 0x15, 0x45, 0xa6, 0xe5, 0x0f, 0x76, 0xe1, 0xd1, 0x2c, 0x23, 0xbb, 0x62, 0x08,
+	0x37, 0x7f, 0xd9, 0x80, 0x11, 0x99, 0xe1, 0xa5, 0x07, 0x07, 0x4c, 0xe6, 0x23, 0xcb, 0xd3, 0x23,
+	0xf6, 0x21, 0xf8, 0x56, 0x77, 0x5c, 0x37, 0xd0, 0xf2, 0xdc, 0x88, 0x4c, 0x51, 0xec, 0x5f, 0x14,
+	0xe8, 0xb9, 0xdd, 0xa6, 0x57, 0xdb, 0xb1, 0x03, 0xca, 0xcd, 0x53, 0xe4, 0xc6, 0x17, 0x76, 0x9b,
+	0x4a, 0x39, 0x6a, 0xb5, 0xcc, 0xff, 0x36, 0x04, 0x57, 0x24, 0xe2, 0x94, 0x34, 0x18, 0xf1, 0xdc,
+	0x3d, 0x38, 0x2b, 0xb7, 0xdb, 0x92, 0x67, 0xd9, 0x91, 0xe9, 0x41, 0xb1, 0x8b, 0xb8, 0x70, 0x7b,
+	0x48, 0xa3, 0xc3, 0x2c, 0x1a, 0x22, 0xd6, 0x37, 0x2f, 0xbe, 0x41, 0xad, 0x66, 0xb0, 0x13, 0xd2,
+	0x2e, 0xf5, 0x13, 0xeb, 0x3b, 0x8d, 0x0f, 0x33, 0xa9, 0x70, 0xd3, 0x07, 0x09, 0xa8, 0x78, 0xd4,
+	0x52, 0xed, 0x2e, 0xfa, 0x70, 0xcb, 0x59, 0xcb, 0xc4, 0x88, 0x39, 0x94, 0xb8, 0x46, 0xd3, 0xda,
+	0xe5, 0x0a, 0x12, 0xa4, 0x81, 0x67, 0xf3, 0x7c, 0x45, 0x91, 0x4e, 0x7f, 0x4d, 0x07, 0x61, 0xb2,
+	0x2e, 0x53, 0xcd, 0x73, 0x53, 0x92, 0x38, 0x78, 0xe4, 0x50, 0x1c, 0x9f, 0xe8, 0x96, 0x06, 0xc1,
+	0x44, 0x4d, 0xf2, 0x2d, 0x06, 0x9c, 0xb3, 0x55, 0xa7, 0x93, 0x70, 0xf4, 0xc5, 0xb2, 0x5c, 0x70,
+	0x89, 0x30, 0xdc, 0xc6, 0x19, 0x68, 0x31, 0x93, 0x18, 0xd3, 0xd2, 0x4b, 0xcf, 0x61, 0x7d, 0x0f,
+	0x88, 0xd0, 0x60, 0x7c, 0x4d, 0x97, 0x32, 0xe0, 0x98, 0xd9, 0xca, 0xfc, 0xa6, 0x12, 0x4c, 0x1c,
+	0x31, 0x6b, 0x67, 0x47, 0x91, 0x39, 0xfa, 0xf0, 0xef, 0x53, 0xa9, 0xf6, 0x20, 0x76, 0x90, 0x17,
+	0x60, 0xaa, 0xc3, 0x27, 0x22, 0x0c, 0xea, 0x25, 0xbf, 0xe9, 0xaf, 0x66, 0x2b, 0xb7, 0xa9, 0x41,
+	0x58, 0x40, 0x48, 0x15, 0xbd, 0x0e, 0xc5, 0x04, 0x1e, 0xf3, 0x7b, 0x86, 0xe0, 0x6c, 0x46, 0x6f,
+	0xb8, 0x19, 0x05, 0x4d, 0x48, 0x46, 0xfd, 0x98, 0x51, 0xa4, 0xa4, 0xac, 0xc8, 0x8c, 0x22, 0x09,
+	0xc1, 0x14, 0x5d, 0xf2, 0x3c, 0x0c, 0xd4, 0x3c, 0x5b, 0x4e, 0xf8, 0x3b, 0x0a, 0xe9, 0x0b, 0x70,
+	0x65, 0x71, 0x5c, 0x52, 0x64, 0xe9, 0x21, 0x91, 0x21, 0x64, 0xe7, 0x88, 0xca, 0x02, 0x43, 0x61,
+	0x8b, 0x9f, 0x23, 0x2a, 0xa7, 0xf4, 0x51, 0xaf, 0x47, 0x5e, 0x80, 0xb2, 0xbc, 0xc8, 0xc9, 0x2e,
+	0x56, 0x5c, 0xc7, 0x0f, 0x18, 0xb7, 0x0a, 0xe4, 0x79, 0xc8, 0x2d, 0x1c, 0x6f, 0xe6, 0xd4, 0xc1,
+	0xdc, 0xd6, 0xe4, 0x1b, 0x61, 0x4a, 0xdb, 0xf9, 0x61, 0x70, 0xa8, 0x82, 0x6e, 0x11, 0x2a, 0x26,
+	0xf1, 0x9d, 0xeb, 0x65, 0x98, 0xa0, 0xc6, 0xa3, 0x77, 0xd6, 0xd4, 0xd4, 0x8b, 0x61, 0xc6, 0xb0,
+	0x85, 0xbe, 0x93, 0x38, 0xc6, 0x82, 0x88, 0x56, 0xcc, 0x62, 0xa1, 0x69, 0xbf, 0xcd, 0xef, 0x1f,
+	0x06, 0x35, 0xe1, 0x2e, 0x59, 0xeb, 0x47, 0x51, 0x17, 0xaf, 0x7a, 0xa8, 0xac, 0x5b, 0x83, 0x81,
+	0x46, 0xbb, 0x53, 0x2e, 0xf5, 0x87, 0xee, 0x3a, 0x43, 0xd7, 0x68, 0x77, 0xc8, 0xf3, 0x91, 0xee,
+	0xaf, 0x98, 0x76, 0x2e, 0x72, 0x80, 0x4b, 0xe8, 0xff, 0x42, 0x66, 0x34, 0x98, 0xcb, 0x8c, 0x5a,
+	0x30, 0xe2, 0x4b, 0xc5, 0xe0, 0x50, 0xf1, 0xf8, 0x7d, 0xca, 0x4c, 0x4b, 0x45, 0xa0, 0x50, 0x59,
+	0xc8, 0x1f, 0x18, 0xd2, 0x60, 0xd7, 0x96, 0x0e, 0xe7, 0xa2, 0x9c, 0xe7, 0x8f, 0x8a, 0x6b, 0xcb,
+	0x26, 0x2f, 0x41, 0x09, 0x49, 0x89, 0x1e, 0x23, 0xbd, 0x88, 0x1e, 0xe4, 0x07, 0x92, 0x29, 0xc6,
+	0x46, 0xaf, 0x0c, 0x14, 0xb5, 0xdb, 0x54, 0x86, 0xf3, 0x67, 0x2c, 0x6b, 0xe8, 0x5f, 0x2e, 0x01,
+	0x49, 0x2f, 0x16, 0x79, 0x0c, 0x86, 0x78, 0x6c, 0x1c, 0x79, 0x6a, 0x45, 0x57, 0x71, 0x1e, 0x1d,
+	0x05, 0x05, 0x8c, 0x54, 0x65, 0xcc, 0xb6, 0x62, 0x9b, 0x9e, 0x5b, 0xac, 0x49, 0x7a, 0x4a, 0x80,
+	0xb7, 0x2b, 0x9a, 0xa7, 0x5b, 0x96, 0xc4, 0xbb, 0xc9, 0xe2, 0x57, 0x3a, 0xac, 0x49, 0x41, 0xad,
+	0xb2, 0x30, 0xac, 0x11, 0x28, 0x30, 0xc4, 0xc5, 0x12, 0x1f, 0x8c, 0xab, 0x57, 0xd0, 0x3d, 0x00,
+	0xab, 0x13, 0xb8, 0xd2, 0xa7, 0xd6, 0x28, 0xae, 0x15, 0x53, 0x90, 0x2e, 0x44, 0x08, 0xc5, 0xf3,
+	0x73, 0xfc, 0x1b, 0x15, 0x62, 0x8c, 0x74, 0x60, 0xb7, 0xe8, 0x1d, 0xdb, 0xa9, 0xbb, 0xf7, 0xcb,
+	0xa5, 0x63, 0x21, 0xbd, 0x11, 0x21, 0x14, 0xa4, 0xe3, 0xdf, 0xa8, 0x10, 0x63, 0x87, 0x10, 0xd7,
+	0x90, 0x39, 0x3c, 0xdd, 0xa7, 0xec, 0x9b, 0x48, 0x83, 0x2c, 0xad, 0x49, 0xf9, 0x21, 0x54, 0xc9,
+	0xa9, 0x83, 0xb9, 0xad, 0xcd, 0x3f, 0x1e, 0x80, 0xf3, 0x99, 0x53, 0x41, 0xae, 0xc3, 0x4c, 0x6c,
+	0xe4, 0xa8, 0x8a, 0x05, 0xa3, 0x71, 0x86, 0xe3, 0x9b, 0xc9, 0x0a, 0x98, 0x6e, 0xc3, 0xfd, 0xa1,
+	0xd3, 0x62, 0x87, 0xb4, 0x90, 0x54, 0x2f, 0x06, 0x2a, 0x18, 0xb3, 0xda, 0x90, 0x1f, 0x36, 0xe0,
+	0x62, 0x8a, 0x80, 0x62, 0x39, 0x59, 0x50, 0x46, 0x93, 0x88, 0xc4, 0xc0, 0x05, 0x3a, 0x61, 0x2e,
+	0x76, 0x33, 0x9b, 0x16, 0xe6, 0x75, 0x82, 0xfc, 0x98, 0x01, 0x97, 0x32, 0x3a, 0x2e, 0xbb, 0x38,
+	0x78, 0xbc, 0x5d, 0xe4, 0x96, 0x95, 0x6b, 0x79, 0xd4, 0x30, 0xbf, 0x23, 0xe6, 0x07, 0xb5, 0x45,
+	0x8f, 0x37, 0x1d, 0xe3, 0x30, 0x5b, 0xb4, 0x61, 0x3b, 0x49, 0x0e, 0xb3, 0xc8, 0x0a, 0x51, 0xc0,
+	0xc8, 0xa3, 0x6a, 0x20, 0x89, 0xe8, 0x94, 0x0c, 0x83, 0x49, 0x98, 0x1f, 0x82, 0x8b, 0x39, 0xd6,
+	0x1d, 0x64, 0x09, 0x26, 0xfc, 0xfb, 0x56, 0x7b, 0x91, 0xee, 0x58, 0xf7, 0x6c, 0x19, 0xb6, 0x49,
+	0x18, 0x01, 0x4f, 0x54, 0x95, 0xf2, 0x07, 0x89, 0xdf, 0xa8, 0xb5, 0x32, 0x03, 0x00, 0x69, 0x2c,
+	0xce, 0xfc, 0x88, 0xb6, 0x61, 0xd4, 0x6a, 0x52, 0x2f, 0x88, 0xa3, 0x07, 0x7e, 0x4d, 0x21, 0xed,
+	0xa6, 0xc4, 0x21, 0x9c, 0xa3, 0xc2, 0x5f, 0x18, 0xe1, 0x36, 0xff, 0xa6, 0x01, 0x17, 0xb2, 0x03,
+	0xf5, 0xf4, 0x70, 0x99, 0x68, 0xc1, 0xb8, 0x17, 0x37, 0x93, 0xcc, 0xe3, 0xed, 0x0a, 0x87, 0x9c,
+	0x57, 0xdc, 0x3a, 0xd8, 0xf5, 0xa9, 0xe2, 0xb9, 0x7e, 0xb8, 0xb7, 0x92, 0x89, 0x05, 0x22, 0x5d,
+	0x92, 0xd2, 0x13, 0x54, 0xf1, 0xf3, 0x24, 0x1f, 0x8c, 0xba, 0xdf, 0xb6, 0x6a, 0xb4, 0x7e, 0xca,
+	0x09, 0xa4, 0x8f, 0x21, 0xb2, 0x7e, 0x76, 0xdf, 0x4f, 0x36, 0xc9, 0x47, 0x0e, 0xcd, 0xc3, 0x93,
+	0x7c, 0x64, 0x37, 0x7c, 0x8d, 0x44, 0x9f, 0xcf, 0xee, 0x7c, 0x8e, 0x5b, 0xf5, 0x7f, 0x19, 0xce,
+	0x1b, 0xed, 0x11, 0xb3, 0x50, 0xdf, 0x3b, 0xc1, 0x2c, 0xd4, 0x53, 0x7f, 0x91, 0x81, 0x3a, 0x23,
+	0x03, 0x75, 0x22, 0x2b, 0xf2, 0xf0, 0x29, 0x65, 0x45, 0x7e, 0x19, 0x86, 0xdb, 0x96, 0xc7, 0x2c,
+	0x66, 0x47, 0x8a, 0xcb, 0x4b, 0x99, 0xc9, 0xd4, 0xe3, 0x4f, 0x72, 0x9d, 0x13, 0x40, 0x49, 0x28,
+	0x23, 0x34, 0xc7, 0xe8, 0x49, 0xc5, 0xbe, 0x8b, 0xf3, 0x31, 0x8f, 0x9d, 0x44, 0x3e, 0x66, 0x16,
+	0xc5, 0xfd, 0x91, 0x6e, 0x6c, 0x89, 0xab, 0x6e, 0x6a, 0x89, 0xcf, 0xb0, 0x1f, 0xd5, 0x4d, 0x8a,
+	0xdb, 0x46, 0xaa, 0x9b, 0x24, 0x04, 0x53, 0x74, 0xc9, 0xfb, 0x80, 0xb8, 0x5b, 0xc2, 0xe0, 0xe6,
+	0x3a, 0xa3, 0x21, 0x7c, 0x35, 0x4b, 0xdc, 0x12, 0x3e, 0xca, 0x3d, 0x78, 0x3b, 0x55, 0x03, 0x33,
+	0x5a, 0x99, 0x3f, 0x5f, 0x02, 0xb8, 0x45, 0x03, 0x16, 0xe7, 0x9f, 0x9d, 0xf1, 0x8f, 0x68, 0x0a,
+	0xf7, 0xd1, 0x57, 0x2f, 0xda, 0xe1, 0x23, 0x30, 0xd8, 0x76, 0xeb, 0xe2, 0x9c, 0x91, 0x1d, 0xe1,
+	0x8e, 0x00, 0xbc, 0x94, 0x85, 0xe0, 0xe2, 0xd6, 0x48, 0xf2, 0x22, 0xcf, 0xd5, 0xf5, 0xfc, 0x49,
+	0x02, 0x45, 0x39, 0xe3, 0x90, 0xd2, 0x63, 0xde, 0x2f, 0x0f, 0xc5, 0x1c, 0x32, 0x7c, 0x9c, 0xc0,
+	0x08, 0x4a, 0x9e, 0x01, 0xb0, 0xdb, 0xd7, 0xac, 0x96, 0xdd, 0xb4, 0xe5, 0xe7, 0x3a, 0xc6, 0xf5,
+	0xc8, 0xb0, 0xb2, 0x1e, 0x96, 0x3e, 0xd8, 0x9f, 0x1b, 0x95, 0xbf, 0xf6, 0x50, 0xa9, 0xcd, 0x22,
+	0x9a, 0x4d, 0xc7, 0x93, 0x27, 0xb7, 0x4a, 0xd8, 0x73, 0x11, 0x6a, 0x36, 0xb7, 0xe7, 0x22, 0x6e,
+	0x7b, 0xf7, 0x9e, 0x0b, 0xd5, 0x59, 0x5e, 0xcf, 0x9f, 0x82, 0x71, 0x2a, 0x02, 0xea, 0xac, 0x2c,
+	0xa1, 0xe0, 0x71, 0x63, 0xe2, 0x5a, 0xb9, 0x1c, 0x17, 0xa3, 0x5a, 0xc7, 0xfc, 0x93, 0x01, 0x98,
+	0xb8, 0xd5, 0xb0, 0x9d, 0xdd, 0x30, 0x72, 0x50, 0xf4, 0x5c, 0x6d, 0x9c, 0xcc, 0x73, 0xf5, 0x0b,
+	0x50, 0x6e, 0xaa, 0x8f, 0x43, 0x42, 0x70, 0xb2, 0x9c, 0x46, 0x34, 0x03, 0xfc, 0x3e, 0xb5, 0x9a,
+	0x53, 0x07, 0x73, 0x5b, 0x93, 0x00, 0x86, 0x6b, 0x61, 0x0e, 0xbc, 0xc2, 0xd1, 0x70, 0xd4, 0xb9,
+	0x98, 0x57, 0x03, 0x43, 0x44, 0x3c, 0x4f, 0x6e, 0x4f, 0x49, 0x8b, 0x3d, 0x59, 0x9c, 0xa7, 0xbb,
+	0x22, 0x30, 0xca, 0x86, 0x67, 0x6d, 0x6f, 0xdb, 0x35, 0xe5, 0xca, 0x31, 0xb6, 0xb8, 0xca, 0x8c,
+	0x3d, 0x96, 0xb3, 0x2a, 0x3c, 0xd8, 0x9f, 0xbb, 0x9a, 0x19, 0xa7, 0x86, 0xaf, 0x66, 0x66, 0x13,
+	0xcc, 0x26, 0xc5, 0x22, 0x14, 0x1e, 0xc1, 0x0b, 0x59, 0x8b, 0x46, 0xf3, 0x0b, 0x25, 0x98, 0x60,
+	0xdb, 0x8d, 0x05, 0x9c, 0x6b, 0xb2, 0xdc, 0x08, 0x4f, 0x26, 0x83, 0xf0, 0x45, 0x0f, 0x73, 0xa9,
+	0x40, 0x7c, 0xab, 0x70, 0x6e, 0xdb, 0xf5, 0x6a, 0x74, 0xa3, 0xb2, 0xbe, 0xe1, 0x4a, 0xab, 0xb0,
+	0xa5, 0x5b, 0xd5, 0x72, 0x29, 0x7e, 0x28, 0xb8, 0x96, 0x01, 0xc7, 0xcc, 0x56, 0xcc, 0x9c, 0x3f,
+	0x2e, 0xdf, 0x6c, 0x0b, 0x73, 0x78, 0x86, 0x6e, 0x20, 0x36, 0xe7, 0xbf, 0x96, 0x55, 0x01, 0xb3,
+	0xdb, 0x31, 0xab, 0x19, 0xf9, 0x22, 0x71, 0xcd, 0xf5, 0xee, 0x5b, 0x5e, 0x5d, 0x47, 0x3b, 0x18,
+	0x5b, 0xcd, 0x2c, 0xe5, 0x57, 0xc3, 0x6e, 0x38, 0x98, 0xa5, 0x82, 0x1e, 0xe2, 0x90, 0x85, 0xfa,
+	0xf3, 0x64, 0x8a, 0x35, 0x19, 0xea, 0x8f, 0x5d, 0x11, 0x58, 0x19, 0xf3, 0x39, 0xf2, 0xa2, 0x8a,
+	0xf2, 0x0e, 0xc7, 0x45, 0xa6, 0xb8, 0x39, 0x82, 0xa7, 0xa1, 0x0a, 0xac, 0x46, 0x79, 0x20, 0x46,
+	0xb5, 0x61, 0x35, 0x90, 0x95, 0xf1, 0x04, 0x16, 0x76, 0x83, 0xfa, 0xa1, 0x22, 0x5c, 0x24, 0xb0,
+	0xe0, 0x25, 0x28, 0x21, 0xc4, 0x82, 0xc9, 0x76, 0xa7, 0x29, 0xa3, 0xf5, 0xb0, 0xab, 0x8f, 0x50,
+	0x5f, 0x3e, 0x91, 0x95, 0x40, 0x8d, 0xaf, 0x7e, 0x66, 0x16, 0xb5, 0x75, 0x15, 0x05, 0xea, 0x18,
+	0xcd, 0x1f, 0x1c, 0x06, 0x25, 0x78, 0xcb, 0x11, 0xa4, 0xd0, 0x1f, 0x35, 0xe0, 0x5c, 0xad, 0x69,
+	0x53, 0x27, 0x48, 0xc4, 0x41, 0x10, 0xc7, 0xc7, 0x66, 0xa1, 0xa8, 0x32, 0x6d, 0xea, 0xac, 0x2c,
+	0x49, 0xe7, 0x89, 0x4a, 0x06, 0x72, 0xe9, 0x60, 0x92, 0x01, 0xc1, 0xcc, 0xce, 0xf0, 0xf1, 0xf0,
+	0xf2, 0x95, 0x25, 0x35, 0x36, 0x63, 0x45, 0x96, 0x61, 0x04, 0x65, 0x9c, 0xb7, 0xe1, 0xb9, 0x9d,
+	0xb6, 0x5f, 0xe1, 0x3e, 0x92, 0x62, 0x51, 0x38, 0xe7, 0xbd, 0x1e, 0x17, 0xa3, 0x5a, 0x87, 0x29,
+	0x71, 0xc5, 0xcf, 0x75, 0x8f, 0x6e, 0xdb, 0xbb, 0xe5, 0xa1, 0x58, 0x89, 0x7b, 0x5d, 0x29, 0x47,
+	0xad, 0x16, 0x8f, 0x0e, 0xe6, 0xfb, 0x1d, 0xea, 0x6d, 0xe2, 0xaa, 0xcc, 0xc3, 0x2b, 0xa2, 0x83,
+	0x85, 0x85, 0x18, 0xc3, 0xc9, 0xf7, 0x1a, 0x30, 0xc5, 0x82, 0xa4, 0xd8, 0x1e, 0x13, 0x61, 0x2c,
+	0xbb, 0xe5, 0x97, 0x47, 0x8a, 0x47, 0xec, 0x8a, 0x17, 0x7a, 0x1e, 0x35, 0xa4, 0x82, 0x41, 0x46,
+	0xef, 0x0e, 0x3a, 0x10, 0x13, 0x3d, 0x60, 0x53, 0xe5, 0xdb, 0x0d, 0xc7, 0x76, 0x1a, 0x0b, 0xcd,
+	0x86, 0x50, 0x42, 0xcb, 0xa9, 0xaa, 0xc6, 0xc5, 0xa8, 0xd6, 0x61, 0x2f, 0x48, 0x1d, 0x9f, 0xb1,
+	0xbd, 0x16, 0x15, 0xf3, 0x3b, 0x16, 0x5b, 0x88, 0x6c, 0xaa, 0x00, 0xd4, 0xeb, 0xb1, 0xb7, 0xd8,
+	0xb0, 0x40, 0xce, 0x32, 0xf0, 0x96, 0x5c, 0xde, 0xd8, 0xd4, 0x20, 0x98, 0xa8, 0x39, 0xbb, 0x00,
+	0x67, 0x33, 0x86, 0x79, 0x24, 0xde, 0xfa, 0xa7, 0x06, 0x9c, 0x17, 0x52, 0x57, 0x98, 0xc1, 0x37,
+	0x4c, 0x6e, 0x90, 0x9d, 0x27, 0xc0, 0x38, 0xd1, 0x3c, 0x01, 0xaf, 0x42, 0x3e, 0x04, 0xf3, 0x27,
+	0x4b, 0xf0, 0xba, 0x43, 0xbf, 0x4b, 0xf2, 0x43, 0x06, 0x8c, 0xd3, 0xdd, 0xc0, 0xb3, 0x22, 0x47,
+	0x72, 0xb6, 0x49, 0xb7, 0x4f, 0x84, 0x09, 0xcc, 0x2f, 0xc7, 0x84, 0xc4, 0xc6, 0x8d, 0xae, 0x52,
+	0x0a, 0x04, 0xd5, 0xfe, 0x30, 0x6e, 0x2b, 0xd2, 0xcd, 0xa8, 0xa6, 0x64, 0x92, 0x0b, 0x4a, 0xc8,
+	0xec, 0x7b, 0x58, 0x9a, 0x00, 0x1d, 0xf3, 0x91, 0xf6, 0xca, 0x4f, 0x18, 0x90, 0x19, 0xec, 0x91,
+	0xf9, 0x37, 0x31, 0x05, 0x95, 0xf6, 0xa2, 0x28, 0x45, 0x49, 0x6e, 0x8d, 0xbd, 0x90, 0x04, 0x62,
+	0xba, 0xbe, 0x50, 0x04, 0x3b, 0x1d, 0xab, 0xa9, 0xa3, 0x11, 0x02, 0x97, 0x54, 0x04, 0xa7, 0xc0,
+	0x98, 0xd5, 0xc6, 0xfc, 0xbb, 0x06, 0x9c, 0xd7, 0x3a, 0xea, 0x4b, 0x85, 0x76, 0x0f, 0xba, 0xb8,
+	0xec, 0x6d, 0x5f, 0x3a, 0xc9, 0x6d, 0x6f, 0xfe, 0x5c, 0x09, 0x58, 0x98, 0x03, 0x76, 0xb4, 0x9d,
+	0x82, 0x06, 0xce, 0xd2, 0x34, 0x70, 0x85, 0xf4, 0x0b, 0xb2, 0xb3, 0xb9, 0x2a, 0x37, 0x3b, 0xa1,
+	0x72, 0x5b, 0xe8, 0x87, 0x48, 0x77, 0x1d, 0xdb, 0xaf, 0x1a, 0x30, 0x2e, 0x6b, 0x9e, 0x82, 0x52,
+	0xed, 0x1b, 0x74, 0xa5, 0xda, 0xbb, 0xfb, 0x18, 0x57, 0x8e, 0x16, 0xed, 0x73, 0x06, 0x4c, 0xca,
+	0x1a, 0x6b, 0xb4, 0xb5, 0x45, 0x3d, 0x72, 0x0d, 0x46, 0xfc, 0x0e, 0x5f, 0x48, 0x39, 0xa0, 0x87,
+	0x95, 0x01, 0xcd, 0x7b, 0x5b, 0x56, 0x8d, 0x75, 0xbf, 0x2a, 0xaa, 0x28, 0xd9, 0x6a, 0x45, 0x01,
+	0x86, 0x8d, 0xd9, 0xde, 0xf7, 0xdc, 0x66, 0x2a, 0x28, 0x3a, 0xba, 0x4d, 0x8a, 0x1c, 0xc2, 0xee,
+	0x79, 0xec, 0x6f, 0x78, 0x87, 0xe3, 0xf7, 0x3c, 0x06, 0xf6, 0x51, 0x94, 0x9b, 0x3f, 0x35, 0x14,
+	0x4d, 0x36, 0xbf, 0xd4, 0xdf, 0x80, 0xb1, 0x9a, 0x47, 0xad, 0x80, 0xd6, 0x17, 0xf7, 0x7a, 0xe9,
+	0x1c, 0x97, 0x03, 0x2a, 0x61, 0x0b, 0x8c, 0x1b, 0xb3, 0x23, 0x57, 0x35, 0x8b, 0x2c, 0xc5, 0xd2,
+	0x49, 0xae, 0x49, 0xe4, 0xd7, 0xc0, 0x90, 0x7b, 0xdf, 0x89, 0xbc, 0x36, 0xba, 0x12, 0xe6, 0x43,
+	0xb9, 0xcd, 0x6a, 0xa3, 0x68, 0xa4, 0x26, 0x05, 0x18, 0xec, 0x92, 0x14, 0xa0, 0x09, 0x23, 0x2d,
+	0xbe, 0x0c, 0x7d, 0x25, 0x2f, 0xd5, 0x16, 0x34, 0x5e, 0x22, 0xf1, 0x9b, 0x05, 0x0a, 0x10, 0xff,
+	0x30, 0xd1, 0xc9, 0x09, 0x35, 0x3a, 0xaa, 0xe8, 0x14, 0xa9, 0x79, 0x30, 0x86, 0xb3, 0xcc, 0x7d,
+	0x6a, 0xb6, 0x89, 0x91, 0xe2, 0x7a, 0x52, 0xd9, 0x3d, 0x25, 0xc1, 0x84, 0x98, 0xfa, 0xbc, 0x8c,
+	0x13, 0x2c, 0x4e, 0xd8, 0xc5, 0x7a, 0x76, 0xc6, 0x2d, 0xf9, 0x64, 0x5f, 0xc8, 0xed, 0x37, 0x27,
+	0x89, 0xd7, 0xe2, 0x9c, 0x9c, 0xb0, 0xbc, 0x2c, 0x5f, 0x98, 0xd7, 0x19, 0xf3, 0x3b, 0x06, 0xa3,
+	0xaf, 0x49, 0x6a, 0x3a, 0xb2, 0xf5, 0x50, 0x46, 0x11, 0x3d, 0x14, 0x79, 0x4b, 0x98, 0xff, 0x49,
+	0x6c, 0xd7, 0x47, 0x93, 0xf9, 0x9f, 0x26, 0x24, 0x69, 0x2d, 0xe7, 0x53, 0x07, 0xce, 0xfa, 0x01,
+	0x0b, 0xb4, 0x6d, 0xcb, 0xc7, 0x35, 0x3f, 0xb0, 0x5a, 0xed, 0x02, 0x09, 0x98, 0x44, 0x18, 0x80,
+	0x34, 0x2a, 0xcc, 0xc2, 0xcf, 0x72, 0xb0, 0x96, 0x79, 0x39, 0x3b, 0x8c, 0xf9, 0xfc, 0x28, 0xc4,
+	0x8f, 0x6e, 0x24, 0x2e, 0x03, 0xb7, 0x65, 0xe3, 0xc3, 0x5c, 0x4a, 0xe4, 0xc3, 0x70, 0x9e, 0x1d,
+	0x80, 0x0b, 0xb5, 0xc0, 0xbe, 0x67, 0x07, 0x7b, 0x71, 0x17, 0x8e, 0x9e, 0x75, 0x89, 0xdf, 0xb6,
+	0x57, 0xb3, 0x90, 0x61, 0x36, 0x0d, 0xf3, 0x8f, 0x0d, 0x20, 0xe9, 0xbd, 0x4e, 0x9a, 0x30, 0x5a,
+	0x0f, 0xfd, 0xf2, 0x8d, 0x63, 0xc9, 0xd9, 0x12, 0x1d, 0x21, 0x91, 0x3b, 0x7f, 0x44, 0x81, 0xb8,
+	0x30, 0x76, 0x7f, 0xc7, 0x0e, 0x68, 0xd3, 0xf6, 0x83, 0x63, 0x4a, 0x11, 0x13, 0x45, 0x1c, 0xbf,
+	0x13, 0x22, 0xc6, 0x98, 0x86, 0xf9, 0xe9, 0x41, 0x18, 0x8d, 0xb2, 0x29, 0x1e, 0x6e, 0x9c, 0xdc,
+	0x01, 0x52, 0x53, 0xc2, 0xcd, 0xf5, 0xa3, 0x33, 0xe5, 0x32, 0x50, 0x25, 0x85, 0x0c, 0x33, 0x08,
+	0x90, 0x0f, 0x33, 0xb3, 0xd2, 0x6d, 0xcf, 0x8a, 0x82, 0xe9, 0x55, 0x42, 0x45, 0x59, 0x01, 0xc2,
+	0xfc, 0x16, 0xbd, 0x92, 0x81, 0x0e, 0x33, 0x89, 0x10, 0x0a, 0x23, 0x22, 0x69, 0x6c, 0xf8, 0xea,
+	0x52, 0x48, 0x57, 0x2f, 0x64, 0xcd, 0x98, 0xbd, 0x87, 0xb2, 0x67, 0x88, 0x5b, 0x84, 0x3e, 0x15,
+	0xff, 0x87, 0x0f, 0x52, 0xe5, 0xa1, 0xe2, 0xee, 0x6c, 0x77, 0x74, 0x54, 0x32, 0xf4, 0xa9, 0x5e,
+	0x88, 0x49, 0x82, 0xe6, 0x3f, 0x28, 0xc1, 0x90, 0x88, 0x30, 0x75, 0xf2, 0xa2, 0xe6, 0x87, 0x34,
+	0x51, 0xb3, 0x50, 0x6e, 0x7a, 0xde, 0xd5, 0x5c, 0x41, 0xb3, 0x91, 0x10, 0x34, 0x9f, 0x2b, 0x4e,
+	0xa2, 0xbb, 0x98, 0xf9, 0xcb, 0x06, 0x8c, 0xf1, 0x7a, 0xa7, 0x20, 0x64, 0xbe, 0xa8, 0x0b, 0x99,
+	0xef, 0x2a, 0x3c, 0xa6, 0x1c, 0x11, 0xf3, 0x5f, 0x0e, 0xc8, 0xb1, 0x70, 0x19, 0x6e, 0x05, 0xce,
+	0x4a, 0xd7, 0x58, 0x96, 0x31, 0x98, 0x7d, 0x4b, 0x4b, 0xd6, 0x9e, 0x2f, 0x3d, 0x29, 0x44, 0xec,
+	0x94, 0x34, 0x18, 0xb3, 0xda, 0x90, 0x5f, 0x30, 0x98, 0xb4, 0x14, 0x78, 0x76, 0xad, 0xaf, 0x57,
+	0xe7, 0xa8, 0x6f, 0xf3, 0x6b, 0x02, 0x99, 0xb8, 0x04, 0x6f, 0xc6, 0x62, 0x13, 0x2f, 0x7d, 0xb0,
+	0x3f, 0x37, 0x97, 0xa1, 0x9c, 0x8e, 0xf3, 0x1f, 0xfb, 0xc1, 0x37, 0xff, 0x5e, 0xd7, 0x2a, 0xfc,
+	0xda, 0x17, 0xf6, 0x98, 0xdc, 0x80, 0x21, 0xbf, 0xe6, 0xb6, 0x43, 0xe7, 0xea, 0xc7, 0xb2, 0x94,
+	0x90, 0x49, 0xfd, 0x63, 0x34, 0xc1, 0x55, 0xd6, 0x12, 0x05, 0x82, 0xd9, 0x97, 0x60, 0x42, 0xed,
+	0x79, 0xc6, 0x25, 0x7b, 0x49, 0x37, 0x11, 0x3c, 0xa2, 0x35, 0x9c, 0x7a, 0x29, 0xff, 0xbe, 0x21,
+	0x18, 0x57, 0x36, 0xf0, 0xb1, 0xca, 0x37, 0x7f, 0xdf, 0x80, 0xc1, 0x1d, 0x16, 0x39, 0x4e, 0x2c,
+	0xe6, 0x4a, 0x9f, 0x1f, 0xd7, 0x3c, 0x0b, 0x0a, 0x27, 0xd6, 0x12, 0xc3, 0x6f, 0x99, 0x15, 0x1d,
+	0xd3, 0x42, 0xf2, 0xae, 0xf2, 0x3e, 0x77, 0x7c, 0x1e, 0x47, 0xf1, 0x58, 0xfa, 0xbc, 0xe9, 0xd3,
+	0x64, 0x9f, 0x59, 0xd1, 0x71, 0xf5, 0x99, 0x75, 0x75, 0xb6, 0x01, 0x63, 0xd1, 0xd4, 0x9c, 0xe4,
+	0x66, 0x61, 0x84, 0xa2, 0xf1, 0x9c, 0xe8, 0xae, 0xfc, 0xcd, 0x01, 0x18, 0x46, 0xda, 0x90, 0xf9,
+	0xe8, 0x0e, 0x51, 0xb9, 0xd8, 0x61, 0xfa, 0xe3, 0x52, 0x71, 0xe7, 0x4d, 0x35, 0xe3, 0x10, 0xcb,
+	0x79, 0x1c, 0x7f, 0x99, 0x6a, 0x06, 0x64, 0xe2, 0x44, 0x59, 0xba, 0xc4, 0xf6, 0x28, 0x74, 0x5d,
+	0x12, 0x03, 0xeb, 0x25, 0x2f, 0x17, 0xf9, 0x1e, 0x03, 0x88, 0x55, 0xab, 0x31, 0x8f, 0x39, 0xea,
+	0x33, 0x8e, 0x20, 0xee, 0x6a, 0x42, 0xc8, 0x28, 0x16, 0x72, 0x3c, 0x89, 0x2d, 0xfe, 0xaa, 0x53,
+	0x20, 0x1f, 0x33, 0x88, 0xf7, 0x93, 0x2b, 0xec, 0x5f, 0x19, 0x30, 0xa1, 0xa5, 0x62, 0x6b, 0xc5,
+	0x4f, 0x49, 0xc5, 0x2d, 0xd6, 0x42, 0x97, 0xc1, 0x87, 0xbb, 0x54, 0x12, 0xcf, 0x53, 0xb7, 0xa3,
+	0x5c, 0x22, 0xc7, 0x93, 0xb5, 0xcd, 0xfc, 0x8c, 0x01, 0x17, 0xc2, 0x01, 0xe9, 0x41, 0xe3, 0xd9,
+	0xcb, 0x8a, 0xd5, 0xb6, 0xf9, 0x3b, 0x87, 0xfa, 0x52, 0xb4, 0xb0, 0xbe, 0xc2, 0xcb, 0x30, 0x82,
+	0x6a, 0x39, 0xa6, 0x4b, 0x87, 0xe6, 0x98, 0x7e, 0xbd, 0x92, 0x35, 0x7b, 0x28, 0x16, 0xdd, 0x23,
+	0xc2, 0xc2, 0xa6, 0xda, 0x7c, 0x3b, 0x8c, 0x55, 0xab, 0x37, 0xc4, 0x92, 0x1e, 0xe1, 0xc1, 0xd3,
+	0xfc, 0xe4, 0x00, 0x4c, 0xca, 0xec, 0x17, 0x36, 0xd7, 0x80, 0x9e, 0x82, 0x98, 0xb7, 0x01, 0x63,
+	0x7e, 0xf4, 0x84, 0x57, 0xca, 0x3f, 0x3d, 0xa3, 0x57, 0xb9, 0x64, 0x0a, 0xc3, 0x08, 0x80, 0x31,
+	0x22, 0x72, 0x13, 0x86, 0x5f, 0x66, 0x8c, 0x38, 0xfc, 0x56, 0x7b, 0x3a, 0x90, 0xa3, 0x0f, 0x91,
+	0xf3, 0x70, 0x1f, 0x25, 0x0a, 0xe2, 0x73, 0x9f, 0x56, 0x7e, 0x07, 0xea, 0x27, 0x0e, 0xaa, 0x36,
+	0xb3, 0x51, 0xce, 0xfc, 0x09, 0xe9, 0x1a, 0xcb, 0x7f, 0x61, 0x44, 0x88, 0xe7, 0x5f, 0xd5, 0x5a,
+	0xbc, 0x46, 0xf2, 0xaf, 0x6a, 0x7d, 0xce, 0x11, 0x22, 0xdf, 0x05, 0xe7, 0x33, 0x27, 0xe3, 0xf0,
+	0x1b, 0xa6, 0xf9, 0xf7, 0x4a, 0x30, 0xc8, 0xb2, 0xa8, 0x9e, 0xc2, 0xce, 0x7c, 0x51, 0xbb, 0x80,
+	0x7c, 0x4d, 0xe1, 0x0c, 0xb0, 0x79, 0xf7, 0x8f, 0xed, 0xc4, 0xfd, 0xe3, 0x3d, 0x85, 0x29, 0x74,
+	0xbf, 0x7e, 0x7c, 0x7c, 0x00, 0x80, 0x55, 0x5b, 0xb4, 0x6a, 0x77, 0x05, 0xc7, 0x89, 0x76, 0x73,
+	0x22, 0xab, 0x7d, 0x7a, 0x1b, 0x9e, 0xa6, 0x05, 0x94, 0x09, 0xc3, 0x1e, 0x3f, 0x1d, 0xcb, 0x03,
+	0xf1, 0x33, 0x94, 0x38, 0x2f, 0x51, 0x42, 0x74, 0x6e, 0x31, 0x78, 0x5c, 0xdc, 0xe2, 0x43, 0x30,
+	0x55, 0x53, 0xb2, 0xad, 0x47, 0xb6, 0x04, 0x3d, 0x71, 0x0d, 0x3e, 0xb4, 0x8a, 0xd6, 0x1c, 0x13,
+	0xe8, 0xcc, 0x5d, 0x18, 0x61, 0x2b, 0xc0, 0xac, 0x36, 0x5a, 0xca, 0xf4, 0x97, 0x8a, 0xdf, 0xdf,
+	0x25, 0xba, 0x43, 0xd9, 0xc8, 0x27, 0x0d, 0x38, 0x93, 0xa8, 0xdb, 0x83, 0x1e, 0xe7, 0x44, 0x98,
+	0xb2, 0xf9, 0x4b, 0x06, 0x8c, 0xb2, 0xbe, 0x9c, 0x02, 0x27, 0xfb, 0x7a, 0x9d, 0x93, 0xbd, 0xb3,
+	0xe8, 0x14, 0xe7, 0x30, 0xb0, 0x3f, 0x2a, 0x01, 0xcf, 0xe5, 0x2c, 0x6d, 0xe1, 0x14, 0x2b, 0x37,
+	0x23, 0xc7, 0x3e, 0xef, 0x8a, 0x34, 0x92, 0x4b, 0x3c, 0xa0, 0x28, 0x86, 0x72, 0x6f, 0xd2, 0xec,
+	0xe0, 0xb4, 0xef, 0x32, 0xc3, 0x16, 0xee, 0x15, 0x98, 0xf4, 0x99, 0x17, 0x7f, 0x14, 0x14, 0x74,
+	0xb0, 0xf8, 0x63, 0x19, 0x0f, 0x07, 0x10, 0x0e, 0x45, 0x98, 0x1d, 0x54, 0x55, 0xdc, 0xa8, 0x93,
+	0x62, 0x86, 0x3e, 0x5b, 0x4d, 0xb7, 0x76, 0x57, 0x98, 0xe1, 0x09, 0xf7, 0x6f, 0x6e, 0xe8, 0xb3,
+	0x18, 0x95, 0xa2, 0x52, 0xa3, 0x2f, 0x8b, 0xc3, 0x3f, 0x30, 0xc4, 0x4c, 0x1f, 0x61, 0xf3, 0x9e,
+	0x22, 0xcb, 0x7a, 0x43, 0x82, 0x65, 0x45, 0x2c, 0x38, 0xc1, 0xb6, 0xe6, 0xc2, 0x5b, 0xca, 0x60,
+	0xfc, 0x38, 0xa6, 0xde, 0x2d, 0xcc, 0x9f, 0x93, 0xc3, 0x8c, 0xd2, 0x81, 0xb7, 0x61, 0x92, 0x5f,
+	0x03, 0x12, 0x79, 0xc8, 0xdf, 0xd2, 0xe3, 0x37, 0xa2, 0x36, 0x8d, 0x4d, 0xc8, 0xb5, 0x62, 0xd4,
+	0x09, 0x30, 0x2b, 0x94, 0x70, 0x74, 0xc2, 0x92, 0xbb, 0x14, 0xfb, 0x31, 0xaf, 0xab, 0x00, 0xd4,
+	0xeb, 0xb1, 0x2c, 0xfa, 0x8f, 0x8a, 0xbe, 0x73, 0x2d, 0xe1, 0x12, 0x6d, 0x53, 0xa7, 0x4e, 0x9d,
+	0xda, 0x1e, 0x17, 0x8a, 0xeb, 0x2e, 0xd3, 0xcf, 0x0e, 0xdf, 0xa7, 0xb4, 0x1e, 0x3d, 0xb7, 0xdd,
+	0x29, 0x7c, 0xd2, 0xe5, 0x91, 0xb8, 0xc3, 0xd1, 0x8b, 0x23, 0x43, 0xfc, 0x8f, 0x92, 0x24, 0x23,
+	0xde, 0xf6, 0xdc, 0xad, 0x48, 0x76, 0x3b, 0x7e, 0xe2, 0xeb, 0x1c, 0xbd, 0x20, 0x2e, 0xfe, 0x47,
+	0x49, 0xd2, 0x5c, 0x87, 0xc7, 0x7a, 0x68, 0x7a, 0x14, 0x19, 0xfd, 0x30, 0x8c, 0x62, 0xf4, 0x47,
+	0xc1, 0xf8, 0xdb, 0x06, 0x3c, 0xae, 0xa0, 0x5c, 0xde, 0x65, 0xd7, 0x06, 0xe6, 0x43, 0x5a, 0x63,
+	0x17, 0x73, 0x1e, 0xe8, 0xf0, 0x48, 0xf9, 0x8b, 0x3f, 0x69, 0xc0, 0x88, 0xb0, 0x1e, 0x0d, 0xd9,
+	0xef, 0x8b, 0x7d, 0x4e, 0x79, 0x6e, 0x97, 0xc2, 0xbc, 0x6e, 0xe1, 0xd8, 0xc4, 0x6f, 0x1f, 0x43,
+	0xfa, 0xe6, 0xbf, 0x18, 0x82, 0xaf, 0xea, 0x1d, 0x11, 0xf9, 0x03, 0x43, 0xcd, 0xbb, 0x2e, 0xde,
+	0x73, 0x5a, 0x27, 0xdb, 0xf9, 0x48, 0xa7, 0x23, 0xb5, 0x01, 0x77, 0x52, 0xa9, 0xd9, 0x8f, 0x49,
+	0x5d, 0x14, 0x0f, 0x8c, 0xfc, 0x2d, 0x03, 0x26, 0xd8, 0xb1, 0x14, 0x31, 0x17, 0xb1, 0x4c, 0xed,
+	0x13, 0x1e, 0xe9, 0x2d, 0x85, 0x64, 0x22, 0x72, 0x99, 0x0a, 0x42, 0xad, 0x6f, 0x64, 0x53, 0x7f,
+	0xaa, 0x16, 0xf7, 0xb9, 0xcb, 0x59, 0xd2, 0x88, 0xf2, 0xaa, 0x15, 0x19, 0x3d, 0xe5, 0x3d, 0x43,
+	0xcf, 0x36, 0x61, 0x4a, 0x9f, 0xf9, 0x13, 0x55, 0x9e, 0x3d, 0x07, 0x33, 0xa9, 0xd1, 0x1f, 0x49,
+	0x7b, 0xf2, 0xfd, 0x43, 0x30, 0xa7, 0x4c, 0x75, 0x56, 0x00, 0x22, 0xe6, 0x01, 0x3f, 0x6e, 0x39,
+	0x8e, 0x34, 0xc2, 0x0b, 0xf7, 0x6f, 0xbd, 0xcf, 0x55, 0xcd, 0x22, 0x35, 0xbf, 0x10, 0x93, 0x49,
+	0x58, 0x99, 0x29, 0x10, 0x54, 0x7b, 0xd3, 0xc5, 0x92, 0xbc, 0x74, 0x6a, 0x96, 0xe4, 0xe4, 0xa3,
+	0xe1, 0x41, 0x2c, 0xb6, 0xd1, 0x0b, 0x27, 0x30, 0x37, 0xfc, 0x5c, 0xcf, 0x51, 0x21, 0x7e, 0x97,
+	0xc1, 0x0f, 0xd9, 0x38, 0x4e, 0x54, 0x79, 0xb0, 0xb8, 0x41, 0xf0, 0xa1, 0x41, 0xa8, 0xa2, 0xb3,
+	0x3b, 0x2e, 0x42, 0x9d, 0x3c, 0x33, 0xeb, 0x4b, 0x2e, 0xe5, 0x91, 0xb6, 0xe5, 0x3f, 0x1b, 0xd4,
+	0xce, 0x8e, 0xdc, 0xf9, 0xe8, 0x41, 0x93, 0xfb, 0xf9, 0xc4, 0xee, 0x15, 0x3c, 0xc9, 0x3e, 0xa9,
+	0x15, 0x3a, 0xde, 0x2d, 0x3c, 0x70, 0x7a, 0x5b, 0xf8, 0xcf, 0xdc, 0x1e, 0x5a, 0x84, 0xf3, 0xca,
+	0x82, 0xc5, 0x79, 0x9a, 0x78, 0x78, 0x53, 0xdb, 0xb7, 0xc3, 0x20, 0xdd, 0x8a, 0x0c, 0xf3, 0xbc,
+	0x28, 0xc6, 0x10, 0x6e, 0xae, 0x6a, 0xdc, 0x71, 0xc3, 0x6d, 0xbb, 0x4d, 0xb7, 0xb1, 0xb7, 0x70,
+	0xdf, 0xf2, 0x28, 0xba, 0x9d, 0x40, 0x62, 0xeb, 0x55, 0x22, 0x5a, 0x83, 0x2b, 0x0a, 0xb6, 0xcc,
+	0x50, 0xa6, 0x47, 0x41, 0xf7, 0xab, 0x23, 0x30, 0xa1, 0xe0, 0xf3, 0xc9, 0xcf, 0x1a, 0x70, 0x89,
+	0xe6, 0x1d, 0x96, 0x52, 0xd2, 0x7f, 0xe1, 0xa4, 0x0e, 0x63, 0x99, 0x36, 0x29, 0x0f, 0x8c, 0xf9,
+	0x3d, 0x63, 0x71, 0x2a, 0xfc, 0x68, 0x79, 0xfa, 0x89, 0x53, 0x91, 0xb9, 0xde, 0x32, 0xd7, 0x7e,
+	0xf4, 0x1b, 0x15, 0x62, 0xe4, 0x0b, 0x06, 0x9c, 0x6b, 0x66, 0x6c, 0x56, 0xb9, 0xf9, 0xab, 0x27,
+	0xc0, 0x26, 0x84, 0x25, 0x48, 0x16, 0x04, 0x33, 0xbb, 0x42, 0x7e, 0x3c, 0x37, 0xc6, 0xae, 0x50,
+	0x27, 0x6d, 0xf4, 0xd9, 0xc9, 0xe3, 0x0a, 0xb7, 0xfb, 0x59, 0x03, 0x48, 0x3d, 0x75, 0x71, 0x90,
+	0x46, 0x80, 0xef, 0x3f, 0xf6, 0xeb, 0x91, 0x30, 0xe5, 0x49, 0x97, 0x63, 0x46, 0x27, 0xf8, 0x3a,
+	0x07, 0x19, 0x9f, 0x6f, 0x79, 0xf4, 0x58, 0xd6, 0x39, 0x8b, 0x33, 0x88, 0x75, 0xce, 0x82, 0x60,
+	0x66, 0x57, 0xcc, 0x5f, 0x1e, 0x13, 0x7a, 0x2c, 0x6e, 0x02, 0xb1, 0x05, 0xc3, 0x5b, 0x5c, 0xb1,
+	0x5a, 0x36, 0xfa, 0xd3, 0xe2, 0x0a, 0xf5, 0xac, 0xb8, 0x45, 0x8a, 0xff, 0x51, 0x62, 0x26, 0x1f,
+	0x80, 0x81, 0xba, 0x13, 0x7a, 0xb3, 0xbf, 0xbb, 0x0f, 0x75, 0x61, 0x1c, 0x53, 0x83, 0xb9, 0x7e,
+	0x31, 0xa4, 0xc4, 0x81, 0x51, 0x47, 0xaa, 0x7e, 0xe4, 0xed, 0xfc, 0xbd, 0x45, 0x09, 0x44, 0x2a,
+	0xa4, 0x48, 0x71, 0x15, 0x96, 0x60, 0x44, 0x83, 0xd1, 0x4b, 0x3c, 0xa6, 0x14, 0xa6, 0x17, 0x29,
+	0x3f, 0xbb, 0x29, 0xb0, 0x29, 0x8b, 0x93, 0x6b, 0x3b, 0x51, 0x08, 0xb2, 0x67, 0x8b, 0x52, 0xdb,
+	0x60, 0x58, 0x62, 0x0d, 0x0f, 0xff, 0xe9, 0xa3, 0x44, 0xce, 0xb6, 0x81, 0xf0, 0x4e, 0x2f, 0x8f,
+	0xf4, 0xb7, 0x0d, 0x84, 0xc3, 0xbb, 0xd8, 0x06, 0xe2, 0x7f, 0x94, 0x98, 0xc9, 0x4b, 0x4c, 0x43,
+	0x28, 0x4d, 0xbf, 0x46, 0xfb, 0x9b, 0xba, 0xc8, 0xee, 0x4b, 0xfa, 0xda, 0x8a, 0x5f, 0x18, 0xe1,
+	0x27, 0x5b, 0x30, 0x62, 0x0b, 0x37, 0xd1, 0xf2, 0x58, 0xf1, 0x6d, 0x27, 0x3d, 0x4d, 0x85, 0xa2,
+	0x40, 0xfe, 0xc0, 0x10, 0x71, 0xde, 0x03, 0x37, 0xbc, 0x8a, 0x0f, 0xdc, 0xe4, 0x65, 0x00, 0x1a,
+	0xaa, 0x00, 0xfd, 0xf2, 0x78, 0xf1, 0x2d, 0xa3, 0x28, 0x12, 0xc3, 0x57, 0xa6, 0xa8, 0xc8, 0x47,
+	0x85, 0x08, 0xf9, 0xb0, 0xaa, 0x73, 0x98, 0xe8, 0x2f, 0xe0, 0x46, 0x3a, 0x84, 0x4c, 0xac, 0x93,
+	0x0f, 0x41, 0xbe, 0xa2, 0x0a, 0x30, 0x7f, 0x15, 0xc4, 0xe3, 0x90, 0xb4, 0x00, 0xda, 0x86, 0xd1,
+	0x90, 0x4a, 0x3f, 0x21, 0x6f, 0xae, 0x4b, 0xb0, 0xd8, 0x5e, 0xe1, 0x2f, 0x8c, 0x70, 0x33, 0xaf,
+	0x9f, 0x74, 0x08, 0xa8, 0x38, 0xd7, 0x69, 0x6f, 0xe1, 0x9f, 0x5e, 0x06, 0xa8, 0xc5, 0x61, 0x48,
+	0x07, 0x8a, 0xaf, 0x55, 0x14, 0xa2, 0x34, 0x5e, 0xab, 0xa8, 0xc8, 0x47, 0x85, 0x48, 0x8e, 0x85,
+	0xd4, 0x60, 0x21, 0x0b, 0xa9, 0x67, 0xe1, 0x8c, 0x34, 0x84, 0x5b, 0xe1, 0x6f, 0x45, 0xc1, 0x9e,
+	0x74, 0x92, 0xe4, 0xb6, 0x98, 0x15, 0x1d, 0x84, 0xc9, 0xba, 0xe4, 0x9f, 0x1a, 0xcc, 0x1d, 0x55,
+	0x08, 0x69, 0xe5, 0xe1, 0xe2, 0x2e, 0xe1, 0xf1, 0xea, 0xcf, 0x87, 0x32, 0x9f, 0xb8, 0x0f, 0x3d,
+	0x1f, 0x72, 0xd5, 0xb0, 0xf8, 0x98, 0x14, 0x51, 0x51, 0xaf, 0xc9, 0xaf, 0xb0, 0x2b, 0x5f, 0xb3,
+	0xe9, 0xd6, 0xac, 0x80, 0x87, 0x04, 0x14, 0xde, 0x9b, 0xb7, 0xfb, 0x1c, 0xc5, 0x42, 0x8c, 0x51,
+	0x0c, 0xe4, 0x6b, 0xa3, 0x8b, 0x5d, 0x0c, 0x39, 0xa6, 0xb1, 0xa8, 0xdd, 0x27, 0x7f, 0xc3, 0x80,
+	0xc7, 0x85, 0xcb, 0x6c, 0x85, 0x7a, 0x81, 0xbd, 0x6d, 0xd7, 0xac, 0x80, 0x8a, 0xc8, 0xa4, 0xa1,
+	0xbb, 0x96, 0xb0, 0x57, 0x1f, 0x3d, 0xb2, 0xbd, 0xfa, 0x13, 0x07, 0xfb, 0x73, 0x8f, 0x57, 0x7a,
+	0xc0, 0x8d, 0x3d, 0xf5, 0x80, 0x3d, 0x1f, 0x35, 0xd5, 0x08, 0xd9, 0xe5, 0xb1, 0xe2, 0xcf, 0x47,
+	0x5a, 0xa8, 0x6d, 0x71, 0x5f, 0xd4, 0x8a, 0x50, 0x27, 0x35, 0x7b, 0x17, 0x26, 0xb5, 0x8d, 0x76,
+	0xa2, 0x8a, 0x37, 0x07, 0xa6, 0x93, 0xfb, 0xe1, 0x44, 0x8d, 0xd7, 0x6e, 0xc2, 0x58, 0x24, 0x2c,
+	0x90, 0x47, 0x15, 0x42, 0xb1, 0xe8, 0x75, 0x93, 0xee, 0x09, 0xaa, 0x73, 0xda, 0x95, 0x58, 0xbc,
+	0x0a, 0xf1, 0x88, 0x8d, 0x12, 0xa1, 0xf9, 0x6b, 0xf2, 0x55, 0x68, 0x83, 0xb6, 0xda, 0x4d, 0x2b,
+	0xa0, 0xaf, 0x7d, 0xa3, 0x07, 0xf3, 0x3f, 0x18, 0xe2, 0xbc, 0x11, 0xa2, 0x0d, 0xb1, 0x60, 0xbc,
+	0x25, 0x32, 0xc0, 0xf1, 0xf8, 0x8e, 0x46, 0xf1, 0xc8, 0x92, 0x6b, 0x31, 0x1a, 0x54, 0x71, 0x92,
+	0xfb, 0x30, 0x16, 0x0a, 0x83, 0xa1, 0x52, 0xe9, 0x5a, 0x7f, 0xc2, 0x59, 0x24, 0x77, 0x46, 0x47,
+	0x6b, 0x58, 0xe2, 0x63, 0x4c, 0xcb, 0xb4, 0x80, 0xa4, 0xdb, 0x30, 0xbd, 0x41, 0xe8, 0x3b, 0x66,
+	0xe8, 0x39, 0x5b, 0x52, 0xfe, 0x63, 0xa1, 0xce, 0xac, 0x94, 0xa7, 0x33, 0x33, 0x7f, 0xb1, 0x04,
+	0xe7, 0xe4, 0xf5, 0x73, 0xa1, 0x56, 0x73, 0x3b, 0x4e, 0x10, 0xdb, 0x52, 0x08, 0x3f, 0x79, 0x49,
+	0x84, 0x8b, 0x93, 0xc2, 0x89, 0x1e, 0x25, 0x84, 0x05, 0xa4, 0xe0, 0x52, 0x48, 0x9d, 0xe7, 0x4a,
+	0x89, 0xb9, 0x84, 0x1a, 0x90, 0x62, 0x39, 0xab, 0x02, 0x66, 0xb7, 0x63, 0x19, 0xe5, 0x5b, 0xd6,
+	0x6e, 0x12, 0x5b, 0x1f, 0x19, 0xe5, 0xd7, 0x52, 0xd8, 0x30, 0x83, 0x02, 0x3b, 0x48, 0x99, 0x24,
+	0xd7, 0x0e, 0x68, 0x5d, 0x0c, 0x31, 0x7c, 0x94, 0xe6, 0x07, 0xe9, 0x82, 0x0e, 0xc2, 0x64, 0x5d,
+	0xf3, 0xdb, 0x86, 0xe1, 0x92, 0x3e, 0x89, 0xec, 0x0b, 0x0d, 0x5d, 0xd9, 0x9f, 0x0b, 0xfd, 0xb4,
+	0xc4, 0x44, 0x3e, 0x99, 0xf4, 0xd3, 0x2a, 0xab, 0xa6, 0x1f, 0xb2, 0x91, 0xe6, 0xb3, 0xf5, 0x2a,
+	0xf8, 0xa5, 0xe7, 0x38, 0x22, 0x0f, 0x9c, 0xa8, 0xff, 0xfd, 0xa7, 0x0c, 0x98, 0xd5, 0x8b, 0xaf,
+	0xd9, 0x8e, 0xed, 0xef, 0xc8, 0xcc, 0x1c, 0x47, 0x77, 0x13, 0xe3, 0x39, 0x70, 0x57, 0x73, 0x31,
+	0x62, 0x17, 0x6a, 0xe4, 0x3b, 0x0d, 0x78, 0x38, 0x31, 0x2f, 0x5a, 0x9e, 0x90, 0xa3, 0x7b, 0x8c,
+	0xf1, 0x40, 0x2a, 0xab, 0xf9, 0x28, 0xb1, 0x1b, 0x3d, 0xa6, 0xd6, 0xb8, 0xd0, 0xce, 0xf2, 0x2a,
+	0x0f, 0xaf, 0xa5, 0x85, 0xd4, 0x68, 0x99, 0x7e, 0xea, 0x8b, 0x97, 0xe5, 0x16, 0xbd, 0x90, 0x09,
+	0xf6, 0x31, 0xa7, 0x23, 0xdc, 0xb9, 0x87, 0xdb, 0x7d, 0xbc, 0x36, 0x9c, 0x7b, 0x78, 0x57, 0x4f,
+	0xd6, 0xb9, 0x47, 0x90, 0xe8, 0x6e, 0x5d, 0xf7, 0xb5, 0x70, 0x81, 0x57, 0x5b, 0xa8, 0x73, 0x65,
+	0x9b, 0x4f, 0xeb, 0x0b, 0xf5, 0x3a, 0xbf, 0xde, 0x1e, 0xfe, 0xe4, 0xf1, 0x28, 0x0c, 0x74, 0xbc,
+	0x66, 0x32, 0xdc, 0x29, 0x8b, 0x72, 0xc2, 0xca, 0x4d, 0x16, 0x83, 0x8d, 0xe3, 0x56, 0x58, 0x0c,
+	0xb9, 0x07, 0xa3, 0x9e, 0x64, 0x33, 0x72, 0x6d, 0x56, 0x0b, 0x0f, 0x2d, 0x83, 0x75, 0x89, 0x1b,
+	0x5b, 0xf8, 0x0b, 0x23, 0x5a, 0xe6, 0x97, 0x86, 0xa1, 0x9c, 0xd7, 0x88, 0x45, 0x62, 0xb9, 0x50,
+	0x8b, 0x25, 0x4e, 0x16, 0x92, 0xc2, 0xf5, 0x44, 0x14, 0xee, 0x3e, 0xb4, 0x62, 0x95, 0x85, 0xa8,
+	0x57, 0x3c, 0xd1, 0x45, 0x25, 0x93, 0x02, 0xe6, 0x50, 0x66, 0x19, 0x85, 0xef, 0xc6, 0x49, 0xc4,
+	0x4a, 0xc5, 0x33, 0x0a, 0xf3, 0x61, 0x2b, 0x89, 0xc6, 0xc2, 0x4e, 0x45, 0xf1, 0x20, 0x65, 0xb9,
+	0x42, 0x8e, 0x11, 0xf7, 0xfd, 0x9d, 0x9b, 0x74, 0xaf, 0x6d, 0xd9, 0xa1, 0xd9, 0x4b, 0x71, 0xe2,
+	0xd5, 0xea, 0x0d, 0x89, 0x4a, 0x27, 0xae, 0x94, 0x2b, 0xe4, 0xd8, 0x3b, 0xd5, 0xa4, 0xab, 0x06,
+	0x66, 0xe9, 0xc7, 0x6c, 0x39, 0x33, 0xc2, 0x8b, 0x10, 0xf3, 0x75, 0x90, 0x4e, 0x92, 0xed, 0x89,
+	0x19, 0x3f, 0x79, 0xac, 0x4a, 0xc6, 0xbb, 0x56, 0x4c, 0x00, 0xcb, 0x39, 0xa3, 0x85, 0xca, 0x20,
+	0x0d, 0x4e, 0x93, 0xe7, 0x9d, 0xa2, 0x41, 0xad, 0xbe, 0xec, 0xd4, 0xbc, 0x3d, 0x1e, 0x0a, 0x80,
+	0x75, 0x6a, 0xb8, 0x78, 0xa7, 0x58, 0x2a, 0x38, 0x0d, 0x99, 0xde, 0xa9, 0x34, 0x38, 0x4d, 0x9e,
+	0xa5, 0x10, 0xb9, 0x98, 0xb3, 0xc7, 0xfe, 0xdc, 0x44, 0xd2, 0x61, 0x3e, 0x92, 0x7c, 0x0e, 0x5e,
+	0x23, 0x3e, 0x92, 0xbc, 0xaf, 0x39, 0xd6, 0xa1, 0xbf, 0xc4, 0x4c, 0xf7, 0x93, 0x29, 0x96, 0x7a,
+	0xf2, 0x65, 0x3a, 0x35, 0xc3, 0xc5, 0xd7, 0xc7, 0x99, 0x23, 0x07, 0xe2, 0x08, 0x16, 0xc9, 0xac,
+	0x91, 0xe6, 0x1d, 0x98, 0xd4, 0x8c, 0x43, 0x95, 0x58, 0x8f, 0x59, 0x51, 0x2a, 0xd5, 0x50, 0x8e,
+	0xa5, 0x6e, 0x41, 0x28, 0xe3, 0x2d, 0x9f, 0xe6, 0x6c, 0x7f, 0x7e, 0xb6, 0x3c, 0x91, 0x5b, 0x9e,
+	0xbf, 0x23, 0xbd, 0x08, 0xc3, 0x3c, 0x82, 0x64, 0x78, 0x62, 0x3e, 0x53, 0x38, 0x32, 0xa5, 0x8c,
+	0xf8, 0x2a, 0xfe, 0x47, 0x89, 0x95, 0xe5, 0x70, 0x57, 0xe3, 0xaa, 0xde, 0x8a, 0x2f, 0x96, 0xe7,
+	0x92, 0x51, 0x58, 0xf9, 0x96, 0x4c, 0xd5, 0x26, 0x28, 0x5e, 0xa1, 0xc4, 0x59, 0x56, 0x28, 0x81,
+	0x0e, 0x7b, 0x81, 0x1a, 0xd1, 0x5e, 0x9f, 0x74, 0x75, 0xfb, 0xe0, 0x69, 0xa8, 0xdb, 0x3d, 0x18,
+	0xdf, 0xb1, 0x99, 0x2a, 0x59, 0xc8, 0x50, 0x43, 0xc5, 0xc5, 0xc3, 0x1b, 0x31, 0x1a, 0xa1, 0x83,
+	0x50, 0x0a, 0x50, 0x25, 0x42, 0x3c, 0x2d, 0x2a, 0xf5, 0x70, 0x71, 0x91, 0x28, 0xd6, 0x8b, 0xc7,
+	0xe3, 0xcc, 0x89, 0x48, 0xed, 0x00, 0x38, 0x51, 0xa8, 0xd6, 0x7e, 0x5e, 0xa5, 0xe2, 0x80, 0xaf,
+	0x42, 0xe8, 0x88, 0x7f, 0xa3, 0x42, 0x81, 0xcd, 0x6b, 0x2b, 0x8e, 0xfc, 0x5f, 0x1e, 0x2d, 0x3e,
+	0xaf, 0x4a, 0x02, 0x01, 0xa9, 0xdb, 0x89, 0x0b, 0x50, 0x25, 0xc2, 0xc6, 0xd8, 0x8a, 0xe2, 0xf5,
+	0x97, 0xc7, 0x8a, 0x8f, 0x31, 0x8e, 0xfa, 0x2f, 0xc6, 0x18, 0xff, 0x46, 0x85, 0x02, 0x7b, 0x81,
+	0x8b, 0x1e, 0x2f, 0xa1, 0xb8, 0x86, 0xac, 0xa7, 0x87, 0xcb, 0xb7, 0xc5, 0x8a, 0xa2, 0x71, 0xfe,
+	0x9d, 0x3e, 0xac, 0x28, 0x89, 0x78, 0x1e, 0x03, 0xc6, 0x3b, 0x52, 0x4a, 0xa3, 0xd8, 0x24, 0x7d,
+	0xa2, 0xab, 0x49, 0x7a, 0x05, 0x66, 0x84, 0x67, 0x86, 0xf4, 0xc1, 0xe2, 0x0c, 0x61, 0x32, 0x7e,
+	0x81, 0xa9, 0x26, 0x81, 0x98, 0xae, 0x2f, 0x18, 0x3e, 0xad, 0xf3, 0xb6, 0x53, 0x2a, 0xc3, 0x17,
+	0x65, 0x18, 0x41, 0xc9, 0x3d, 0x98, 0xf0, 0x15, 0xfb, 0xf6, 0xf2, 0x99, 0x7e, 0xdf, 0x2f, 0x05,
+	0x1e, 0x11, 0x50, 0x52, 0x2d, 0x41, 0x8d, 0x8e, 0xfe, 0xb8, 0x36, 0x7d, 0xba, 0x8f, 0x6b, 0x2c,
+	0x34, 0xba, 0x6a, 0xba, 0x3a, 0x73, 0x2c, 0x01, 0x5b, 0x0e, 0x35, 0x6d, 0x65, 0x4b, 0x4b, 0x77,
+	0xdb, 0xae, 0xcf, 0x62, 0x94, 0x34, 0x2d, 0xdf, 0xe7, 0xcb, 0x43, 0xe2, 0xa5, 0x5d, 0x4e, 0x02,
+	0x31, 0x5d, 0x9f, 0x7c, 0xbb, 0x01, 0xd3, 0xfe, 0x9e, 0x1f, 0xd0, 0x16, 0x3b, 0xb6, 0x5c, 0x87,
+	0xb2, 0x27, 0xf4, 0xb3, 0xc5, 0x03, 0x80, 0x57, 0x13, 0xb8, 0xc4, 0xb1, 0x93, 0x2c, 0xc5, 0x14,
+	0x4d, 0xb6, 0x73, 0xd4, 0x90, 0x2f, 0xe5, 0x73, 0xc5, 0x77, 0x8e, 0x1a, 0x4e, 0x46, 0xec, 0x1c,
+	0xb5, 0x04, 0x35, 0x3a, 0xcc, 0x1f, 0xc2, 0x0f, 0xb3, 0x88, 0xf3, 0x19, 0x3c, 0x1f, 0x47, 0xe5,
+	0xac, 0xaa, 0x00, 0xd4, 0xeb, 0x91, 0x8f, 0xc1, 0x84, 0x7a, 0x76, 0x96, 0x2f, 0x1c, 0x77, 0x7c,
+	0x7a, 0xd1, 0x73, 0x15, 0xa4, 0x11, 0x24, 0x08, 0x17, 0x14, 0xff, 0x33, 0xf5, 0xfb, 0xbe, 0xc8,
+	0x87, 0x20, 0x2e, 0xd3, 0x99, 0x35, 0x30, 0xa7, 0x25, 0xf9, 0xc1, 0xec, 0xb7, 0xfa, 0xf2, 0x95,
+	0x81, 0xa2, 0x59, 0x31, 0x52, 0x0f, 0xf2, 0x77, 0xec, 0x60, 0xe7, 0x36, 0xbf, 0x14, 0xf9, 0x47,
+	0x7d, 0xb6, 0x37, 0x7f, 0x93, 0x3d, 0x2b, 0x84, 0xda, 0x9a, 0xd3, 0x78, 0x27, 0xa9, 0x6b, 0x0a,
+	0xac, 0xc5, 0xbe, 0xb4, 0x4b, 0xb9, 0xe9, 0x47, 0xcc, 0xdf, 0x30, 0x60, 0x2a, 0xae, 0x76, 0x0a,
+	0x57, 0xa3, 0x9a, 0x7e, 0x35, 0x7a, 0x4f, 0x7f, 0xe3, 0xca, 0xb9, 0x1f, 0xfd, 0xdf, 0x92, 0x3a,
+	0x2a, 0x2e, 0xfd, 0xde, 0xd3, 0xec, 0x0e, 0x18, 0xe9, 0x1b, 0xfd, 0xd8, 0x1d, 0xa8, 0x71, 0x06,
+	0xe2, 0xf1, 0x66, 0xd8, 0x21, 0x7c, 0xa3, 0x26, 0x7f, 0xf6, 0x11, 0x77, 0x26, 0x12, 0x36, 0x43,
+	0xd2, 0x62, 0x02, 0x0e, 0x13, 0x46, 0x5f, 0x56, 0x8f, 0xa7, 0x3e, 0x52, 0x86, 0x68, 0x03, 0xee,
+	0x6e, 0xf1, 0xf1, 0x5d, 0xd3, 0x30, 0xae, 0x28, 0x36, 0x13, 0x56, 0x14, 0xc6, 0x69, 0x58, 0x51,
+	0x04, 0x30, 0x5e, 0x8b, 0xb2, 0x55, 0x86, 0xd3, 0xde, 0x27, 0xcd, 0xe8, 0x58, 0x8c, 0xf3, 0x60,
+	0xfa, 0xa8, 0x92, 0x61, 0xc2, 0x5b, 0xb4, 0xc7, 0x06, 0x8e, 0xc1, 0xb6, 0xa5, 0xdb, 0xbe, 0x7a,
+	0x2b, 0x40, 0x28, 0xff, 0xd3, 0xba, 0x0c, 0xc5, 0x1e, 0x39, 0xbb, 0xac, 0xf8, 0x37, 0x22, 0x18,
+	0x2a, 0xf5, 0xd2, 0xaf, 0xf2, 0x43, 0xa7, 0xf6, 0x2a, 0xcf, 0xb6, 0x41, 0x33, 0xcc, 0x49, 0xdf,
+	0x97, 0xad, 0x5c, 0x94, 0xd9, 0x3e, 0xde, 0x06, 0x51, 0x91, 0x8f, 0x0a, 0x91, 0x1c, 0x63, 0x9a,
+	0x91, 0x42, 0xc6, 0x34, 0x1d, 0x38, 0xeb, 0xd1, 0xc0, 0xdb, 0xab, 0xec, 0xd5, 0x78, 0x0e, 0x13,
+	0x2f, 0xe0, 0x37, 0xf8, 0xd1, 0x62, 0x91, 0x11, 0x31, 0x8d, 0x0a, 0xb3, 0xf0, 0x6b, 0x02, 0xf0,
+	0x58, 0x57, 0x01, 0xf8, 0x6d, 0x30, 0x1e, 0xd0, 0xda, 0x8e, 0xc3, 0xcc, 0x71, 0x57, 0x96, 0x64,
+	0xa0, 0xee, 0x58, 0x96, 0x8b, 0x41, 0xa8, 0xd6, 0x23, 0x8b, 0x30, 0xd0, 0xb1, 0xeb, 0xf2, 0x06,
+	0xf0, 0xd5, 0xd1, 0x13, 0xc1, 0xca, 0xd2, 0x83, 0xfd, 0xb9, 0xd7, 0xc5, 0xd6, 0x29, 0xd1, 0xa8,
+	0xae, 0xb6, 0xef, 0x36, 0xae, 0x06, 0xcc, 0x2f, 0x73, 0x7e, 0x73, 0x65, 0x09, 0x59, 0xe3, 0x2c,
+	0x43, 0xa3, 0x89, 0x23, 0x18, 0x1a, 0x7d, 0xd6, 0x80, 0xb3, 0x56, 0xf2, 0x75, 0x83, 0xfa, 0xe5,
+	0xc9, 0xe2, 0xdc, 0x32, 0xfb, 0xc5, 0x64, 0xf1, 0x61, 0x39, 0xbe, 0xb3, 0x0b, 0x69, 0x72, 0x98,
+	0xd5, 0x07, 0xa6, 0xb7, 0x69, 0xd9, 0x8d, 0x28, 0x3d, 0xbc, 0x5c, 0xf5, 0xa9, 0x62, 0x7a, 0x9b,
+	0xb5, 0x14, 0x26, 0xcc, 0xc0, 0x4e, 0xee, 0xc3, 0xb8, 0x22, 0x24, 0x95, 0xcf, 0xf4, 0x21, 0x13,
+	0x27, 0xde, 0x53, 0xc4, 0x6d, 0x57, 0x29, 0x40, 0x95, 0x52, 0xf4, 0xc2, 0xaa, 0xa8, 0x19, 0xe4,
+	0x2b, 0x23, 0x1f, 0xf5, 0x74, 0xf1, 0x17, 0xd6, 0x6c, 0x8c, 0xd8, 0x85, 0x1a, 0x8f, 0x47, 0xc8,
+	0xc0, 0xca, 0xdd, 0xbc, 0x3c, 0x53, 0x3c, 0x9e, 0xc1, 0xaa, 0x8e, 0x4a, 0x6c, 0xcd, 0x44, 0x21,
+	0x26, 0x09, 0x92, 0x6b, 0x40, 0xa8, 0x50, 0xa5, 0xc7, 0x97, 0x33, 0xbf, 0x4c, 0xf8, 0xe3, 0x3f,
+	0x5f, 0xd2, 0xe5, 0x14, 0x14, 0x33, 0x5a, 0x90, 0x40, 0xd3, 0x95, 0xf4, 0x71, 0xcb, 0x49, 0x26,
+	0xc7, 0xe9, 0xaa, 0x31, 0xf9, 0x56, 0x23, 0x95, 0xa7, 0x59, 0x5c, 0x6e, 0x6e, 0xf4, 0x9f, 0xa7,
+	0x59, 0x92, 0xef, 0x21, 0x5b, 0xb3, 0xf9, 0xeb, 0x86, 0xd4, 0xf2, 0x9e, 0xa2, 0x99, 0xd1, 0x49,
+	0xbf, 0xff, 0x9a, 0x77, 0xa0, 0x5c, 0x0d, 0x03, 0x75, 0xd6, 0x13, 0xf1, 0xf8, 0xdf, 0x0d, 0x93,
+	0xe2, 0x95, 0x65, 0xcd, 0x6a, 0xdf, 0x8a, 0x55, 0xf2, 0x91, 0x9b, 0x7c, 0x45, 0x05, 0xa2, 0x5e,
+	0xd7, 0xfc, 0xb2, 0x01, 0x17, 0x75, 0xcc, 0xae, 0x67, 0xbf, 0xd2, 0x3f, 0x62, 0xf2, 0x09, 0x03,
+	0xc6, 0xe3, 0x07, 0xc4, 0x50, 0x2a, 0x2a, 0xe4, 0x8e, 0x11, 0xf6, 0x8a, 0x7a, 0xca, 0x8b, 0x52,
+	0x3a, 0xc9, 0x63, 0x0c, 0xf4, 0x51, 0x25, 0x6d, 0xfe, 0x57, 0xf6, 0xf0, 0x9c, 0xbc, 0x87, 0x6f,
+	0x31, 0xaf, 0x6e, 0x8f, 0xb2, 0xd4, 0x32, 0x46, 0x71, 0x8b, 0xf0, 0x8a, 0x40, 0x21, 0xde, 0x1b,
+	0xe4, 0x0f, 0x0c, 0x11, 0xb3, 0xbb, 0xbe, 0xa3, 0x24, 0xeb, 0x91, 0xdb, 0xa3, 0x90, 0x44, 0xac,
+	0x26, 0xfd, 0x11, 0x37, 0x66, 0xb5, 0x04, 0x35, 0x3a, 0xe6, 0x2a, 0x40, 0xac, 0x4d, 0xe9, 0xdb,
+	0x6c, 0xef, 0x3f, 0x1a, 0x70, 0x36, 0x23, 0xab, 0x2a, 0x59, 0x86, 0x51, 0x3f, 0x4c, 0xbd, 0xaf,
+	0x5b, 0x13, 0x8d, 0x2a, 0x89, 0xf7, 0xcf, 0x6b, 0x4d, 0x43, 0x00, 0x46, 0x4d, 0x59, 0xf8, 0x8d,
+	0x58, 0xac, 0x55, 0xf3, 0xec, 0x28, 0xb9, 0xe1, 0x95, 0x1a, 0xe4, 0x83, 0x70, 0xa9, 0x65, 0x3b,
+	0xae, 0x27, 0xf1, 0xfa, 0x8b, 0x74, 0xc7, 0x76, 0xea, 0xab, 0xec, 0xdb, 0x0f, 0x64, 0x24, 0x31,
+	0x91, 0xef, 0x35, 0xaf, 0x12, 0xe6, 0xb7, 0x37, 0xff, 0xf1, 0x19, 0x38, 0xdf, 0xaf, 0x83, 0x1c,
+	0x3b, 0x4b, 0x2e, 0xd0, 0x7b, 0x76, 0x2d, 0x58, 0xd8, 0x0e, 0xa8, 0x77, 0xfb, 0xf6, 0xda, 0xc6,
+	0x8e, 0x47, 0xfd, 0x1d, 0xb7, 0x59, 0xef, 0xc5, 0x20, 0x33, 0xc3, 0x7a, 0x8c, 0x6b, 0x38, 0x96,
+	0x33, 0x31, 0x62, 0x0e, 0x25, 0xae, 0x35, 0xbb, 0x27, 0xf4, 0x09, 0x68, 0x05, 0x74, 0xb1, 0xe3,
+	0x45, 0xd3, 0x23, 0xb4, 0x66, 0x49, 0x20, 0xa6, 0xeb, 0x27, 0x91, 0xf0, 0xac, 0x7b, 0x5c, 0xfc,
+	0x37, 0xd2, 0x48, 0x38, 0x10, 0xd3, 0xf5, 0x55, 0x24, 0x62, 0x57, 0xb2, 0xb3, 0x75, 0x28, 0x8d,
+	0x24, 0x02, 0x62, 0xba, 0x3e, 0xa9, 0xc3, 0x23, 0x1e, 0xad, 0xb9, 0xad, 0x16, 0x75, 0xea, 0x7c,
+	0x52, 0xd6, 0x2c, 0xaf, 0x61, 0x3b, 0xd7, 0x3c, 0x8b, 0x57, 0xe4, 0x8f, 0x10, 0x06, 0x4f, 0x90,
+	0xfb, 0x08, 0x76, 0xa9, 0x87, 0x5d, 0xb1, 0x90, 0x16, 0x9c, 0xe9, 0xf0, 0x7d, 0xea, 0xad, 0x38,
+	0x01, 0xf5, 0xee, 0x59, 0xcd, 0xf2, 0x48, 0xa1, 0x15, 0xe3, 0xe7, 0xfd, 0xa6, 0x8e, 0x0a, 0x93,
+	0xb8, 0xc9, 0x1e, 0x9c, 0x8d, 0xba, 0xa3, 0x90, 0x1c, 0x2d, 0x44, 0x52, 0x4a, 0xfa, 0x29, 0x74,
+	0x98, 0x45, 0x83, 0x85, 0xba, 0x0d, 0x2c, 0xaf, 0x41, 0x83, 0xca, 0xfa, 0xe6, 0x3a, 0xf5, 0x6a,
+	0xec, 0x3c, 0x69, 0x0a, 0xa1, 0xdf, 0x10, 0xa8, 0x36, 0xd2, 0x60, 0xcc, 0x6a, 0x43, 0x3e, 0x06,
+	0xaf, 0xd7, 0x27, 0x75, 0xd5, 0xbd, 0x4f, 0xbd, 0x45, 0xb7, 0xe3, 0xd4, 0x75, 0xe4, 0xc0, 0x91,
+	0x3f, 0x79, 0xb0, 0x3f, 0xf7, 0x7a, 0xec, 0xa5, 0x01, 0xf6, 0x86, 0x37, 0xdd, 0x81, 0xcd, 0x76,
+	0x3b, 0xb3, 0x03, 0xe3, 0x79, 0x1d, 0xc8, 0x69, 0x80, 0xbd, 0xe1, 0x65, 0x1a, 0x4a, 0x31, 0x31,
+	0x22, 0x9d, 0xb3, 0x42, 0x71, 0x82, 0x53, 0xe4, 0xdf, 0xef, 0x46, 0x66, 0x0d, 0xcc, 0x69, 0xc9,
+	0xce, 0xcf, 0x27, 0xf2, 0x86, 0x9f, 0x22, 0x33, 0xc9, 0xc9, 0xbc, 0xe9, 0x60, 0x7f, 0xee, 0x09,
+	0xec, 0xb1, 0x0d, 0xf6, 0x8c, 0x3d, 0xa3, 0x2b, 0xf1, 0x44, 0xa4, 0xba, 0x32, 0x95, 0xd7, 0x95,
+	0xfc, 0x36, 0xd8, 0x33, 0x76, 0xf2, 0x1d, 0x06, 0x5c, 0xaa, 0xb5, 0x3b, 0x37, 0x6c, 0x3f, 0x70,
+	0x1b, 0x9e, 0xd5, 0x5a, 0xa2, 0x35, 0x6b, 0xef, 0x86, 0xd5, 0xdc, 0x66, 0xc1, 0x97, 0xcb, 0x67,
+	0x0a, 0x7d, 0x38, 0xfc, 0xb4, 0xa8, 0xac, 0x6f, 0x66, 0x23, 0xc5, 0x7c, 0x7a, 0xe4, 0xfb, 0x0d,
+	0x78, 0xa4, 0xc5, 0xbb, 0x98, 0xd3, 0xa1, 0xe9, 0x42, 0x1d, 0xe2, 0x5c, 0x6c, 0xad, 0x0b, 0x5e,
+	0xec, 0x4a, 0x95, 0x4f, 0x92, 0xa8, 0xb0, 0xd0, 0x68, 0x78, 0xb4, 0xc1, 0xb1, 0x46, 0xdc, 0x65,
+	0xa6, 0xf8, 0x24, 0xad, 0xe5, 0x21, 0xc5, 0x7c, 0x7a, 0xe4, 0x25, 0xb8, 0x9c, 0x0b, 0xac, 0x30,
+	0x43, 0x26, 0xfe, 0x96, 0x33, 0xb0, 0x68, 0x1e, 0xec, 0xcf, 0x5d, 0x5e, 0xeb, 0x5a, 0x13, 0x0f,
+	0xc1, 0x64, 0x7e, 0xd6, 0x00, 0xe9, 0x65, 0xc8, 0x4c, 0x3b, 0x14, 0xfb, 0x94, 0xd1, 0x84, 0x6d,
+	0x4a, 0x98, 0x27, 0xb5, 0x94, 0x99, 0x27, 0xf5, 0x0d, 0x4a, 0x5c, 0xd2, 0xb1, 0x58, 0xf4, 0x17,
+	0x98, 0xe3, 0xc0, 0xa4, 0x2c, 0x47, 0x49, 0x74, 0xfb, 0x92, 0x5a, 0x31, 0x9e, 0xa3, 0x24, 0xbe,
+	0xa6, 0xc5, 0x70, 0x16, 0x30, 0x16, 0xe2, 0xf4, 0xbf, 0x2c, 0x81, 0x7c, 0x8d, 0xbd, 0x4e, 0x25,
+	0x13, 0xc8, 0xf3, 0x27, 0x2b, 0x14, 0xb0, 0xc3, 0x4d, 0xe6, 0x99, 0x65, 0x7c, 0x87, 0x27, 0x3c,
+	0x94, 0x66, 0xee, 0xdc, 0x56, 0x62, 0x93, 0x97, 0xa0, 0x84, 0x90, 0x4d, 0x18, 0x69, 0xd9, 0x0e,
+	0xf7, 0x48, 0x18, 0x2c, 0xe4, 0x91, 0xc0, 0xa5, 0xdb, 0x35, 0x81, 0x02, 0x43, 0x5c, 0xe6, 0xcf,
+	0x1a, 0x70, 0x46, 0x0f, 0x14, 0xeb, 0x33, 0x43, 0x1c, 0x99, 0xdd, 0x41, 0x46, 0x4d, 0xe7, 0x4d,
+	0x65, 0xa8, 0x35, 0x0c, 0x61, 0xfa, 0x33, 0x66, 0x1f, 0x6a, 0xea, 0xec, 0x78, 0xb5, 0x87, 0x68,
+	0x8c, 0x3f, 0x7d, 0x0e, 0x86, 0x85, 0xb1, 0x30, 0x93, 0xd4, 0x32, 0x42, 0xcc, 0xdc, 0x2c, 0x9e,
+	0x81, 0xa0, 0x48, 0x18, 0x0e, 0x35, 0x0f, 0x63, 0xa9, 0x6b, 0x1e, 0x46, 0x84, 0x81, 0x9a, 0x67,
+	0xf7, 0x63, 0xb2, 0x52, 0xc1, 0x15, 0x61, 0xb2, 0x52, 0xc1, 0x15, 0x64, 0xc8, 0x98, 0xae, 0x40,
+	0xb1, 0xe5, 0x18, 0x2c, 0xae, 0x2b, 0x10, 0x13, 0xa0, 0x58, 0x74, 0x4c, 0x75, 0xb5, 0xe6, 0x08,
+	0x83, 0x4f, 0x0f, 0x15, 0x77, 0x61, 0x91, 0x53, 0xde, 0x4b, 0xf0, 0xe9, 0xf0, 0x43, 0x1a, 0xce,
+	0xfd, 0x90, 0xb6, 0x61, 0x44, 0x7e, 0x0a, 0xe5, 0x91, 0xe2, 0xf7, 0x41, 0x69, 0x22, 0xa7, 0xe4,
+	0x35, 0x12, 0x05, 0x18, 0x22, 0x67, 0xf7, 0x88, 0x96, 0xb5, 0xcb, 0xdc, 0x79, 0xb8, 0x9c, 0x37,
+	0xa4, 0x56, 0xe5, 0xc5, 0x18, 0xc2, 0x79, 0x55, 0xe1, 0xf9, 0x53, 0x1e, 0x4b, 0x54, 0x15, 0xc5,
+	0x18, 0xc2, 0xc9, 0x07, 0x60, 0xb4, 0x65, 0xed, 0x56, 0x3b, 0x5e, 0x83, 0x96, 0xe1, 0x10, 0x15,
+	0x47, 0x27, 0xb0, 0x9b, 0xf3, 0xec, 0x09, 0x21, 0xf0, 0xe6, 0x57, 0x9c, 0xe0, 0xb6, 0x57, 0x0d,
+	0xb8, 0xa5, 0x08, 0xdf, 0x75, 0x6b, 0x12, 0x0b, 0x46, 0xf8, 0x48, 0x13, 0xa6, 0x5a, 0xd6, 0xee,
+	0xa6, 0x63, 0x89, 0xb8, 0xe2, 0x52, 0x8e, 0x2a, 0x42, 0x81, 0xab, 0x6f, 0xd6, 0x34, 0x5c, 0x98,
+	0xc0, 0x9d, 0x61, 0x35, 0x38, 0x71, 0x52, 0x56, 0x83, 0x0b, 0x91, 0x2f, 0xbd, 0xd0, 0xfd, 0x5e,
+	0xca, 0x8c, 0xc2, 0xd5, 0xd5, 0x4f, 0xfe, 0xc5, 0xc8, 0x4f, 0x7e, 0xaa, 0xb8, 0x99, 0x5b, 0x17,
+	0x1f, 0xf9, 0x0e, 0x8c, 0xd7, 0xad, 0xc0, 0x12, 0xa5, 0x4c, 0x39, 0x5b, 0xf8, 0x19, 0x73, 0x29,
+	0x42, 0x13, 0xb3, 0xa4, 0xb8, 0xcc, 0x47, 0x95, 0x0e, 0xf3, 0xa5, 0x62, 0x1f, 0x6b, 0x93, 0x06,
+	0x71, 0x15, 0xae, 0x01, 0x9a, 0xe6, 0xdf, 0x0f, 0xf7, 0xa5, 0xba, 0x99, 0x55, 0x01, 0xb3, 0xdb,
+	0xc5, 0x11, 0x23, 0x67, 0xb2, 0x23, 0x46, 0x92, 0x4f, 0x67, 0xd9, 0x67, 0x90, 0x2b, 0x46, 0xd1,
+	0x93, 0x41, 0xf0, 0x86, 0xc2, 0x56, 0x1a, 0xff, 0xd0, 0x80, 0xb2, 0xdc, 0x65, 0xd2, 0xa6, 0xa2,
+	0x49, 0xbd, 0x35, 0xcb, 0xb1, 0x1a, 0xd4, 0x2b, 0x9f, 0x2d, 0x1e, 0xfe, 0x64, 0x2d, 0x07, 0x67,
+	0x14, 0xc0, 0xe0, 0xf1, 0x83, 0xfd, 0xb9, 0x2b, 0x87, 0xd5, 0xc2, 0xdc, 0xbe, 0x11, 0x0f, 0x46,
+	0xfc, 0x3d, 0xbf, 0x16, 0x34, 0x99, 0xf2, 0x95, 0x6d, 0x96, 0xeb, 0x7d, 0x70, 0xd6, 0xaa, 0xc0,
+	0x24, 0x58, 0x6b, 0x9c, 0x4d, 0x4f, 0x94, 0x62, 0x48, 0x88, 0x05, 0x3e, 0x98, 0x91, 0xaf, 0x2c,
+	0x4a, 0x90, 0x98, 0xf3, 0xc5, 0xbd, 0x39, 0x2a, 0x49, 0x64, 0xa1, 0x1d, 0x05, 0xd7, 0x17, 0xa4,
+	0xa0, 0x98, 0xa6, 0xce, 0x0e, 0xd5, 0xb6, 0x67, 0xbb, 0x1e, 0x7b, 0x1d, 0xba, 0xc0, 0x99, 0xa7,
+	0x0c, 0x29, 0x2c, 0xca, 0x30, 0x82, 0x92, 0x2a, 0x4c, 0x75, 0x34, 0xdd, 0x94, 0x34, 0x2b, 0x79,
+	0x23, 0xcf, 0x3a, 0xab, 0x41, 0x98, 0x3a, 0x4b, 0xae, 0x8d, 0x0e, 0xc0, 0x04, 0x0a, 0xf2, 0x91,
+	0x84, 0x95, 0x4f, 0xb9, 0x78, 0x46, 0x3a, 0xb1, 0x16, 0x47, 0xb2, 0xf5, 0x09, 0x00, 0xac, 0x4e,
+	0xe0, 0x8a, 0x3e, 0x96, 0x2f, 0xf5, 0x7b, 0xa6, 0x2f, 0x44, 0xb8, 0xc4, 0x99, 0x1e, 0xff, 0x46,
+	0x85, 0x4e, 0xbf, 0x81, 0xb3, 0xfa, 0x48, 0xc6, 0x30, 0xfb, 0x0c, 0x4c, 0xa8, 0x7b, 0xf5, 0x28,
+	0x6d, 0xcd, 0x7f, 0x53, 0x82, 0xe9, 0xe4, 0x38, 0xc9, 0x0f, 0x1b, 0x70, 0x31, 0x15, 0xa1, 0x41,
+	0x06, 0x47, 0x13, 0xea, 0xe2, 0x42, 0xdf, 0x55, 0x86, 0x1a, 0x95, 0xdb, 0x41, 0x5e, 0xbc, 0x99,
+	0x4d, 0x0b, 0xf3, 0x3a, 0x41, 0x7e, 0x8c, 0xdd, 0xf1, 0x14, 0x7b, 0x7c, 0xbd, 0x8b, 0xa5, 0xe3,
+	0xed, 0xa2, 0xb8, 0xfc, 0xe5, 0x51, 0xc3, 0xfc, 0x8e, 0x98, 0xe7, 0x80, 0xa4, 0xf7, 0xaf, 0xf9,
+	0xa3, 0x46, 0x38, 0xe5, 0xf1, 0xb8, 0xc9, 0x0e, 0x8c, 0xc8, 0xb3, 0xa3, 0x6c, 0x14, 0xb7, 0x13,
+	0x90, 0xa7, 0x92, 0x8c, 0xa4, 0xca, 0x6f, 0x1f, 0xb2, 0x08, 0x43, 0xf4, 0xaa, 0xb7, 0x40, 0xa9,
+	0x8b, 0xb7, 0xc0, 0xb3, 0x70, 0x21, 0xfb, 0x14, 0x61, 0x77, 0x37, 0x16, 0x28, 0xe1, 0xbe, 0xd4,
+	0x04, 0x47, 0x77, 0x37, 0xe6, 0x9a, 0x7f, 0x1f, 0x05, 0xcc, 0xfc, 0x28, 0x24, 0x13, 0x90, 0x91,
+	0x97, 0x60, 0xcc, 0xf7, 0x77, 0x84, 0x0d, 0x58, 0xd9, 0xe8, 0xe3, 0xad, 0x28, 0xcc, 0x86, 0x21,
+	0xae, 0x9b, 0xd1, 0x4f, 0x8c, 0xd1, 0x2f, 0xbe, 0xf0, 0xc5, 0x2f, 0x5f, 0x7e, 0xe8, 0xd7, 0xbe,
+	0x7c, 0xf9, 0xa1, 0x2f, 0x7d, 0xf9, 0xf2, 0x43, 0x1f, 0x3f, 0xb8, 0x6c, 0x7c, 0xf1, 0xe0, 0xb2,
+	0xf1, 0x6b, 0x07, 0x97, 0x8d, 0x2f, 0x1d, 0x5c, 0x36, 0x7e, 0xff, 0xe0, 0xb2, 0xf1, 0xdd, 0xff,
+	0xee, 0xf2, 0x43, 0x1f, 0x78, 0x3a, 0xa6, 0x7e, 0x35, 0x24, 0x1a, 0xff, 0xc3, 0x1e, 0xdf, 0x19,
+	0xf5, 0x30, 0x58, 0x04, 0xa7, 0xfe, 0xff, 0x07, 0x00, 0x19, 0xbf, 0x1b, 0x47, 0xdb, 0x21, 0x01,
+	0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -13004,6 +13107,30 @@ func (m *MaintenanceAutoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MachineImageVersionPolicy != nil {
+		{
+			size, err := m.MachineImageVersionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.KubernetesVersionPolicy != nil {
+		{
+			size, err := m.KubernetesVersionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.MachineImageVersion != nil {
 		i--
 		if *m.MachineImageVersion {
@@ -14513,6 +14640,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -14650,6 +14787,90 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[k8s_io_api_core_v1.ResourceName(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17916,6 +18137,46 @@ func (m *Toleration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersionUpdatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionUpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionUpdatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinorVersionsBehindLatest != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinorVersionsBehindLatest))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Constraint != nil {
+		i -= len(*m.Constraint)
+		copy(dAtA[i:], *m.Constraint)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Constraint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VerticalPodAutoscaler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18258,6 +18519,20 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoUpdate != nil {
+		{
+			size, err := m.AutoUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
 	if m.ControlPlane != nil {
 		{
 			size, err := m.ControlPlane.MarshalToSizedBuffer(dAtA[:i])
@@ -18555,6 +18830,53 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerAutoUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerAutoUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerAutoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MachineImageVersionPolicy != nil {
+		{
+			size, err := m.MachineImageVersionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.KubernetesVersionPolicy != nil {
+		{
+			size, err := m.KubernetesVersionPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkerControlPlane) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -21032,6 +21354,14 @@ func (m *MaintenanceAutoUpdate) Size() (n int) {
 	if m.MachineImageVersion != nil {
 		n += 2
 	}
+	if m.KubernetesVersionPolicy != nil {
+		l = m.KubernetesVersionPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MachineImageVersionPolicy != nil {
+		l = m.MachineImageVersionPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21630,6 +21960,8 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -21673,6 +22005,34 @@ func (m *QuotaSpec) Size() (n int) {
 	return n
 }
 
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Region) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22871,6 +23231,24 @@ func (m *Toleration) Size() (n int) {
 	return n
 }
 
+func (m *VersionUpdatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Constraint != nil {
+		l = len(*m.Constraint)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MinorVersionsBehindLatest != nil {
+		n += 1 + sovGenerated(uint64(*m.MinorVersionsBehindLatest))
+	}
+	return n
+}
+
 func (m *VerticalPodAutoscaler) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23107,6 +23485,27 @@ func (m *Worker) Size() (n int) {
 		l = m.ControlPlane.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.AutoUpdate != nil {
+		l = m.AutoUpdate.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerAutoUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KubernetesVersionPolicy != nil {
+		l = m.KubernetesVersionPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MachineImageVersionPolicy != nil {
+		l = m.MachineImageVersionPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -24740,6 +25139,8 @@ func (this *MaintenanceAutoUpdate) String() string {
 	s := strings.Join([]string{`&MaintenanceAutoUpdate{`,
 		`KubernetesVersion:` + fmt.Sprintf("%v", this.KubernetesVersion) + `,`,
 		`MachineImageVersion:` + valueToStringGenerated(this.MachineImageVersion) + `,`,
+		`KubernetesVersionPolicy:` + strings.Replace(this.KubernetesVersionPolicy.String(), "VersionUpdatePolicy", "VersionUpdatePolicy", 1) + `,`,
+		`MachineImageVersionPolicy:` + strings.Replace(this.MachineImageVersionPolicy.String(), "VersionUpdatePolicy", "VersionUpdatePolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25146,6 +25547,7 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25188,6 +25590,38 @@ func (this *QuotaSpec) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForHard += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Hard:` + mapStringForHard + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Region) String() string {
 	if this == nil {
 		return "nil"
@@ -26069,6 +26503,18 @@ func (this *Toleration) String() string {
 	}, "")
 	return s
 }
+func (this *VersionUpdatePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionUpdatePolicy{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
+		`Constraint:` + valueToStringGenerated(this.Constraint) + `,`,
+		`MinorVersionsBehindLatest:` + valueToStringGenerated(this.MinorVersionsBehindLatest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VerticalPodAutoscaler) String() string {
 	if this == nil {
 		return "nil"
@@ -26207,6 +26653,18 @@ func (this *Worker) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`UpdateStrategy:` + valueToStringGenerated(this.UpdateStrategy) + `,`,
 		`ControlPlane:` + strings.Replace(this.ControlPlane.String(), "WorkerControlPlane", "WorkerControlPlane", 1) + `,`,
+		`AutoUpdate:` + strings.Replace(this.AutoUpdate.String(), "WorkerAutoUpdate", "WorkerAutoUpdate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WorkerAutoUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkerAutoUpdate{`,
+		`KubernetesVersionPolicy:` + strings.Replace(this.KubernetesVersionPolicy.String(), "VersionUpdatePolicy", "VersionUpdatePolicy", 1) + `,`,
+		`MachineImageVersionPolicy:` + strings.Replace(this.MachineImageVersionPolicy.String(), "VersionUpdatePolicy", "VersionUpdatePolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -44512,6 +44970,78 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.MachineImageVersion = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubernetesVersionPolicy == nil {
+				m.KubernetesVersionPolicy = &VersionUpdatePolicy{}
+			}
+			if err := m.KubernetesVersionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MachineImageVersionPolicy == nil {
+				m.MachineImageVersionPolicy = &VersionUpdatePolicy{}
+			}
+			if err := m.MachineImageVersionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49103,6 +49633,39 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49473,6 +50036,333 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Region) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -58836,17 +59726,254 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
+			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreDNS == nil {
+				m.CoreDNS = &CoreDNS{}
+			}
+			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeLocalDNS == nil {
+				m.NodeLocalDNS = &NodeLocalDNS{}
+			}
+			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Toleration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionUpdatePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionUpdatePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionUpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -58856,117 +59983,27 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreDNS == nil {
-				m.CoreDNS = &CoreDNS{}
-			}
-			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Strategy = VersionUpdateStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NodeLocalDNS == nil {
-				m.NodeLocalDNS = &NodeLocalDNS{}
-			}
-			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Toleration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -58994,13 +60031,14 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Constraint = &s
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinorVersionsBehindLatest", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -59010,25 +60048,12 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
+			m.MinorVersionsBehindLatest = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -61020,6 +62045,164 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoUpdate == nil {
+				m.AutoUpdate = &WorkerAutoUpdate{}
+			}
+			if err := m.AutoUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerAutoUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerAutoUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubernetesVersionPolicy == nil {
+				m.KubernetesVersionPolicy = &VersionUpdatePolicy{}
+			}
+			if err := m.KubernetesVersionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MachineImageVersionPolicy == nil {
+				m.MachineImageVersionPolicy = &VersionUpdatePolicy{}
+			}
+			if err := m.MachineImageVersionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
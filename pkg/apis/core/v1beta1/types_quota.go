@@ -21,6 +21,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -45,3 +48,19 @@ type QuotaSpec struct {
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"` // TODO: When graduating the API to v1 consider reworking this field as described in https://github.com/gardener/gardener/issues/9773#issuecomment-2293340267
 }
+
+// QuotaStatus holds the most recently observed status of the Quota.
+type QuotaStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Quota.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+	// Hard is the set of enforced limits for each metric, i.e. the metrics of the Quota specification at the time of the
+	// last observation.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty" protobuf:"bytes,2,rep,name=hard,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Used is the amount of each metric which is currently consumed by the shoot clusters in the scope of the Quota.
+	// For Quotas with the 'project' scope, the usage is enforced per namespace, hence the highest usage of all
+	// namespaces referencing the Quota is reported.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,3,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
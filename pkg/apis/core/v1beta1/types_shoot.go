@@ -1532,8 +1532,43 @@ type MaintenanceAutoUpdate struct {
 	// MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).
 	// +optional
 	MachineImageVersion *bool `json:"machineImageVersion,omitempty" protobuf:"varint,2,opt,name=machineImageVersion"`
+	// KubernetesVersionPolicy is the policy for automatic updates of the Kubernetes version of the control plane and of
+	// the worker pools. If set, it takes precedence over the KubernetesVersion field.
+	// +optional
+	KubernetesVersionPolicy *VersionUpdatePolicy `json:"kubernetesVersionPolicy,omitempty" protobuf:"bytes,3,opt,name=kubernetesVersionPolicy"`
+	// MachineImageVersionPolicy is the policy for automatic updates of the machine image versions of the worker pools.
+	// If set, it takes precedence over the MachineImageVersion field.
+	// +optional
+	MachineImageVersionPolicy *VersionUpdatePolicy `json:"machineImageVersionPolicy,omitempty" protobuf:"bytes,4,opt,name=machineImageVersionPolicy"`
+}
+
+// VersionUpdatePolicy is a policy for automatic updates of a version during the maintenance time window.
+type VersionUpdatePolicy struct {
+	// Strategy is the strategy for automatic updates. Possible values are "patch", "minor" and "pinned".
+	Strategy VersionUpdateStrategy `json:"strategy" protobuf:"bytes,1,opt,name=strategy,casttype=VersionUpdateStrategy"`
+	// Constraint is a semantic version constraint, e.g. "< 1.33", which a version must satisfy to be considered for
+	// automatic updates. It does not prevent forceful updates of expired versions.
+	// +optional
+	Constraint *string `json:"constraint,omitempty" protobuf:"bytes,2,opt,name=constraint"`
+	// MinorVersionsBehindLatest is the number of minor versions automatic updates stay behind the latest supported minor
+	// version in the CloudProfile. It can only be set for the "minor" strategy.
+	// +optional
+	MinorVersionsBehindLatest *int32 `json:"minorVersionsBehindLatest,omitempty" protobuf:"varint,3,opt,name=minorVersionsBehindLatest"`
 }
 
+// VersionUpdateStrategy is the strategy for automatic updates of a version.
+type VersionUpdateStrategy string
+
+const (
+	// VersionUpdateStrategyPatch updates to the latest patch version of the current minor version.
+	VersionUpdateStrategyPatch VersionUpdateStrategy = "patch"
+	// VersionUpdateStrategyMinor updates to the latest minor version of the current major version. Kubernetes versions
+	// are updated by at most one minor version per maintenance.
+	VersionUpdateStrategyMinor VersionUpdateStrategy = "minor"
+	// VersionUpdateStrategyPinned disables automatic updates. Expired versions are still updated forcefully.
+	VersionUpdateStrategyPinned VersionUpdateStrategy = "pinned"
+)
+
 // MaintenanceTimeWindow contains information about the time window for maintenance operations.
 type MaintenanceTimeWindow struct {
 	// Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
@@ -1666,6 +1701,21 @@ type Worker struct {
 	// This is only relevant for autonomous shoot clusters.
 	// +optional
 	ControlPlane *WorkerControlPlane `json:"controlPlane,omitempty" protobuf:"bytes,24,opt,name=controlPlane"`
+	// AutoUpdate contains the policies for automatic updates of this worker pool. They take precedence over the policies
+	// in the maintenance configuration of the Shoot.
+	// +optional
+	AutoUpdate *WorkerAutoUpdate `json:"autoUpdate,omitempty" protobuf:"bytes,25,opt,name=autoUpdate"`
+}
+
+// WorkerAutoUpdate contains the policies for automatic updates of a worker pool.
+type WorkerAutoUpdate struct {
+	// KubernetesVersionPolicy is the policy for automatic updates of the Kubernetes version of the worker pool. It is
+	// only considered if the worker pool specifies its own Kubernetes version.
+	// +optional
+	KubernetesVersionPolicy *VersionUpdatePolicy `json:"kubernetesVersionPolicy,omitempty" protobuf:"bytes,1,opt,name=kubernetesVersionPolicy"`
+	// MachineImageVersionPolicy is the policy for automatic updates of the machine image version of the worker pool.
+	// +optional
+	MachineImageVersionPolicy *VersionUpdatePolicy `json:"machineImageVersionPolicy,omitempty" protobuf:"bytes,2,opt,name=machineImageVersionPolicy"`
 }
 
 // WorkerControlPlane specifies that the shoot cluster control plane components should be running in this worker pool.
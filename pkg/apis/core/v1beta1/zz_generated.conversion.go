@@ -1356,6 +1356,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1886,6 +1896,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VersionUpdatePolicy)(nil), (*core.VersionUpdatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionUpdatePolicy_To_core_VersionUpdatePolicy(a.(*VersionUpdatePolicy), b.(*core.VersionUpdatePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionUpdatePolicy)(nil), (*VersionUpdatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionUpdatePolicy_To_v1beta1_VersionUpdatePolicy(a.(*core.VersionUpdatePolicy), b.(*VersionUpdatePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -1936,6 +1956,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerAutoUpdate)(nil), (*core.WorkerAutoUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerAutoUpdate_To_core_WorkerAutoUpdate(a.(*WorkerAutoUpdate), b.(*core.WorkerAutoUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerAutoUpdate)(nil), (*WorkerAutoUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerAutoUpdate_To_v1beta1_WorkerAutoUpdate(a.(*core.WorkerAutoUpdate), b.(*WorkerAutoUpdate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerControlPlane)(nil), (*core.WorkerControlPlane)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerControlPlane_To_core_WorkerControlPlane(a.(*WorkerControlPlane), b.(*core.WorkerControlPlane), scope)
 	}); err != nil {
@@ -4896,6 +4926,8 @@ func Convert_core_Maintenance_To_v1beta1_Maintenance(in *core.Maintenance, out *
 func autoConvert_v1beta1_MaintenanceAutoUpdate_To_core_MaintenanceAutoUpdate(in *MaintenanceAutoUpdate, out *core.MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.MachineImageVersion = (*bool)(unsafe.Pointer(in.MachineImageVersion))
+	out.KubernetesVersionPolicy = (*core.VersionUpdatePolicy)(unsafe.Pointer(in.KubernetesVersionPolicy))
+	out.MachineImageVersionPolicy = (*core.VersionUpdatePolicy)(unsafe.Pointer(in.MachineImageVersionPolicy))
 	return nil
 }
 
@@ -4907,6 +4939,8 @@ func Convert_v1beta1_MaintenanceAutoUpdate_To_core_MaintenanceAutoUpdate(in *Mai
 func autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *core.MaintenanceAutoUpdate, out *MaintenanceAutoUpdate, s conversion.Scope) error {
 	out.KubernetesVersion = in.KubernetesVersion
 	out.MachineImageVersion = (*bool)(unsafe.Pointer(in.MachineImageVersion))
+	out.KubernetesVersionPolicy = (*VersionUpdatePolicy)(unsafe.Pointer(in.KubernetesVersionPolicy))
+	out.MachineImageVersionPolicy = (*VersionUpdatePolicy)(unsafe.Pointer(in.MachineImageVersionPolicy))
 	return nil
 }
 
@@ -5617,6 +5651,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5630,6 +5667,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5684,6 +5724,30 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -7112,6 +7176,30 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_VersionUpdatePolicy_To_core_VersionUpdatePolicy(in *VersionUpdatePolicy, out *core.VersionUpdatePolicy, s conversion.Scope) error {
+	out.Strategy = core.VersionUpdateStrategy(in.Strategy)
+	out.Constraint = (*string)(unsafe.Pointer(in.Constraint))
+	out.MinorVersionsBehindLatest = (*int32)(unsafe.Pointer(in.MinorVersionsBehindLatest))
+	return nil
+}
+
+// Convert_v1beta1_VersionUpdatePolicy_To_core_VersionUpdatePolicy is an autogenerated conversion function.
+func Convert_v1beta1_VersionUpdatePolicy_To_core_VersionUpdatePolicy(in *VersionUpdatePolicy, out *core.VersionUpdatePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionUpdatePolicy_To_core_VersionUpdatePolicy(in, out, s)
+}
+
+func autoConvert_core_VersionUpdatePolicy_To_v1beta1_VersionUpdatePolicy(in *core.VersionUpdatePolicy, out *VersionUpdatePolicy, s conversion.Scope) error {
+	out.Strategy = VersionUpdateStrategy(in.Strategy)
+	out.Constraint = (*string)(unsafe.Pointer(in.Constraint))
+	out.MinorVersionsBehindLatest = (*int32)(unsafe.Pointer(in.MinorVersionsBehindLatest))
+	return nil
+}
+
+// Convert_core_VersionUpdatePolicy_To_v1beta1_VersionUpdatePolicy is an autogenerated conversion function.
+func Convert_core_VersionUpdatePolicy_To_v1beta1_VersionUpdatePolicy(in *core.VersionUpdatePolicy, out *VersionUpdatePolicy, s conversion.Scope) error {
+	return autoConvert_core_VersionUpdatePolicy_To_v1beta1_VersionUpdatePolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -7275,6 +7363,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*core.WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.AutoUpdate = (*core.WorkerAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	return nil
 }
 
@@ -7318,6 +7407,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.ControlPlane = (*WorkerControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.AutoUpdate = (*WorkerAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	return nil
 }
 
@@ -7326,6 +7416,28 @@ func Convert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s conve
 	return autoConvert_core_Worker_To_v1beta1_Worker(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerAutoUpdate_To_core_WorkerAutoUpdate(in *WorkerAutoUpdate, out *core.WorkerAutoUpdate, s conversion.Scope) error {
+	out.KubernetesVersionPolicy = (*core.VersionUpdatePolicy)(unsafe.Pointer(in.KubernetesVersionPolicy))
+	out.MachineImageVersionPolicy = (*core.VersionUpdatePolicy)(unsafe.Pointer(in.MachineImageVersionPolicy))
+	return nil
+}
+
+// Convert_v1beta1_WorkerAutoUpdate_To_core_WorkerAutoUpdate is an autogenerated conversion function.
+func Convert_v1beta1_WorkerAutoUpdate_To_core_WorkerAutoUpdate(in *WorkerAutoUpdate, out *core.WorkerAutoUpdate, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerAutoUpdate_To_core_WorkerAutoUpdate(in, out, s)
+}
+
+func autoConvert_core_WorkerAutoUpdate_To_v1beta1_WorkerAutoUpdate(in *core.WorkerAutoUpdate, out *WorkerAutoUpdate, s conversion.Scope) error {
+	out.KubernetesVersionPolicy = (*VersionUpdatePolicy)(unsafe.Pointer(in.KubernetesVersionPolicy))
+	out.MachineImageVersionPolicy = (*VersionUpdatePolicy)(unsafe.Pointer(in.MachineImageVersionPolicy))
+	return nil
+}
+
+// Convert_core_WorkerAutoUpdate_To_v1beta1_WorkerAutoUpdate is an autogenerated conversion function.
+func Convert_core_WorkerAutoUpdate_To_v1beta1_WorkerAutoUpdate(in *core.WorkerAutoUpdate, out *WorkerAutoUpdate, s conversion.Scope) error {
+	return autoConvert_core_WorkerAutoUpdate_To_v1beta1_WorkerAutoUpdate(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerControlPlane_To_core_WorkerControlPlane(in *WorkerControlPlane, out *core.WorkerControlPlane, s conversion.Scope) error {
 	return nil
 }
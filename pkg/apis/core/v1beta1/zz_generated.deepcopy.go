@@ -3383,6 +3383,16 @@ func (in *MaintenanceAutoUpdate) DeepCopyInto(out *MaintenanceAutoUpdate) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KubernetesVersionPolicy != nil {
+		in, out := &in.KubernetesVersionPolicy, &out.KubernetesVersionPolicy
+		*out = new(VersionUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImageVersionPolicy != nil {
+		in, out := &in.MachineImageVersionPolicy, &out.MachineImageVersionPolicy
+		*out = new(VersionUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4206,6 +4216,7 @@ func (in *Quota) DeepCopyInto(out *Quota) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -4289,6 +4300,36 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaStatus) DeepCopyInto(out *QuotaStatus) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaStatus.
+func (in *QuotaStatus) DeepCopy() *QuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Region) DeepCopyInto(out *Region) {
 	*out = *in
@@ -5917,6 +5958,32 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionUpdatePolicy) DeepCopyInto(out *VersionUpdatePolicy) {
+	*out = *in
+	if in.Constraint != nil {
+		in, out := &in.Constraint, &out.Constraint
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinorVersionsBehindLatest != nil {
+		in, out := &in.MinorVersionsBehindLatest, &out.MinorVersionsBehindLatest
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionUpdatePolicy.
+func (in *VersionUpdatePolicy) DeepCopy() *VersionUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -6217,6 +6284,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(WorkerControlPlane)
 		**out = **in
 	}
+	if in.AutoUpdate != nil {
+		in, out := &in.AutoUpdate, &out.AutoUpdate
+		*out = new(WorkerAutoUpdate)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6230,6 +6302,32 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAutoUpdate) DeepCopyInto(out *WorkerAutoUpdate) {
+	*out = *in
+	if in.KubernetesVersionPolicy != nil {
+		in, out := &in.KubernetesVersionPolicy, &out.KubernetesVersionPolicy
+		*out = new(VersionUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineImageVersionPolicy != nil {
+		in, out := &in.MachineImageVersionPolicy, &out.MachineImageVersionPolicy
+		*out = new(VersionUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAutoUpdate.
+func (in *WorkerAutoUpdate) DeepCopy() *WorkerAutoUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAutoUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerControlPlane) DeepCopyInto(out *WorkerControlPlane) {
 	*out = *in
@@ -34,6 +34,30 @@ func ValidateQuotaUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
 	return allErrs
 }
 
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, _ *core.Quota) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("status")
+	)
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(newQuota.Status.ObservedGeneration, fldPath.Child("observedGeneration"))...)
+	allErrs = append(allErrs, validateQuotaStatusResourceList(newQuota.Status.Hard, fldPath.Child("hard"))...)
+	allErrs = append(allErrs, validateQuotaStatusResourceList(newQuota.Status.Used, fldPath.Child("used"))...)
+
+	return allErrs
+}
+
+func validateQuotaStatusResourceList(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range resources {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, fldPath.Key(string(k)))...)
+	}
+
+	return allErrs
+}
+
 // ValidateQuotaSpec validates the specification of a Quota object.
 func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
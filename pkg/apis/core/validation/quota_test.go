@@ -130,4 +130,52 @@ var _ = Describe("Quota Validation Tests ", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var quota *core.Quota
+
+		BeforeEach(func() {
+			quota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quota-1",
+					Namespace: "my-namespace",
+				},
+				Status: core.QuotaStatus{
+					ObservedGeneration: 1,
+					Hard: corev1.ResourceList{
+						"cpu": resource.MustParse("200"),
+					},
+					Used: corev1.ResourceList{
+						"cpu": resource.MustParse("100"),
+					},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			Expect(ValidateQuotaStatusUpdate(quota, quota)).To(BeEmpty())
+		})
+
+		It("should forbid negative values", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Status.ObservedGeneration = -1
+			newQuota.Status.Hard["cpu"] = resource.MustParse("-1")
+			newQuota.Status.Used["memory"] = resource.MustParse("-1Gi")
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, quota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.observedGeneration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.hard[cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[memory]"),
+				})),
+			))
+		})
+	})
 })
@@ -123,7 +123,12 @@ var (
 		gardencorev1beta1.GardenerName,
 		v1beta1constants.ReferenceProtectionFinalizerName,
 	)
-	availableUpdateStrategies = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	availableUpdateStrategies        = sets.New(core.AutoRollingUpdate, core.AutoInPlaceUpdate, core.ManualInPlaceUpdate)
+	availableVersionUpdateStrategies = sets.New(
+		string(core.VersionUpdateStrategyPatch),
+		string(core.VersionUpdateStrategyMinor),
+		string(core.VersionUpdateStrategyPinned),
+	)
 
 	// asymmetric algorithms from https://datatracker.ietf.org/doc/html/rfc7518#section-3.1
 	availableOIDCSigningAlgs = sets.New(
@@ -1638,6 +1643,16 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 		if workerless && maintenance.AutoUpdate.MachineImageVersion != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoUpdate", "machineImageVersion"), workerlessErrorMsg))
 		}
+		if maintenance.AutoUpdate.KubernetesVersionPolicy != nil {
+			allErrs = append(allErrs, ValidateVersionUpdatePolicy(maintenance.AutoUpdate.KubernetesVersionPolicy, fldPath.Child("autoUpdate", "kubernetesVersionPolicy"))...)
+		}
+		if maintenance.AutoUpdate.MachineImageVersionPolicy != nil {
+			if workerless {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoUpdate", "machineImageVersionPolicy"), workerlessErrorMsg))
+			} else {
+				allErrs = append(allErrs, ValidateVersionUpdatePolicy(maintenance.AutoUpdate.MachineImageVersionPolicy, fldPath.Child("autoUpdate", "machineImageVersionPolicy"))...)
+			}
+		}
 	}
 
 	if maintenance.TimeWindow != nil {
@@ -1855,6 +1870,39 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		}
 	}
 
+	if worker.AutoUpdate != nil {
+		if worker.AutoUpdate.KubernetesVersionPolicy != nil {
+			allErrs = append(allErrs, ValidateVersionUpdatePolicy(worker.AutoUpdate.KubernetesVersionPolicy, fldPath.Child("autoUpdate", "kubernetesVersionPolicy"))...)
+		}
+		if worker.AutoUpdate.MachineImageVersionPolicy != nil {
+			allErrs = append(allErrs, ValidateVersionUpdatePolicy(worker.AutoUpdate.MachineImageVersionPolicy, fldPath.Child("autoUpdate", "machineImageVersionPolicy"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVersionUpdatePolicy validates a policy for automatic version updates.
+func ValidateVersionUpdatePolicy(policy *core.VersionUpdatePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableVersionUpdateStrategies.Has(string(policy.Strategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("strategy"), policy.Strategy, sets.List(availableVersionUpdateStrategies)))
+	}
+
+	if policy.Constraint != nil {
+		if _, err := semver.NewConstraint(*policy.Constraint); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("constraint"), *policy.Constraint, fmt.Sprintf("must be a valid semantic version constraint: %v", err)))
+		}
+	}
+
+	if policy.MinorVersionsBehindLatest != nil {
+		if policy.Strategy != core.VersionUpdateStrategyMinor {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("minorVersionsBehindLatest"), fmt.Sprintf("can only be set for strategy %q", core.VersionUpdateStrategyMinor)))
+		}
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*policy.MinorVersionsBehindLatest), fldPath.Child("minorVersionsBehindLatest"))...)
+	}
+
 	return allErrs
 }
 
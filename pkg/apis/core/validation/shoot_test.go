@@ -3895,6 +3895,70 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Detail": ContainSubstring("this field should not be set for workerless Shoot cluster"),
 				}))))
 			})
+
+			It("should not allow setting machineImageVersionPolicy for autoUpdate if it's a workerless Shoot", func() {
+				shoot.Spec.Provider.Workers = nil
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = nil
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &core.VersionUpdatePolicy{Strategy: core.VersionUpdateStrategyPatch}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ContainElements(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.maintenance.autoUpdate.machineImageVersionPolicy"),
+					"Detail": ContainSubstring("this field should not be set for workerless Shoot cluster"),
+				}))))
+			})
+
+			It("should allow valid version update policies", func() {
+				shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy = &core.VersionUpdatePolicy{
+					Strategy:                  core.VersionUpdateStrategyMinor,
+					Constraint:                ptr.To("< 1.33"),
+					MinorVersionsBehindLatest: ptr.To[int32](1),
+				}
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &core.VersionUpdatePolicy{Strategy: core.VersionUpdateStrategyPinned}
+				shoot.Spec.Provider.Workers[0].AutoUpdate = &core.WorkerAutoUpdate{
+					MachineImageVersionPolicy: &core.VersionUpdatePolicy{Strategy: core.VersionUpdateStrategyPatch, Constraint: ptr.To("~1.2")},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid version update policies", func() {
+				shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy = &core.VersionUpdatePolicy{
+					Strategy:                  "major",
+					Constraint:                ptr.To("foo"),
+					MinorVersionsBehindLatest: ptr.To[int32](-1),
+				}
+				shoot.Spec.Provider.Workers[0].AutoUpdate = &core.WorkerAutoUpdate{
+					MachineImageVersionPolicy: &core.VersionUpdatePolicy{Strategy: core.VersionUpdateStrategyPatch, MinorVersionsBehindLatest: ptr.To[int32](1)},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenance.autoUpdate.kubernetesVersionPolicy.strategy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.autoUpdate.kubernetesVersionPolicy.constraint"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.maintenance.autoUpdate.kubernetesVersionPolicy.minorVersionsBehindLatest"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.autoUpdate.kubernetesVersionPolicy.minorVersionsBehindLatest"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.workers[0].autoUpdate.machineImageVersionPolicy.minorVersionsBehindLatest"),
+					})),
+				))
+			})
 		})
 
 		It("should forbid updating the spec for shoots with deletion timestamp", func() {
@@ -88,6 +88,12 @@ type OperatingSystemConfigSpec struct {
 	// InPlaceUpdates contains the configuration for in-place updates.
 	// +optional
 	InPlaceUpdates *InPlaceUpdates `json:"inPlaceUpdates,omitempty"`
+	// HealthChecks is a list of custom health checks which are executed by the gardener-node-agent on the node.
+	// Only to be set for OperatingSystemConfigs with purpose 'reconcile'.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	HealthChecks []NodeHealthCheck `json:"healthChecks,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
@@ -187,6 +193,99 @@ type FileContentImageRef struct {
 	FilePathInImage string `json:"filePathInImage"`
 }
 
+// NodeHealthCheck is a custom health check which is executed by the gardener-node-agent on the node. Exactly one of
+// the probes 'systemdUnit', 'httpGet', 'tcpSocket', 'file' and 'exec' must be set.
+type NodeHealthCheck struct {
+	// Name is the name of the health check.
+	Name string `json:"name"`
+	// SystemdUnit checks that a systemd unit is active.
+	// +optional
+	SystemdUnit *NodeHealthCheckSystemdUnit `json:"systemdUnit,omitempty"`
+	// HTTPGet checks that an HTTP GET request to a URL returns a successful status code.
+	// +optional
+	HTTPGet *NodeHealthCheckHTTPGet `json:"httpGet,omitempty"`
+	// TCPSocket checks that a TCP connection to an address can be established.
+	// +optional
+	TCPSocket *NodeHealthCheckTCPSocket `json:"tcpSocket,omitempty"`
+	// File checks that a file exists and, optionally, that it was modified recently.
+	// +optional
+	File *NodeHealthCheckFile `json:"file,omitempty"`
+	// Exec checks that a command exits with exit code 0.
+	// +optional
+	Exec *NodeHealthCheckExec `json:"exec,omitempty"`
+	// Timeout is the timeout of a single probe. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// FailureThreshold is the number of consecutive failed probes after which the remediation is performed. Defaults
+	// to 3.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+	// Remediation is the action which is performed when the failure threshold is reached. If not set, only events are
+	// recorded for the node.
+	// +optional
+	Remediation *NodeHealthCheckRemediation `json:"remediation,omitempty"`
+}
+
+// NodeHealthCheckSystemdUnit contains the configuration of a systemd unit probe.
+type NodeHealthCheckSystemdUnit struct {
+	// Name is the name of the systemd unit.
+	Name string `json:"name"`
+}
+
+// NodeHealthCheckHTTPGet contains the configuration of an HTTP GET probe.
+type NodeHealthCheckHTTPGet struct {
+	// URL is the URL which is requested. The probe succeeds if the status code is greater than or equal to 200 and
+	// less than 400.
+	URL string `json:"url"`
+}
+
+// NodeHealthCheckTCPSocket contains the configuration of a TCP socket probe.
+type NodeHealthCheckTCPSocket struct {
+	// Address is the address in the form '<host>:<port>' to which a connection is established.
+	Address string `json:"address"`
+}
+
+// NodeHealthCheckFile contains the configuration of a file probe.
+type NodeHealthCheckFile struct {
+	// Path is the path of the file.
+	Path string `json:"path"`
+	// MaxAge is the maximum duration since the last modification of the file.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// NodeHealthCheckExec contains the configuration of a command probe.
+type NodeHealthCheckExec struct {
+	// Command is the command line which is executed. The first element is the path of the executable.
+	Command []string `json:"command"`
+}
+
+// NodeHealthCheckRemediation contains the configuration of the remediation of a failed health check.
+type NodeHealthCheckRemediation struct {
+	// Type is the type of the remediation. Supported values are 'RestartUnit', 'Reboot' and 'NodeCondition'.
+	Type NodeHealthCheckRemediationType `json:"type"`
+	// UnitName is the name of the systemd unit which is restarted for remediations of type 'RestartUnit'. Defaults to
+	// the unit of the 'systemdUnit' probe.
+	// +optional
+	UnitName *string `json:"unitName,omitempty"`
+	// ConditionType is the type of the node condition which is set for remediations of type 'NodeCondition'. The
+	// condition status is 'True' while the health check is failing and 'False' once it succeeds again.
+	// +optional
+	ConditionType *string `json:"conditionType,omitempty"`
+}
+
+// NodeHealthCheckRemediationType is a string alias.
+type NodeHealthCheckRemediationType string
+
+const (
+	// NodeHealthCheckRemediationRestartUnit restarts a systemd unit.
+	NodeHealthCheckRemediationRestartUnit NodeHealthCheckRemediationType = "RestartUnit"
+	// NodeHealthCheckRemediationReboot reboots the node.
+	NodeHealthCheckRemediationReboot NodeHealthCheckRemediationType = "Reboot"
+	// NodeHealthCheckRemediationNodeCondition sets a condition on the node.
+	NodeHealthCheckRemediationNodeCondition NodeHealthCheckRemediationType = "NodeCondition"
+)
+
 // OperatingSystemConfigStatus is the status for a OperatingSystemConfig resource.
 type OperatingSystemConfigStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
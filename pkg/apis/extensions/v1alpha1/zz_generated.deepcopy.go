@@ -1598,6 +1598,178 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheck) DeepCopyInto(out *NodeHealthCheck) {
+	*out = *in
+	if in.SystemdUnit != nil {
+		in, out := &in.SystemdUnit, &out.SystemdUnit
+		*out = new(NodeHealthCheckSystemdUnit)
+		**out = **in
+	}
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(NodeHealthCheckHTTPGet)
+		**out = **in
+	}
+	if in.TCPSocket != nil {
+		in, out := &in.TCPSocket, &out.TCPSocket
+		*out = new(NodeHealthCheckTCPSocket)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(NodeHealthCheckFile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(NodeHealthCheckExec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(NodeHealthCheckRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheck.
+func (in *NodeHealthCheck) DeepCopy() *NodeHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckExec) DeepCopyInto(out *NodeHealthCheckExec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckExec.
+func (in *NodeHealthCheckExec) DeepCopy() *NodeHealthCheckExec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckFile) DeepCopyInto(out *NodeHealthCheckFile) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckFile.
+func (in *NodeHealthCheckFile) DeepCopy() *NodeHealthCheckFile {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckHTTPGet) DeepCopyInto(out *NodeHealthCheckHTTPGet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckHTTPGet.
+func (in *NodeHealthCheckHTTPGet) DeepCopy() *NodeHealthCheckHTTPGet {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckHTTPGet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckRemediation) DeepCopyInto(out *NodeHealthCheckRemediation) {
+	*out = *in
+	if in.UnitName != nil {
+		in, out := &in.UnitName, &out.UnitName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConditionType != nil {
+		in, out := &in.ConditionType, &out.ConditionType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckRemediation.
+func (in *NodeHealthCheckRemediation) DeepCopy() *NodeHealthCheckRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckSystemdUnit) DeepCopyInto(out *NodeHealthCheckSystemdUnit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckSystemdUnit.
+func (in *NodeHealthCheckSystemdUnit) DeepCopy() *NodeHealthCheckSystemdUnit {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckSystemdUnit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeHealthCheckTCPSocket) DeepCopyInto(out *NodeHealthCheckTCPSocket) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckTCPSocket.
+func (in *NodeHealthCheckTCPSocket) DeepCopy() *NodeHealthCheckTCPSocket {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeHealthCheckTCPSocket)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeTemplate) DeepCopyInto(out *NodeTemplate) {
 	*out = *in
@@ -1738,6 +1910,13 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 		*out = new(InPlaceUpdates)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]NodeHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
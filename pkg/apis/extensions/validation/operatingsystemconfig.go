@@ -7,12 +7,15 @@ package validation
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/url"
+	"path"
 	"regexp"
 	"slices"
 	"strings"
 
 	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -65,6 +68,12 @@ func ValidateOperatingSystemConfigSpec(spec *extensionsv1alpha1.OperatingSystemC
 	allErrs = append(allErrs, ValidateUnits(spec.Units, pathsFromFiles, fldPath.Child("units"))...)
 	allErrs = append(allErrs, ValidateFiles(spec.Files, fldPath.Child("files"))...)
 
+	if len(spec.HealthChecks) > 0 && spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthChecks"), "health checks are not allowed for OperatingSystemConfig with purpose 'provision'"))
+	} else {
+		allErrs = append(allErrs, ValidateNodeHealthChecks(spec.HealthChecks, fldPath.Child("healthChecks"))...)
+	}
+
 	return allErrs
 }
 
@@ -346,6 +355,150 @@ func ValidateFiles(files []extensionsv1alpha1.File, fldPath *field.Path) field.E
 	return allErrs
 }
 
+var (
+	availableNodeHealthCheckRemediationTypes = sets.New(
+		extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit,
+		extensionsv1alpha1.NodeHealthCheckRemediationReboot,
+		extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition,
+	)
+	// nodeConditionTypesManagedByKubelet are the node conditions which are maintained by the kubelet and must not be
+	// overwritten by health checks.
+	nodeConditionTypesManagedByKubelet = sets.New(
+		string(corev1.NodeReady),
+		string(corev1.NodeMemoryPressure),
+		string(corev1.NodeDiskPressure),
+		string(corev1.NodePIDPressure),
+		string(corev1.NodeNetworkUnavailable),
+	)
+)
+
+// ValidateNodeHealthChecks validates custom node health checks.
+func ValidateNodeHealthChecks(healthChecks []extensionsv1alpha1.NodeHealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, healthCheck := range healthChecks {
+		idxPath := fldPath.Index(i)
+
+		if len(healthCheck.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
+		} else {
+			if names.Has(healthCheck.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), healthCheck.Name))
+			}
+			names.Insert(healthCheck.Name)
+		}
+
+		allErrs = append(allErrs, validateNodeHealthCheckProbe(healthCheck, idxPath)...)
+
+		if healthCheck.Timeout != nil && healthCheck.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("timeout"), healthCheck.Timeout.Duration.String(), "must be positive"))
+		}
+		if healthCheck.FailureThreshold != nil && *healthCheck.FailureThreshold < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("failureThreshold"), *healthCheck.FailureThreshold, "must be at least 1"))
+		}
+
+		if healthCheck.Remediation != nil {
+			allErrs = append(allErrs, validateNodeHealthCheckRemediation(healthCheck, idxPath.Child("remediation"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateNodeHealthCheckProbe(healthCheck extensionsv1alpha1.NodeHealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		probes  []string
+	)
+
+	if probe := healthCheck.SystemdUnit; probe != nil {
+		probes = append(probes, "systemdUnit")
+		if len(probe.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("systemdUnit", "name"), "field is required"))
+		}
+	}
+
+	if probe := healthCheck.HTTPGet; probe != nil {
+		probes = append(probes, "httpGet")
+		if u, err := url.Parse(probe.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("httpGet", "url"), probe.URL, "must be a valid URL with scheme 'http' or 'https'"))
+		}
+	}
+
+	if probe := healthCheck.TCPSocket; probe != nil {
+		probes = append(probes, "tcpSocket")
+		if _, port, err := net.SplitHostPort(probe.Address); err != nil || !portRegexp.MatchString(port) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tcpSocket", "address"), probe.Address, "must be a valid address in the form '<host>:<port>'"))
+		}
+	}
+
+	if probe := healthCheck.File; probe != nil {
+		probes = append(probes, "file")
+		if !path.IsAbs(probe.Path) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("file", "path"), probe.Path, "must be an absolute path"))
+		}
+		if probe.MaxAge != nil && probe.MaxAge.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("file", "maxAge"), probe.MaxAge.Duration.String(), "must be positive"))
+		}
+	}
+
+	if probe := healthCheck.Exec; probe != nil {
+		probes = append(probes, "exec")
+		if len(probe.Command) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("exec", "command"), "field is required"))
+		} else if !path.IsAbs(probe.Command[0]) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("exec", "command").Index(0), probe.Command[0], "must be an absolute path"))
+		}
+	}
+
+	switch len(probes) {
+	case 0:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of 'systemdUnit', 'httpGet', 'tcpSocket', 'file' or 'exec' must be provided"))
+	case 1:
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("exactly one of 'systemdUnit', 'httpGet', 'tcpSocket', 'file' or 'exec' must be provided, but found %s", strings.Join(probes, ", "))))
+	}
+
+	return allErrs
+}
+
+func validateNodeHealthCheckRemediation(healthCheck extensionsv1alpha1.NodeHealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		remediation = healthCheck.Remediation
+	)
+
+	if !availableNodeHealthCheckRemediationTypes.Has(remediation.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), remediation.Type, sets.List(availableNodeHealthCheckRemediationTypes)))
+	}
+
+	if remediation.Type == extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit {
+		if remediation.UnitName == nil && healthCheck.SystemdUnit == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("unitName"), "must be provided if the health check does not probe a systemd unit"))
+		}
+	} else if remediation.UnitName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("unitName"), fmt.Sprintf("can only be set for remediation type %q", extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit)))
+	}
+	if remediation.UnitName != nil && len(*remediation.UnitName) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("unitName"), *remediation.UnitName, "must not be empty"))
+	}
+
+	if remediation.Type == extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition {
+		if remediation.ConditionType == nil || len(*remediation.ConditionType) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("conditionType"), "must be provided for remediation type "+string(extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition)))
+		} else if nodeConditionTypesManagedByKubelet.Has(*remediation.ConditionType) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("conditionType"), fmt.Sprintf("node condition %q is managed by the kubelet", *remediation.ConditionType)))
+		}
+	} else if remediation.ConditionType != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("conditionType"), fmt.Sprintf("can only be set for remediation type %q", extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition)))
+	}
+
+	return allErrs
+}
+
 // ValidateOperatingSystemConfigSpecUpdate validates the spec of a OperatingSystemConfig object before an update.
 func ValidateOperatingSystemConfigSpecUpdate(new, old *extensionsv1alpha1.OperatingSystemConfigSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
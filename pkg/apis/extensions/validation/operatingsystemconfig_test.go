@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -558,6 +560,148 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should allow valid health checks", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.HealthChecks = []extensionsv1alpha1.NodeHealthCheck{
+				{
+					Name:        "unit",
+					SystemdUnit: &extensionsv1alpha1.NodeHealthCheckSystemdUnit{Name: "foo.service"},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit},
+				},
+				{
+					Name:             "http",
+					HTTPGet:          &extensionsv1alpha1.NodeHealthCheckHTTPGet{URL: "http://127.0.0.1:8080/healthz"},
+					Timeout:          &metav1.Duration{Duration: 5 * time.Second},
+					FailureThreshold: ptr.To[int32](5),
+					Remediation:      &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit, UnitName: ptr.To("foo.service")},
+				},
+				{
+					Name:      "tcp",
+					TCPSocket: &extensionsv1alpha1.NodeHealthCheckTCPSocket{Address: "localhost:53"},
+				},
+				{
+					Name:        "file",
+					File:        &extensionsv1alpha1.NodeHealthCheckFile{Path: "/var/run/foo", MaxAge: &metav1.Duration{Duration: time.Minute}},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition, ConditionType: ptr.To("FooProblem")},
+				},
+				{
+					Name:        "exec",
+					Exec:        &extensionsv1alpha1.NodeHealthCheckExec{Command: []string{"/bin/foo", "--check"}},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationReboot},
+				},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
+		It("should forbid health checks for provisioning OSC", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+			oscCopy.Spec.CRIConfig.Containerd = nil
+			oscCopy.Spec.HealthChecks = []extensionsv1alpha1.NodeHealthCheck{{
+				Name:        "unit",
+				SystemdUnit: &extensionsv1alpha1.NodeHealthCheckSystemdUnit{Name: "foo.service"},
+			}}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.healthChecks"),
+			}))))
+		})
+
+		It("should forbid invalid health checks", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.HealthChecks = []extensionsv1alpha1.NodeHealthCheck{
+				{
+					Name:             "foo",
+					HTTPGet:          &extensionsv1alpha1.NodeHealthCheckHTTPGet{URL: "ftp://foo"},
+					TCPSocket:        &extensionsv1alpha1.NodeHealthCheckTCPSocket{Address: "foo"},
+					Timeout:          &metav1.Duration{},
+					FailureThreshold: ptr.To[int32](0),
+					Remediation:      &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit},
+				},
+				{
+					Name:        "foo",
+					File:        &extensionsv1alpha1.NodeHealthCheckFile{Path: "foo", MaxAge: &metav1.Duration{}},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition, ConditionType: ptr.To("Ready"), UnitName: ptr.To("foo.service")},
+				},
+				{
+					Exec:        &extensionsv1alpha1.NodeHealthCheckExec{Command: []string{"foo"}},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: "Bar", ConditionType: ptr.To("FooProblem")},
+				},
+				{
+					Name: "bar",
+				},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[0].httpGet.url"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[0].tcpSocket.address"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.healthChecks[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[0].timeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[0].failureThreshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.healthChecks[0].remediation.unitName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.healthChecks[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[1].file.path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[1].file.maxAge"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.healthChecks[1].remediation.unitName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.healthChecks[1].remediation.conditionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.healthChecks[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.healthChecks[2].exec.command[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.healthChecks[2].remediation.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.healthChecks[2].remediation.conditionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.healthChecks[3]"),
+				})),
+			))
+		})
+
 		It("should allow valid osc resources", func() {
 			errorList := ValidateOperatingSystemConfig(osc)
 
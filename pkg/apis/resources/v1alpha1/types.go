@@ -17,6 +17,14 @@ const (
 	Ignore = "resources.gardener.cloud/ignore"
 	// SkipHealthCheck is an annotation that dictates whether a resource should be ignored during health check.
 	SkipHealthCheck = "resources.gardener.cloud/skip-health-check"
+	// HealthRule is an annotation on a resource managed by a ManagedResource. Its value is an expression in the Common
+	// Expression Language (CEL) evaluating to a bool which indicates whether the resource is healthy. It takes
+	// precedence over the health rules configured for the resource's kind.
+	HealthRule = "resources.gardener.cloud/health-rule"
+	// ProgressingRule is an annotation on a resource managed by a ManagedResource. Its value is an expression in the
+	// Common Expression Language (CEL) evaluating to a bool which indicates whether the resource is progressing. It
+	// takes precedence over the progressing rules configured for the resource's kind.
+	ProgressingRule = "resources.gardener.cloud/progressing-rule"
 	// DeleteOnInvalidUpdate is a constant for an annotation on a resource managed by a ManagedResource. If set to
 	// true then the controller will delete the object in case it faces an "Invalid" response during an update operation.
 	DeleteOnInvalidUpdate = "resources.gardener.cloud/delete-on-invalid-update"
@@ -49,6 +57,9 @@ const (
 	BrotliCompressionSuffix = ".br"
 	// CompressedDataKey is the name of a data key containing Brotli compressed YAML manifests.
 	CompressedDataKey = "data.yaml" + BrotliCompressionSuffix
+	// OCIArtifactManifestsMediaType is the media type of the layer of an OCI artifact referenced by a ManagedResource
+	// which contains the YAML or JSON manifests of the objects.
+	OCIArtifactManifestsMediaType = "application/vnd.gardener.managedresource.manifests.v1+yaml"
 
 	// ManagedBy is a constant for a label on an object managed by a ManagedResource.
 	// It is set by the ManagedResource controller depending on its configuration. By default it is set to "gardener".
@@ -229,7 +240,19 @@ type ManagedResourceSpec struct {
 	// +optional
 	Class *string `json:"class,omitempty"`
 	// SecretRefs is a list of secret references.
-	SecretRefs []corev1.LocalObjectReference `json:"secretRefs"`
+	// +optional
+	SecretRefs []corev1.LocalObjectReference `json:"secretRefs,omitempty"`
+	// ConfigMapRefs is a list of references to ConfigMaps containing objects. The objects are read from both the `data`
+	// and the `binaryData` of the ConfigMaps.
+	// +optional
+	ConfigMapRefs []corev1.LocalObjectReference `json:"configMapRefs,omitempty"`
+	// OCIArtifact is a reference to an OCI artifact containing objects.
+	// +optional
+	OCIArtifact *OCIArtifactSource `json:"ociArtifact,omitempty"`
+	// ChunkedSources is a list of sources whose content is split into chunks across several Secrets or ConfigMaps, e.g.,
+	// because it exceeds the size limit of a single object.
+	// +optional
+	ChunkedSources []ChunkedSource `json:"chunkedSources,omitempty"`
 	// InjectLabels injects the provided labels into every resource that is part of the referenced secrets.
 	// +optional
 	InjectLabels map[string]string `json:"injectLabels,omitempty"`
@@ -250,8 +273,54 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ApplyMode specifies how the resources are applied to the target cluster. With `Merge`, the resource manager
+	// merges the desired state into the current state of the objects itself. With `ServerSideApply`, the objects are
+	// applied via server-side apply with a field manager per resource class, and `ForceOverwriteLabels` and
+	// `ForceOverwriteAnnotations` are not considered. Defaults to `Merge`.
+	// +kubebuilder:validation:Enum=Merge;ServerSideApply
+	// +optional
+	ApplyMode *ApplyMode `json:"applyMode,omitempty"`
+}
+
+// OCIArtifactSource is a reference to an OCI artifact containing objects. The objects are read from the layer with media
+// type `application/vnd.gardener.managedresource.manifests.v1+yaml`.
+type OCIArtifactSource struct {
+	// Repository is the repository of the artifact, e.g. `example.com/foo/bar`.
+	Repository string `json:"repository"`
+	// Digest is the digest of the artifact in the format `sha256:<hash>`. Artifacts are always pinned by their digest so
+	// that the objects only change if the ManagedResource is updated.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	Digest string `json:"digest"`
+	// PullSecretRef is a reference to a Secret of type `kubernetes.io/dockerconfigjson` in the namespace of the
+	// ManagedResource which is used for pulling the artifact.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+}
+
+// ChunkedSource is a source of objects whose content is split into chunks stored in several Secrets or ConfigMaps.
+type ChunkedSource struct {
+	// Kind is the kind of the objects containing the chunks.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind string `json:"kind"`
+	// Names are the names of the objects containing the chunks in the order in which the chunks are concatenated.
+	// +kubebuilder:validation:MinItems=1
+	Names []string `json:"names"`
+	// Key is the key of the chunk in the data of each object. If it ends with `.br`, the concatenated content is
+	// decompressed with Brotli. For ConfigMaps, the chunks are read from the `binaryData`.
+	Key string `json:"key"`
 }
 
+// ApplyMode is a type for the modes how the resources of a ManagedResource are applied.
+type ApplyMode string
+
+const (
+	// ApplyModeMerge is the mode in which the resource manager merges the desired state into the current state of the
+	// objects and updates them.
+	ApplyModeMerge ApplyMode = "Merge"
+	// ApplyModeServerSideApply is the mode in which the objects are applied via server-side apply.
+	ApplyModeServerSideApply ApplyMode = "ServerSideApply"
+)
+
 // ManagedResourceStatus is the status of a managed resource.
 type ManagedResourceStatus struct {
 	Conditions []gardencorev1beta1.Condition `json:"conditions,omitempty"`
@@ -260,7 +329,8 @@ type ManagedResourceStatus struct {
 	// Resources is a list of objects that have been created.
 	// +optional
 	Resources []ObjectReference `json:"resources,omitempty"`
-	// SecretsDataChecksum is the checksum of referenced secrets data.
+	// SecretsDataChecksum is the checksum of the content of all sources of the objects, i.e., the referenced Secrets,
+	// ConfigMaps, OCI artifact and chunked sources. It is named after the Secrets as they used to be the only source.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
 }
@@ -291,6 +361,9 @@ const (
 	// ConditionApplyFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because applying the resources failed.
 	ConditionApplyFailed = "ApplyFailed"
+	// ConditionFieldOwnershipConflict indicates that the `ResourcesApplied` condition is `False`,
+	// because applying the resources via server-side apply conflicts with fields owned by other field managers.
+	ConditionFieldOwnershipConflict = "FieldOwnershipConflict"
 	// ConditionDecodingFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because decoding the resources of the ManagedResource failed.
 	ConditionDecodingFailed = "DecodingFailed"
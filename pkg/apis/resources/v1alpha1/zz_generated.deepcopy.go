@@ -16,6 +16,27 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChunkedSource) DeepCopyInto(out *ChunkedSource) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChunkedSource.
+func (in *ChunkedSource) DeepCopy() *ChunkedSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ChunkedSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedResource) DeepCopyInto(out *ManagedResource) {
 	*out = *in
@@ -90,6 +111,23 @@ func (in *ManagedResourceSpec) DeepCopyInto(out *ManagedResourceSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.ConfigMapRefs != nil {
+		in, out := &in.ConfigMapRefs, &out.ConfigMapRefs
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChunkedSources != nil {
+		in, out := &in.ChunkedSources, &out.ChunkedSources
+		*out = make([]ChunkedSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.InjectLabels != nil {
 		in, out := &in.InjectLabels, &out.InjectLabels
 		*out = make(map[string]string, len(*in))
@@ -128,6 +166,11 @@ func (in *ManagedResourceSpec) DeepCopyInto(out *ManagedResourceSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ApplyMode != nil {
+		in, out := &in.ApplyMode, &out.ApplyMode
+		*out = new(ApplyMode)
+		**out = **in
+	}
 	return
 }
 
@@ -176,6 +219,27 @@ func (in *ManagedResourceStatus) DeepCopy() *ManagedResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSource) DeepCopyInto(out *OCIArtifactSource) {
+	*out = *in
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSource.
+func (in *OCIArtifactSource) DeepCopy() *OCIArtifactSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectReference) DeepCopyInto(out *ObjectReference) {
 	*out = *in
@@ -168,6 +168,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                      schema_pkg_apis_core_v1beta1_Quota(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                  schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                  schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus":                                schema_pkg_apis_core_v1beta1_QuotaStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                     schema_pkg_apis_core_v1beta1_Region(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                               schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                     schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
@@ -221,11 +222,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization":                    schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                           schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                 schema_pkg_apis_core_v1beta1_Toleration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy":                        schema_pkg_apis_core_v1beta1_VersionUpdatePolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                      schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                     schema_pkg_apis_core_v1beta1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                 schema_pkg_apis_core_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerAutoUpdate":                           schema_pkg_apis_core_v1beta1_WorkerAutoUpdate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane":                         schema_pkg_apis_core_v1beta1_WorkerControlPlane(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
@@ -5918,10 +5921,24 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"kubernetesVersionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersionPolicy is the policy for automatic updates of the Kubernetes version of the control plane and of the worker pools. If set, it takes precedence over the KubernetesVersion field.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy"),
+						},
+					},
+					"machineImageVersionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageVersionPolicy is the policy for automatic updates of the machine image versions of the worker pools. If set, it takes precedence over the MachineImageVersion field.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy"),
+						},
+					},
 				},
 				Required: []string{"kubernetesVersion"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy"},
 	}
 }
 
@@ -7241,11 +7258,18 @@ func schema_pkg_apis_core_v1beta1_Quota(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the most recently observed usage of the Quota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -7344,6 +7368,56 @@ func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_QuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaStatus holds the most recently observed status of the Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Quota.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the set of enforced limits for each metric, i.e. the metrics of the Quota specification at the time of the last observation.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the amount of each metric which is currently consumed by the shoot clusters in the scope of the Quota. For Quotas with the 'project' scope, the usage is enforced per namespace, hence the highest usage of all namespaces referencing the Quota is reported.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9949,6 +10023,42 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_VersionUpdatePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionUpdatePolicy is a policy for automatic updates of a version during the maintenance time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy is the strategy for automatic updates. Possible values are \"patch\", \"minor\" and \"pinned\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"constraint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraint is a semantic version constraint, e.g. \"< 1.33\", which a version must satisfy to be considered for automatic updates. It does not prevent forceful updates of expired versions.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"minorVersionsBehindLatest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinorVersionsBehindLatest is the number of minor versions automatic updates stay behind the latest supported minor version in the CloudProfile. It can only be set for the \"minor\" strategy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"strategy"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -10427,12 +10537,45 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane"),
 						},
 					},
+					"autoUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoUpdate contains the policies for automatic updates of this worker pool. They take precedence over the policies in the maintenance configuration of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerAutoUpdate"),
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerAutoUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerAutoUpdate contains the policies for automatic updates of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetesVersionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersionPolicy is the policy for automatic updates of the Kubernetes version of the worker pool. It is only considered if the worker pool specifies its own Kubernetes version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy"),
+						},
+					},
+					"machineImageVersionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImageVersionPolicy is the policy for automatic updates of the machine image version of the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUpdatePolicy"},
 	}
 }
 
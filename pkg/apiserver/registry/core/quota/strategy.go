@@ -7,6 +7,7 @@ package quota
 import (
 	"context"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/storage/names"
@@ -28,7 +29,11 @@ func (quotaStrategy) NamespaceScoped() bool {
 	return true
 }
 
-func (quotaStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+func (quotaStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	quota := obj.(*core.Quota)
+
+	quota.Generation = 1
+	quota.Status = core.QuotaStatus{}
 }
 
 func (quotaStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
@@ -44,8 +49,13 @@ func (quotaStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (quotaStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*core.Quota)
-	_ = newObj.(*core.Quota)
+	oldQuota := oldObj.(*core.Quota)
+	newQuota := newObj.(*core.Quota)
+	newQuota.Status = oldQuota.Status
+
+	if !apiequality.Semantic.DeepEqual(oldQuota.Spec, newQuota.Spec) {
+		newQuota.Generation = oldQuota.Generation + 1
+	}
 }
 
 func (quotaStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -66,3 +76,20 @@ func (quotaStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []str
 func (quotaStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
 	return nil
 }
+
+type quotaStatusStrategy struct {
+	quotaStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of Quotas.
+var StatusStrategy = quotaStatusStrategy{Strategy}
+
+func (quotaStatusStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	newQuota := newObj.(*core.Quota)
+	oldQuota := oldObj.(*core.Quota)
+	newQuota.Spec = oldQuota.Spec
+}
+
+func (quotaStatusStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	return validation.ValidateQuotaStatusUpdate(newObj.(*core.Quota), oldObj.(*core.Quota))
+}
@@ -160,6 +160,18 @@ func (mr *MockInterfaceMockRecorder) SetCredentialsRotationStatus(arg0 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentialsRotationStatus", reflect.TypeOf((*MockInterface)(nil).SetCredentialsRotationStatus), arg0)
 }
 
+// SetSSHHostPrivateKey mocks base method.
+func (m *MockInterface) SetSSHHostPrivateKey(arg0 []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSSHHostPrivateKey", arg0)
+}
+
+// SetSSHHostPrivateKey indicates an expected call of SetSSHHostPrivateKey.
+func (mr *MockInterfaceMockRecorder) SetSSHHostPrivateKey(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSSHHostPrivateKey", reflect.TypeOf((*MockInterface)(nil).SetSSHHostPrivateKey), arg0)
+}
+
 // SetSSHPublicKeys mocks base method.
 func (m *MockInterface) SetSSHPublicKeys(arg0 []string) {
 	m.ctrl.T.Helper()
@@ -18,6 +18,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -89,6 +90,8 @@ type Interface interface {
 	SetCredentialsRotationStatus(*gardencorev1beta1.ShootCredentialsRotation)
 	// SetSSHPublicKeys sets the SSHPublicKeys value.
 	SetSSHPublicKeys([]string)
+	// SetSSHHostPrivateKey sets the SSHHostPrivateKey value.
+	SetSSHHostPrivateKey([]byte)
 	// WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 	// containing both the init and the original operating system config data.
 	WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs
@@ -118,6 +121,9 @@ type InitValues struct {
 	// APIServerURL is the address (including https:// protocol prefix) to the kube-apiserver (from which the original
 	// cloud-config user data will be downloaded).
 	APIServerURL string
+	// SSHHostPrivateKey is an optional RSA private key which is installed as host key of the SSH daemon on new machines.
+	// It allows verifying the identity of machines which have just been created, e.g., by `gardenadm bootstrap`.
+	SSHHostPrivateKey []byte
 }
 
 // OriginalValues are configuration values required for the 'reconcile' OperatingSystemConfigPurpose.
@@ -151,6 +157,9 @@ type OriginalValues struct {
 	NodeLocalDNSEnabled bool
 	// PrimaryIPFamily represents the preferred IP family (IPv4 or IPv6) to be used.
 	PrimaryIPFamily gardencorev1beta1.IPFamily
+	// MaintenanceTimeWindow is the maintenance time window of the shoot. Coordinated node reboots are only performed
+	// within this time window.
+	MaintenanceTimeWindow *gardencorev1beta1.MaintenanceTimeWindow
 }
 
 // New creates a new instance of Interface.
@@ -655,6 +664,11 @@ func (o *operatingSystemConfig) SetSSHPublicKeys(keys []string) {
 	o.values.SSHPublicKeys = keys
 }
 
+// SetSSHHostPrivateKey sets the SSHHostPrivateKey value.
+func (o *operatingSystemConfig) SetSSHHostPrivateKey(key []byte) {
+	o.values.SSHHostPrivateKey = key
+}
+
 // WorkerPoolNameToOperatingSystemConfigsMap returns a map whose key is a worker pool name and whose value is a structure
 // containing both the init script and the original config.
 func (o *operatingSystemConfig) WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs {
@@ -747,6 +761,7 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		purpose:                      purpose,
 		key:                          oscKey,
 		apiServerURL:                 o.values.APIServerURL,
+		sshHostPrivateKey:            o.values.SSHHostPrivateKey,
 		caBundle:                     caBundle,
 		clusterCASecretName:          clusterCASecret.Name,
 		clusterCABundle:              clusterCASecret.Data[secretsutils.DataKeyCertificateBundle],
@@ -769,11 +784,37 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		nodeLocalDNSEnabled:          o.values.NodeLocalDNSEnabled,
 		primaryIPFamily:              o.values.PrimaryIPFamily,
 		taints:                       taints,
+		nodeAgentRebootConfig:        nodeAgentRebootConfig(worker, o.values.MaintenanceTimeWindow),
 		caRotationLastInitiationTime: caRotationLastInitiationTime,
 		serviceAccountKeyRotationLastInitiationTime: serviceAccountKeyRotationLastInitiationTime,
 	}, nil
 }
 
+// nodeAgentRebootConfig computes the configuration for the reboot controller of gardener-node-agent. It returns nil if
+// the CoordinatedNodeReboots feature gate is disabled.
+func nodeAgentRebootConfig(worker gardencorev1beta1.Worker, maintenanceTimeWindow *gardencorev1beta1.MaintenanceTimeWindow) *nodeagentconfigv1alpha1.RebootControllerConfig {
+	if !features.DefaultFeatureGate.Enabled(features.CoordinatedNodeReboots) {
+		return nil
+	}
+
+	maxUnavailable := 1
+	if worker.MaxUnavailable != nil {
+		if value, err := intstr.GetScaledValueFromIntOrPercent(worker.MaxUnavailable, int(worker.Maximum), false); err == nil && value > 1 {
+			maxUnavailable = value
+		}
+	}
+
+	config := &nodeagentconfigv1alpha1.RebootControllerConfig{MaxUnavailable: ptr.To(int32(maxUnavailable))} // #nosec G115 -- maxUnavailable is limited by the maximum of the worker pool.
+	if maintenanceTimeWindow != nil {
+		config.MaintenanceTimeWindow = &nodeagentconfigv1alpha1.MaintenanceTimeWindow{
+			Begin: maintenanceTimeWindow.Begin,
+			End:   maintenanceTimeWindow.End,
+		}
+	}
+
+	return config
+}
+
 func setDefaultEvictionMemoryAvailable(evictionHard, evictionSoft map[string]string, machineTypes []gardencorev1beta1.MachineType, machineType string) {
 	evictionHardMemoryAvailable, evictionSoftMemoryAvailable := "100Mi", "200Mi"
 
@@ -813,7 +854,8 @@ type deployer struct {
 	purpose extensionsv1alpha1.OperatingSystemConfigPurpose
 
 	// init values
-	apiServerURL string
+	apiServerURL      string
+	sshHostPrivateKey []byte
 
 	// original values
 	caBundle                                    *string
@@ -838,6 +880,7 @@ type deployer struct {
 	nodeMonitorGracePeriod                      metav1.Duration
 	primaryIPFamily                             gardencorev1beta1.IPFamily
 	taints                                      []corev1.Taint
+	nodeAgentRebootConfig                       *nodeagentconfigv1alpha1.RebootControllerConfig
 	caRotationLastInitiationTime                *metav1.Time
 	serviceAccountKeyRotationLastInitiationTime *metav1.Time
 }
@@ -880,6 +923,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		Sysctls:                 d.worker.Sysctls,
 		PreferIPv6:              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 		Taints:                  d.taints,
+		NodeAgentRebootConfig:   d.nodeAgentRebootConfig,
 	}
 
 	switch d.purpose {
@@ -893,8 +937,10 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			return nil, err
 		}
 
-		// Add gardener-user and sshd-ensurer when SSH access for the node is enabled
+		// Add gardener-user and sshd-ensurer when SSH access for the node is enabled. The SSH host key is only part of the
+		// user data, i.e., it is only installed when the machine is provisioned.
 		if d.sshAccessEnabled {
+			componentsContext.SSHHostPrivateKey = d.sshHostPrivateKey
 			for _, c := range []components.Component{gardeneruser.New(), sshdensurer.New()} {
 				cUnits, cFiles, err := c.Config(componentsContext)
 				if err != nil {
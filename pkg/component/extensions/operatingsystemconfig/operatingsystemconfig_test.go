@@ -41,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -686,6 +687,37 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should add the SSH host key only to the user data", func() {
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+					&values.SSHAccessEnabled, true,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				defaultDepWaiter.SetSSHHostPrivateKey([]byte("host-key"))
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				for _, e := range computeExpectedOperatingSystemConfigs(true, false) {
+					actual := &extensionsv1alpha1.OperatingSystemConfig{}
+					Expect(c.Get(ctx, client.ObjectKey{Name: e.Name, Namespace: e.Namespace}, actual)).To(Succeed())
+
+					hostKeyFile := extensionsv1alpha1.File{
+						Path:        "/var/lib/sshd-ensurer/ssh_host_rsa_key",
+						Permissions: ptr.To[uint32](0600),
+						Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("host-key"))}},
+					}
+
+					if e.Spec.Purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+						Expect(actual.Spec.Files).To(ContainElement(hostKeyFile))
+					} else {
+						Expect(actual.Spec.Files).NotTo(ContainElement(HaveField("Path", hostKeyFile.Path)))
+					}
+				}
+			})
+
 			Context("In-place update", func() {
 				BeforeEach(func() {
 					values = &Values{
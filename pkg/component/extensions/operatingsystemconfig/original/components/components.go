@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
@@ -39,10 +40,12 @@ type Context struct {
 	KubernetesVersion       *semver.Version
 	SSHPublicKeys           []string
 	SSHAccessEnabled        bool
+	SSHHostPrivateKey       []byte
 	ValiIngress             string
 	ValitailEnabled         bool
 	APIServerURL            string
 	Sysctls                 map[string]string
 	PreferIPv6              bool
 	Taints                  []corev1.Taint
+	NodeAgentRebootConfig   *nodeagentconfigv1alpha1.RebootControllerConfig
 }
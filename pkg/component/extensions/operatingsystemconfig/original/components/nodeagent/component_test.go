@@ -104,6 +104,30 @@ WantedBy=multi-user.target`),
 				},
 			}))
 		})
+
+		It("should configure the reboot controller if configured in the context", func() {
+			key := "key"
+			rebootConfig := &nodeagentconfigv1alpha1.RebootControllerConfig{
+				MaxUnavailable:        ptr.To[int32](2),
+				MaintenanceTimeWindow: &nodeagentconfigv1alpha1.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+			}
+
+			config := ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, nil)
+			config.Controllers.Reboot = rebootConfig
+			expectedFiles, err := Files(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:                   key,
+				KubernetesVersion:     kubernetesVersion,
+				APIServerURL:          apiServerURL,
+				CABundle:              ptr.To(string(caBundle)),
+				Images:                map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				NodeAgentRebootConfig: rebootConfig,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElements(expectedFiles))
+		})
 	})
 
 	Describe("#UnitContent", func() {
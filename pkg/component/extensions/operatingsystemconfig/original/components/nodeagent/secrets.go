@@ -39,6 +39,7 @@ func OperatingSystemConfigSecret(
 			Files:          osc.Spec.Files,
 			CRIConfig:      osc.Spec.CRIConfig,
 			InPlaceUpdates: osc.Spec.InPlaceUpdates,
+			HealthChecks:   osc.Spec.HealthChecks,
 		},
 		Status: extensionsv1alpha1.OperatingSystemConfigStatus{
 			ExtensionUnits: osc.Status.ExtensionUnits,
@@ -45,7 +45,8 @@ func init() {
 }
 
 const (
-	pathScript = "/var/lib/sshd-ensurer/run.sh"
+	pathScript  = "/var/lib/sshd-ensurer/run.sh"
+	pathHostKey = "/var/lib/sshd-ensurer/ssh_host_rsa_key"
 )
 
 type component struct{}
@@ -60,10 +61,30 @@ func (component) Name() string {
 }
 
 func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
-	var script bytes.Buffer
+	var (
+		script bytes.Buffer
+		files  []extensionsv1alpha1.File
+	)
 
 	if ctx.SSHAccessEnabled {
-		if err := tplEnableSSH.Execute(&script, nil); err != nil {
+		values := map[string]any{}
+		if len(ctx.SSHHostPrivateKey) > 0 {
+			// The host key is installed by the script instead of writing it to its final location directly, since some
+			// operating systems regenerate the host keys when the machine boots for the first time.
+			values["pathHostKey"] = pathHostKey
+			files = append(files, extensionsv1alpha1.File{
+				Path:        pathHostKey,
+				Permissions: ptr.To[uint32](0600),
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Encoding: "b64",
+						Data:     utils.EncodeBase64(ctx.SSHHostPrivateKey),
+					},
+				},
+			})
+		}
+
+		if err := tplEnableSSH.Execute(&script, values); err != nil {
 			return nil, nil, err
 		}
 	} else {
@@ -99,5 +120,5 @@ WantedBy=multi-user.target`),
 		FilePaths: []string{sshdEnsurerFile.Path},
 	}
 
-	return []extensionsv1alpha1.Unit{sshdEnsurerUnit}, []extensionsv1alpha1.File{sshdEnsurerFile}, nil
+	return []extensionsv1alpha1.Unit{sshdEnsurerUnit}, append([]extensionsv1alpha1.File{sshdEnsurerFile}, files...), nil
 }
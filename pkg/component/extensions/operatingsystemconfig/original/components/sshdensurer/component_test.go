@@ -64,6 +64,36 @@ WantedBy=multi-user.target`),
 			Expect(files).To(ConsistOf(sshdEnsurerFile))
 		})
 
+		It("should install the SSH host key if it is set", func() {
+			ctx = components.Context{SSHAccessEnabled: true, SSHHostPrivateKey: []byte("host-key")}
+			units, files, err := component.Config(ctx)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(HaveLen(1))
+			Expect(files).To(ConsistOf(
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/sshd-ensurer/run.sh",
+					Permissions: ptr.To[uint32](0755),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte(enableScriptWithHostKey)),
+						},
+					},
+				},
+				extensionsv1alpha1.File{
+					Path:        "/var/lib/sshd-ensurer/ssh_host_rsa_key",
+					Permissions: ptr.To[uint32](0600),
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     utils.EncodeBase64([]byte("host-key")),
+						},
+					},
+				},
+			))
+		})
+
 		It("should return the expected units and files when SSHAccessEnabled is set to false", func() {
 			ctx = components.Context{SSHAccessEnabled: false}
 			units, files, err := component.Config(ctx)
@@ -117,6 +147,34 @@ if ! systemctl is-enabled --quiet sshd.service ; then
     fi
 fi
 
+# Start sshd service if not active
+if ! systemctl is-active --quiet sshd.service ; then
+    systemctl start sshd.service
+fi
+`
+	enableScriptWithHostKey = `#!/bin/bash -eu
+set -e
+
+# Unmask and enable sshd service if not enabled
+if ! systemctl is-enabled --quiet sshd.service ; then
+    systemctl unmask sshd.service
+    # When sshd.service is disabled on gardenlinux the service is deleted
+    # On gardenlinux sshd.service is enabled by enabling ssh.service
+    if ! systemctl enable sshd.service ; then
+        systemctl enable ssh.service
+    fi
+fi
+
+# Install the host key from the user data so that clients can verify the identity of the machine
+if [ -f "/var/lib/sshd-ensurer/ssh_host_rsa_key" ] ; then
+    install -m 0600 "/var/lib/sshd-ensurer/ssh_host_rsa_key" /etc/ssh/ssh_host_rsa_key
+    ssh-keygen -y -f /etc/ssh/ssh_host_rsa_key > /etc/ssh/ssh_host_rsa_key.pub
+    rm -f "/var/lib/sshd-ensurer/ssh_host_rsa_key"
+    if systemctl is-active --quiet sshd.service ; then
+        systemctl restart sshd.service
+    fi
+fi
+
 # Start sshd service if not active
 if ! systemctl is-active --quiet sshd.service ; then
     systemctl start sshd.service
@@ -10,6 +10,18 @@ if ! systemctl is-enabled --quiet sshd.service ; then
         systemctl enable ssh.service
     fi
 fi
+{{- if .pathHostKey }}
+
+# Install the host key from the user data so that clients can verify the identity of the machine
+if [ -f "{{ .pathHostKey }}" ] ; then
+    install -m 0600 "{{ .pathHostKey }}" /etc/ssh/ssh_host_rsa_key
+    ssh-keygen -y -f /etc/ssh/ssh_host_rsa_key > /etc/ssh/ssh_host_rsa_key.pub
+    rm -f "{{ .pathHostKey }}"
+    if systemctl is-active --quiet sshd.service ; then
+        systemctl restart sshd.service
+    fi
+fi
+{{- end }}
 
 # Start sshd service if not active
 if ! systemctl is-active --quiet sshd.service ; then
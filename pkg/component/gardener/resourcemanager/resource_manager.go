@@ -164,6 +164,11 @@ var (
 				Resources: []string{"secrets"},
 				Verbs:     []string{"get", "list", "watch", "update", "patch"},
 			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
 			{
 				APIGroups: []string{""},
 				Resources: []string{"configmaps", "events"},
@@ -165,6 +165,11 @@ var _ = Describe("ResourceManager", func() {
 				Resources: []string{"secrets"},
 				Verbs:     []string{"get", "list", "watch", "update", "patch"},
 			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
 			{
 				APIGroups: []string{""},
 				Resources: []string{"configmaps", "events"},
@@ -325,18 +330,18 @@ var _ = Describe("ResourceManager", func() {
 				{Key: "c"},
 				{Key: "node-role.kubernetes.io/control-plane", Operator: corev1.TolerationOpExists},
 			},
-			ResponsibilityMode:                        ForTarget,
-			TargetDisableCache:                        &targetDisableCache,
-			WatchedNamespace:                          &watchedNamespace,
-			SchedulingProfile:                         &binPackingSchedulingProfile,
-			DefaultSeccompProfileEnabled:              false,
-			EndpointSliceHintsEnabled:                 false,
-			PodTopologySpreadConstraintsEnabled:       true,
-			LogLevel:                                  "info",
-			LogFormat:                                 "json",
-			Zones:                                     []string{"a", "b"},
-			ManagedResourceLabels:                     map[string]string{"foo": "bar"},
-			NodeAgentAuthorizerEnabled:                true,
+			ResponsibilityMode:                  ForTarget,
+			TargetDisableCache:                  &targetDisableCache,
+			WatchedNamespace:                    &watchedNamespace,
+			SchedulingProfile:                   &binPackingSchedulingProfile,
+			DefaultSeccompProfileEnabled:        false,
+			EndpointSliceHintsEnabled:           false,
+			PodTopologySpreadConstraintsEnabled: true,
+			LogLevel:                            "info",
+			LogFormat:                           "json",
+			Zones:                               []string{"a", "b"},
+			ManagedResourceLabels:               map[string]string{"foo": "bar"},
+			NodeAgentAuthorizerEnabled:          true,
 			NodeAgentAuthorizerAuthorizeWithSelectors: ptr.To(true),
 		}
 		resourceManager = New(c, deployNamespace, sm, cfg)
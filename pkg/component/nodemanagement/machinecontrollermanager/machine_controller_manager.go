@@ -90,6 +90,10 @@ type Values struct {
 	Image string
 	// Replicas is the number of replicas for the deployment.
 	Replicas int32
+	// WithoutTargetCluster specifies whether machine-controller-manager runs without a target cluster, i.e., it only
+	// manages the machines but does not interact with the nodes. This is used by `gardenadm bootstrap` which creates
+	// the machines before the control plane of the shoot cluster exists.
+	WithoutTargetCluster bool
 
 	namespaceUID types.UID
 }
@@ -108,10 +112,15 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 	)
 
 	genericTokenKubeconfigSecret, found := m.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
-	if !found {
+	if !found && !m.values.WithoutTargetCluster {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
 	}
 
+	targetKubeconfig := gardenerutils.PathGenericKubeconfig
+	if m.values.WithoutTargetCluster {
+		targetKubeconfig = TargetKubeconfigNone
+	}
+
 	if _, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, m.client, serviceAccount, func() error {
 		serviceAccount.AutomountServiceAccountToken = ptr.To(false)
 		return nil
@@ -178,8 +187,10 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if err := shootAccessSecret.Reconcile(ctx, m.client); err != nil {
-		return err
+	if !m.values.WithoutTargetCluster {
+		if err := shootAccessSecret.Reconcile(ctx, m.client); err != nil {
+			return err
+		}
 	}
 
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, m.client, deployment, func() error {
@@ -216,7 +227,7 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 						fmt.Sprintf("--port=%d", portMetrics),
 						"--safety-up=2",
 						"--safety-down=1",
-						"--target-kubeconfig=" + gardenerutils.PathGenericKubeconfig,
+						"--target-kubeconfig=" + targetKubeconfig,
 						"--concurrent-syncs=30",
 						"--kube-api-qps=150",
 						"--kube-api-burst=200",
@@ -258,7 +269,9 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 			},
 		}
 
-		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name))
+		if !m.values.WithoutTargetCluster {
+			utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name))
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -432,6 +445,12 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	if m.values.WithoutTargetCluster {
+		// There is no target cluster (yet), hence there is no gardener-resource-manager which could reconcile the
+		// resources for the shoot.
+		return nil
+	}
+
 	data, err := m.computeShootResourcesData(shootAccessSecret.ServiceAccountName)
 	if err != nil {
 		return err
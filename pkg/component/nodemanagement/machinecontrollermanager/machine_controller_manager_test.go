@@ -589,6 +589,28 @@ subjects:
 		})
 	})
 
+	Describe("#Deploy without target cluster", func() {
+		JustBeforeEach(func() {
+			values.WithoutTargetCluster = true
+			mcm = New(fakeClient, namespace, sm, values)
+			mcm.SetNamespaceUID(namespaceUID)
+		})
+
+		It("should not configure a target kubeconfig and not deploy resources for the shoot", func() {
+			Expect(mcm.Deploy(ctx)).To(Succeed())
+
+			actualDeployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), actualDeployment)).To(Succeed())
+			Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--target-kubeconfig=none"))
+			Expect(actualDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(BeEmpty())
+			Expect(actualDeployment.Spec.Template.Spec.Volumes).To(BeEmpty())
+			Expect(RunsWithoutTargetCluster(actualDeployment)).To(BeTrue())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootAccessSecret), &corev1.Secret{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			Expect(fakeClient.Create(ctx, serviceAccount)).To(Succeed())
@@ -5,8 +5,11 @@
 package machinecontrollermanager
 
 import (
+	"slices"
 	"strconv"
+	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
@@ -18,6 +21,10 @@ import (
 const (
 	portProviderMetrics     = 10259
 	portNameProviderMetrics = "providermetrics"
+
+	// TargetKubeconfigNone is the value for the --target-kubeconfig flag which makes machine-controller-manager run
+	// without a target cluster.
+	TargetKubeconfigNone = "none"
 )
 
 // ProviderSidecarContainer returns a corev1.Container object which can be injected into the machine-controller-manager
@@ -71,6 +78,33 @@ func ProviderSidecarContainer(namespace, providerName, image string) corev1.Cont
 	}
 }
 
+// ProviderSidecarContainerWithoutTargetCluster returns the same container as ProviderSidecarContainer, but configured
+// to run without a target cluster. It should be used if RunsWithoutTargetCluster returns true for the
+// machine-controller-manager deployment.
+func ProviderSidecarContainerWithoutTargetCluster(namespace, providerName, image string) corev1.Container {
+	container := ProviderSidecarContainer(namespace, providerName, image)
+
+	for i, arg := range container.Args {
+		if strings.HasPrefix(arg, "--target-kubeconfig=") {
+			container.Args[i] = "--target-kubeconfig=" + TargetKubeconfigNone
+		}
+	}
+	container.VolumeMounts = nil
+
+	return container
+}
+
+// RunsWithoutTargetCluster returns true if the machine-controller-manager container of the given deployment is
+// configured to run without a target cluster.
+func RunsWithoutTargetCluster(deployment *appsv1.Deployment) bool {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == containerName {
+			return slices.Contains(container.Command, "--target-kubeconfig="+TargetKubeconfigNone)
+		}
+	}
+	return false
+}
+
 // ProviderSidecarVPAContainerPolicy returns a vpaautoscalingv1.ContainerResourcePolicy object which can be injected
 // into the machine-controller-manager-vpa VPA managed by the gardenlet. This function can be used in provider-specific
 // control plane webhook implementations when the standard container policy for the sidecar is required.
@@ -71,6 +71,14 @@ var _ = Describe("Provider", func() {
 		}))
 	})
 
+	It("should return a provider-specific sidecar container object running without target cluster", func() {
+		container := ProviderSidecarContainerWithoutTargetCluster("test-namespace", provider, "provider-test:latest")
+
+		Expect(container.Args).To(ContainElement("--target-kubeconfig=none"))
+		Expect(container.Args).NotTo(ContainElement(HavePrefix("--target-kubeconfig=/")))
+		Expect(container.VolumeMounts).To(BeEmpty())
+	})
+
 	It("should return a default VPA container policy object for the provider-specific sidecar container", func() {
 		Expect(ProviderSidecarVPAContainerPolicy(provider)).To(Equal(vpaautoscalingv1.ContainerResourcePolicy{
 			ContainerName:    "machine-controller-manager-" + provider,
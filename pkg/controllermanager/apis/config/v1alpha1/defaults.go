@@ -184,6 +184,12 @@ func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration)
 	if obj.ConcurrentSyncs == nil {
 		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
 	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.UsageWarningThresholds == nil {
+		obj.UsageWarningThresholds = []int32{80, 100}
+	}
 }
 
 // SetDefaults_SecretBindingControllerConfiguration sets defaults for the SecretBindingControllerConfiguration.
@@ -227,6 +233,22 @@ func SetDefaults_SeedReferenceControllerConfiguration(obj *SeedReferenceControll
 	}
 }
 
+// SetDefaults_SeedRebalancerControllerConfiguration sets defaults for the SeedRebalancerControllerConfiguration.
+func SetDefaults_SeedRebalancerControllerConfiguration(obj *SeedRebalancerControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.Mode == "" {
+		obj.Mode = SeedRebalancingModeSuggest
+	}
+	if obj.UtilizationThreshold == nil {
+		obj.UtilizationThreshold = ptr.To[int32](80)
+	}
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = ptr.To[int32](1)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -422,7 +422,9 @@ var _ = Describe("Defaults", func() {
 	Describe("QuotaControllerConfiguration defaulting", func() {
 		It("should default QuotaControllerConfiguration correctly", func() {
 			expected := &QuotaControllerConfiguration{
-				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				ConcurrentSyncs:        ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:             &metav1.Duration{Duration: time.Minute},
+				UsageWarningThresholds: []int32{80, 100},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -433,7 +435,9 @@ var _ = Describe("Defaults", func() {
 			obj = &ControllerManagerConfiguration{
 				Controllers: ControllerManagerControllerConfiguration{
 					Quota: &QuotaControllerConfiguration{
-						ConcurrentSyncs: ptr.To(10),
+						ConcurrentSyncs:        ptr.To(10),
+						SyncPeriod:             &metav1.Duration{Duration: time.Hour},
+						UsageWarningThresholds: []int32{50},
 					},
 				},
 			}
@@ -575,6 +579,48 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedRebalancerControllerConfiguration defaulting", func() {
+		It("should default SeedRebalancerControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedRebalancer: &SeedRebalancerControllerConfiguration{},
+				},
+			}
+			expected := &SeedRebalancerControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				Mode:                    SeedRebalancingModeSuggest,
+				UtilizationThreshold:    ptr.To[int32](80),
+				MaxConcurrentMigrations: ptr.To[int32](1),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(Equal(expected))
+		})
+
+		It("should not default SeedRebalancerControllerConfiguration if not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(BeNil())
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedRebalancer: &SeedRebalancerControllerConfiguration{
+						SyncPeriod:              &metav1.Duration{Duration: 10 * time.Minute},
+						Mode:                    SeedRebalancingModeMigrate,
+						UtilizationThreshold:    ptr.To[int32](90),
+						MaxConcurrentMigrations: ptr.To[int32](3),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedRebalancer.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedRebalancer).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -90,6 +90,9 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedReference defines the configuration of the SeedReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	SeedReference *SeedReferenceControllerConfiguration `json:"seedReference,omitempty"`
+	// SeedRebalancer defines the configuration of the SeedRebalancer controller. If unset, the controller is disabled.
+	// +optional
+	SeedRebalancer *SeedRebalancerControllerConfiguration `json:"seedRebalancer,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -241,6 +244,14 @@ type QuotaControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the usage of the Quotas is computed and published in their status
+	// (defaults to `1m`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// UsageWarningThresholds is a list of thresholds in percent of the allowed value of a metric. When the usage of a
+	// metric exceeds one of the thresholds, a warning event is emitted for the Quota (defaults to `[80, 100]`).
+	// +optional
+	UsageWarningThresholds []int32 `json:"usageWarningThresholds,omitempty"`
 }
 
 // SecretBindingControllerConfiguration defines the configuration of the
@@ -323,6 +334,43 @@ type SeedReferenceControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// SeedRebalancerControllerConfiguration defines the configuration of the
+// SeedRebalancer controller.
+type SeedRebalancerControllerConfiguration struct {
+	// SyncPeriod is the duration how often the seeds are checked for rebalancing (defaults to `1h`).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Mode defines whether the controller only suggests migrations via events (`Suggest`) or whether it also performs
+	// them by changing the `.spec.seedName` of the shoots (`Migrate`). Defaults to `Suggest`.
+	// +optional
+	Mode SeedRebalancingMode `json:"mode,omitempty"`
+	// UtilizationThreshold is the utilization of a seed in percent of its allocatable shoots above which shoots are
+	// migrated away from it. Seeds only receive shoots as long as their utilization does not exceed this threshold
+	// (defaults to `80`).
+	// +optional
+	UtilizationThreshold *int32 `json:"utilizationThreshold,omitempty"`
+	// MaxConcurrentMigrations is the maximum number of control plane migrations which may be in progress at the same
+	// time in the whole landscape. The controller does not trigger further migrations as long as this budget is
+	// exhausted (defaults to `1`).
+	// +optional
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty"`
+	// ControlPlaneResourceRequests are the estimated resource requests of a shoot control plane, e.g. `cpu` or `memory`.
+	// They are only taken into account for seeds which report allocatable values for the respective resources (see the
+	// `resources` setting of gardenlet). Highly available control planes are assumed to request three times as much.
+	// +optional
+	ControlPlaneResourceRequests corev1.ResourceList `json:"controlPlaneResourceRequests,omitempty"`
+}
+
+// SeedRebalancingMode is a mode of the SeedRebalancer controller.
+type SeedRebalancingMode string
+
+const (
+	// SeedRebalancingModeSuggest only suggests migrations via events.
+	SeedRebalancingModeSuggest SeedRebalancingMode = "Suggest"
+	// SeedRebalancingModeMigrate performs the migrations by changing the `.spec.seedName` of the shoots.
+	SeedRebalancingModeMigrate SeedRebalancingMode = "Migrate"
+)
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
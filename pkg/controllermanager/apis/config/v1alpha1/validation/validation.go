@@ -10,9 +10,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 // ValidateControllerManagerConfiguration validates the given `ControllerManagerConfiguration`.
@@ -46,11 +48,21 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	quotaFldPath := fldPath.Child("quota")
+	if conf.Quota != nil {
+		allErrs = append(allErrs, validateQuotaControllerConfiguration(conf.Quota, quotaFldPath)...)
+	}
+
 	shootStateFldPath := fldPath.Child("shootState")
 	if conf.ShootState != nil {
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
 	}
 
+	seedRebalancerFldPath := fldPath.Child("seedRebalancer")
+	if conf.SeedRebalancer != nil {
+		allErrs = append(allErrs, validateSeedRebalancerControllerConfiguration(conf.SeedRebalancer, seedRebalancerFldPath)...)
+	}
+
 	return allErrs
 }
 
@@ -70,6 +82,22 @@ func validateProjectQuotaConfiguration(conf controllermanagerconfigv1alpha1.Quot
 	return allErrs
 }
 
+func validateQuotaControllerConfiguration(conf *controllermanagerconfigv1alpha1.QuotaControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	for i, threshold := range conf.UsageWarningThresholds {
+		if threshold <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("usageWarningThresholds").Index(i), threshold, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootStateControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if conf.ConcurrentSyncs != nil {
@@ -77,3 +105,39 @@ func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1al
 	}
 	return allErrs
 }
+
+var availableSeedRebalancingModes = sets.New(
+	string(controllermanagerconfigv1alpha1.SeedRebalancingModeSuggest),
+	string(controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate),
+)
+
+func validateSeedRebalancerControllerConfiguration(conf *controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.SyncPeriod != nil && conf.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), conf.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if conf.Mode != "" && !availableSeedRebalancingModes.Has(string(conf.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), conf.Mode, sets.List(availableSeedRebalancingModes)))
+	}
+
+	if conf.UtilizationThreshold != nil && (*conf.UtilizationThreshold <= 0 || *conf.UtilizationThreshold > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("utilizationThreshold"), *conf.UtilizationThreshold, "must be between 1 and 100"))
+	}
+
+	if conf.MaxConcurrentMigrations != nil && *conf.MaxConcurrentMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *conf.MaxConcurrentMigrations, "must be positive"))
+	}
+
+	for resourceName, quantity := range conf.ControlPlaneResourceRequests {
+		idxPath := fldPath.Child("controlPlaneResourceRequests").Key(string(resourceName))
+
+		if resourceName == gardencorev1beta1.ResourceShoots {
+			allErrs = append(allErrs, field.Forbidden(idxPath, "the number of shoots is always taken into account"))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateNonnegativeQuantity(quantity, idxPath)...)
+	}
+
+	return allErrs
+}
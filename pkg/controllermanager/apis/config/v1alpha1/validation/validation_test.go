@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -193,4 +196,93 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("QuotaControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.Quota = &controllermanagerconfigv1alpha1.QuotaControllerConfiguration{
+				SyncPeriod:             &metav1.Duration{Duration: time.Minute},
+				UsageWarningThresholds: []int32{80, 100, 120},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid values", func() {
+			conf.Controllers.Quota.SyncPeriod = &metav1.Duration{}
+			conf.Controllers.Quota.UsageWarningThresholds = []int32{80, 0, -1}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.quota.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.quota.usageWarningThresholds[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.quota.usageWarningThresholds[2]"),
+				})),
+			))
+		})
+	})
+
+	Context("SeedRebalancerControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.SeedRebalancer = &controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: time.Hour},
+				Mode:                    controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate,
+				UtilizationThreshold:    ptr.To[int32](80),
+				MaxConcurrentMigrations: ptr.To[int32](2),
+				ControlPlaneResourceRequests: corev1.ResourceList{
+					"memory": resource.MustParse("4Gi"),
+				},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid values", func() {
+			conf.Controllers.SeedRebalancer.SyncPeriod = &metav1.Duration{}
+			conf.Controllers.SeedRebalancer.Mode = "Foo"
+			conf.Controllers.SeedRebalancer.UtilizationThreshold = ptr.To[int32](101)
+			conf.Controllers.SeedRebalancer.MaxConcurrentMigrations = ptr.To[int32](0)
+			conf.Controllers.SeedRebalancer.ControlPlaneResourceRequests = corev1.ResourceList{
+				"shoots": resource.MustParse("1"),
+				"memory": resource.MustParse("-1Gi"),
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.seedRebalancer.mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.utilizationThreshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.maxConcurrentMigrations"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.seedRebalancer.controlPlaneResourceRequests[shoots]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedRebalancer.controlPlaneResourceRequests[memory]"),
+				})),
+			))
+		})
+	})
 })
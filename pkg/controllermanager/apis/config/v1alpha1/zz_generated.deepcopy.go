@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -249,6 +250,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedReferenceControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedRebalancer != nil {
+		in, out := &in.SeedRebalancer, &out.SeedRebalancer
+		*out = new(SeedRebalancerControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -519,6 +525,16 @@ func (in *QuotaControllerConfiguration) DeepCopyInto(out *QuotaControllerConfigu
 		*out = new(int)
 		**out = **in
 	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UsageWarningThresholds != nil {
+		in, out := &in.UsageWarningThresholds, &out.UsageWarningThresholds
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -651,6 +667,44 @@ func (in *SeedExtensionsCheckControllerConfiguration) DeepCopy() *SeedExtensions
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedRebalancerControllerConfiguration) DeepCopyInto(out *SeedRebalancerControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.UtilizationThreshold != nil {
+		in, out := &in.UtilizationThreshold, &out.UtilizationThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ControlPlaneResourceRequests != nil {
+		in, out := &in.ControlPlaneResourceRequests, &out.ControlPlaneResourceRequests
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedRebalancerControllerConfiguration.
+func (in *SeedRebalancerControllerConfiguration) DeepCopy() *SeedRebalancerControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedRebalancerControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedReferenceControllerConfiguration) DeepCopyInto(out *SeedReferenceControllerConfiguration) {
 	*out = *in
@@ -75,6 +75,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedReference != nil {
 		SetDefaults_SeedReferenceControllerConfiguration(in.Controllers.SeedReference)
 	}
+	if in.Controllers.SeedRebalancer != nil {
+		SetDefaults_SeedRebalancerControllerConfiguration(in.Controllers.SeedRebalancer)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
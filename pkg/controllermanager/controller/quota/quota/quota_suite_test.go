@@ -13,5 +13,5 @@ import (
 
 func TestQuota(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "ControllerManager Controller Quota Suite")
+	RunSpecs(t, "ControllerManager Controller Quota Main Suite")
 }
@@ -0,0 +1,368 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler periodically computes the usage of Quotas by the shoot clusters in their scope and publishes it in the
+// status of the Quotas and as metrics.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.QuotaControllerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile computes the usage of the Quota and updates its status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	quota := &gardencorev1beta1.Quota{}
+	if err := r.Client.Get(ctx, request.NamespacedName, quota); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			deleteMetrics(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if quota.DeletionTimestamp != nil {
+		deleteMetrics(request.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	used, skippedShoots, err := r.computeUsage(ctx, log, quota)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing usage of Quota: %w", err)
+	}
+
+	if len(skippedShoots) > 0 {
+		r.Recorder.Eventf(quota, corev1.EventTypeWarning, v1beta1constants.EventQuotaUsageIncomplete,
+			"Usage does not include the resources of the following Shoots because they cannot be computed: %s", strings.Join(skippedShoots, ", "))
+	}
+
+	status := gardencorev1beta1.QuotaStatus{
+		ObservedGeneration: quota.Generation,
+		Hard:               quota.Spec.Metrics.DeepCopy(),
+		Used:               used,
+	}
+
+	r.reportExceededThresholds(quota, status)
+	r.updateMetrics(quota, status)
+
+	patch := client.MergeFrom(quota.DeepCopy())
+	quota.Status = status
+	if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status of Quota: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// computeUsage returns the resources consumed by the shoot clusters using a SecretBinding or CredentialsBinding which
+// references the given Quota. The admission plugin enforces Quotas with the 'project' scope per namespace, hence the
+// highest usage of all namespaces is returned for them. Only the metrics of the Quota specification are considered.
+// Shoots whose resources cannot be computed because of their specification, e.g. a machine type which is not part of the
+// CloudProfile, are skipped so that they do not prevent reporting the usage of all other Shoots. Their names are returned
+// in addition to the usage.
+func (r *Reconciler) computeUsage(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) (corev1.ResourceList, []string, error) {
+	bindingsPerNamespace, err := r.bindingsReferencingQuota(ctx, quota)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	used := make(corev1.ResourceList, len(quota.Spec.Metrics))
+	for metric := range quota.Spec.Metrics {
+		used[metric] = resource.Quantity{}
+	}
+
+	scope, err := helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, nil, err
+	}
+	projectScope := scope == "project"
+
+	var skippedShoots []string
+	for namespace, bindingNames := range bindingsPerNamespace {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(namespace)); err != nil {
+			return nil, nil, fmt.Errorf("failed listing Shoots in namespace %s: %w", namespace, err)
+		}
+
+		namespaceUsage := corev1.ResourceList{}
+		for _, shoot := range shootList.Items {
+			if !bindingNames.Has(bindingNameOfShoot(&shoot)) {
+				continue
+			}
+
+			resources, err := r.shootResources(ctx, &shoot)
+			if err != nil {
+				if errors.Is(err, errInvalidShootSpec) {
+					log.Info("Skipping Shoot when computing usage of Quota", "shoot", client.ObjectKeyFromObject(&shoot), "reason", err.Error())
+					skippedShoots = append(skippedShoots, client.ObjectKeyFromObject(&shoot).String())
+					continue
+				}
+				return nil, nil, fmt.Errorf("failed computing resources of Shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+			}
+
+			for metric, quantity := range resources {
+				total := namespaceUsage[metric]
+				total.Add(quantity)
+				namespaceUsage[metric] = total
+			}
+		}
+
+		for metric := range used {
+			quantity := namespaceUsage[metric]
+			if projectScope {
+				if quantity.Cmp(used[metric]) > 0 {
+					used[metric] = quantity
+				}
+				continue
+			}
+
+			total := used[metric]
+			total.Add(quantity)
+			used[metric] = total
+		}
+	}
+
+	slices.Sort(skippedShoots)
+	return used, skippedShoots, nil
+}
+
+// bindingsReferencingQuota returns the names of the SecretBindings and CredentialsBindings referencing the given Quota,
+// grouped by their namespace. The names are prefixed with the kind of the binding to distinguish them.
+func (r *Reconciler) bindingsReferencingQuota(ctx context.Context, quota *gardencorev1beta1.Quota) (map[string]sets.Set[string], error) {
+	bindingsPerNamespace := map[string]sets.Set[string]{}
+
+	add := func(namespace, name string, quotaRefs []corev1.ObjectReference) {
+		if !slices.ContainsFunc(quotaRefs, func(ref corev1.ObjectReference) bool {
+			return ref.Namespace == quota.Namespace && ref.Name == quota.Name
+		}) {
+			return
+		}
+
+		if _, ok := bindingsPerNamespace[namespace]; !ok {
+			bindingsPerNamespace[namespace] = sets.New[string]()
+		}
+		bindingsPerNamespace[namespace].Insert(name)
+	}
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, fmt.Errorf("failed listing SecretBindings: %w", err)
+	}
+	for _, binding := range secretBindingList.Items {
+		add(binding.Namespace, secretBindingPrefix+binding.Name, binding.Quotas)
+	}
+
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList); err != nil {
+		return nil, fmt.Errorf("failed listing CredentialsBindings: %w", err)
+	}
+	for _, binding := range credentialsBindingList.Items {
+		add(binding.Namespace, credentialsBindingPrefix+binding.Name, binding.Quotas)
+	}
+
+	return bindingsPerNamespace, nil
+}
+
+const (
+	secretBindingPrefix      = "secretbinding/"
+	credentialsBindingPrefix = "credentialsbinding/"
+)
+
+func bindingNameOfShoot(shoot *gardencorev1beta1.Shoot) string {
+	if shoot.Spec.SecretBindingName != nil {
+		return secretBindingPrefix + *shoot.Spec.SecretBindingName
+	}
+	if shoot.Spec.CredentialsBindingName != nil {
+		return credentialsBindingPrefix + *shoot.Spec.CredentialsBindingName
+	}
+	return ""
+}
+
+// errInvalidShootSpec is returned by shootResources if the resources of a Shoot cannot be computed because of its
+// specification.
+var errInvalidShootSpec = errors.New("invalid specification")
+
+// shootResources computes the resources of the given Shoot in the same way as the ShootQuotaValidator admission
+// plugin, i.e. the maximum number of machines of all worker pools is assumed.
+func (r *Reconciler) shootResources(ctx context.Context, shoot *gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading CloudProfile: %w", err)
+	}
+
+	var (
+		countLB   int64 = 1
+		resources       = corev1.ResourceList{}
+	)
+
+	add := func(metric corev1.ResourceName, quantity resource.Quantity, multiplier int32) {
+		total := resources[metric]
+		for range multiplier {
+			total.Add(quantity)
+		}
+		resources[metric] = total
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		machineType := v1beta1helper.FindMachineTypeByName(cloudProfile.Spec.MachineTypes, worker.Machine.Type)
+		if machineType == nil {
+			return nil, fmt.Errorf("%w: machineType %s not found in CloudProfile", errInvalidShootSpec, worker.Machine.Type)
+		}
+
+		add(core.QuotaMetricCPU, machineType.CPU, worker.Maximum)
+		add(core.QuotaMetricGPU, machineType.GPU, worker.Maximum)
+		add(core.QuotaMetricMemory, machineType.Memory, worker.Maximum)
+
+		var (
+			volumeClass string
+			volumeSize  resource.Quantity
+		)
+
+		switch {
+		case worker.Volume != nil:
+			volumeSize, err = resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				return nil, fmt.Errorf("%w: failed parsing volume size of worker %s: %w", errInvalidShootSpec, worker.Name, err)
+			}
+
+			if machineType.Storage != nil {
+				volumeClass = machineType.Storage.Class
+			} else if worker.Volume.Type != nil {
+				for _, volumeType := range cloudProfile.Spec.VolumeTypes {
+					if volumeType.Name == *worker.Volume.Type {
+						volumeClass = volumeType.Class
+						break
+					}
+				}
+			}
+		case machineType.Storage != nil && machineType.Storage.StorageSize != nil:
+			volumeSize = *machineType.Storage.StorageSize
+			volumeClass = machineType.Storage.Class
+		}
+
+		switch volumeClass {
+		case gardencorev1beta1.VolumeClassStandard:
+			add(core.QuotaMetricStorageStandard, volumeSize, worker.Maximum)
+		case gardencorev1beta1.VolumeClassPremium:
+			add(core.QuotaMetricStoragePremium, volumeSize, worker.Maximum)
+		}
+	}
+
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	return resources, nil
+}
+
+// reportExceededThresholds emits a warning event for each metric whose usage exceeds a higher warning threshold than in
+// the previous status of the Quota. This way, the event is only emitted once per exceeded threshold.
+func (r *Reconciler) reportExceededThresholds(quota *gardencorev1beta1.Quota, status gardencorev1beta1.QuotaStatus) {
+	thresholds := slices.Clone(r.Config.UsageWarningThresholds)
+	slices.Sort(thresholds)
+
+	for _, metric := range sortedMetrics(status.Hard) {
+		exceeded, percentage := exceededThreshold(thresholds, status.Hard, status.Used, metric)
+		if exceeded == nil {
+			continue
+		}
+
+		if previouslyExceeded, _ := exceededThreshold(thresholds, quota.Status.Hard, quota.Status.Used, metric); previouslyExceeded != nil && *previouslyExceeded >= *exceeded {
+			continue
+		}
+
+		hard, used := status.Hard[metric], status.Used[metric]
+		r.Recorder.Eventf(quota, corev1.EventTypeWarning, v1beta1constants.EventQuotaUsageThresholdExceeded,
+			"Usage of metric %q is at %d%% of the allowed value (%s of %s) and exceeds the warning threshold of %d%%",
+			metric, percentage, used.String(), hard.String(), *exceeded)
+	}
+}
+
+// exceededThreshold returns the highest threshold exceeded by the usage of the given metric and the usage in percent
+// of the allowed value. Metrics with an allowed value of zero are not considered.
+func exceededThreshold(sortedThresholds []int32, hard, used corev1.ResourceList, metric corev1.ResourceName) (*int32, int64) {
+	hardQuantity, ok := hard[metric]
+	if !ok || hardQuantity.IsZero() {
+		return nil, 0
+	}
+	usedQuantity := used[metric]
+
+	percentage := int64(usedQuantity.AsApproximateFloat64() / hardQuantity.AsApproximateFloat64() * 100)
+
+	var exceeded *int32
+	for _, threshold := range sortedThresholds {
+		if percentage >= int64(threshold) {
+			exceeded = ptr.To(threshold)
+		}
+	}
+
+	return exceeded, percentage
+}
+
+func (r *Reconciler) updateMetrics(quota *gardencorev1beta1.Quota, status gardencorev1beta1.QuotaStatus) {
+	// Remove metrics which are no longer part of the Quota specification.
+	deleteMetrics(client.ObjectKeyFromObject(quota))
+
+	scope := strings.ToLower(quota.Spec.Scope.Kind)
+	for metric, hard := range status.Hard {
+		used := status.Used[metric]
+		metrics.QuotaAllowed.WithLabelValues(quota.Namespace, quota.Name, scope, string(metric)).Set(hard.AsApproximateFloat64())
+		metrics.QuotaUsed.WithLabelValues(quota.Namespace, quota.Name, scope, string(metric)).Set(used.AsApproximateFloat64())
+	}
+}
+
+func deleteMetrics(key types.NamespacedName) {
+	labels := prometheus.Labels{"namespace": key.Namespace, "name": key.Name}
+	metrics.QuotaAllowed.DeletePartialMatch(labels)
+	metrics.QuotaUsed.DeletePartialMatch(labels)
+}
+
+func sortedMetrics(resources corev1.ResourceList) []corev1.ResourceName {
+	names := make([]corev1.ResourceName, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
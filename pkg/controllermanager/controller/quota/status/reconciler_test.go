@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/quota/status"
+	"github.com/gardener/gardener/pkg/controllermanager/metrics"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		quota              *gardencorev1beta1.Quota
+		cloudProfile       *gardencorev1beta1.CloudProfile
+		secretBinding      *gardencorev1beta1.SecretBinding
+		credentialsBinding *securityv1alpha1.CredentialsBinding
+		shoot1, shoot2     *gardencorev1beta1.Shoot
+		otherShoot         *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Quota{}).
+			Build()
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Recorder: recorder,
+			Config: controllermanagerconfigv1alpha1.QuotaControllerConfiguration{
+				SyncPeriod:             &metav1.Duration{Duration: time.Minute},
+				UsageWarningThresholds: []int32{100, 80},
+			},
+		}
+
+		quota = &gardencorev1beta1.Quota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "garden", Generation: 2},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Scope: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret"},
+				Metrics: corev1.ResourceList{
+					"cpu":              resource.MustParse("6"),
+					"memory":           resource.MustParse("20Gi"),
+					"storage.standard": resource.MustParse("100Gi"),
+					"loadbalancer":     resource.MustParse("4"),
+				},
+			},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:   "small",
+					CPU:    resource.MustParse("2"),
+					GPU:    resource.MustParse("0"),
+					Memory: resource.MustParse("4Gi"),
+				}},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{
+					Name:  "standard",
+					Class: "standard",
+				}},
+			},
+		}
+
+		quotaRef := []corev1.ObjectReference{{Name: quota.Name, Namespace: quota.Namespace}}
+		secretBinding = &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-foo"},
+			Quotas:     quotaRef,
+		}
+		credentialsBinding = &securityv1alpha1.CredentialsBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "garden-bar"},
+			Quotas:     quotaRef,
+		}
+
+		newShoot := func(namespace, name string, maximum int32) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{Name: cloudProfile.Name},
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{
+							Name:    "worker",
+							Machine: gardencorev1beta1.Machine{Type: "small"},
+							Maximum: maximum,
+							Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard"), VolumeSize: "20Gi"},
+						}},
+					},
+				},
+			}
+		}
+
+		shoot1 = newShoot(secretBinding.Namespace, "shoot1", 2)
+		shoot1.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+		shoot2 = newShoot(credentialsBinding.Namespace, "shoot2", 1)
+		shoot2.Spec.CredentialsBindingName = ptr.To(credentialsBinding.Name)
+		shoot2.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+		otherShoot = newShoot(secretBinding.Namespace, "other", 5)
+		otherShoot.Spec.SecretBindingName = ptr.To("other")
+
+		for _, obj := range []client.Object{cloudProfile, secretBinding, credentialsBinding, shoot1, shoot2, otherShoot} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	AfterEach(func() {
+		metrics.QuotaAllowed.Reset()
+		metrics.QuotaUsed.Reset()
+	})
+
+	reconcileQuota := func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+	}
+
+	It("should do nothing if the Quota does not exist", func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should sum up the usage of all namespaces for Quotas with the 'secret' scope", func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+		reconcileQuota()
+
+		Expect(quota.Status.ObservedGeneration).To(Equal(quota.Generation))
+		Expect(quota.Status.Hard).To(Equal(quota.Spec.Metrics))
+		Expect(quota.Status.Used).To(HaveLen(4))
+		Expect(quota.Status.Used.Cpu().String()).To(Equal("6"))
+		Expect(quota.Status.Used.Memory().String()).To(Equal("12Gi"))
+		Expect(quota.Status.Used.Name("storage.standard", resource.BinarySI).String()).To(Equal("60Gi"))
+		Expect(quota.Status.Used.Name("loadbalancer", resource.DecimalSI).String()).To(Equal("3"))
+
+		Expect(testutil.ToFloat64(metrics.QuotaAllowed.WithLabelValues("garden", "quota", "secret", "cpu"))).To(Equal(float64(6)))
+		Expect(testutil.ToFloat64(metrics.QuotaUsed.WithLabelValues("garden", "quota", "secret", "memory"))).To(Equal(float64(12 << 30)))
+	})
+
+	It("should report the highest usage of all namespaces for Quotas with the 'project' scope", func() {
+		quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+		reconcileQuota()
+
+		Expect(quota.Status.Used.Cpu().String()).To(Equal("4"))
+		Expect(quota.Status.Used.Memory().String()).To(Equal("8Gi"))
+		Expect(quota.Status.Used.Name("storage.standard", resource.BinarySI).String()).To(Equal("40Gi"))
+		Expect(quota.Status.Used.Name("loadbalancer", resource.DecimalSI).String()).To(Equal("2"))
+	})
+
+	It("should skip Shoots whose resources cannot be computed and report them", func() {
+		reconciler.Config.UsageWarningThresholds = nil
+		shoot1.Spec.Provider.Workers[0].Machine.Type = "unknown"
+		Expect(fakeClient.Update(ctx, shoot1)).To(Succeed())
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+		reconcileQuota()
+
+		Expect(quota.Status.Used.Cpu().String()).To(Equal("2"))
+		Expect(quota.Status.Used.Memory().String()).To(Equal("4Gi"))
+		Expect(quota.Status.Used.Name("storage.standard", resource.BinarySI).String()).To(Equal("20Gi"))
+		Expect(quota.Status.Used.Name("loadbalancer", resource.DecimalSI).String()).To(Equal("2"))
+		Expect(recorder.Events).To(Receive(Equal(`Warning QuotaUsageIncomplete Usage does not include the resources of the following Shoots because they cannot be computed: garden-foo/shoot1`)))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should fail if the CloudProfile of a Shoot cannot be read", func() {
+		Expect(fakeClient.Delete(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		Expect(err).To(MatchError(ContainSubstring("failed reading CloudProfile")))
+	})
+
+	It("should emit a warning event once a threshold is exceeded", func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+		reconcileQuota()
+		Expect(recorder.Events).To(Receive(Equal(`Warning QuotaUsageThresholdExceeded Usage of metric "cpu" is at 100% of the allowed value (6 of 6) and exceeds the warning threshold of 100%`)))
+		Expect(recorder.Events).To(BeEmpty())
+
+		By("Reconcile again without changes")
+		reconcileQuota()
+		Expect(recorder.Events).To(BeEmpty())
+
+		By("Increase the usage of memory and storage")
+		shoot2.Spec.Provider.Workers[0].Maximum = 2
+		Expect(fakeClient.Update(ctx, shoot2)).To(Succeed())
+
+		reconcileQuota()
+		Expect(recorder.Events).To(Receive(Equal(`Warning QuotaUsageThresholdExceeded Usage of metric "memory" is at 80% of the allowed value (16Gi of 20Gi) and exceeds the warning threshold of 80%`)))
+		Expect(recorder.Events).To(Receive(Equal(`Warning QuotaUsageThresholdExceeded Usage of metric "storage.standard" is at 80% of the allowed value (80Gi of 100Gi) and exceeds the warning threshold of 80%`)))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should remove the metrics if the Quota is gone", func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+		reconcileQuota()
+		Expect(testutil.CollectAndCount(metrics.QuotaUsed)).To(Equal(4))
+
+		Expect(fakeClient.Delete(ctx, quota)).To(Succeed())
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(testutil.CollectAndCount(metrics.QuotaUsed)).To(Equal(0))
+	})
+})
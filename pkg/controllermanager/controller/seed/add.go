@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupbucketscheck"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/extensionscheck"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/lifecycle"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/rebalancer"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/secrets"
 )
@@ -38,6 +39,14 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding lifecycle reconciler: %w", err)
 	}
 
+	if config := cfg.Controllers.SeedRebalancer; config != nil {
+		if err := (&rebalancer.Reconciler{
+			Config: *config,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding rebalancer reconciler: %w", err)
+		}
+	}
+
 	if err := (&secrets.Reconciler{}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding secrets reconciler: %w", err)
 	}
@@ -0,0 +1,554 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancer
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+)
+
+const (
+	// EventMigrationSuggested is the reason of the event which is emitted for a shoot whose control plane should be
+	// migrated to another seed.
+	EventMigrationSuggested = "ControlPlaneMigrationSuggested"
+	// EventMigrationTriggered is the reason of the event which is emitted for a shoot whose control plane migration
+	// to another seed was triggered.
+	EventMigrationTriggered = "ControlPlaneMigrationTriggered"
+)
+
+// Reconciler reconciles Seeds and migrates shoot control planes away from seeds whose utilization exceeds the
+// configured threshold. Depending on the configured mode, the migrations are only suggested or also performed.
+type Reconciler struct {
+	Client    client.Client
+	APIReader client.Reader
+	Config    controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration
+	Clock     clock.Clock
+	Recorder  record.EventRecorder
+
+	suggestionsMutex sync.Mutex
+	// suggestions contains the migrations suggested during the last reconciliation of a seed, i.e., the names of the
+	// target seeds by shoot keys indexed by the seed names. It is used to emit events only for new suggestions.
+	suggestions map[string]map[client.ObjectKey]string
+}
+
+// Reconcile reconciles Seeds and migrates shoot control planes away from seeds whose utilization exceeds the
+// configured threshold.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	result := reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+
+	if seed.DeletionTimestamp != nil {
+		log.V(1).Info("Seed is being deleted, skipping rebalancing")
+		return result, nil
+	}
+
+	shoots, source, err := r.computeUtilization(ctx, r.Client, seed)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if len(source.allocatable) == 0 {
+		log.V(1).Info("Seed does not report allocatable resources, skipping rebalancing")
+		return result, nil
+	}
+
+	if !source.exceedsThreshold(r.threshold(), nil) {
+		log.V(1).Info("Seed utilization does not exceed threshold, nothing to rebalance", "requests", source.requests, "allocatable", source.allocatable)
+		r.setSuggestions(seed.Name, nil)
+		return result, nil
+	}
+
+	if !hasUsableBackup(seed) {
+		log.Info("Seed utilization exceeds threshold but its backup is not usable, skipping rebalancing", "requests", source.requests, "allocatable", source.allocatable)
+		return result, nil
+	}
+
+	var budget int64
+	if r.Config.Mode == controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate {
+		// The cache might not yet contain the migrations which were triggered shortly before, e.g., while reconciling
+		// another seed. Hence, the shoots are read from the API server before triggering further migrations, otherwise
+		// the budget could be exceeded and the target seeds could be overloaded.
+		shoots, source, err = r.computeUtilization(ctx, r.APIReader, seed)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if !source.exceedsThreshold(r.threshold(), nil) {
+			log.V(1).Info("Seed utilization does not exceed threshold, nothing to rebalance", "requests", source.requests, "allocatable", source.allocatable)
+			return result, nil
+		}
+
+		budget = int64(ptr.Deref(r.Config.MaxConcurrentMigrations, 0)) - countMigrations(shoots)
+		if budget <= 0 {
+			log.Info("Seed utilization exceeds threshold but budget for concurrent migrations is exhausted", "requests", source.requests, "allocatable", source.allocatable)
+			return result, nil
+		}
+	}
+
+	targetSeeds, err := r.getTargetSeeds(ctx, seed, shoots)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Seed utilization exceeds threshold, computing migration candidates", "requests", source.requests, "allocatable", source.allocatable)
+
+	var (
+		previousSuggestions = r.getSuggestions(seed.Name)
+		suggestions         = make(map[client.ObjectKey]string)
+	)
+
+	for _, shoot := range r.getMigrationCandidates(source, shoots) {
+		if !source.exceedsThreshold(r.threshold(), nil) || (r.Config.Mode == controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate && budget <= 0) {
+			break
+		}
+
+		requests := r.getRequests(shoot)
+
+		target, err := r.findTargetSeed(ctx, log, shoot, requests, targetSeeds)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if target == nil {
+			continue
+		}
+
+		if r.Config.Mode == controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate {
+			if err := r.migrate(ctx, log, shoot, seed, target); err != nil {
+				return reconcile.Result{}, err
+			}
+			budget--
+		} else {
+			suggestions[client.ObjectKeyFromObject(shoot)] = target.seed.Name
+			r.suggest(log, shoot, seed, target, previousSuggestions)
+		}
+
+		target.add(requests)
+		source.subtract(requests)
+	}
+
+	if r.Config.Mode != controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate {
+		r.setSuggestions(seed.Name, suggestions)
+	}
+
+	if source.exceedsThreshold(r.threshold(), nil) {
+		log.Info("Could not find enough migration candidates to bring seed utilization below threshold", "requests", source.requests, "allocatable", source.allocatable)
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) threshold() int64 {
+	return int64(ptr.Deref(r.Config.UtilizationThreshold, 0))
+}
+
+// computeUtilization reads all shoots with the given reader and returns them together with the utilization of the
+// given seed.
+func (r *Reconciler) computeUtilization(ctx context.Context, reader client.Reader, seed *gardencorev1beta1.Seed) ([]gardencorev1beta1.Shoot, *seedUtilization, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := reader.List(ctx, shootList); err != nil {
+		return nil, nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	return shootList.Items, r.newSeedUtilization(seed, r.calculateSeedRequests(shootList.Items)), nil
+}
+
+// getRequests returns the resource requests of the control plane of the given shoot. Only the resources configured in
+// `ControlPlaneResourceRequests` and the number of shoots are considered.
+func (r *Reconciler) getRequests(shoot *gardencorev1beta1.Shoot) corev1.ResourceList {
+	requests := corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(1, resource.DecimalSI)}
+
+	for resourceName, quantity := range r.Config.ControlPlaneResourceRequests {
+		request := quantity.DeepCopy()
+		if v1beta1helper.IsHAControlPlaneConfigured(shoot) {
+			request.Mul(highAvailabilityRequestsFactor)
+		}
+		requests[resourceName] = request
+	}
+
+	return requests
+}
+
+// highAvailabilityRequestsFactor is the factor by which the requests of highly available control planes exceed the
+// requests of other control planes, since etcd and the control plane components run with three replicas.
+const highAvailabilityRequestsFactor = 3
+
+// calculateSeedRequests returns the sum of the resource requests of all shoots indexed by the names of their seeds.
+// Similar to `CalculateSeedUsage`, shoots which are being migrated are counted for both seeds.
+func (r *Reconciler) calculateSeedRequests(shoots []gardencorev1beta1.Shoot) map[string]corev1.ResourceList {
+	seedRequests := make(map[string]corev1.ResourceList)
+
+	for _, shoot := range shoots {
+		var (
+			specSeed   = ptr.Deref(shoot.Spec.SeedName, "")
+			statusSeed = ptr.Deref(shoot.Status.SeedName, "")
+			requests   = r.getRequests(&shoot)
+		)
+
+		seedNames := sets.New[string]()
+		if specSeed != "" {
+			seedNames.Insert(specSeed)
+		}
+		if statusSeed != "" {
+			seedNames.Insert(statusSeed)
+		}
+
+		for seedName := range seedNames {
+			if seedRequests[seedName] == nil {
+				seedRequests[seedName] = corev1.ResourceList{}
+			}
+			addResources(seedRequests[seedName], requests)
+		}
+	}
+
+	return seedRequests
+}
+
+// newSeedUtilization returns the utilization of the given seed. Only the resources for which the seed reports positive
+// allocatable values are considered.
+func (r *Reconciler) newSeedUtilization(seed *gardencorev1beta1.Seed, seedRequests map[string]corev1.ResourceList) *seedUtilization {
+	utilization := &seedUtilization{seed: seed.DeepCopy(), allocatable: corev1.ResourceList{}, requests: corev1.ResourceList{}}
+
+	for _, resourceName := range append([]corev1.ResourceName{gardencorev1beta1.ResourceShoots}, slices.Collect(maps.Keys(r.Config.ControlPlaneResourceRequests))...) {
+		if allocatable, ok := seed.Status.Allocatable[resourceName]; ok && allocatable.Sign() > 0 {
+			utilization.allocatable[resourceName] = allocatable.DeepCopy()
+		}
+	}
+
+	addResources(utilization.requests, seedRequests[seed.Name])
+	return utilization
+}
+
+// seedUtilization contains the allocatable resources of a seed and the (projected) resource requests of the shoots
+// scheduled onto it.
+type seedUtilization struct {
+	seed        *gardencorev1beta1.Seed
+	allocatable corev1.ResourceList
+	requests    corev1.ResourceList
+}
+
+// percentage returns the highest utilization of all allocatable resources in percent if the given additional requests
+// were scheduled onto the seed.
+func (s *seedUtilization) percentage(additional corev1.ResourceList) int64 {
+	var percentage int64
+	for resourceName, allocatable := range s.allocatable {
+		requests := s.requests[resourceName].DeepCopy()
+		requests.Add(additional[resourceName])
+		percentage = max(percentage, requests.MilliValue()*100/allocatable.MilliValue())
+	}
+	return percentage
+}
+
+// exceedsThreshold returns true if the utilization of any allocatable resource exceeds the given threshold if the
+// given additional requests were scheduled onto the seed.
+func (s *seedUtilization) exceedsThreshold(threshold int64, additional corev1.ResourceList) bool {
+	for resourceName, allocatable := range s.allocatable {
+		requests := s.requests[resourceName].DeepCopy()
+		requests.Add(additional[resourceName])
+		if requests.MilliValue()*100 > threshold*allocatable.MilliValue() {
+			return true
+		}
+	}
+	return false
+}
+
+// share returns the highest share of the given requests in the allocatable resources of the seed.
+func (s *seedUtilization) share(requests corev1.ResourceList) float64 {
+	var share float64
+	for resourceName, allocatable := range s.allocatable {
+		request := requests[resourceName]
+		share = max(share, float64(request.MilliValue())/float64(allocatable.MilliValue()))
+	}
+	return share
+}
+
+func (s *seedUtilization) add(requests corev1.ResourceList) {
+	addResources(s.requests, requests)
+}
+
+func (s *seedUtilization) subtract(requests corev1.ResourceList) {
+	for resourceName, quantity := range requests {
+		request := s.requests[resourceName].DeepCopy()
+		request.Sub(quantity)
+		s.requests[resourceName] = request
+	}
+}
+
+func addResources(list, requests corev1.ResourceList) {
+	for resourceName, quantity := range requests {
+		request := list[resourceName].DeepCopy()
+		request.Add(quantity)
+		list[resourceName] = request
+	}
+}
+
+// getTargetSeeds returns all seeds which are usable and have the same provider type and region as the given seed.
+func (r *Reconciler) getTargetSeeds(ctx context.Context, seed *gardencorev1beta1.Seed, shoots []gardencorev1beta1.Shoot) ([]*seedUtilization, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return nil, fmt.Errorf("failed listing seeds: %w", err)
+	}
+
+	var (
+		seedRequests = r.calculateSeedRequests(shoots)
+		targetSeeds  []*seedUtilization
+	)
+
+	for _, s := range seedList.Items {
+		if s.Name == seed.Name ||
+			s.Spec.Provider.Type != seed.Spec.Provider.Type ||
+			s.Spec.Provider.Region != seed.Spec.Provider.Region ||
+			!isUsableSeed(&s) {
+			continue
+		}
+
+		target := r.newSeedUtilization(&s, seedRequests)
+		if len(target.allocatable) == 0 {
+			continue
+		}
+
+		targetSeeds = append(targetSeeds, target)
+	}
+
+	return targetSeeds, nil
+}
+
+// getMigrationCandidates returns the shoots on the given seed which can be migrated right now, i.e., they are not
+// being deleted, not already being migrated, their last operation succeeded, and they are in their maintenance time
+// window. The candidates are sorted descending by the share of their resource requests in the allocatable resources
+// of the seed so that as few control planes as possible are migrated, and then by their namespace and name.
+func (r *Reconciler) getMigrationCandidates(source *seedUtilization, shoots []gardencorev1beta1.Shoot) []*gardencorev1beta1.Shoot {
+	var (
+		seed       = source.seed
+		candidates []*gardencorev1beta1.Shoot
+		shares     = make(map[client.ObjectKey]float64)
+	)
+
+	for _, shoot := range shoots {
+		if ptr.Deref(shoot.Spec.SeedName, "") != seed.Name ||
+			ptr.Deref(shoot.Status.SeedName, "") != seed.Name ||
+			shoot.DeletionTimestamp != nil ||
+			shoot.Status.LastOperation == nil ||
+			shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded ||
+			!gardenerutils.EffectiveShootMaintenanceTimeWindow(&shoot).Contains(r.Clock.Now()) {
+			continue
+		}
+
+		candidates = append(candidates, shoot.DeepCopy())
+		shares[client.ObjectKeyFromObject(&shoot)] = source.share(r.getRequests(&shoot))
+	}
+
+	slices.SortFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		if c := cmp.Compare(shares[client.ObjectKeyFromObject(b)], shares[client.ObjectKeyFromObject(a)]); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	return candidates
+}
+
+// findTargetSeed returns the least utilized seed which can host the given shoot with the given resource requests without
+// exceeding the utilization threshold. It returns nil if there is no such seed.
+func (r *Reconciler) findTargetSeed(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, requests corev1.ResourceList, targetSeeds []*seedUtilization) (*seedUtilization, error) {
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting cloud profile for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	var best *seedUtilization
+	for _, target := range targetSeeds {
+		if target.exceedsThreshold(r.threshold(), requests) {
+			continue
+		}
+
+		if reason := incompatibilityReason(target.seed, shoot, cloudProfile); reason != "" {
+			log.V(1).Info("Seed cannot host shoot", "seed", target.seed.Name, "shoot", client.ObjectKeyFromObject(shoot), "reason", reason)
+			continue
+		}
+
+		if best == nil || target.percentage(requests) < best.percentage(requests) {
+			best = target
+		}
+	}
+
+	return best, nil
+}
+
+// suggest emits an event suggesting the control plane migration of the given shoot unless the same migration was
+// already suggested during the last reconciliation of the seed.
+func (r *Reconciler) suggest(log logr.Logger, shoot *gardencorev1beta1.Shoot, source *gardencorev1beta1.Seed, target *seedUtilization, previousSuggestions map[client.ObjectKey]string) {
+	log = log.WithValues("shoot", client.ObjectKeyFromObject(shoot), "targetSeed", target.seed.Name)
+
+	if previousSuggestions[client.ObjectKeyFromObject(shoot)] == target.seed.Name {
+		log.V(1).Info("Control plane migration was already suggested")
+		return
+	}
+
+	log.Info("Suggesting control plane migration")
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventMigrationSuggested, "Control plane should be migrated from seed %q to seed %q to reduce the utilization of seed %q", source.Name, target.seed.Name, source.Name)
+}
+
+func (r *Reconciler) migrate(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, source *gardencorev1beta1.Seed, target *seedUtilization) error {
+	log = log.WithValues("shoot", client.ObjectKeyFromObject(shoot), "targetSeed", target.seed.Name)
+
+	log.Info("Triggering control plane migration")
+	shoot.Spec.SeedName = &target.seed.Name
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return fmt.Errorf("failed triggering control plane migration of shoot %s to seed %s: %w", client.ObjectKeyFromObject(shoot), target.seed.Name, err)
+	}
+
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventMigrationTriggered, "Triggered control plane migration from seed %q to seed %q to reduce the utilization of seed %q", source.Name, target.seed.Name, source.Name)
+	return nil
+}
+
+func (r *Reconciler) getSuggestions(seedName string) map[client.ObjectKey]string {
+	r.suggestionsMutex.Lock()
+	defer r.suggestionsMutex.Unlock()
+
+	return r.suggestions[seedName]
+}
+
+func (r *Reconciler) setSuggestions(seedName string, suggestions map[client.ObjectKey]string) {
+	r.suggestionsMutex.Lock()
+	defer r.suggestionsMutex.Unlock()
+
+	if len(suggestions) == 0 {
+		delete(r.suggestions, seedName)
+		return
+	}
+
+	if r.suggestions == nil {
+		r.suggestions = make(map[string]map[client.ObjectKey]string)
+	}
+	r.suggestions[seedName] = suggestions
+}
+
+// incompatibilityReason returns why the given seed cannot host the control plane of the given shoot. It returns an
+// empty string if the seed is compatible.
+func incompatibilityReason(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) string {
+	if cloudProfile.Spec.SeedSelector != nil && !matchesLabelSelector(seed, &cloudProfile.Spec.SeedSelector.LabelSelector) {
+		return "seed does not match the seed selector of the cloud profile"
+	}
+
+	if shoot.Spec.SeedSelector != nil && !matchesLabelSelector(seed, &shoot.Spec.SeedSelector.LabelSelector) {
+		return "seed does not match the seed selector of the shoot"
+	}
+
+	if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+		return "shoot does not tolerate the seed's taints"
+	}
+
+	if !v1beta1helper.AccessRestrictionsAreSupported(seed.Spec.AccessRestrictions, shoot.Spec.AccessRestrictions) {
+		return "seed does not support the access restrictions of the shoot"
+	}
+
+	if v1beta1helper.IsMultiZonalShootControlPlane(shoot) && len(seed.Spec.Provider.Zones) < 3 {
+		return "seed does not have at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"
+	}
+
+	if seed.Spec.Backup == nil {
+		return "seed does not have a backup configuration which is required for control plane migration"
+	}
+
+	if shoot.Spec.Networking != nil {
+		if errs := cidrvalidation.ValidateNetworkDisjointedness(
+			field.NewPath(""),
+			shoot.Spec.Networking.Nodes,
+			shoot.Spec.Networking.Pods,
+			shoot.Spec.Networking.Services,
+			seed.Spec.Networks.Nodes,
+			seed.Spec.Networks.Pods,
+			seed.Spec.Networks.Services,
+			!v1beta1helper.IsHAVPNEnabled(shoot),
+		); len(errs) > 0 {
+			return "networks of the shoot are not disjoint with the seed networks: " + errs.ToAggregate().Error()
+		}
+	}
+
+	return ""
+}
+
+func matchesLabelSelector(seed *gardencorev1beta1.Seed, labelSelector *metav1.LabelSelector) bool {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(seed.Labels))
+}
+
+func isUsableSeed(seed *gardencorev1beta1.Seed) bool {
+	if seed.DeletionTimestamp != nil ||
+		seed.Spec.Settings == nil || seed.Spec.Settings.Scheduling == nil || !seed.Spec.Settings.Scheduling.Visible ||
+		!hasUsableBackup(seed) {
+		return false
+	}
+
+	condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady)
+	return condition != nil && condition.Status == gardencorev1beta1.ConditionTrue
+}
+
+// hasUsableBackup returns true if the seed has a backup configuration and its backup buckets are ready. Both the
+// source and the target seed of a control plane migration require a usable backup since the etcd data is transferred
+// via the backup.
+func hasUsableBackup(seed *gardencorev1beta1.Seed) bool {
+	if seed.Spec.Backup == nil {
+		return false
+	}
+
+	condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedBackupBucketsReady)
+	return condition != nil && condition.Status == gardencorev1beta1.ConditionTrue
+}
+
+// countMigrations returns the number of shoots whose control plane is currently being migrated.
+func countMigrations(shoots []gardencorev1beta1.Shoot) int64 {
+	var count int64
+	for _, shoot := range shoots {
+		if v1beta1helper.ShouldPrepareShootForMigration(&shoot) || v1beta1helper.ShootHasOperationType(shoot.Status.LastOperation, gardencorev1beta1.LastOperationTypeMigrate) ||
+			(v1beta1helper.ShootHasOperationType(shoot.Status.LastOperation, gardencorev1beta1.LastOperationTypeRestore) && shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancer_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/rebalancer"
+)
+
+var _ = Describe("Reconciler", func() {
+	const syncPeriod = time.Hour
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		fakeClock    *testclock.FakeClock
+		fakeRecorder *record.FakeRecorder
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		sourceSeed *gardencorev1beta1.Seed
+		shoots     []*gardencorev1beta1.Shoot
+	)
+
+	newSeed := func(name, region string, allocatableShoots int64) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Backup:   &gardencorev1beta1.SeedBackup{Provider: "foo"},
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+				Networks: gardencorev1beta1.SeedNetworks{Pods: "10.0.0.0/16", Services: "10.1.0.0/16"},
+				Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Allocatable: corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(allocatableShoots, resource.DecimalSI)},
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+					{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	newShoot := func(name, seedName string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("profile"),
+				SeedName:         ptr.To(seedName),
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName: ptr.To(seedName),
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+	}
+
+	createShoots := func(prefix, seedName string, count int) {
+		for i := range count {
+			ExpectWithOffset(1, c.Create(ctx, newShoot(fmt.Sprintf("%s-%d", prefix, i), seedName))).To(Succeed())
+		}
+	}
+
+	getSeedName := func(shoot *gardencorev1beta1.Shoot) string {
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return ptr.Deref(shoot.Spec.SeedName, "")
+	}
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					if subResourceName != "binding" {
+						return fmt.Errorf("unexpected subresource %q", subResourceName)
+					}
+					return c.Update(ctx, obj)
+				},
+			}).
+			Build()
+
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		fakeRecorder = record.NewFakeRecorder(10)
+
+		reconciler = &Reconciler{
+			Client:    c,
+			APIReader: c,
+			Config: controllermanagerconfigv1alpha1.SeedRebalancerControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: syncPeriod},
+				Mode:                    controllermanagerconfigv1alpha1.SeedRebalancingModeSuggest,
+				UtilizationThreshold:    ptr.To[int32](80),
+				MaxConcurrentMigrations: ptr.To[int32](1),
+			},
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
+		}
+
+		Expect(c.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}})).To(Succeed())
+
+		sourceSeed = newSeed("seed-1", "europe", 4)
+		Expect(c.Create(ctx, sourceSeed)).To(Succeed())
+		Expect(c.Create(ctx, newSeed("seed-2", "europe", 10))).To(Succeed())
+		Expect(c.Create(ctx, newSeed("seed-3", "europe", 10))).To(Succeed())
+		Expect(c.Create(ctx, newSeed("seed-4", "asia", 10))).To(Succeed())
+
+		shoots = nil
+		for _, name := range []string{"shoot-a", "shoot-b", "shoot-c", "shoot-d"} {
+			shoot := newShoot(name, sourceSeed.Name)
+			Expect(c.Create(ctx, shoot)).To(Succeed())
+			shoots = append(shoots, shoot)
+		}
+
+		createShoots("seed-2", "seed-2", 5)
+		createShoots("seed-3", "seed-3", 2)
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(sourceSeed)}
+	})
+
+	It("should do nothing if the seed does not report allocatable shoots", func() {
+		sourceSeed.Status.Allocatable = nil
+		Expect(c.Update(ctx, sourceSeed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	It("should do nothing if the utilization does not exceed the threshold", func() {
+		Expect(c.Delete(ctx, shoots[3])).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	It("should suggest migrating a shoot to the least utilized seed", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+		Expect(<-fakeRecorder.Events).To(Equal(`Normal ControlPlaneMigrationSuggested Control plane should be migrated from seed "seed-1" to seed "seed-3" to reduce the utilization of seed "seed-1"`))
+		Expect(getSeedName(shoots[0])).To(Equal("seed-1"))
+	})
+
+	It("should not suggest the same migration again", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(<-fakeRecorder.Events).To(ContainSubstring(`ControlPlaneMigrationSuggested`))
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+
+		By("Change the target seed of the suggestion")
+		createShoots("seed-3-more", "seed-3", 4)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+		Expect(<-fakeRecorder.Events).To(ContainSubstring(`to seed "seed-2"`))
+	})
+
+	It("should not rebalance if the backup of the seed is not usable", func() {
+		sourceSeed.Status.Conditions[1].Status = gardencorev1beta1.ConditionFalse
+		Expect(c.Update(ctx, sourceSeed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	It("should take the resource requests of the control planes into account", func() {
+		reconciler.Config.Mode = controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate
+		reconciler.Config.ControlPlaneResourceRequests = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")}
+
+		for seedName, allocatableMemory := range map[string]string{"seed-1": "20Gi", "seed-2": "40Gi", "seed-3": "40Gi"} {
+			seed := &gardencorev1beta1.Seed{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: seedName}, seed)).To(Succeed())
+			seed.Status.Allocatable[gardencorev1beta1.ResourceShoots] = resource.MustParse("10")
+			seed.Status.Allocatable[corev1.ResourceMemory] = resource.MustParse(allocatableMemory)
+			Expect(c.Update(ctx, seed)).To(Succeed())
+		}
+
+		By("Make the control plane of a shoot highly available")
+		shoots[2].Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{
+			FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeNode},
+		}}
+		Expect(c.Update(ctx, shoots[2])).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getSeedName(shoots[0])).To(Equal("seed-1"))
+		Expect(getSeedName(shoots[1])).To(Equal("seed-1"))
+		Expect(getSeedName(shoots[2])).To(Equal("seed-3"))
+		Expect(getSeedName(shoots[3])).To(Equal("seed-1"))
+	})
+
+	It("should only consider shoots in their maintenance time window", func() {
+		shoots[0].Spec.Maintenance = &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"}}
+		Expect(c.Update(ctx, shoots[0])).To(Succeed())
+
+		reconciler.Config.Mode = controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(getSeedName(shoots[0])).To(Equal("seed-1"))
+		Expect(getSeedName(shoots[1])).To(Equal("seed-3"))
+	})
+
+	It("should not consider seeds which cannot host the shoot", func() {
+		seed3 := &gardencorev1beta1.Seed{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "seed-3"}, seed3)).To(Succeed())
+		seed3.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+		Expect(c.Update(ctx, seed3)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(fakeRecorder.Events).To(HaveLen(1))
+		Expect(<-fakeRecorder.Events).To(ContainSubstring(`to seed "seed-2"`))
+	})
+
+	It("should not suggest migrations if no seed can take the shoot without exceeding the threshold", func() {
+		createShoots("seed-2-more", "seed-2", 3)
+		createShoots("seed-3-more", "seed-3", 6)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	Context("migrate mode", func() {
+		BeforeEach(func() {
+			reconciler.Config.Mode = controllermanagerconfigv1alpha1.SeedRebalancingModeMigrate
+		})
+
+		It("should trigger the migration of a shoot", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(getSeedName(shoots[0])).To(Equal("seed-3"))
+			Expect(getSeedName(shoots[1])).To(Equal("seed-1"))
+			Expect(<-fakeRecorder.Events).To(Equal(`Normal ControlPlaneMigrationTriggered Triggered control plane migration from seed "seed-1" to seed "seed-3" to reduce the utilization of seed "seed-1"`))
+		})
+
+		It("should respect the budget for concurrent migrations", func() {
+			migratingShoot := newShoot("migrating", "seed-4")
+			migratingShoot.Status.SeedName = ptr.To("seed-5")
+			Expect(c.Create(ctx, migratingShoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(getSeedName(shoots[0])).To(Equal("seed-1"))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+		})
+
+		It("should read the shoots from the API server to compute the budget", func() {
+			migratingShoot := newShoot("migrating", "seed-4")
+			migratingShoot.Status.SeedName = ptr.To("seed-5")
+			Expect(c.Create(ctx, migratingShoot)).To(Succeed())
+
+			// The cache does not contain the shoot yet.
+			reconciler.Client = interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if err := c.List(ctx, list, opts...); err != nil {
+						return err
+					}
+					if shootList, ok := list.(*gardencorev1beta1.ShootList); ok {
+						shootList.Items = slices.DeleteFunc(shootList.Items, func(shoot gardencorev1beta1.Shoot) bool {
+							return shoot.Name == migratingShoot.Name
+						})
+					}
+					return nil
+				},
+			})
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(getSeedName(shoots[0])).To(Equal("seed-1"))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+		})
+
+		It("should migrate multiple shoots within the budget", func() {
+			reconciler.Config.MaxConcurrentMigrations = ptr.To[int32](5)
+			reconciler.Config.UtilizationThreshold = ptr.To[int32](50)
+
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+			Expect(getSeedName(shoots[0])).To(Equal("seed-3"))
+			Expect(getSeedName(shoots[1])).To(Equal("seed-3"))
+			Expect(getSeedName(shoots[2])).To(Equal("seed-1"))
+			Expect(getSeedName(shoots[3])).To(Equal("seed-1"))
+		})
+	})
+})
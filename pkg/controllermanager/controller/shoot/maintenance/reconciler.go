@@ -7,6 +7,7 @@ package maintenance
 import (
 	"context"
 	"fmt"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
@@ -126,7 +127,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(maintainedShoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 		maintainedShoot.Spec.Kubernetes.Version = v
 		return v, nil
 	})
@@ -181,7 +182,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, kubernetesVersionUpdatePolicy(maintainedShoot.Spec.Maintenance.AutoUpdate, pool.AutoUpdate), cloudProfile, func(v string) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -527,13 +528,15 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		filteredMachineImageVersionsFromCloudProfile = filterForCRI(filteredMachineImageVersionsFromCloudProfile, worker.CRI)
 		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
 
+		policy := machineImageVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, worker.AutoUpdate)
+
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, policy)
 		if !shouldBeUpdated {
 			continue
 		}
 
-		updatedMachineImageVersion, err := determineMachineImageVersion(workerImage, filteredMachineImageVersionsFromCloudProfile, isExpired)
+		updatedMachineImageVersion, err := determineMachineImageVersion(workerImage, filteredMachineImageVersionsFromCloudProfile, policy, isExpired)
 		if err != nil {
 			log.Error(err, "Maintenance of machine image failed", "workerPool", worker.Name, "machineImage", workerImage.Name)
 			maintenanceResults[worker.Name] = updateResult{
@@ -562,9 +565,42 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
+// kubernetesVersionUpdatePolicy returns the policy for automatic updates of the Kubernetes version. The policy of the
+// worker pool takes precedence over the policy of the Shoot. If no policy is configured, the KubernetesVersion field is
+// translated to the "patch" or "pinned" strategy.
+func kubernetesVersionUpdatePolicy(autoUpdate *gardencorev1beta1.MaintenanceAutoUpdate, workerAutoUpdate *gardencorev1beta1.WorkerAutoUpdate) gardencorev1beta1.VersionUpdatePolicy {
+	if workerAutoUpdate != nil && workerAutoUpdate.KubernetesVersionPolicy != nil {
+		return *workerAutoUpdate.KubernetesVersionPolicy
+	}
+	if autoUpdate.KubernetesVersionPolicy != nil {
+		return *autoUpdate.KubernetesVersionPolicy
+	}
+	if autoUpdate.KubernetesVersion {
+		return gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPatch}
+	}
+	return gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned}
+}
+
+// machineImageVersionUpdatePolicy returns the policy for automatic updates of the machine image version of a worker
+// pool. The policy of the worker pool takes precedence over the policy of the Shoot. If no policy is configured, nil is
+// returned if the MachineImageVersion field allows automatic updates, i.e. the update strategy of the machine image in
+// the CloudProfile is used.
+func machineImageVersionUpdatePolicy(autoUpdate *gardencorev1beta1.MaintenanceAutoUpdate, workerAutoUpdate *gardencorev1beta1.WorkerAutoUpdate) *gardencorev1beta1.VersionUpdatePolicy {
+	if workerAutoUpdate != nil && workerAutoUpdate.MachineImageVersionPolicy != nil {
+		return workerAutoUpdate.MachineImageVersionPolicy
+	}
+	if autoUpdate.MachineImageVersionPolicy != nil {
+		return autoUpdate.MachineImageVersionPolicy
+	}
+	if ptr.Deref(autoUpdate.MachineImageVersion, true) {
+		return nil
+	}
+	return &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned}
+}
+
 // maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
-	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, policy gardencorev1beta1.VersionUpdatePolicy, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
+	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, policy, profile)
 	if err != nil {
 		return nil, err
 	}
@@ -572,7 +608,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		return nil, nil
 	}
 
-	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, profile, isExpired)
+	updatedKubernetesVersion, err := determineKubernetesVersion(kubernetesVersion, policy, profile, isExpired)
 	if err != nil {
 		return &updateResult{
 			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
@@ -603,8 +639,13 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 	}, nil
 }
 
-func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1beta1.CloudProfile, isExpired bool) (string, error) {
+func determineKubernetesVersion(kubernetesVersion string, policy gardencorev1beta1.VersionUpdatePolicy, profile *gardencorev1beta1.CloudProfile, isExpired bool) (string, error) {
 	getHigherVersionAutoUpdate := v1beta1helper.GetLatestVersionForPatchAutoUpdate
+	if policy.Strategy == gardencorev1beta1.VersionUpdateStrategyMinor {
+		getHigherVersionAutoUpdate = v1beta1helper.GetLatestVersionForMinorAutoUpdate
+	}
+	// Kubernetes versions must not skip minor versions
+	getHigherVersionAutoUpdate = applyVersionUpdatePolicy(getHigherVersionAutoUpdate, policy, true)
 	getHigherVersionForceUpdate := v1beta1helper.GetVersionForForcefulUpdateToConsecutiveMinor
 
 	version, err := determineVersionForStrategy(profile.Spec.Kubernetes.Versions, kubernetesVersion, getHigherVersionAutoUpdate, getHigherVersionForceUpdate, isExpired)
@@ -614,7 +655,7 @@ func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1b
 	return version, nil
 }
 
-func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
+func shouldKubernetesVersionBeUpdated(kubernetesVersion string, policy gardencorev1beta1.VersionUpdatePolicy, profile *gardencorev1beta1.CloudProfile) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
 	versionExistsInCloudProfile, version, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(profile, kubernetesVersion)
 	if err != nil {
 		return false, "", false, err
@@ -631,9 +672,13 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 		return true, updateReason, true, nil
 	}
 
-	if autoUpdate {
+	switch policy.Strategy {
+	case gardencorev1beta1.VersionUpdateStrategyPatch:
 		updateReason = "Automatic update of Kubernetes version configured"
 		return true, updateReason, false, nil
+	case gardencorev1beta1.VersionUpdateStrategyMinor:
+		updateReason = "Automatic minor update of Kubernetes version configured"
+		return true, updateReason, false, nil
 	}
 
 	return false, "", false, nil
@@ -775,7 +820,7 @@ func determineMachineImage(cloudProfile *gardencorev1beta1.CloudProfile, shootMa
 	return machineImageFromCloudProfile, nil
 }
 
-func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate bool) (shouldBeUpdated bool, reason string, isExpired bool) {
+func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, policy *gardencorev1beta1.VersionUpdatePolicy) (shouldBeUpdated bool, reason string, isExpired bool) {
 	versionExistsInCloudProfile, versionIndex := v1beta1helper.ShootMachineImageVersionExists(*machineImage, *shootMachineImage)
 
 	var updateReason string
@@ -789,18 +834,23 @@ func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.Sho
 		return true, updateReason, true
 	}
 
-	if autoUpdate {
+	if policy == nil {
 		updateReason = fmt.Sprintf("Automatic update of the machine image version is configured (image update strategy: %s)", *machineImage.UpdateStrategy)
 		return true, updateReason, false
 	}
 
+	if policy.Strategy != gardencorev1beta1.VersionUpdateStrategyPinned {
+		updateReason = fmt.Sprintf("Automatic update of the machine image version is configured (version update strategy: %s)", policy.Strategy)
+		return true, updateReason, false
+	}
+
 	return false, "", false
 }
 
 // GetHigherVersion takes a slice of versions and returns if higher suitable version could be found, the version or an error
 type GetHigherVersion func(versions []gardencorev1beta1.ExpirableVersion, currentVersion string) (bool, string, error)
 
-func determineMachineImageVersion(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, isExpired bool) (string, error) {
+func determineMachineImageVersion(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, policy *gardencorev1beta1.VersionUpdatePolicy, isExpired bool) (string, error) {
 	var (
 		getHigherVersionAutoUpdate  GetHigherVersion
 		getHigherVersionForceUpdate GetHigherVersion
@@ -822,6 +872,19 @@ func determineMachineImageVersion(shootMachineImage *gardencorev1beta1.ShootMach
 		}
 	}
 
+	if policy != nil {
+		// The policy can only restrict automatic updates further than the update strategy of the machine image, forceful
+		// updates of expired versions are still performed according to the update strategy.
+		switch {
+		case policy.Strategy == gardencorev1beta1.VersionUpdateStrategyPatch,
+			policy.Strategy == gardencorev1beta1.VersionUpdateStrategyMinor && *machineImage.UpdateStrategy == gardencorev1beta1.UpdateStrategyPatch:
+			getHigherVersionAutoUpdate = v1beta1helper.GetLatestVersionForPatchAutoUpdate
+		case policy.Strategy == gardencorev1beta1.VersionUpdateStrategyMinor:
+			getHigherVersionAutoUpdate = v1beta1helper.GetLatestVersionForMinorAutoUpdate
+		}
+		getHigherVersionAutoUpdate = applyVersionUpdatePolicy(getHigherVersionAutoUpdate, *policy, false)
+	}
+
 	version, err := determineVersionForStrategy(
 		v1beta1helper.ToExpirableVersions(machineImage.Versions),
 		*shootMachineImage.Version,
@@ -835,6 +898,80 @@ func determineMachineImageVersion(shootMachineImage *gardencorev1beta1.ShootMach
 	return version, nil
 }
 
+// applyVersionUpdatePolicy returns a GetHigherVersion function which only considers the versions that are eligible for
+// automatic updates according to the given policy, i.e. versions which satisfy the constraint of the policy and which
+// stay the configured number of minor versions behind the latest supported minor version. If consecutiveMinor is true,
+// versions which skip a minor version are not considered either.
+func applyVersionUpdatePolicy(getHigherVersion GetHigherVersion, policy gardencorev1beta1.VersionUpdatePolicy, consecutiveMinor bool) GetHigherVersion {
+	return func(versions []gardencorev1beta1.ExpirableVersion, currentVersion string) (bool, string, error) {
+		filteredVersions, err := filterVersionsForPolicy(versions, currentVersion, policy, consecutiveMinor)
+		if err != nil {
+			return false, "", err
+		}
+		return getHigherVersion(filteredVersions, currentVersion)
+	}
+}
+
+func filterVersionsForPolicy(versions []gardencorev1beta1.ExpirableVersion, currentVersion string, policy gardencorev1beta1.VersionUpdatePolicy, consecutiveMinor bool) ([]gardencorev1beta1.ExpirableVersion, error) {
+	currentSemVerVersion, err := semver.NewVersion(currentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var constraint *semver.Constraints
+	if policy.Constraint != nil {
+		constraint, err = semver.NewConstraint(*policy.Constraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q: %w", *policy.Constraint, err)
+		}
+	}
+
+	maxMinor := uint64(math.MaxUint64)
+	if consecutiveMinor {
+		maxMinor = currentSemVerVersion.Minor() + 1
+	}
+
+	if minorVersionsBehindLatest := uint64(ptr.Deref(policy.MinorVersionsBehindLatest, 0)); minorVersionsBehindLatest > 0 {
+		// stay on the current minor version if there is no supported version at all
+		maxMinorBehindLatest := currentSemVerVersion.Minor()
+
+		found, latestSupportedVersion, err := v1beta1helper.GetLatestQualifyingVersion(versions, v1beta1helper.FilterDifferentMajorVersion(*currentSemVerVersion), v1beta1helper.FilterExpiredVersion(), v1beta1helper.FilterDeprecatedVersion())
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			latestSupportedSemVerVersion, err := semver.NewVersion(latestSupportedVersion.Version)
+			if err != nil {
+				return nil, err
+			}
+			if latestSupportedSemVerVersion.Minor() >= minorVersionsBehindLatest {
+				maxMinorBehindLatest = max(maxMinorBehindLatest, latestSupportedSemVerVersion.Minor()-minorVersionsBehindLatest)
+			}
+		}
+
+		maxMinor = min(maxMinor, maxMinorBehindLatest)
+	}
+
+	var filteredVersions []gardencorev1beta1.ExpirableVersion
+	for _, version := range versions {
+		semVerVersion, err := semver.NewVersion(version.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		if constraint != nil && !constraint.Check(semVerVersion) {
+			continue
+		}
+		if semVerVersion.Major() == currentSemVerVersion.Major() && semVerVersion.Minor() > maxMinor {
+			continue
+		}
+
+		filteredVersions = append(filteredVersions, version)
+	}
+
+	return filteredVersions, nil
+}
+
 func determineVersionForStrategy(expirableVersions []gardencorev1beta1.ExpirableVersion, currentVersion string, getHigherVersionAutoUpdate GetHigherVersion, getHigherVersionForceUpdate GetHigherVersion, isCurrentVersionExpired bool) (string, error) {
 	higherQualifyingVersionFound, latestVersionForMajor, err := getHigherVersionAutoUpdate(expirableVersions, currentVersion)
 	if err != nil {
@@ -985,6 +985,86 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			Expect(err).To(HaveOccurred())
 		})
+
+		Describe("VersionUpdatePolicy", func() {
+			BeforeEach(func() {
+				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions,
+					gardencorev1beta1.MachineImageVersion{
+						ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.1"},
+						CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+						Architectures:    []string{"amd64"},
+					},
+					gardencorev1beta1.MachineImageVersion{
+						ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.4.0"},
+						CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+						Architectures:    []string{"amd64"},
+					},
+				)
+			})
+
+			It("should not update the machine image version if the policy is pinned", func() {
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned}
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
+			})
+
+			It("should update an expired machine image version even if the policy is pinned", func() {
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned}
+				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.1")
+			})
+
+			It("should only update to the latest patch version if the policy is patch", func() {
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPatch}
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.1")
+			})
+
+			It("should only update to versions satisfying the constraint of the policy", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.0.1")
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{
+					Strategy:   gardencorev1beta1.VersionUpdateStrategyMinor,
+					Constraint: ptr.To("< 1.5"),
+				}
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.4.0")
+			})
+
+			It("should stay the configured number of minor versions behind the latest supported version", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.0.1")
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{
+					Strategy:                  gardencorev1beta1.VersionUpdateStrategyMinor,
+					MinorVersionsBehindLatest: ptr.To[int32](1),
+				}
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.4.0")
+			})
+
+			It("should prefer the policy of the worker pool over the policy of the Shoot", func() {
+				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyMinor}
+				shoot.Spec.Provider.Workers[0].AutoUpdate = &gardencorev1beta1.WorkerAutoUpdate{
+					MachineImageVersionPolicy: &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned},
+				}
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-policy", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
+				shoot.Spec.Provider.Workers[1].Machine.Image.Version = ptr.To("1.0.1")
+
+				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				Expect(err).NotTo(HaveOccurred())
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
+				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[1], "CoreOs", overallLatestVersion)
+			})
+		})
 	})
 
 	Describe("#maintainKubernetesVersion", func() {
@@ -1050,7 +1130,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1068,7 +1148,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// mark latest version 1.02 as preview
 			cloudProfile.Spec.Kubernetes.Versions[3].Classification = &previewClassification
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1082,7 +1162,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1096,7 +1176,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1117,7 +1197,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[1].ExpirationDate = &expirationDateInThePast
 			cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = &expirationDateInThePast
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1135,7 +1215,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1147,7 +1227,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1161,7 +1241,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[4].ExpirationDate = &expirationDateInTheFuture
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.1"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1175,7 +1255,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.0"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1189,7 +1269,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.0.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1203,7 +1283,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.Kubernetes.Versions[3].ExpirationDate = &expirationDateInThePast
 			shoot.Spec.Kubernetes = gardencorev1beta1.Kubernetes{Version: "1.1.2"}
 
-			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+			_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, nil), cloudProfile, func(v string) (string, error) {
 				shoot.Spec.Kubernetes.Version = v
 				return v, nil
 			})
@@ -1211,6 +1291,75 @@ var _ = Describe("Shoot Maintenance", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
 		})
+
+		Describe("VersionUpdatePolicy", func() {
+			maintain := func(policy gardencorev1beta1.VersionUpdatePolicy) error {
+				_, err := maintainKubernetesVersion(log, shoot.Spec.Kubernetes.Version, policy, cloudProfile, func(v string) (string, error) {
+					shoot.Spec.Kubernetes.Version = v
+					return v, nil
+				})
+				return err
+			}
+
+			It("should not update the Kubernetes version if the policy is pinned", func() {
+				shoot.Spec.Kubernetes.Version = "1.0.1"
+
+				Expect(maintain(gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned})).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.1"))
+			})
+
+			It("should update to the latest patch version first if the policy is minor", func() {
+				shoot.Spec.Kubernetes.Version = "1.0.1"
+
+				Expect(maintain(gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyMinor})).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+			})
+
+			It("should update to the next minor version if the policy is minor", func() {
+				shoot.Spec.Kubernetes.Version = "1.0.2"
+
+				Expect(maintain(gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyMinor})).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
+			})
+
+			It("should not skip minor versions if the policy is minor", func() {
+				cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions, gardencorev1beta1.ExpirableVersion{Version: "1.2.0"})
+				shoot.Spec.Kubernetes.Version = "1.0.2"
+
+				Expect(maintain(gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyMinor})).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
+			})
+
+			It("should only update to versions satisfying the constraint of the policy", func() {
+				shoot.Spec.Kubernetes.Version = "1.0.2"
+
+				Expect(maintain(gardencorev1beta1.VersionUpdatePolicy{
+					Strategy:   gardencorev1beta1.VersionUpdateStrategyMinor,
+					Constraint: ptr.To("< 1.1.2"),
+				})).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.1"))
+			})
+
+			It("should stay the configured number of minor versions behind the latest supported version", func() {
+				shoot.Spec.Kubernetes.Version = "1.0.2"
+
+				Expect(maintain(gardencorev1beta1.VersionUpdatePolicy{
+					Strategy:                  gardencorev1beta1.VersionUpdateStrategyMinor,
+					MinorVersionsBehindLatest: ptr.To[int32](1),
+				})).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.2"))
+			})
+
+			It("should prefer the policy of the worker pool over the policy of the Shoot", func() {
+				shoot.Spec.Maintenance.AutoUpdate.KubernetesVersionPolicy = &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyPinned}
+				shoot.Spec.Kubernetes.Version = "1.0.2"
+
+				Expect(maintain(kubernetesVersionUpdatePolicy(shoot.Spec.Maintenance.AutoUpdate, &gardencorev1beta1.WorkerAutoUpdate{
+					KubernetesVersionPolicy: &gardencorev1beta1.VersionUpdatePolicy{Strategy: gardencorev1beta1.VersionUpdateStrategyMinor},
+				}))).To(Succeed())
+				Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.1.2"))
+			})
+		})
 	})
 
 	Describe("#maintainFeatureGatesForShoot", func() {
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-controller-manager.
+const Namespace = "gardener_controller_manager"
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	Factory = promauto.With(runtimemetrics.Registry)
+
+	// QuotaAllowed defines the gauge quota_allowed.
+	QuotaAllowed = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "quota_allowed",
+			Help:      "Allowed value of a metric of a Quota.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"scope",
+			"metric",
+		},
+	)
+
+	// QuotaUsed defines the gauge quota_used.
+	QuotaUsed = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "quota_used",
+			Help:      "Consumed value of a metric of a Quota.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"scope",
+			"metric",
+		},
+	)
+)
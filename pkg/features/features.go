@@ -101,6 +101,13 @@ const (
 	// owner: @roncossek
 	// alpha: v1.117.0
 	CloudProfileCapabilities featuregate.Feature = "CloudProfileCapabilities"
+
+	// CoordinatedNodeReboots enables the reboot controller of gardener-node-agent. It coordinates reboots requested by
+	// the operating system across the nodes of a worker pool respecting the pool's `maxUnavailable` and the maintenance
+	// time window of the shoot.
+	// owner: @oliver-goetz
+	// alpha: v1.119.0
+	CoordinatedNodeReboots featuregate.Feature = "CoordinatedNodeReboots"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -140,6 +147,7 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	RemoveAPIServerProxyLegacyPort:           {Default: false, PreRelease: featuregate.Alpha},
 	IstioTLSTermination:                      {Default: false, PreRelease: featuregate.Alpha},
 	CloudProfileCapabilities:                 {Default: false, PreRelease: featuregate.Alpha},
+	CoordinatedNodeReboots:                   {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
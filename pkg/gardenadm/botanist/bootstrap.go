@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
+	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// ReconcileMachineControllerManagerCustomResourceDefinitions reconciles the custom resource definitions of
+// machine-controller-manager. They are needed in the bootstrap cluster since the machines are managed there.
+func (b *AutonomousBotanist) ReconcileMachineControllerManagerCustomResourceDefinitions(ctx context.Context) error {
+	machineCRDDeployer, err := machinecontrollermanager.NewCRD(b.SeedClientSet.Client(), b.SeedClientSet.Applier())
+	if err != nil {
+		return fmt.Errorf("failed creating machine-controller-manager CRD deployer: %w", err)
+	}
+
+	return machineCRDDeployer.Deploy(ctx)
+}
+
+// NewBootstrapGardenerResourceManager returns a deployer for a gardener-resource-manager which reconciles the
+// ManagedResources in the bootstrap cluster, e.g., the ones for the extension controllers.
+func (b *AutonomousBotanist) NewBootstrapGardenerResourceManager() (component.DeployWaiter, error) {
+	return sharedcomponent.NewRuntimeGardenerResourceManager(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.SecretsManager, resourcemanager.Values{
+		RuntimeKubernetesVersion: b.Seed.KubernetesVersion,
+		SecretNameServerCA:       v1beta1constants.SecretNameCACluster,
+	})
+}
+
+// DeployBootstrapCloudProviderSecret deploys the cloud provider secret into the control plane namespace of the
+// bootstrap cluster. If the shoot does not reference any credentials, an empty secret is deployed. This is sufficient
+// for providers which do not need credentials, e.g., provider-local.
+func (b *AutonomousBotanist) DeployBootstrapCloudProviderSecret(ctx context.Context) error {
+	if b.Shoot.Credentials == nil {
+		b.Logger.Info("Shoot does not reference any credentials, deploying empty cloud provider secret")
+		b.Shoot.Credentials = &corev1.Secret{}
+	}
+
+	return b.DeployCloudProviderSecret(ctx)
+}
+
+// ListControlPlaneMachines lists the machines in the control plane namespace of the bootstrap cluster. Since
+// `gardenadm bootstrap` only creates machines for the control plane worker pool, all of them belong to it. The machines
+// are returned as unstructured objects because the vendored machine-controller-manager API types do not contain the
+// `.status.addresses` field yet.
+// TODO: Switch to the typed API once the machine-controller-manager dependency has been upgraded to a version containing
+// the addresses in the machine status.
+func (b *AutonomousBotanist) ListControlPlaneMachines(ctx context.Context) ([]unstructured.Unstructured, error) {
+	machineList := &unstructured.UnstructuredList{}
+	machineList.SetGroupVersionKind(machinev1alpha1.SchemeGroupVersion.WithKind("MachineList"))
+	if err := b.SeedClientSet.Client().List(ctx, machineList, client.InNamespace(b.Shoot.ControlPlaneNamespace)); err != nil {
+		return nil, fmt.Errorf("failed listing machines: %w", err)
+	}
+
+	slices.SortFunc(machineList.Items, func(machineA, machineB unstructured.Unstructured) int {
+		return machineA.GetCreationTimestamp().Compare(machineB.GetCreationTimestamp().Time)
+	})
+
+	return machineList.Items, nil
+}
+
+// DeployControlPlaneMachinesDNSRecord deploys the external DNSRecord for the shoot's API server pointing to the
+// addresses of the control plane machines. If the shoot does not need an external DNSRecord, it is destroyed.
+func (b *AutonomousBotanist) DeployControlPlaneMachinesDNSRecord(ctx context.Context) error {
+	if !b.NeedsExternalDNS() {
+		return b.DestroyExternalDNSRecord(ctx)
+	}
+
+	machines, err := b.ListControlPlaneMachines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var addresses []string
+	for _, machine := range machines {
+		machineAddresses, err := MachineAddresses(machine)
+		if err != nil {
+			return err
+		}
+		addresses = append(addresses, machineAddresses...)
+	}
+
+	if len(addresses) == 0 {
+		return fmt.Errorf("no addresses reported for control plane machines yet")
+	}
+
+	b.Shoot.Components.Extensions.ExternalDNSRecord.SetRecordType(extensionsv1alpha1helper.GetDNSRecordType(addresses[0]))
+	b.Shoot.Components.Extensions.ExternalDNSRecord.SetValues(addresses)
+	return b.DeployOrDestroyExternalDNSRecord(ctx)
+}
+
+// MachineAddresses returns the IP addresses reported by the provider in the status of the given machine. External
+// addresses are preferred over internal ones since `gardenadm bootstrap` usually runs outside the network of the
+// machines. It returns no addresses if the provider has not reported them yet.
+func MachineAddresses(machine unstructured.Unstructured) ([]string, error) {
+	var status struct {
+		Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+	}
+
+	if rawStatus, ok := machine.Object["status"].(map[string]any); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawStatus, &status); err != nil {
+			return nil, fmt.Errorf("failed reading addresses of machine %s: %w", machine.GetName(), err)
+		}
+	}
+
+	for _, addressType := range []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeInternalIP} {
+		var addresses []string
+		for _, address := range status.Addresses {
+			if address.Type == addressType {
+				addresses = append(addresses, address.Address)
+			}
+		}
+
+		if len(addresses) > 0 {
+			return addresses, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// secretNameSSHHostKeypair is the name of the secret containing the SSH host key of the control plane machines.
+const secretNameSSHHostKeypair = "ssh-host-keypair" // #nosec G101 -- No credential.
+
+// DeployBootstrapOperatingSystemConfig generates the SSH host key for the control plane machines and deploys the
+// OperatingSystemConfig. The host key is installed via the user data of the machines, hence `gardenadm bootstrap` can
+// verify the identity of the machine when connecting to it via SSH.
+func (b *AutonomousBotanist) DeployBootstrapOperatingSystemConfig(ctx context.Context) error {
+	hostKeySecret, err := b.SecretsManager.Generate(ctx, &secretsutils.RSASecretConfig{
+		Name:       secretNameSSHHostKeypair,
+		Bits:       4096,
+		UsedForSSH: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed generating SSH host key: %w", err)
+	}
+
+	b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHHostPrivateKey(hostKeySecret.Data[secretsutils.DataKeyRSAPrivateKey])
+	return b.DeployOperatingSystemConfig(ctx)
+}
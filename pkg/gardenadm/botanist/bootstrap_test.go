@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("Bootstrap", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		b *AutonomousBotanist
+	)
+
+	BeforeEach(func() {
+		// The machines are handled as unstructured objects, hence the scheme must not contain the machine API types.
+		// Otherwise, the fake client would drop the fields unknown to them.
+		fakeClient = fakeclient.NewClientBuilder().Build()
+
+		b = &AutonomousBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+				Logger:        logr.Discard(),
+				SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				Shoot:         &shootpkg.Shoot{ControlPlaneNamespace: "shoot--garden--root"},
+			}},
+		}
+	})
+
+	Describe("#ListControlPlaneMachines", func() {
+		It("should return the machines in the control plane namespace sorted by creation timestamp", func() {
+			now := time.Now()
+
+			for _, machine := range []*unstructured.Unstructured{
+				newMachine("machine-b", "shoot--garden--root", now.Add(time.Minute)),
+				newMachine("machine-a", "shoot--garden--root", now),
+				newMachine("machine-c", "other", now),
+			} {
+				Expect(fakeClient.Create(ctx, machine)).To(Succeed())
+			}
+
+			machines, err := b.ListControlPlaneMachines(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machines).To(HaveLen(2))
+			Expect(machines[0].GetName()).To(Equal("machine-a"))
+			Expect(machines[1].GetName()).To(Equal("machine-b"))
+		})
+	})
+
+	Describe("#MachineAddresses", func() {
+		var machine *unstructured.Unstructured
+
+		BeforeEach(func() {
+			machine = newMachine("machine", "shoot--garden--root", time.Now())
+		})
+
+		It("should prefer the external IP addresses", func() {
+			setMachineAddresses(machine,
+				corev1.NodeAddress{Type: corev1.NodeHostName, Address: "machine"},
+				corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+				corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "1.2.3.4"},
+				corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "1.2.3.5"},
+			)
+
+			Expect(MachineAddresses(*machine)).To(Equal([]string{"1.2.3.4", "1.2.3.5"}))
+		})
+
+		It("should return the internal IP addresses if there are no external ones", func() {
+			setMachineAddresses(machine,
+				corev1.NodeAddress{Type: corev1.NodeInternalDNS, Address: "machine.internal"},
+				corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+			)
+
+			Expect(MachineAddresses(*machine)).To(Equal([]string{"10.0.0.2"}))
+		})
+
+		It("should return no addresses if the machine does not report IP addresses yet", func() {
+			Expect(MachineAddresses(*machine)).To(BeEmpty())
+
+			setMachineAddresses(machine, corev1.NodeAddress{Type: corev1.NodeHostName, Address: "machine"})
+			Expect(MachineAddresses(*machine)).To(BeEmpty())
+		})
+
+		It("should fail if the addresses cannot be read", func() {
+			machine.Object["status"] = map[string]any{"addresses": "invalid"}
+
+			_, err := MachineAddresses(*machine)
+			Expect(err).To(MatchError(ContainSubstring("failed reading addresses of machine machine")))
+		})
+	})
+})
+
+func newMachine(name, namespace string, creationTimestamp time.Time) *unstructured.Unstructured {
+	machine := &unstructured.Unstructured{}
+	machine.SetGroupVersionKind(machinev1alpha1.SchemeGroupVersion.WithKind("Machine"))
+	machine.SetName(name)
+	machine.SetNamespace(namespace)
+	machine.SetCreationTimestamp(metav1.NewTime(creationTimestamp))
+	return machine
+}
+
+func setMachineAddresses(machine *unstructured.Unstructured, addresses ...corev1.NodeAddress) {
+	var rawAddresses []any
+	for _, address := range addresses {
+		rawAddresses = append(rawAddresses, map[string]any{"type": string(address.Type), "address": address.Address})
+	}
+	ExpectWithOffset(1, unstructured.SetNestedSlice(machine.Object, rawAddresses, "status", "addresses")).To(Succeed())
+}
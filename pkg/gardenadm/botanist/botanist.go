@@ -22,6 +22,7 @@ import (
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
@@ -44,6 +45,7 @@ type AutonomousBotanist struct {
 	Extensions []Extension
 
 	operatingSystemConfigSecret *corev1.Secret
+	operatingSystemConfig       *extensionsv1alpha1.OperatingSystemConfig
 }
 
 // Extension contains the resources needed for an extension registration.
@@ -53,30 +55,39 @@ type Extension struct {
 	ControllerInstallation *gardencorev1beta1.ControllerInstallation
 }
 
+// AutonomousBotanistOptions contains the options for constructing an AutonomousBotanist.
+type AutonomousBotanistOptions struct {
+	// ClientSet is the client set for the cluster in which the resources are deployed. If nil, a fake client set is
+	// used.
+	ClientSet kubernetes.Interface
+	// Project is the project of the autonomous shoot cluster.
+	Project *gardencorev1beta1.Project
+	// CloudProfile is the cloud profile referenced by the autonomous shoot cluster.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// Shoot is the autonomous shoot cluster.
+	Shoot *gardencorev1beta1.Shoot
+	// Extensions are the extensions needed for the autonomous shoot cluster.
+	Extensions []Extension
+	// RunsControlPlane specifies whether the control plane runs on the machines of the autonomous shoot cluster
+	// itself. In this case, the control plane namespace is kube-system. Otherwise, the shoot's technical ID is used as
+	// control plane namespace, e.g., when deploying the extension resources into a bootstrap cluster via
+	// `gardenadm bootstrap`.
+	RunsControlPlane bool
+}
+
 // NewAutonomousBotanist creates a new botanist.AutonomousBotanist instance for the gardenadm command execution.
-func NewAutonomousBotanist(
-	ctx context.Context,
-	log logr.Logger,
-	clientSet kubernetes.Interface,
-	project *gardencorev1beta1.Project,
-	cloudProfile *gardencorev1beta1.CloudProfile,
-	shoot *gardencorev1beta1.Shoot,
-	extensions []Extension,
-) (
-	*AutonomousBotanist,
-	error,
-) {
+func NewAutonomousBotanist(ctx context.Context, log logr.Logger, opts AutonomousBotanistOptions) (*AutonomousBotanist, error) {
 	hostName, err := nodeagent.GetHostName()
 	if err != nil {
 		return nil, fmt.Errorf("failed fetching hostname: %w", err)
 	}
 
-	gardenObj, err := newGardenObject(ctx, project)
+	gardenObj, err := newGardenObject(ctx, opts.Project)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating garden object: %w", err)
 	}
 
-	shootObj, err := newShootObject(ctx, project.Name, cloudProfile, shoot)
+	shootObj, err := newShootObject(ctx, opts.Project.Name, opts.CloudProfile, opts.Shoot, opts.RunsControlPlane)
 	if err != nil {
 		return nil, fmt.Errorf("failed creating shoot object: %w", err)
 	}
@@ -86,7 +97,8 @@ func NewAutonomousBotanist(
 		return nil, fmt.Errorf("failed creating seed object: %w", err)
 	}
 
-	keysAndValues := []any{"cloudProfile", cloudProfile, "project", project, "shoot", shoot}
+	clientSet := opts.ClientSet
+	keysAndValues := []any{"cloudProfile", opts.CloudProfile, "project", opts.Project, "shoot", opts.Shoot}
 	if clientSet == nil {
 		clientSet = newFakeSeedClientSet(seedObj.KubernetesVersion.String())
 		log.Info("Initializing autonomous botanist with fake client set", keysAndValues...) //nolint:logcheck
@@ -113,7 +125,7 @@ func NewAutonomousBotanist(
 		HostName:   hostName,
 		DBus:       dbus.New(log),
 		FS:         afero.Afero{Fs: afero.NewOsFs()},
-		Extensions: extensions,
+		Extensions: opts.Extensions,
 	}
 
 	if err := autonomousBotanist.initializeFakeGardenResources(ctx); err != nil {
@@ -123,11 +135,33 @@ func NewAutonomousBotanist(
 	return autonomousBotanist, nil
 }
 
+// NewAutonomousBotanistWithoutResources creates a new botanist.AutonomousBotanist instance which does not know about
+// the Gardener resources (CloudProfile, Project, Shoot, etc.) of the autonomous shoot cluster. It can be used for
+// commands which only operate on the node, e.g., `gardenadm join`.
+func NewAutonomousBotanistWithoutResources(log logr.Logger) (*AutonomousBotanist, error) {
+	hostName, err := nodeagent.GetHostName()
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching hostname: %w", err)
+	}
+
+	return &AutonomousBotanist{
+		Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: log}},
+
+		HostName: hostName,
+		DBus:     dbus.New(log),
+		FS:       afero.Afero{Fs: afero.NewOsFs()},
+	}, nil
+}
+
 func (b *AutonomousBotanist) initializeFakeGardenResources(ctx context.Context) error {
 	if err := b.GardenClient.Create(ctx, b.Seed.GetInfo().DeepCopy()); client.IgnoreAlreadyExists(err) != nil {
 		return fmt.Errorf("failed creating Seed %s: %w", b.Seed.GetInfo().Name, err)
 	}
 
+	if err := b.GardenClient.Create(ctx, b.Shoot.GetInfo().DeepCopy()); client.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("failed creating Shoot %s: %w", client.ObjectKeyFromObject(b.Shoot.GetInfo()), err)
+	}
+
 	for _, extension := range b.Extensions {
 		if err := b.GardenClient.Create(ctx, extension.ControllerRegistration.DeepCopy()); client.IgnoreAlreadyExists(err) != nil {
 			return fmt.Errorf("failed creating ControllerRegistration %s: %w", extension.ControllerRegistration.Name, err)
@@ -174,7 +208,7 @@ func newSeedObject(ctx context.Context, shootObj *shootpkg.Shoot) (*seedpkg.Seed
 	return obj, nil
 }
 
-func newShootObject(ctx context.Context, projectName string, cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot) (*shootpkg.Shoot, error) {
+func newShootObject(ctx context.Context, projectName string, cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot, runsControlPlane bool) (*shootpkg.Shoot, error) {
 	shoot.Status.TechnicalID = gardenerutils.ComputeTechnicalID(projectName, shoot)
 	shoot.Status.Gardener = gardencorev1beta1.Gardener{Name: "gardenadm", Version: version.Get().GitVersion}
 	// TODO(rfranzke): This UID is used to compute the name of the BackupEntry object. Consider persisting this random
@@ -199,6 +233,10 @@ func newShootObject(ctx context.Context, projectName string, cloudProfile *garde
 	}
 
 	obj.ControlPlaneNamespace = metav1.NamespaceSystem
+	if !runsControlPlane {
+		obj.ControlPlaneNamespace = shoot.Status.TechnicalID
+	}
+
 	return obj, nil
 }
 
@@ -206,7 +244,7 @@ func newFakeGardenClient() client.Client {
 	return fakeclient.
 		NewClientBuilder().
 		WithScheme(kubernetes.GardenScheme).
-		WithStatusSubresource(&gardencorev1beta1.ControllerInstallation{}).
+		WithStatusSubresource(&gardencorev1beta1.ControllerInstallation{}, &gardencorev1beta1.Shoot{}).
 		Build()
 }
 
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// clusterInfoRoleName is the name of the Role and RoleBinding which allow anonymous users to read the cluster-info
+// ConfigMap.
+const clusterInfoRoleName = "gardener.cloud:gardenadm:cluster-info"
+
+// PublishClusterInfo publishes the address and the certificate authority bundle of the control plane in the
+// cluster-info ConfigMap in the kube-public namespace and allows anonymous users to read it. The bootstrap signer of
+// kube-controller-manager adds a signature for each bootstrap token to the ConfigMap, which allows `gardenadm join` to
+// discover and verify the certificate authority bundle based on the bootstrap token.
+func (b *AutonomousBotanist) PublishClusterInfo(ctx context.Context) error {
+	caSecret, ok := b.SecretsManager.Get(v1beta1constants.SecretNameCACluster)
+	if !ok {
+		return fmt.Errorf("failed to retrieve cluster CA secret")
+	}
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, &clientcmdv1.Config{
+		Clusters: []clientcmdv1.NamedCluster{{
+			Cluster: clientcmdv1.Cluster{
+				Server:                   "https://" + b.Shoot.ComputeOutOfClusterAPIServerAddress(false),
+				CertificateAuthorityData: caSecret.Data[secretsutils.DataKeyCertificateBundle],
+			},
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed encoding kubeconfig for cluster-info: %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: bootstraptokenapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, b.SeedClientSet.Client(), configMap, func() error {
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[bootstraptokenapi.KubeConfigKey] = string(kubeconfig)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: clusterInfoRoleName, Namespace: metav1.NamespacePublic}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, b.SeedClientSet.Client(), role, func() error {
+		role.Rules = []rbacv1.PolicyRule{{
+			APIGroups:     []string{""},
+			Resources:     []string{"configmaps"},
+			ResourceNames: []string{bootstraptokenapi.ConfigMapClusterInfo},
+			Verbs:         []string{"get"},
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling Role %s: %w", client.ObjectKeyFromObject(role), err)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterInfoRoleName, Namespace: metav1.NamespacePublic}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, b.SeedClientSet.Client(), roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		}
+		roleBinding.Subjects = []rbacv1.Subject{{
+			APIGroup: rbacv1.GroupName,
+			Kind:     rbacv1.UserKind,
+			Name:     user.Anonymous,
+		}}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reconciling RoleBinding %s: %w", client.ObjectKeyFromObject(roleBinding), err)
+	}
+
+	return nil
+}
+
+// NewClientSetForClusterInfo creates a client set for the control plane which neither authenticates nor verifies the
+// serving certificate of the API server. It must only be used for reading the cluster-info ConfigMap whose content is
+// verified with VerifyClusterInfo.
+func NewClientSetForClusterInfo(controlPlaneAddress string) (kubernetes.Interface, error) {
+	return kubernetes.NewWithConfig(
+		kubernetes.WithRESTConfig(&rest.Config{
+			Host:            controlPlaneAddress,
+			TLSClientConfig: rest.TLSClientConfig{Insecure: true},
+		}),
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.SeedScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+}
+
+// DiscoverCertificateAuthority reads the cluster-info ConfigMap with the given client and returns the certificate
+// authority bundle of the control plane after verifying the signature of the ConfigMap for the given bootstrap token.
+func DiscoverCertificateAuthority(ctx context.Context, c client.Reader, bootstrapToken string) ([]byte, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: bootstraptokenapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}, configMap); err != nil {
+		return nil, fmt.Errorf("failed reading ConfigMap %s/%s: %w", metav1.NamespacePublic, bootstraptokenapi.ConfigMapClusterInfo, err)
+	}
+
+	return VerifyClusterInfo(configMap, bootstrapToken)
+}
+
+// VerifyClusterInfo verifies the signature of the given cluster-info ConfigMap for the given bootstrap token and returns
+// the certificate authority bundle contained in the kubeconfig of the ConfigMap.
+func VerifyClusterInfo(configMap *corev1.ConfigMap, bootstrapToken string) ([]byte, error) {
+	if !bootstraptokenutil.IsValidBootstrapToken(bootstrapToken) {
+		return nil, fmt.Errorf("bootstrap token is invalid")
+	}
+	tokenID, tokenSecret, _ := strings.Cut(bootstrapToken, ".")
+
+	kubeconfig, ok := configMap.Data[bootstraptokenapi.KubeConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("no %s key found in ConfigMap %s", bootstraptokenapi.KubeConfigKey, client.ObjectKeyFromObject(configMap))
+	}
+
+	signature, ok := configMap.Data[bootstraptokenapi.JWSSignatureKeyPrefix+tokenID]
+	if !ok {
+		return nil, fmt.Errorf("ConfigMap %s has not been signed for bootstrap token with ID %q yet", client.ObjectKeyFromObject(configMap), tokenID)
+	}
+
+	if err := verifyDetachedSignature(signature, kubeconfig, tokenID, tokenSecret); err != nil {
+		return nil, fmt.Errorf("failed verifying signature of ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	config, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed loading kubeconfig from ConfigMap %s: %w", client.ObjectKeyFromObject(configMap), err)
+	}
+
+	for _, cluster := range config.Clusters {
+		if len(cluster.CertificateAuthorityData) > 0 {
+			return cluster.CertificateAuthorityData, nil
+		}
+	}
+
+	return nil, fmt.Errorf("kubeconfig in ConfigMap %s does not contain a certificate authority bundle", client.ObjectKeyFromObject(configMap))
+}
+
+// verifyDetachedSignature verifies a JWS with detached payload (i.e., "<header>..<signature>") as computed by the
+// bootstrap signer of kube-controller-manager. The signature is a HMAC-SHA256 whose key is the secret of the bootstrap
+// token.
+func verifyDetachedSignature(detachedSignature, content, tokenID, tokenSecret string) error {
+	parts := strings.Split(detachedSignature, ".")
+	if len(parts) != 3 || parts[1] != "" {
+		return fmt.Errorf("signature is not a JWS with detached payload")
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("failed decoding JWS header: %w", err)
+	}
+
+	header := struct {
+		Algorithm string `json:"alg"`
+		KeyID     string `json:"kid"`
+	}{}
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return fmt.Errorf("failed unmarshalling JWS header: %w", err)
+	}
+	if header.Algorithm != "HS256" {
+		return fmt.Errorf("unsupported JWS algorithm %q", header.Algorithm)
+	}
+	if header.KeyID != tokenID {
+		return fmt.Errorf("JWS was signed for bootstrap token with ID %q", header.KeyID)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("failed decoding JWS signature: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(tokenSecret))
+	mac.Write([]byte(parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(content))))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return fmt.Errorf("JWS signature does not match")
+	}
+
+	return nil
+}
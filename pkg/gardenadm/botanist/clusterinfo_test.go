@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+)
+
+var _ = Describe("ClusterInfo", func() {
+	const (
+		bootstrapToken = "abcdef.0123456789abcdef"
+		kubeconfig     = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: Y2EtYnVuZGxl
+    server: https://api.foo.gardenadm.local
+  name: ""
+contexts: null
+current-context: ""
+kind: Config
+preferences: {}
+users: null
+`
+		// signature is the detached JWS of kubeconfig for bootstrapToken as computed by the bootstrap signer of
+		// kube-controller-manager.
+		signature = "eyJhbGciOiJIUzI1NiIsImtpZCI6ImFiY2RlZiJ9..QfADTZ53bHc1c0Bf2J9Ms4UpUwrk4TA9Cq2ukdhh374"
+	)
+
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-info", Namespace: "kube-public"},
+			Data: map[string]string{
+				"kubeconfig":            kubeconfig,
+				"jws-kubeconfig-abcdef": signature,
+			},
+		}
+	})
+
+	Describe("#PublishClusterInfo", func() {
+		var b *AutonomousBotanist
+
+		BeforeEach(func() {
+			b = &AutonomousBotanist{
+				Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+					Logger:         logr.Discard(),
+					SeedClientSet:  fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+					SecretsManager: fakesecretsmanager.New(fakeClient, "kube-system"),
+					Shoot:          &shoot.Shoot{ExternalClusterDomain: ptr.To("foo.gardenadm.local")},
+				}},
+			}
+			b.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "kube-system"},
+				Data:       map[string][]byte{"bundle.crt": []byte("ca-bundle")},
+			})).To(Succeed())
+		})
+
+		It("should publish the cluster-info ConfigMap and allow anonymous users to read it", func() {
+			Expect(b.PublishClusterInfo(ctx)).To(Succeed())
+
+			actual := &corev1.ConfigMap{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), actual)).To(Succeed())
+			Expect(actual.Data).To(Equal(map[string]string{"kubeconfig": kubeconfig}))
+
+			role := &rbacv1.Role{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardener.cloud:gardenadm:cluster-info", Namespace: "kube-public"}, role)).To(Succeed())
+			Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+				APIGroups:     []string{""},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{"cluster-info"},
+				Verbs:         []string{"get"},
+			}))
+
+			roleBinding := &rbacv1.RoleBinding{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "gardener.cloud:gardenadm:cluster-info", Namespace: "kube-public"}, roleBinding)).To(Succeed())
+			Expect(roleBinding.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: "gardener.cloud:gardenadm:cluster-info"}))
+			Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: "User", Name: "system:anonymous"}))
+		})
+
+		It("should keep the signatures added by the bootstrap signer", func() {
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(b.PublishClusterInfo(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(HaveKeyWithValue("jws-kubeconfig-abcdef", signature))
+		})
+	})
+
+	Describe("#DiscoverCertificateAuthority", func() {
+		It("should return the certificate authority bundle", func() {
+			Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(DiscoverCertificateAuthority(ctx, fakeClient, bootstrapToken)).To(Equal([]byte("ca-bundle")))
+		})
+
+		It("should fail if the ConfigMap does not exist", func() {
+			_, err := DiscoverCertificateAuthority(ctx, fakeClient, bootstrapToken)
+			Expect(err).To(MatchError(ContainSubstring("failed reading ConfigMap kube-public/cluster-info")))
+		})
+	})
+
+	Describe("#VerifyClusterInfo", func() {
+		It("should return the certificate authority bundle if the signature is valid", func() {
+			Expect(VerifyClusterInfo(configMap, bootstrapToken)).To(Equal([]byte("ca-bundle")))
+		})
+
+		It("should fail if the ConfigMap has not been signed for the token", func() {
+			_, err := VerifyClusterInfo(configMap, "foo123.0123456789abcdef")
+			Expect(err).To(MatchError(ContainSubstring(`has not been signed for bootstrap token with ID "foo123" yet`)))
+		})
+
+		It("should fail if the token secret does not match", func() {
+			_, err := VerifyClusterInfo(configMap, "abcdef.fedcba9876543210")
+			Expect(err).To(MatchError(ContainSubstring("JWS signature does not match")))
+		})
+
+		It("should fail if the kubeconfig has been tampered with", func() {
+			configMap.Data["kubeconfig"] = kubeconfig + "# foo\n"
+
+			_, err := VerifyClusterInfo(configMap, bootstrapToken)
+			Expect(err).To(MatchError(ContainSubstring("JWS signature does not match")))
+		})
+
+		It("should fail if the signature was computed for another token", func() {
+			configMap.Data["jws-kubeconfig-foo123"] = signature
+
+			_, err := VerifyClusterInfo(configMap, "foo123.0123456789abcdef")
+			Expect(err).To(MatchError(ContainSubstring(`JWS was signed for bootstrap token with ID "abcdef"`)))
+		})
+
+		It("should fail if the signature does not have a detached payload", func() {
+			configMap.Data["jws-kubeconfig-abcdef"] = "foo.bar.baz"
+
+			_, err := VerifyClusterInfo(configMap, bootstrapToken)
+			Expect(err).To(MatchError(ContainSubstring("signature is not a JWS with detached payload")))
+		})
+
+		It("should fail if the bootstrap token is invalid", func() {
+			_, err := VerifyClusterInfo(configMap, "foo")
+			Expect(err).To(MatchError(ContainSubstring("bootstrap token is invalid")))
+		})
+	})
+})
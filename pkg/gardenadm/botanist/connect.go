@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/charts"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/seedmanagement/encoding"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controller/gardenletdeployer"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+)
+
+// EnsureProjectInGarden creates the Project of the autonomous shoot cluster in the garden cluster if it does not exist
+// yet.
+func (b *AutonomousBotanist) EnsureProjectInGarden(ctx context.Context, gardenClient client.Client) error {
+	project := b.Garden.Project
+
+	if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(project), &gardencorev1beta1.Project{}); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading project %s: %w", project.Name, err)
+		}
+
+		b.Logger.Info("Creating project in garden cluster", "projectName", project.Name)
+		if err := gardenClient.Create(ctx, &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        project.Name,
+				Labels:      project.Labels,
+				Annotations: project.Annotations,
+			},
+			Spec: project.Spec,
+		}); err != nil {
+			return fmt.Errorf("failed creating project %s: %w", project.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// WaitUntilProjectReadyInGarden returns an error if the Project of the autonomous shoot cluster in the garden cluster
+// is not ready yet. It is meant to be retried.
+func (b *AutonomousBotanist) WaitUntilProjectReadyInGarden(ctx context.Context, gardenClient client.Client) error {
+	project := &gardencorev1beta1.Project{}
+	if err := gardenClient.Get(ctx, client.ObjectKey{Name: b.Garden.Project.Name}, project); err != nil {
+		return fmt.Errorf("failed reading project %s: %w", b.Garden.Project.Name, err)
+	}
+
+	if project.Status.Phase != gardencorev1beta1.ProjectReady {
+		return fmt.Errorf("project %s is not ready yet (phase: %q)", project.Name, project.Status.Phase)
+	}
+
+	return nil
+}
+
+// DeployGardenlet deploys gardenlet into the autonomous shoot cluster. The gardenlet uses a bootstrap token created
+// with the given garden client set for registering the Seed of the autonomous shoot cluster in the garden cluster.
+func (b *AutonomousBotanist) DeployGardenlet(ctx context.Context, gardenClientSet kubernetes.Interface) error {
+	gardenlet, err := b.newGardenlet()
+	if err != nil {
+		return fmt.Errorf("failed computing gardenlet configuration: %w", err)
+	}
+
+	actuator := b.newGardenletDeployer(gardenClientSet)
+	if _, err := actuator.Reconcile(
+		ctx,
+		b.Logger,
+		gardenlet,
+		nil,
+		&gardenlet.Spec.Deployment.GardenletDeployment,
+		&gardenlet.Spec.Config,
+		seedmanagementv1alpha1.BootstrapToken,
+		false,
+	); err != nil {
+		return fmt.Errorf("failed deploying gardenlet: %w", err)
+	}
+
+	return nil
+}
+
+// WaitUntilSeedRegisteredInGarden returns an error if gardenlet has not yet registered the Seed of the autonomous
+// shoot cluster in the garden cluster. It is meant to be retried.
+func (b *AutonomousBotanist) WaitUntilSeedRegisteredInGarden(ctx context.Context, gardenClient client.Client) error {
+	seed, err := gardenletdeployer.GetSeed(ctx, gardenClient, b.Seed.GetInfo().Name)
+	if err != nil {
+		return fmt.Errorf("failed reading seed %s: %w", b.Seed.GetInfo().Name, err)
+	}
+
+	if seed == nil {
+		return fmt.Errorf("seed %s has not yet been registered by gardenlet", b.Seed.GetInfo().Name)
+	}
+
+	return nil
+}
+
+// RegisterShootInGarden creates the Shoot of the autonomous shoot cluster in the garden cluster if it does not exist
+// yet. The Shoot is assigned to the Seed of the autonomous shoot cluster itself. Its technical ID is set to the namespace
+// in which the control plane actually runs (i.e., kube-system) so that gardenlet reconciles the existing control plane.
+func (b *AutonomousBotanist) RegisterShootInGarden(ctx context.Context, gardenClient client.Client) error {
+	shoot := b.Shoot.GetInfo()
+
+	gardenShoot := &gardencorev1beta1.Shoot{}
+	if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), gardenShoot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+
+		gardenShoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        shoot.Name,
+				Namespace:   shoot.Namespace,
+				Labels:      shoot.Labels,
+				Annotations: shoot.Annotations,
+			},
+			Spec: *shoot.Spec.DeepCopy(),
+		}
+		gardenShoot.Spec.SeedName = ptr.To(b.Seed.GetInfo().Name)
+
+		b.Logger.Info("Creating shoot in garden cluster", "shoot", client.ObjectKeyFromObject(gardenShoot))
+		if err := gardenClient.Create(ctx, gardenShoot); err != nil {
+			return fmt.Errorf("failed creating shoot %s: %w", client.ObjectKeyFromObject(gardenShoot), err)
+		}
+	}
+
+	patch := client.MergeFrom(gardenShoot.DeepCopy())
+	gardenShoot.Status.TechnicalID = b.Shoot.ControlPlaneNamespace
+	gardenShoot.Status.Gardener = shoot.Status.Gardener
+	if err := gardenClient.Status().Patch(ctx, gardenShoot, patch); err != nil {
+		return fmt.Errorf("failed patching status of shoot %s: %w", client.ObjectKeyFromObject(gardenShoot), err)
+	}
+
+	return nil
+}
+
+// MigrateShootStateToGarden computes the ShootState (e.g., the secrets which must be persisted) from the control plane
+// namespace of the autonomous shoot cluster and deploys it into the garden cluster. It must be called after
+// RegisterShootInGarden so that the ShootState is not orphaned if the Shoot cannot be registered.
+func (b *AutonomousBotanist) MigrateShootStateToGarden(ctx context.Context, gardenClient client.Client) error {
+	shoot := b.Shoot.GetInfo()
+
+	if err := gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), &gardencorev1beta1.Shoot{}); err != nil {
+		return fmt.Errorf("failed reading shoot %s, it must be registered before its state is migrated: %w", client.ObjectKeyFromObject(shoot), err)
+	}
+
+	return shootstate.DeployFromNamespace(ctx, clock.RealClock{}, gardenClient, b.SeedClientSet.Client(), shoot, b.Shoot.ControlPlaneNamespace, true)
+}
+
+func (b *AutonomousBotanist) newGardenletDeployer(gardenClientSet kubernetes.Interface) gardenletdeployer.Interface {
+	return &gardenletdeployer.Actuator{
+		GardenConfig: gardenClientSet.RESTConfig(),
+		GardenClient: gardenClientSet.Client(),
+		GetTargetClientFunc: func(_ context.Context) (kubernetes.Interface, error) {
+			return b.SeedClientSet, nil
+		},
+		CheckIfVPAAlreadyExists: func(ctx context.Context) (bool, error) {
+			if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.ControlPlaneNamespace, Name: v1beta1constants.DeploymentNameVPAAdmissionController}, &appsv1.Deployment{}); err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		},
+		GetInfrastructureSecret: func(_ context.Context) (*corev1.Secret, error) {
+			return nil, nil
+		},
+		GetTargetDomain: func() string {
+			if b.Shoot.GetInfo().Spec.DNS == nil {
+				return ""
+			}
+			return ptr.Deref(b.Shoot.GetInfo().Spec.DNS.Domain, "")
+		},
+		ApplyGardenletChart: func(ctx context.Context, targetChartApplier kubernetes.ChartApplier, values map[string]any) error {
+			return targetChartApplier.ApplyFromEmbeddedFS(ctx, charts.ChartGardenlet, charts.ChartPathGardenlet, v1beta1constants.GardenNamespace, "gardenlet", kubernetes.Values(values))
+		},
+		DeleteGardenletChart: func(ctx context.Context, targetChartApplier kubernetes.ChartApplier, values map[string]any) error {
+			return targetChartApplier.DeleteFromEmbeddedFS(ctx, charts.ChartGardenlet, charts.ChartPathGardenlet, v1beta1constants.GardenNamespace, "gardenlet", kubernetes.Values(values))
+		},
+		Clock:                 clock.RealClock{},
+		ValuesHelper:          gardenletdeployer.NewValuesHelper(nil),
+		Recorder:              &record.FakeRecorder{},
+		GardenNamespaceTarget: v1beta1constants.GardenNamespace,
+	}
+}
+
+func (b *AutonomousBotanist) newGardenlet() (*seedmanagementv1alpha1.Gardenlet, error) {
+	rawConfig, err := encoding.EncodeGardenletConfiguration(&gardenletconfigv1alpha1.GardenletConfiguration{
+		SeedConfig: &gardenletconfigv1alpha1.SeedConfig{
+			SeedTemplate: gardencorev1beta1.SeedTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: b.Seed.GetInfo().Labels,
+				},
+				Spec: SeedSpecForShoot(b.Shoot.GetInfo()),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding gardenlet configuration: %w", err)
+	}
+
+	gardenlet := &seedmanagementv1alpha1.Gardenlet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.Seed.GetInfo().Name,
+			Namespace: v1beta1constants.GardenNamespace,
+		},
+		Spec: seedmanagementv1alpha1.GardenletSpec{
+			Deployment: seedmanagementv1alpha1.GardenletSelfDeployment{
+				GardenletDeployment: seedmanagementv1alpha1.GardenletDeployment{
+					ReplicaCount: ptr.To[int32](1),
+				},
+			},
+			Config: *rawConfig,
+		},
+	}
+	kubernetes.GardenScheme.Default(gardenlet)
+
+	return gardenlet, nil
+}
+
+// SeedSpecForShoot computes the specification of the Seed for the given autonomous shoot cluster. The Seed is not
+// visible for the scheduler since it is only supposed to host the control plane of the autonomous shoot cluster
+// itself. The ingress settings are derived from the primary DNS provider of the shoot, if configured.
+func SeedSpecForShoot(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.SeedSpec {
+	spec := gardencorev1beta1.SeedSpec{
+		Provider: gardencorev1beta1.SeedProvider{
+			Type:   shoot.Spec.Provider.Type,
+			Region: shoot.Spec.Region,
+		},
+		Settings: &gardencorev1beta1.SeedSettings{
+			Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: false},
+		},
+	}
+
+	if controlPlaneWorkerPool := v1beta1helper.ControlPlaneWorkerPoolForShoot(shoot); controlPlaneWorkerPool != nil {
+		spec.Provider.Zones = controlPlaneWorkerPool.Zones
+	}
+
+	if networking := shoot.Spec.Networking; networking != nil {
+		spec.Networks = gardencorev1beta1.SeedNetworks{
+			Nodes:    networking.Nodes,
+			Pods:     ptr.Deref(networking.Pods, ""),
+			Services: ptr.Deref(networking.Services, ""),
+		}
+	}
+
+	if dns := shoot.Spec.DNS; dns != nil && dns.Domain != nil {
+		if primaryProvider := v1beta1helper.FindPrimaryDNSProvider(dns.Providers); primaryProvider != nil && primaryProvider.Type != nil && primaryProvider.SecretName != nil {
+			spec.DNS.Provider = &gardencorev1beta1.SeedDNSProvider{
+				Type:      *primaryProvider.Type,
+				SecretRef: corev1.SecretReference{Name: *primaryProvider.SecretName, Namespace: shoot.Namespace},
+			}
+			spec.Ingress = &gardencorev1beta1.Ingress{
+				Domain:     "ingress." + *dns.Domain,
+				Controller: gardencorev1beta1.IngressController{Kind: v1beta1constants.IngressKindNginx},
+			}
+		}
+	}
+
+	return spec
+}
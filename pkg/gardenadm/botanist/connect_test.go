@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
+	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Connect", func() {
+	var (
+		ctx          context.Context
+		gardenClient client.Client
+
+		project *gardencorev1beta1.Project
+		shoot   *gardencorev1beta1.Shoot
+
+		b *AutonomousBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gardenClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}, &gardencorev1beta1.Shoot{}).
+			Build()
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Region: "local",
+				Provider: gardencorev1beta1.Provider{
+					Type: "local",
+					Workers: []gardencorev1beta1.Worker{{
+						Name:         "control-plane",
+						ControlPlane: &gardencorev1beta1.WorkerControlPlane{},
+						Zones:        []string{"1"},
+					}},
+				},
+				Networking: &gardencorev1beta1.Networking{
+					Nodes:    ptr.To("10.0.0.0/16"),
+					Pods:     ptr.To("10.1.0.0/16"),
+					Services: ptr.To("10.2.0.0/16"),
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID: "shoot--foo--bar",
+				Gardener:    gardencorev1beta1.Gardener{Name: "gardenadm"},
+			},
+		}
+
+		b = &AutonomousBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Garden: &garden.Garden{Project: project},
+					Seed:   &seedpkg.Seed{},
+					Shoot:  &shootpkg.Shoot{ControlPlaneNamespace: "kube-system"},
+				},
+			},
+		}
+		b.Seed.SetInfo(&gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})
+		b.Shoot.SetInfo(shoot)
+	})
+
+	Describe("#EnsureProjectInGarden", func() {
+		It("should create the project if it does not exist", func() {
+			Expect(b.EnsureProjectInGarden(ctx, gardenClient)).To(Succeed())
+
+			actual := &gardencorev1beta1.Project{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "foo"}, actual)).To(Succeed())
+			Expect(actual.Spec.Namespace).To(Equal(ptr.To("garden-foo")))
+		})
+
+		It("should not touch the project if it already exists", func() {
+			existing := &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-other")},
+			}
+			Expect(gardenClient.Create(ctx, existing)).To(Succeed())
+
+			Expect(b.EnsureProjectInGarden(ctx, gardenClient)).To(Succeed())
+
+			actual := &gardencorev1beta1.Project{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "foo"}, actual)).To(Succeed())
+			Expect(actual.Spec.Namespace).To(Equal(ptr.To("garden-other")))
+		})
+	})
+
+	Describe("#WaitUntilProjectReadyInGarden", func() {
+		It("should fail if the project is not ready", func() {
+			Expect(gardenClient.Create(ctx, project.DeepCopy())).To(Succeed())
+
+			Expect(b.WaitUntilProjectReadyInGarden(ctx, gardenClient)).To(MatchError(ContainSubstring("is not ready yet")))
+		})
+
+		It("should succeed if the project is ready", func() {
+			readyProject := project.DeepCopy()
+			Expect(gardenClient.Create(ctx, readyProject)).To(Succeed())
+			readyProject.Status.Phase = gardencorev1beta1.ProjectReady
+			Expect(gardenClient.Status().Update(ctx, readyProject)).To(Succeed())
+
+			Expect(b.WaitUntilProjectReadyInGarden(ctx, gardenClient)).To(Succeed())
+		})
+	})
+
+	Describe("#WaitUntilSeedRegisteredInGarden", func() {
+		It("should fail if the seed does not exist", func() {
+			Expect(b.WaitUntilSeedRegisteredInGarden(ctx, gardenClient)).To(MatchError(ContainSubstring("has not yet been registered")))
+		})
+
+		It("should succeed if the seed exists", func() {
+			Expect(gardenClient.Create(ctx, &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})).To(Succeed())
+
+			Expect(b.WaitUntilSeedRegisteredInGarden(ctx, gardenClient)).To(Succeed())
+		})
+	})
+
+	Describe("#RegisterShootInGarden", func() {
+		It("should create the shoot assigned to its own seed and set the technical ID to the control plane namespace", func() {
+			Expect(b.RegisterShootInGarden(ctx, gardenClient)).To(Succeed())
+
+			actual := &gardencorev1beta1.Shoot{}
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), actual)).To(Succeed())
+			Expect(actual.Spec.SeedName).To(Equal(ptr.To("bar")))
+			Expect(actual.Spec.Region).To(Equal("local"))
+			Expect(actual.Status.TechnicalID).To(Equal("kube-system"))
+			Expect(actual.Status.Gardener.Name).To(Equal("gardenadm"))
+		})
+
+		It("should keep the status of an existing shoot", func() {
+			existing := shoot.DeepCopy()
+			existing.Status = gardencorev1beta1.ShootStatus{}
+			Expect(gardenClient.Create(ctx, existing)).To(Succeed())
+			existing.Status.Conditions = []gardencorev1beta1.Condition{{Type: "APIServerAvailable", Status: "True"}}
+			Expect(gardenClient.Status().Update(ctx, existing)).To(Succeed())
+
+			Expect(b.RegisterShootInGarden(ctx, gardenClient)).To(Succeed())
+
+			actual := &gardencorev1beta1.Shoot{}
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), actual)).To(Succeed())
+			Expect(actual.Status.TechnicalID).To(Equal("kube-system"))
+			Expect(actual.Status.Conditions).To(ConsistOf(HaveField("Type", gardencorev1beta1.ConditionType("APIServerAvailable"))))
+		})
+	})
+
+	Describe("#MigrateShootStateToGarden", func() {
+		BeforeEach(func() {
+			seedClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			b.SeedClientSet = fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build()
+
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "kube-system", Labels: map[string]string{"persist": "true"}},
+				Data:       map[string][]byte{"ca.crt": []byte("ca")},
+			})).To(Succeed())
+		})
+
+		It("should fail if the shoot is not registered yet", func() {
+			Expect(b.MigrateShootStateToGarden(ctx, gardenClient)).To(MatchError(ContainSubstring("it must be registered before its state is migrated")))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), &gardencorev1beta1.ShootState{})).To(BeNotFoundError())
+		})
+
+		It("should deploy the ShootState", func() {
+			Expect(b.RegisterShootInGarden(ctx, gardenClient)).To(Succeed())
+
+			Expect(b.MigrateShootStateToGarden(ctx, gardenClient)).To(Succeed())
+
+			shootState := &gardencorev1beta1.ShootState{}
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(ConsistOf(HaveField("Name", "ca")))
+		})
+	})
+
+	Describe("#SeedSpecForShoot", func() {
+		It("should compute the seed spec from the shoot", func() {
+			Expect(SeedSpecForShoot(shoot)).To(Equal(gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{
+					Type:   "local",
+					Region: "local",
+					Zones:  []string{"1"},
+				},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    ptr.To("10.0.0.0/16"),
+					Pods:     "10.1.0.0/16",
+					Services: "10.2.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: false},
+				},
+			}))
+		})
+
+		It("should derive the ingress settings from the primary DNS provider of the shoot", func() {
+			shoot.Spec.DNS = &gardencorev1beta1.DNS{
+				Domain: ptr.To("bar.example.com"),
+				Providers: []gardencorev1beta1.DNSProvider{{
+					Primary:    ptr.To(true),
+					Type:       ptr.To("provider"),
+					SecretName: ptr.To("dns-secret"),
+				}},
+			}
+
+			spec := SeedSpecForShoot(shoot)
+			Expect(spec.DNS.Provider).To(Equal(&gardencorev1beta1.SeedDNSProvider{
+				Type:      "provider",
+				SecretRef: corev1.SecretReference{Name: "dns-secret", Namespace: "garden-foo"},
+			}))
+			Expect(spec.Ingress).To(Equal(&gardencorev1beta1.Ingress{
+				Domain:     "ingress.bar.example.com",
+				Controller: gardencorev1beta1.IngressController{Kind: "nginx"},
+			}))
+		})
+	})
+})
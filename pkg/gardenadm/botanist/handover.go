@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// sshUser is the user which is created on the machines when SSH access is enabled for the shoot's workers.
+	sshUser = "gardener"
+	// sshPort is the port of the SSH daemon on the machines.
+	sshPort = "22"
+
+	// MachineBinaryPath is the path to which the gardenadm binary is installed on the control plane machine.
+	MachineBinaryPath = "/opt/bin/gardenadm"
+	// MachineConfigDir is the directory to which the configuration files are copied on the control plane machine.
+	MachineConfigDir = "/var/lib/gardenadm/resources"
+	// MachineImageVectorOverwritePath is the path to which the image vector overwrite is copied on the control plane
+	// machine.
+	MachineImageVectorOverwritePath = "/var/lib/gardenadm/imagevector-overwrite.yaml"
+
+	// sessionCloseTimeout is the time to wait for the machine to close an SSH session after the context was cancelled.
+	sessionCloseTimeout = 10 * time.Second
+)
+
+// MachineConnection is a connection to a machine which can be used to run commands on it.
+type MachineConnection interface {
+	// Run runs the given command on the machine. The given reader is passed to the standard input of the command and
+	// its output is written to the given writers.
+	Run(ctx context.Context, command string, stdin io.Reader, stdout, stderr io.Writer) error
+	// Close closes the connection.
+	Close() error
+}
+
+// ConnectToMachine opens an SSH connection to the machine with the given address. Exposed for testing.
+var ConnectToMachine = func(ctx context.Context, address string, config *ssh.ClientConfig) (MachineConnection, error) {
+	conn, err := (&net.Dialer{Timeout: config.Timeout}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed dialing %s: %w", address, err)
+	}
+
+	clientConn, channels, requests, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed establishing SSH connection to %s: %w", address, err)
+	}
+
+	return &sshConnection{client: ssh.NewClient(clientConn, channels, requests)}, nil
+}
+
+// ConnectToControlPlaneMachine opens an SSH connection to the first machine of the control plane worker pool. It uses
+// the SSH key pair generated for the shoot, which is authorized for the gardener user in the operating system
+// configuration of the machines. The identity of the machine is verified with the SSH host key which has been installed
+// via the user data of the machine (see DeployBootstrapOperatingSystemConfig).
+func (b *AutonomousBotanist) ConnectToControlPlaneMachine(ctx context.Context) (MachineConnection, error) {
+	sshKeypairSecret, ok := b.SecretsManager.Get(v1beta1constants.SecretNameSSHKeyPair)
+	if !ok {
+		return nil, fmt.Errorf("secret %q not found, SSH access must be enabled for the workers of the shoot", v1beta1constants.SecretNameSSHKeyPair)
+	}
+
+	signer, err := ssh.ParsePrivateKey(sshKeypairSecret.Data[secretsutils.DataKeyRSAPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing SSH private key: %w", err)
+	}
+
+	hostKeySecret, ok := b.SecretsManager.Get(secretNameSSHHostKeypair)
+	if !ok {
+		return nil, fmt.Errorf("secret %q not found", secretNameSSHHostKeypair)
+	}
+
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey(hostKeySecret.Data[secretsutils.DataKeySSHAuthorizedKeys])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing SSH host key: %w", err)
+	}
+
+	machines, err := b.ListControlPlaneMachines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(machines) == 0 {
+		return nil, fmt.Errorf("no control plane machines found")
+	}
+
+	addresses, err := MachineAddresses(machines[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no addresses reported for machine %s yet", machines[0].GetName())
+	}
+
+	b.Logger.Info("Connecting to control plane machine", "machine", machines[0].GetName(), "address", addresses[0])
+	return ConnectToMachine(ctx, net.JoinHostPort(addresses[0], sshPort), &ssh.ClientConfig{
+		User:            sshUser,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+		// Only the RSA host key is installed via the user data, hence the SSH daemon must not offer any other host key
+		// it might have generated when the machine booted.
+		HostKeyAlgorithms: []string{ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256},
+		Timeout:           30 * time.Second,
+	})
+}
+
+// InitFiles contains the files needed for running `gardenadm init` on the control plane machine.
+type InitFiles struct {
+	// GardenadmImage is the reference of the container image containing the gardenadm binary. It is pulled on the
+	// machine, hence the binary matches the operating system and architecture of the machine.
+	GardenadmImage string
+	// ConfigDir contains the configuration files (CloudProfile, Project, Shoot, etc.). Like for `gardenadm init`, only
+	// files with .{yaml,yml,json} file extensions are considered.
+	ConfigDir fs.FS
+	// ImageVectorOverwrite is the optional content of an image vector overwrite file.
+	ImageVectorOverwrite []byte
+}
+
+// installGardenadmScript pulls the gardenadm image with containerd on the machine and copies the binary from it.
+// Images built with ko contain the binary in the /ko-app directory.
+const installGardenadmScript = `set -o errexit
+set -o nounset
+set -o pipefail
+
+tmp_dir="$(mktemp -d)"
+unmount() {
+  ctr images unmount "$tmp_dir" && rm -rf "$tmp_dir"
+}
+trap unmount EXIT
+
+ctr images pull --hosts-dir "/etc/containerd/certs.d" %[1]s
+ctr images mount %[1]s "$tmp_dir"
+
+binary="$tmp_dir/gardenadm"
+if [[ ! -f "$binary" ]]; then
+  binary="$tmp_dir/ko-app/gardenadm"
+fi
+
+mkdir -p %[2]s
+cp -f "$binary" %[3]s
+chmod 0755 %[3]s
+`
+
+// RunInitOnMachine installs gardenadm on the machine, copies the given files to it, and runs `gardenadm init`. The
+// output of the command is written to the given writers.
+func RunInitOnMachine(ctx context.Context, conn MachineConnection, files InitFiles, stdout, stderr io.Writer) error {
+	if err := conn.Run(ctx, "sudo rm -rf "+MachineConfigDir, nil, io.Discard, stderr); err != nil {
+		return fmt.Errorf("failed cleaning up config directory on machine: %w", err)
+	}
+
+	script := fmt.Sprintf(installGardenadmScript, shellQuote(files.GardenadmImage), shellQuote(path.Dir(MachineBinaryPath)), shellQuote(MachineBinaryPath))
+	if err := conn.Run(ctx, "sudo bash -s", strings.NewReader(script), io.Discard, stderr); err != nil {
+		return fmt.Errorf("failed installing gardenadm from image %s on machine: %w", files.GardenadmImage, err)
+	}
+
+	if err := fs.WalkDir(files.ConfigDir, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed walking directory: %w", err)
+		}
+
+		if d.IsDir() || !strings.HasSuffix(filePath, ".yaml") && !strings.HasSuffix(filePath, ".yml") && !strings.HasSuffix(filePath, ".json") {
+			return nil
+		}
+
+		file, err := files.ConfigDir.Open(filePath)
+		if err != nil {
+			return fmt.Errorf("failed opening file %s: %w", filePath, err)
+		}
+		defer file.Close()
+
+		return copyFileToMachine(ctx, conn, path.Join(MachineConfigDir, filePath), "0600", file, stderr)
+	}); err != nil {
+		return fmt.Errorf("failed copying config directory to machine: %w", err)
+	}
+
+	command := MachineBinaryPath + " init --config-dir " + MachineConfigDir
+	if len(files.ImageVectorOverwrite) > 0 {
+		if err := copyFileToMachine(ctx, conn, MachineImageVectorOverwritePath, "0600", bytes.NewReader(files.ImageVectorOverwrite), stderr); err != nil {
+			return err
+		}
+		command = "env " + imagevectorutils.OverrideEnv + "=" + MachineImageVectorOverwritePath + " " + command
+	}
+
+	if err := conn.Run(ctx, "sudo "+command, nil, stdout, stderr); err != nil {
+		return fmt.Errorf("failed running gardenadm init on machine: %w", err)
+	}
+
+	return nil
+}
+
+func copyFileToMachine(ctx context.Context, conn MachineConnection, filePath, permissions string, content io.Reader, stderr io.Writer) error {
+	quotedPath := shellQuote(filePath)
+	command := fmt.Sprintf("sudo mkdir -p %s && sudo tee %s >/dev/null && sudo chmod %s %s", shellQuote(path.Dir(filePath)), quotedPath, permissions, quotedPath)
+
+	if err := conn.Run(ctx, command, content, io.Discard, stderr); err != nil {
+		return fmt.Errorf("failed copying file %s to machine: %w", filePath, err)
+	}
+
+	return nil
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+type sshConnection struct {
+	client *ssh.Client
+}
+
+func (s *sshConnection) Run(ctx context.Context, command string, stdin io.Reader, stdout, stderr io.Writer) error {
+	session, err := s.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("failed opening SSH session: %w", err)
+	}
+	defer session.Close()
+
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = stderr
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- session.Run(command)
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-errCh:
+		return err
+	}
+
+	// Terminate the command and wait for the goroutine so that it does not outlive this call and does not write to the
+	// given writers anymore. If the machine does not close the session in time, the whole connection is closed.
+	_ = session.Signal(ssh.SIGTERM)
+	_ = session.Close()
+
+	select {
+	case <-errCh:
+	case <-time.After(sessionCloseTimeout):
+		_ = s.client.Close()
+		<-errCh
+	}
+
+	return ctx.Err()
+}
+
+func (s *sshConnection) Close() error {
+	return s.client.Close()
+}
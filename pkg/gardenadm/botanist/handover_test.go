@@ -0,0 +1,385 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing/fstest"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Handover", func() {
+	var ctx = context.Background()
+
+	Describe("#ConnectToControlPlaneMachine", func() {
+		const namespace = "shoot--garden--root"
+
+		var (
+			fakeClient client.Client
+			b          *AutonomousBotanist
+		)
+
+		BeforeEach(func() {
+			// The machines are handled as unstructured objects, hence the scheme must not contain the machine API types.
+			fakeClient = fakeclient.NewClientBuilder().Build()
+
+			b = &AutonomousBotanist{
+				Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+					Logger:         logr.Discard(),
+					SeedClientSet:  fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+					SecretsManager: fakesecretsmanager.New(fakeClient, namespace),
+					Shoot:          &shootpkg.Shoot{ControlPlaneNamespace: namespace},
+				}},
+			}
+		})
+
+		createKeypairSecret := func(name string) *secretsutils.RSAKeys {
+			keypair, err := (&secretsutils.RSASecretConfig{Name: name, Bits: 2048, UsedForSSH: true}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Data:       keypair.SecretData(),
+			})).To(Succeed())
+
+			return keypair.(*secretsutils.RSAKeys)
+		}
+
+		It("should fail if the SSH key pair does not exist", func() {
+			_, err := b.ConnectToControlPlaneMachine(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`secret "ssh-keypair" not found`)))
+		})
+
+		It("should fail if the SSH host key does not exist", func() {
+			createKeypairSecret("ssh-keypair")
+
+			_, err := b.ConnectToControlPlaneMachine(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`secret "ssh-host-keypair" not found`)))
+		})
+
+		When("the SSH key pairs exist", func() {
+			var hostKey *secretsutils.RSAKeys
+
+			BeforeEach(func() {
+				createKeypairSecret("ssh-keypair")
+				hostKey = createKeypairSecret("ssh-host-keypair")
+			})
+
+			It("should fail if there are no control plane machines", func() {
+				_, err := b.ConnectToControlPlaneMachine(ctx)
+				Expect(err).To(MatchError(ContainSubstring("no control plane machines found")))
+			})
+
+			It("should fail if the machine does not report addresses yet", func() {
+				Expect(fakeClient.Create(ctx, newMachine("machine-0", namespace, time.Now()))).To(Succeed())
+
+				_, err := b.ConnectToControlPlaneMachine(ctx)
+				Expect(err).To(MatchError(ContainSubstring("no addresses reported for machine machine-0 yet")))
+			})
+
+			Context("with machine addresses", func() {
+				BeforeEach(func() {
+					machine := newMachine("machine-0", namespace, time.Now())
+					setMachineAddresses(machine, corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.2"})
+					Expect(fakeClient.Create(ctx, machine)).To(Succeed())
+				})
+
+				It("should connect to the first control plane machine as gardener user", func() {
+					conn := &fakeConnection{}
+					DeferCleanup(test.WithVar(&ConnectToMachine, func(_ context.Context, address string, config *ssh.ClientConfig) (MachineConnection, error) {
+						Expect(address).To(Equal("10.0.0.2:22"))
+						Expect(config.User).To(Equal("gardener"))
+						Expect(config.Auth).To(HaveLen(1))
+						Expect(config.HostKeyAlgorithms).To(ConsistOf("rsa-sha2-512", "rsa-sha2-256"))
+						return conn, nil
+					}))
+
+					Expect(b.ConnectToControlPlaneMachine(ctx)).To(BeIdenticalTo(conn))
+				})
+
+				It("should only accept the SSH host key of the machine", func() {
+					connectToMachine := ConnectToMachine
+
+					var config *ssh.ClientConfig
+					DeferCleanup(test.WithVar(&ConnectToMachine, func(ctx context.Context, _ string, c *ssh.ClientConfig) (MachineConnection, error) {
+						config = c
+						return connectToMachine(ctx, startSSHServer(hostKey.PrivateKey, nil), c)
+					}))
+
+					conn, err := b.ConnectToControlPlaneMachine(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(conn.Close()).To(Succeed())
+
+					otherHostKey, err := rsa.GenerateKey(rand.Reader, 2048)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = connectToMachine(ctx, startSSHServer(otherHostKey, nil), config)
+					Expect(err).To(MatchError(ContainSubstring("host key mismatch")))
+				})
+			})
+		})
+	})
+
+	Describe("#ConnectToMachine", func() {
+		var (
+			hostKey *rsa.PrivateKey
+			config  *ssh.ClientConfig
+		)
+
+		BeforeEach(func() {
+			var err error
+			hostKey, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+
+			signer, err := ssh.NewSignerFromKey(hostKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			config = &ssh.ClientConfig{User: "gardener", HostKeyCallback: ssh.FixedHostKey(signer.PublicKey()), Timeout: 5 * time.Second}
+		})
+
+		It("should run commands on the machine", func() {
+			address := startSSHServer(hostKey, func(channel ssh.Channel, command string) {
+				defer channel.Close()
+				_, _ = io.Copy(channel, strings.NewReader("output of "+command))
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+			})
+
+			conn, err := ConnectToMachine(ctx, address, config)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(conn.Close)
+
+			var stdout strings.Builder
+			Expect(conn.Run(ctx, "foo", nil, &stdout, io.Discard)).To(Succeed())
+			Expect(stdout.String()).To(Equal("output of foo"))
+		})
+
+		It("should close the session when the context is cancelled", func() {
+			var (
+				started = make(chan struct{})
+				closed  = make(chan struct{})
+			)
+
+			address := startSSHServer(hostKey, func(channel ssh.Channel, _ string) {
+				close(started)
+				// Block until the client closes the session.
+				_, _ = io.Copy(io.Discard, channel)
+				close(closed)
+			})
+
+			conn, err := ConnectToMachine(ctx, address, config)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(conn.Close)
+
+			runCtx, cancel := context.WithCancel(ctx)
+			go func() {
+				defer GinkgoRecover()
+				<-started
+				cancel()
+			}()
+
+			Expect(conn.Run(runCtx, "sleep infinity", nil, io.Discard, io.Discard)).To(MatchError(context.Canceled))
+			Eventually(closed).Should(BeClosed())
+		})
+	})
+
+	Describe("#RunInitOnMachine", func() {
+		var (
+			conn  *fakeConnection
+			files InitFiles
+
+			installRun = run{
+				command: "sudo bash -s",
+				stdin: `set -o errexit
+set -o nounset
+set -o pipefail
+
+tmp_dir="$(mktemp -d)"
+unmount() {
+  ctr images unmount "$tmp_dir" && rm -rf "$tmp_dir"
+}
+trap unmount EXIT
+
+ctr images pull --hosts-dir "/etc/containerd/certs.d" 'registry.example.com/gardenadm:v1.2.3'
+ctr images mount 'registry.example.com/gardenadm:v1.2.3' "$tmp_dir"
+
+binary="$tmp_dir/gardenadm"
+if [[ ! -f "$binary" ]]; then
+  binary="$tmp_dir/ko-app/gardenadm"
+fi
+
+mkdir -p '/opt/bin'
+cp -f "$binary" '/opt/bin/gardenadm'
+chmod 0755 '/opt/bin/gardenadm'
+`,
+			}
+		)
+
+		BeforeEach(func() {
+			conn = &fakeConnection{}
+			files = InitFiles{
+				GardenadmImage: "registry.example.com/gardenadm:v1.2.3",
+				ConfigDir: fstest.MapFS{
+					"shoot.yaml":               {Data: []byte("shoot")},
+					"profile/cloudprofile.yml": {Data: []byte("cloudprofile")},
+					"README.md":                {Data: []byte("readme")},
+				},
+			}
+		})
+
+		It("should install gardenadm, copy the files to the machine and run gardenadm init", func() {
+			Expect(RunInitOnMachine(ctx, conn, files, io.Discard, io.Discard)).To(Succeed())
+
+			Expect(conn.runs).To(Equal([]run{
+				{command: "sudo rm -rf /var/lib/gardenadm/resources"},
+				installRun,
+				{command: "sudo mkdir -p '/var/lib/gardenadm/resources/profile' && sudo tee '/var/lib/gardenadm/resources/profile/cloudprofile.yml' >/dev/null && sudo chmod 0600 '/var/lib/gardenadm/resources/profile/cloudprofile.yml'", stdin: "cloudprofile"},
+				{command: "sudo mkdir -p '/var/lib/gardenadm/resources' && sudo tee '/var/lib/gardenadm/resources/shoot.yaml' >/dev/null && sudo chmod 0600 '/var/lib/gardenadm/resources/shoot.yaml'", stdin: "shoot"},
+				{command: "sudo /opt/bin/gardenadm init --config-dir /var/lib/gardenadm/resources"},
+			}))
+		})
+
+		It("should copy the image vector overwrite and pass it to gardenadm init", func() {
+			files.ConfigDir = fstest.MapFS{}
+			files.ImageVectorOverwrite = []byte("images")
+
+			Expect(RunInitOnMachine(ctx, conn, files, io.Discard, io.Discard)).To(Succeed())
+
+			Expect(conn.runs).To(Equal([]run{
+				{command: "sudo rm -rf /var/lib/gardenadm/resources"},
+				installRun,
+				{command: "sudo mkdir -p '/var/lib/gardenadm' && sudo tee '/var/lib/gardenadm/imagevector-overwrite.yaml' >/dev/null && sudo chmod 0600 '/var/lib/gardenadm/imagevector-overwrite.yaml'", stdin: "images"},
+				{command: "sudo env IMAGEVECTOR_OVERWRITE=/var/lib/gardenadm/imagevector-overwrite.yaml /opt/bin/gardenadm init --config-dir /var/lib/gardenadm/resources"},
+			}))
+		})
+
+		It("should fail if installing gardenadm fails", func() {
+			conn.failOn = "bash -s"
+
+			Expect(RunInitOnMachine(ctx, conn, files, io.Discard, io.Discard)).To(MatchError(ContainSubstring("failed installing gardenadm from image registry.example.com/gardenadm:v1.2.3 on machine")))
+		})
+
+		It("should fail if copying a file fails", func() {
+			conn.failOn = "tee '/var/lib/gardenadm/resources/shoot.yaml'"
+
+			Expect(RunInitOnMachine(ctx, conn, files, io.Discard, io.Discard)).To(MatchError(ContainSubstring("failed copying file /var/lib/gardenadm/resources/shoot.yaml to machine")))
+		})
+
+		It("should fail if gardenadm init fails", func() {
+			conn.failOn = "gardenadm init"
+
+			Expect(RunInitOnMachine(ctx, conn, files, io.Discard, io.Discard)).To(MatchError(ContainSubstring("failed running gardenadm init on machine")))
+		})
+	})
+})
+
+// startSSHServer starts an SSH server with the given host key which accepts all clients. It calls the given handler for
+// every command executed by a client and returns the address of the server.
+func startSSHServer(hostKey any, handleExec func(channel ssh.Channel, command string)) string {
+	signer, err := ssh.NewSignerFromKey(hostKey)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	DeferCleanup(listener.Close)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_, channels, requests, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					_ = conn.Close()
+					return
+				}
+				go ssh.DiscardRequests(requests)
+
+				for newChannel := range channels {
+					channel, channelRequests, err := newChannel.Accept()
+					if err != nil {
+						continue
+					}
+
+					go func() {
+						for request := range channelRequests {
+							if request.Type != "exec" {
+								_ = request.Reply(false, nil)
+								continue
+							}
+
+							var payload struct{ Command string }
+							_ = ssh.Unmarshal(request.Payload, &payload)
+							_ = request.Reply(true, nil)
+							go handleExec(channel, payload.Command)
+						}
+					}()
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+type run struct {
+	command string
+	stdin   string
+}
+
+type fakeConnection struct {
+	runs   []run
+	failOn string
+}
+
+func (f *fakeConnection) Run(_ context.Context, command string, stdin io.Reader, _, _ io.Writer) error {
+	r := run{command: command}
+	if stdin != nil {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+		r.stdin = string(data)
+	}
+	f.runs = append(f.runs, r)
+
+	if f.failOn != "" && strings.Contains(command, f.failOn) {
+		return fmt.Errorf("fake")
+	}
+	return nil
+}
+
+func (f *fakeConnection) Close() error {
+	return nil
+}
@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+var joinDecoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(extensionsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(nodeagentconfigv1alpha1.AddToScheme(scheme))
+	joinDecoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// NewClientSetFromBootstrapToken creates a client set for the control plane which authenticates with the given
+// bootstrap token. The certificate authority bundle is used to verify the serving certificate of the API server.
+func NewClientSetFromBootstrapToken(controlPlaneAddress string, certificateAuthority []byte, bootstrapToken string, scheme *runtime.Scheme) (kubernetes.Interface, error) {
+	return kubernetes.NewWithConfig(
+		kubernetes.WithRESTConfig(&rest.Config{
+			Host:            controlPlaneAddress,
+			BearerToken:     bootstrapToken,
+			TLSClientConfig: rest.TLSClientConfig{CAData: certificateAuthority},
+		}),
+		kubernetes.WithClientOptions(client.Options{Scheme: scheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+}
+
+// FetchOperatingSystemConfigForNodeAgent reads the Secret containing the OperatingSystemConfig for gardener-node-agent
+// with the given client and stores the decoded OperatingSystemConfig for the subsequent join steps.
+func (b *AutonomousBotanist) FetchOperatingSystemConfigForNodeAgent(ctx context.Context, c client.Client, secretName string) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: metav1.NamespaceSystem}}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return fmt.Errorf("failed reading secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	oscRaw, ok := secret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig]
+	if !ok {
+		return fmt.Errorf("no %s key found in secret %s", nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig, client.ObjectKeyFromObject(secret))
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(joinDecoder, oscRaw, osc); err != nil {
+		return fmt.Errorf("failed decoding OperatingSystemConfig from secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	b.operatingSystemConfig = osc
+	return nil
+}
+
+// WriteGardenerNodeAgentConfiguration writes the configuration of gardener-node-agent contained in the
+// OperatingSystemConfig to the file system. The API server information is replaced with the given control plane
+// address and certificate authority bundle since the node might not be able to reach the API server via the address
+// that was used when the OperatingSystemConfig was generated.
+func (b *AutonomousBotanist) WriteGardenerNodeAgentConfiguration(controlPlaneAddress string, certificateAuthority []byte) error {
+	if b.operatingSystemConfig == nil {
+		return fmt.Errorf("operating system config is nil, make sure to call FetchOperatingSystemConfigForNodeAgent first")
+	}
+
+	configFile := fileWithPath(b.operatingSystemConfig.Spec.Files, nodeagentconfigv1alpha1.ConfigFilePath)
+	if configFile == nil || configFile.Content.Inline == nil {
+		return fmt.Errorf("OperatingSystemConfig does not contain an inline file with path %s", nodeagentconfigv1alpha1.ConfigFilePath)
+	}
+
+	configRaw, err := extensionsv1alpha1helper.Decode(configFile.Content.Inline.Encoding, []byte(configFile.Content.Inline.Data))
+	if err != nil {
+		return fmt.Errorf("failed decoding content of file %s: %w", configFile.Path, err)
+	}
+
+	config := &nodeagentconfigv1alpha1.NodeAgentConfiguration{}
+	if err := runtime.DecodeInto(joinDecoder, configRaw, config); err != nil {
+		return fmt.Errorf("failed decoding gardener-node-agent configuration: %w", err)
+	}
+
+	config.APIServer = nodeagentconfigv1alpha1.APIServer{
+		Server:   controlPlaneAddress,
+		CABundle: certificateAuthority,
+	}
+
+	files, err := nodeagentcomponent.Files(config)
+	if err != nil {
+		return fmt.Errorf("failed computing gardener-node-agent files: %w", err)
+	}
+
+	if err := b.ensureGardenerNodeAgentDirectories(); err != nil {
+		return fmt.Errorf("failed ensuring gardener-node-agent directories exist: %w", err)
+	}
+
+	for _, file := range files {
+		data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+		if err != nil {
+			return fmt.Errorf("failed decoding content of file %s: %w", file.Path, err)
+		}
+
+		b.Logger.Info("Writing gardener-node-agent configuration", "path", file.Path)
+		if err := b.FS.WriteFile(file.Path, data, os.FileMode(*file.Permissions)); err != nil {
+			return fmt.Errorf("failed writing file %s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// WriteJoinBootstrapToken writes the given bootstrap token to the file system so that gardener-node-agent and kubelet
+// can use it to request their client certificates.
+func (b *AutonomousBotanist) WriteJoinBootstrapToken(bootstrapToken string) error {
+	if err := b.ensureGardenerNodeAgentDirectories(); err != nil {
+		return fmt.Errorf("failed ensuring gardener-node-agent directories exist: %w", err)
+	}
+
+	return b.FS.WriteFile(nodeagentconfigv1alpha1.BootstrapTokenFilePath, []byte(bootstrapToken), kubeletTokenFilePermission)
+}
+
+// ExtractGardenerNodeAgentBinary copies the gardener-node-agent binary from the image referenced in the
+// OperatingSystemConfig to the file system.
+func (b *AutonomousBotanist) ExtractGardenerNodeAgentBinary(ctx context.Context) error {
+	if b.operatingSystemConfig == nil {
+		return fmt.Errorf("operating system config is nil, make sure to call FetchOperatingSystemConfigForNodeAgent first")
+	}
+
+	file := fileWithPath(b.operatingSystemConfig.Spec.Files, nodeagentcomponent.PathBinary)
+	if file == nil || file.Content.ImageRef == nil {
+		return fmt.Errorf("OperatingSystemConfig does not contain a file with image reference and path %s", nodeagentcomponent.PathBinary)
+	}
+
+	permissions := os.FileMode(0755)
+	if file.Permissions != nil {
+		permissions = os.FileMode(*file.Permissions)
+	}
+
+	b.Logger.Info("Extracting gardener-node-agent binary from image", "image", file.Content.ImageRef.Image, "path", file.Path)
+	return registry.NewExtractor().CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, permissions)
+}
+
+// StartGardenerNodeAgent writes the unit file for gardener-node-agent, and enables and starts it.
+func (b *AutonomousBotanist) StartGardenerNodeAgent(ctx context.Context) error {
+	unitFilePath := path.Join("/", "etc", "systemd", "system", nodeagentconfigv1alpha1.UnitName)
+	if err := b.FS.WriteFile(unitFilePath, []byte(nodeagentcomponent.UnitContent()), 0644); err != nil {
+		return fmt.Errorf("failed writing unit file %s: %w", unitFilePath, err)
+	}
+
+	if err := b.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	if err := b.DBus.Enable(ctx, nodeagentconfigv1alpha1.UnitName); err != nil {
+		return fmt.Errorf("failed enabling unit %s: %w", nodeagentconfigv1alpha1.UnitName, err)
+	}
+
+	return b.DBus.Start(ctx, nil, nil, nodeagentconfigv1alpha1.UnitName)
+}
+
+// WaitUntilNodeIsRegistered waits until kubelet has obtained its client certificate and registered the Node object for
+// this host.
+func (b *AutonomousBotanist) WaitUntilNodeIsRegistered(ctx context.Context) error {
+	kubeconfigExists, err := b.FS.Exists(kubelet.PathKubeconfigReal)
+	if err != nil {
+		return fmt.Errorf("failed checking whether kubelet kubeconfig %s exists: %w", kubelet.PathKubeconfigReal, err)
+	}
+	if !kubeconfigExists {
+		return fmt.Errorf("kubelet has not yet written its kubeconfig %s", kubelet.PathKubeconfigReal)
+	}
+
+	clientSet, err := kubernetes.NewClientFromFile("", kubelet.PathKubeconfigReal,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.SeedScheme}),
+		kubernetes.WithAllowedUserFields([]string{kubernetes.AuthClientCertificate, kubernetes.AuthClientKey}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed creating client set from kubelet kubeconfig: %w", err)
+	}
+
+	node, err := nodeagent.FetchNodeByHostName(ctx, clientSet.Client(), b.HostName)
+	if err != nil {
+		return fmt.Errorf("failed fetching node object via hostname: %w", err)
+	}
+	if node == nil {
+		return fmt.Errorf("node for hostname %q is not yet registered", b.HostName)
+	}
+
+	b.Logger.Info("Node has been registered", "hostName", b.HostName, "nodeName", node.Name)
+	return nil
+}
+
+func fileWithPath(files []extensionsv1alpha1.File, filePath string) *extensionsv1alpha1.File {
+	for _, file := range files {
+		if file.Path == filePath {
+			return &file
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Join", func() {
+	var (
+		ctx        = context.Background()
+		secretName = "gardener-node-agent-worker"
+
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+
+		b *AutonomousBotanist
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeDBus = fakedbus.New()
+
+		b = &AutonomousBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: logr.Discard()}},
+			FS:       afero.Afero{Fs: afero.NewMemMapFs()},
+			DBus:     fakeDBus,
+			HostName: "test",
+		}
+	})
+
+	Describe("#FetchOperatingSystemConfigForNodeAgent", func() {
+		It("should fail when the secret does not exist", func() {
+			Expect(b.FetchOperatingSystemConfigForNodeAgent(ctx, fakeClient, secretName)).To(MatchError(ContainSubstring("failed reading secret")))
+		})
+
+		It("should fail when the secret does not contain an OperatingSystemConfig", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(b.FetchOperatingSystemConfigForNodeAgent(ctx, fakeClient, secretName)).To(MatchError(ContainSubstring("no osc.yaml key found")))
+		})
+	})
+
+	Describe("#WriteGardenerNodeAgentConfiguration", func() {
+		It("should fail when the OperatingSystemConfig was not fetched before", func() {
+			Expect(b.WriteGardenerNodeAgentConfiguration("https://10.1.2.3", []byte("ca"))).To(MatchError(ContainSubstring("make sure to call FetchOperatingSystemConfigForNodeAgent first")))
+		})
+
+		It("should write the configuration with the replaced API server information", func() {
+			files, err := nodeagentcomponent.Files(nodeagentcomponent.ComponentConfig(secretName, nil, "https://api.foo.gardenadm.local", []byte("old-ca"), nil))
+			Expect(err).NotTo(HaveOccurred())
+
+			secret, err := nodeagentcomponent.OperatingSystemConfigSecret(ctx, fakeClient, &extensionsv1alpha1.OperatingSystemConfig{
+				Spec: extensionsv1alpha1.OperatingSystemConfigSpec{Files: files},
+			}, secretName, "worker")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			Expect(b.FetchOperatingSystemConfigForNodeAgent(ctx, fakeClient, secretName)).To(Succeed())
+			Expect(b.WriteGardenerNodeAgentConfiguration("https://10.1.2.3", []byte("new-ca"))).To(Succeed())
+
+			apiServerConfig, err := nodeagent.GetAPIServerConfig(b.FS)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiServerConfig).To(Equal(&nodeagentconfigv1alpha1.APIServer{Server: "https://10.1.2.3", CABundle: []byte("new-ca")}))
+		})
+	})
+
+	Describe("#WriteJoinBootstrapToken", func() {
+		It("should write the bootstrap token", func() {
+			Expect(b.WriteJoinBootstrapToken("foo123.bar4567890baz123")).To(Succeed())
+			Expect(b.FS.ReadFile("/var/lib/gardener-node-agent/credentials/bootstrap-token")).To(Equal([]byte("foo123.bar4567890baz123")))
+		})
+	})
+
+	Describe("#StartGardenerNodeAgent", func() {
+		It("should write the unit file and start the unit", func() {
+			Expect(b.StartGardenerNodeAgent(ctx)).To(Succeed())
+
+			Expect(b.FS.ReadFile("/etc/systemd/system/gardener-node-agent.service")).To(Equal([]byte(nodeagentcomponent.UnitContent())))
+			Expect(fakeDBus.Actions).To(HaveExactElements(
+				fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload},
+				fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{"gardener-node-agent.service"}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionStart, UnitNames: []string{"gardener-node-agent.service"}},
+			))
+		})
+	})
+
+	Describe("#WaitUntilNodeIsRegistered", func() {
+		It("should fail when kubelet has not written its kubeconfig yet", func() {
+			Expect(b.WaitUntilNodeIsRegistered(ctx)).To(MatchError(ContainSubstring("kubelet has not yet written its kubeconfig")))
+		})
+	})
+})
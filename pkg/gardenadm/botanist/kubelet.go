@@ -89,6 +89,18 @@ func (b *AutonomousBotanist) WriteKubeletBootstrapKubeconfig(ctx context.Context
 	return kubeletBootstrapKubeconfigCreator.Start(ctx)
 }
 
+// RemoveKubeletBootstrapKubeconfig reverts WriteKubeletBootstrapKubeconfig by removing the kubelet bootstrap
+// kubeconfig and the bootstrap token file.
+func (b *AutonomousBotanist) RemoveKubeletBootstrapKubeconfig(_ context.Context) error {
+	for _, filePath := range []string{kubelet.PathKubeconfigBootstrap, nodeagentconfigv1alpha1.BootstrapTokenFilePath} {
+		if err := b.FS.Remove(filePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing file %s: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
 // BootstrapKubelet bootstraps the kubelet.
 func (b *AutonomousBotanist) BootstrapKubelet(ctx context.Context) error {
 	node, err := nodeagent.FetchNodeByHostName(ctx, b.SeedClientSet.Client(), b.HostName)
@@ -113,6 +113,22 @@ var _ = Describe("Kubelet", func() {
 		)
 	})
 
+	Describe("#RemoveKubeletBootstrapKubeconfig", func() {
+		It("should remove the kubelet bootstrap kubeconfig and the bootstrap token", func() {
+			Expect(b.FS.WriteFile("/var/lib/kubelet/kubeconfig-bootstrap", []byte{}, 0o600)).To(Succeed())
+			Expect(b.FS.WriteFile("/var/lib/gardener-node-agent/credentials/bootstrap-token", []byte{}, 0o600)).To(Succeed())
+
+			Expect(b.RemoveKubeletBootstrapKubeconfig(ctx)).To(Succeed())
+
+			Expect(b.FS.Exists("/var/lib/kubelet/kubeconfig-bootstrap")).To(BeFalse())
+			Expect(b.FS.Exists("/var/lib/gardener-node-agent/credentials/bootstrap-token")).To(BeFalse())
+		})
+
+		It("should succeed if the files do not exist", func() {
+			Expect(b.RemoveKubeletBootstrapKubeconfig(ctx)).To(Succeed())
+		})
+	})
+
 	Describe("#BootstrapKubelet", func() {
 		It("should do nothing when the node was already found", func() {
 			Expect(fakeSeedClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"kubernetes.io/hostname": hostName}}})).To(Succeed())
@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var (
+	pathSystemdUnits = path.Join("/", "etc", "systemd", "system")
+
+	// ControlPlanePorts are the ports on the host network which are served by the static control plane pods. Exposed
+	// for testing.
+	ControlPlanePorts = []int32{kubeapiserverconstants.Port, etcdconstants.PortEtcdClient, etcdconstants.StaticPodPortEtcdEventsClient}
+
+	// cniDirectories are the directories containing the configuration and the state of the CNI plugins.
+	cniDirectories = []string{
+		filepath.Join(string(filepath.Separator), "etc", "cni", "net.d"),
+		filepath.Join(string(filepath.Separator), "var", "lib", "cni"),
+	}
+	// iptablesChainPrefixes are the prefixes of the iptables chains created by kube-proxy, the kubelet, and the
+	// supported CNI plugins.
+	iptablesChainPrefixes = []string{"KUBE-", "cali-", "CILIUM_"}
+
+	// RunCommand runs the given command with the given input and returns its output. Exposed for testing.
+	RunCommand = func(ctx context.Context, input []byte, name string, args ...string) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Stdin = bytes.NewReader(input)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("command %q failed: %w (stderr: %s)", name, err, stderr.String())
+		}
+		return stdout.Bytes(), nil
+	}
+)
+
+// staticControlPlanePodNames returns the names of the static pods for the control plane components. Their host paths
+// are located at /var/lib/<name>, see the staticpod translator.
+func staticControlPlanePodNames() []string {
+	return []string{
+		"etcd-" + v1beta1constants.ETCDRoleMain + "-0",
+		"etcd-" + v1beta1constants.ETCDRoleEvents + "-0",
+		v1beta1constants.DeploymentNameKubeAPIServer,
+		v1beta1constants.DeploymentNameKubeControllerManager,
+		v1beta1constants.DeploymentNameKubeScheduler,
+	}
+}
+
+// StopGardenerNodeAgent stops, disables, and removes the gardener-node-agent units so that they do not reconcile the
+// OperatingSystemConfig again while the node is being reset.
+func (b *AutonomousBotanist) StopGardenerNodeAgent(ctx context.Context) error {
+	for _, unitName := range []string{nodeagentconfigv1alpha1.UnitName, nodeagentconfigv1alpha1.InitUnitName} {
+		unitFilePath := path.Join(pathSystemdUnits, unitName)
+
+		exists, err := b.FS.Exists(unitFilePath)
+		if err != nil {
+			return fmt.Errorf("failed checking whether unit file %s exists: %w", unitFilePath, err)
+		}
+		if !exists {
+			continue
+		}
+
+		b.Logger.Info("Stopping unit", "unitName", unitName)
+		if err := b.DBus.Stop(ctx, nil, nil, unitName); err != nil {
+			return fmt.Errorf("failed stopping unit %s: %w", unitName, err)
+		}
+
+		if err := b.DBus.Disable(ctx, unitName); err != nil {
+			return fmt.Errorf("failed disabling unit %s: %w", unitName, err)
+		}
+
+		if err := b.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing unit file %s: %w", unitFilePath, err)
+		}
+	}
+
+	return b.DBus.DaemonReload(ctx)
+}
+
+// RemoveStaticPodManifests removes all static pod manifests so that kubelet terminates the static control plane pods.
+func (b *AutonomousBotanist) RemoveStaticPodManifests() error {
+	exists, err := b.FS.DirExists(kubelet.FilePathKubernetesManifests)
+	if err != nil {
+		return fmt.Errorf("failed checking whether directory %s exists: %w", kubelet.FilePathKubernetesManifests, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	fileInfos, err := b.FS.ReadDir(kubelet.FilePathKubernetesManifests)
+	if err != nil {
+		return fmt.Errorf("failed reading directory %s: %w", kubelet.FilePathKubernetesManifests, err)
+	}
+
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() {
+			continue
+		}
+
+		filePath := filepath.Join(kubelet.FilePathKubernetesManifests, fileInfo.Name())
+		b.Logger.Info("Removing static pod manifest", "path", filePath)
+		if err := b.FS.Remove(filePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing static pod manifest %s: %w", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+// WaitUntilStaticControlPlanePodsTerminated checks whether the static control plane pods still serve their ports on
+// the host network. It returns an error as long as any of them is still reachable.
+func (b *AutonomousBotanist) WaitUntilStaticControlPlanePodsTerminated(_ context.Context) error {
+	for _, port := range ControlPlanePorts {
+		address := net.JoinHostPort("localhost", strconv.Itoa(int(port)))
+
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err != nil {
+			continue
+		}
+		if err := conn.Close(); err != nil {
+			return fmt.Errorf("failed closing connection to %s: %w", address, err)
+		}
+
+		return fmt.Errorf("static control plane pods have not yet been terminated, %s is still reachable", address)
+	}
+
+	return nil
+}
+
+// RemoveOperatingSystemConfigUnitsAndFiles stops and removes the units and removes the files of the last
+// OperatingSystemConfig which was applied by gardener-node-agent. Units which were not created by gardener-node-agent
+// (i.e., units without content, for example containerd) are not stopped, only their drop-ins are removed.
+func (b *AutonomousBotanist) RemoveOperatingSystemConfigUnitsAndFiles(ctx context.Context) error {
+	osc, err := b.readLastAppliedOperatingSystemConfig()
+	if err != nil {
+		return err
+	}
+	if osc == nil {
+		b.Logger.Info("No last applied OperatingSystemConfig found, skipping removal of units and files", "path", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+		return nil
+	}
+
+	for _, unit := range append(osc.Spec.Units, osc.Status.ExtensionUnits...) {
+		if err := b.removeUnit(ctx, unit); err != nil {
+			return fmt.Errorf("failed removing unit %s: %w", unit.Name, err)
+		}
+	}
+
+	if err := b.DBus.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	for _, file := range append(osc.Spec.Files, osc.Status.ExtensionFiles...) {
+		if err := b.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing file %s: %w", file.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveNodeState removes the remaining state of the node, i.e., the data of etcd and the volumes of the static
+// control plane pods, the kubelet directory, the gardener-node-agent directory, and the admin kubeconfig.
+func (b *AutonomousBotanist) RemoveNodeState() error {
+	var directories []string
+	for _, name := range staticControlPlanePodNames() {
+		directories = append(directories, filepath.Join(string(filepath.Separator), "var", "lib", name))
+	}
+	directories = append(directories, kubelet.PathKubeletDirectory, nodeagentconfigv1alpha1.BaseDir)
+
+	for _, directory := range directories {
+		b.Logger.Info("Removing directory", "path", directory)
+		if err := b.FS.RemoveAll(directory); err != nil {
+			return fmt.Errorf("failed removing directory %s: %w", directory, err)
+		}
+	}
+
+	if err := b.FS.Remove(PathKubeconfig); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing kubeconfig %s: %w", PathKubeconfig, err)
+	}
+
+	return nil
+}
+
+// RevertOperatingSystemConfig reverts ApplyOperatingSystemConfig if a later step of `gardenadm init` fails. It
+// terminates the static control plane pods, removes the units and files of the applied OperatingSystemConfig, and
+// removes the state of the node. Otherwise, the admin kubeconfig would be left behind and a subsequent run of
+// `gardenadm init` would skip the initialization of the control plane although it was never completed.
+func (b *AutonomousBotanist) RevertOperatingSystemConfig(ctx context.Context) error {
+	if err := b.StopGardenerNodeAgent(ctx); err != nil {
+		return err
+	}
+
+	if err := b.RemoveStaticPodManifests(); err != nil {
+		return err
+	}
+
+	if err := retry.UntilTimeout(ctx, 2*time.Second, 2*time.Minute, func(ctx context.Context) (bool, error) {
+		if err := b.WaitUntilStaticControlPlanePodsTerminated(ctx); err != nil {
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return err
+	}
+
+	if err := b.RemoveOperatingSystemConfigUnitsAndFiles(ctx); err != nil {
+		return err
+	}
+
+	return b.RemoveNodeState()
+}
+
+// RemoveNetworkState removes the configuration and the state of the CNI plugins as well as the iptables chains which
+// were created by kube-proxy, the kubelet, and the CNI plugins. Other iptables rules of the host are kept.
+func (b *AutonomousBotanist) RemoveNetworkState(ctx context.Context) error {
+	for _, directory := range cniDirectories {
+		b.Logger.Info("Removing directory", "path", directory)
+		if err := b.FS.RemoveAll(directory); err != nil {
+			return fmt.Errorf("failed removing directory %s: %w", directory, err)
+		}
+	}
+
+	for _, binary := range []string{"iptables", "ip6tables"} {
+		if err := b.removeIPTablesChains(ctx, binary); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *AutonomousBotanist) removeIPTablesChains(ctx context.Context, binary string) error {
+	rules, err := RunCommand(ctx, nil, binary+"-save")
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			b.Logger.Info("Binary not found, skipping removal of iptables chains", "binary", binary+"-save")
+			return nil
+		}
+		return fmt.Errorf("failed saving %s rules: %w", binary, err)
+	}
+
+	filteredRules := FilterIPTablesRules(rules)
+	if bytes.Equal(rules, filteredRules) {
+		return nil
+	}
+
+	b.Logger.Info("Removing iptables chains", "binary", binary, "prefixes", iptablesChainPrefixes)
+	if _, err := RunCommand(ctx, filteredRules, binary+"-restore"); err != nil {
+		return fmt.Errorf("failed restoring %s rules: %w", binary, err)
+	}
+
+	return nil
+}
+
+// FilterIPTablesRules removes the chains created by kube-proxy, the kubelet, and the CNI plugins as well as all rules
+// in or jumping to these chains from the given output of iptables-save.
+func FilterIPTablesRules(rules []byte) []byte {
+	var lines []string
+	for _, line := range strings.Split(string(rules), "\n") {
+		if !isManagedIPTablesLine(line) {
+			lines = append(lines, line)
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+func isManagedIPTablesLine(line string) bool {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return false
+	}
+
+	// Chain declarations have the format ":<chain> <policy> [<packets>:<bytes>]".
+	if chain, ok := strings.CutPrefix(fields[0], ":"); ok {
+		return hasManagedIPTablesChainPrefix(chain)
+	}
+
+	if fields[0] != "-A" || len(fields) < 2 {
+		return false
+	}
+	if hasManagedIPTablesChainPrefix(fields[1]) {
+		return true
+	}
+
+	for i, field := range fields[:len(fields)-1] {
+		if (field == "-j" || field == "-g") && hasManagedIPTablesChainPrefix(fields[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasManagedIPTablesChainPrefix(chain string) bool {
+	for _, prefix := range iptablesChainPrefixes {
+		if strings.HasPrefix(chain, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *AutonomousBotanist) readLastAppliedOperatingSystemConfig() (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	oscRaw, err := b.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading last applied OperatingSystemConfig from %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(joinDecoder, oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("failed decoding last applied OperatingSystemConfig: %w", err)
+	}
+
+	return osc, nil
+}
+
+func (b *AutonomousBotanist) removeUnit(ctx context.Context, unit extensionsv1alpha1.Unit) error {
+	unitFilePath := path.Join(pathSystemdUnits, unit.Name)
+
+	if unit.Content != nil {
+		exists, err := b.FS.Exists(unitFilePath)
+		if err != nil {
+			return fmt.Errorf("failed checking whether unit file %s exists: %w", unitFilePath, err)
+		}
+
+		if exists {
+			b.Logger.Info("Stopping and removing unit", "unitName", unit.Name)
+			if err := b.DBus.Stop(ctx, nil, nil, unit.Name); err != nil {
+				return fmt.Errorf("failed stopping unit: %w", err)
+			}
+
+			if err := b.DBus.Disable(ctx, unit.Name); err != nil {
+				return fmt.Errorf("failed disabling unit: %w", err)
+			}
+
+			if err := b.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("failed removing unit file %s: %w", unitFilePath, err)
+			}
+		}
+	}
+
+	dropInDirectory := unitFilePath + ".d"
+	for _, dropIn := range unit.DropIns {
+		dropInFilePath := path.Join(dropInDirectory, dropIn.Name)
+		if err := b.FS.Remove(dropInFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed removing drop-in file %s: %w", dropInFilePath, err)
+		}
+	}
+
+	if exists, err := b.FS.DirExists(dropInDirectory); err != nil {
+		return fmt.Errorf("failed checking whether drop-in directory %s exists: %w", dropInDirectory, err)
+	} else if exists {
+		if empty, err := b.FS.IsEmpty(dropInDirectory); err != nil {
+			return fmt.Errorf("failed checking whether drop-in directory %s is empty: %w", dropInDirectory, err)
+		} else if empty {
+			if err := b.FS.RemoveAll(dropInDirectory); err != nil {
+				return fmt.Errorf("failed removing drop-in directory %s: %w", dropInDirectory, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/exec"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx = context.Background()
+
+		fakeDBus *fakedbus.DBus
+		fakeFS   afero.Afero
+
+		b *AutonomousBotanist
+	)
+
+	BeforeEach(func() {
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		b = &AutonomousBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{Logger: logr.Discard()}},
+			FS:       fakeFS,
+			DBus:     fakeDBus,
+			HostName: "test",
+		}
+	})
+
+	Describe("#StopGardenerNodeAgent", func() {
+		It("should only reload the daemon if the units do not exist", func() {
+			Expect(b.StopGardenerNodeAgent(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload}))
+		})
+
+		It("should stop, disable, and remove the gardener-node-agent unit", func() {
+			Expect(fakeFS.WriteFile("/etc/systemd/system/gardener-node-agent.service", []byte("unit"), 0644)).To(Succeed())
+
+			Expect(b.StopGardenerNodeAgent(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+			}))
+			Expect(fakeFS.Exists("/etc/systemd/system/gardener-node-agent.service")).To(BeFalse())
+		})
+	})
+
+	Describe("#RemoveStaticPodManifests", func() {
+		It("should succeed if the manifests directory does not exist", func() {
+			Expect(b.RemoveStaticPodManifests()).To(Succeed())
+		})
+
+		It("should remove all static pod manifests", func() {
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/kube-apiserver.yaml", []byte("pod"), 0640)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/etcd-main-0.yaml", []byte("pod"), 0640)).To(Succeed())
+
+			Expect(b.RemoveStaticPodManifests()).To(Succeed())
+
+			Expect(fakeFS.IsEmpty("/etc/kubernetes/manifests")).To(BeTrue())
+		})
+	})
+
+	Describe("#WaitUntilStaticControlPlanePodsTerminated", func() {
+		var listener net.Listener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "localhost:0")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { _ = listener.Close() })
+		})
+
+		It("should fail while a control plane port is still reachable", func() {
+			DeferCleanup(test.WithVar(&ControlPlanePorts, []int32{int32(listener.Addr().(*net.TCPAddr).Port)}))
+
+			Expect(b.WaitUntilStaticControlPlanePodsTerminated(ctx)).To(MatchError(ContainSubstring("is still reachable")))
+		})
+
+		It("should succeed when no control plane port is reachable", func() {
+			port := int32(listener.Addr().(*net.TCPAddr).Port)
+			Expect(listener.Close()).To(Succeed())
+			DeferCleanup(test.WithVar(&ControlPlanePorts, []int32{port}))
+
+			Expect(b.WaitUntilStaticControlPlanePodsTerminated(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#RemoveOperatingSystemConfigUnitsAndFiles", func() {
+		It("should do nothing if there is no last applied OperatingSystemConfig", func() {
+			Expect(b.RemoveOperatingSystemConfigUnitsAndFiles(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should remove the units and files of the last applied OperatingSystemConfig", func() {
+			Expect(fakeFS.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  units:
+  - name: kubelet.service
+    content: kubelet-unit
+  - name: containerd.service
+    dropIns:
+    - name: 10-gardener.conf
+      content: drop-in
+  files:
+  - path: /var/lib/foo/bar
+    content:
+      inline:
+        data: bar
+status:
+  extensionFiles:
+  - path: /var/lib/foo/baz
+    content:
+      inline:
+        data: baz
+`), 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/systemd/system/kubelet.service", []byte("kubelet-unit"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/systemd/system/containerd.service.d/10-gardener.conf", []byte("drop-in"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/var/lib/foo/bar", []byte("bar"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/var/lib/foo/baz", []byte("baz"), 0644)).To(Succeed())
+
+			Expect(b.RemoveOperatingSystemConfigUnitsAndFiles(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+			}))
+			Expect(fakeFS.Exists("/etc/systemd/system/kubelet.service")).To(BeFalse())
+			Expect(fakeFS.DirExists("/etc/systemd/system/containerd.service.d")).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/foo/bar")).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/foo/baz")).To(BeFalse())
+		})
+	})
+
+	Describe("#RemoveNodeState", func() {
+		It("should remove the state directories and the admin kubeconfig", func() {
+			for _, path := range []string{
+				"/var/lib/etcd-main-0/data/member",
+				"/var/lib/etcd-events-0/data/member",
+				"/var/lib/kube-apiserver/static-token/token",
+				"/var/lib/kubelet/kubeconfig-real",
+				"/var/lib/gardener-node-agent/config.yaml",
+				PathKubeconfig,
+			} {
+				Expect(fakeFS.WriteFile(path, []byte("foo"), 0600)).To(Succeed())
+			}
+			Expect(fakeFS.WriteFile("/var/lib/other/file", []byte("foo"), 0600)).To(Succeed())
+
+			Expect(b.RemoveNodeState()).To(Succeed())
+
+			for _, path := range []string{
+				"/var/lib/etcd-main-0",
+				"/var/lib/etcd-events-0",
+				"/var/lib/kube-apiserver",
+				"/var/lib/kubelet",
+				"/var/lib/gardener-node-agent",
+				PathKubeconfig,
+			} {
+				Expect(fakeFS.Exists(path)).To(BeFalse(), path)
+			}
+			Expect(fakeFS.Exists("/var/lib/other/file")).To(BeTrue())
+		})
+	})
+
+	Describe("#RevertOperatingSystemConfig", func() {
+		var listener net.Listener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "localhost:0")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { _ = listener.Close() })
+			DeferCleanup(test.WithVar(&ControlPlanePorts, []int32{int32(listener.Addr().(*net.TCPAddr).Port)}))
+
+			Expect(fakeFS.WriteFile("/etc/systemd/system/gardener-node-agent.service", []byte("unit"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/kube-apiserver.yaml", []byte("pod"), 0640)).To(Succeed())
+			Expect(fakeFS.WriteFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+spec:
+  units:
+  - name: kubelet.service
+    content: kubelet-unit
+  files:
+  - path: `+PathKubeconfig+`
+    content:
+      inline:
+        data: kubeconfig
+`), 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/systemd/system/kubelet.service", []byte("kubelet-unit"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile(PathKubeconfig, []byte("kubeconfig"), 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/var/lib/etcd-main-0/data/member", []byte("foo"), 0600)).To(Succeed())
+		})
+
+		It("should terminate the static pods and remove the units, files, and state of the node", func() {
+			Expect(listener.Close()).To(Succeed())
+
+			Expect(b.RevertOperatingSystemConfig(ctx)).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+			}))
+			for _, path := range []string{
+				"/etc/systemd/system/gardener-node-agent.service",
+				"/etc/kubernetes/manifests/kube-apiserver.yaml",
+				"/etc/systemd/system/kubelet.service",
+				"/var/lib/etcd-main-0",
+				nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath,
+				PathKubeconfig,
+			} {
+				Expect(fakeFS.Exists(path)).To(BeFalse(), path)
+			}
+		})
+
+		It("should fail if the static control plane pods are not terminated in time", func() {
+			ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			Expect(b.RevertOperatingSystemConfig(ctx)).To(MatchError(ContainSubstring("is still reachable")))
+
+			Expect(fakeFS.Exists("/etc/kubernetes/manifests/kube-apiserver.yaml")).To(BeFalse())
+			Expect(fakeFS.Exists(PathKubeconfig)).To(BeTrue())
+		})
+	})
+
+	Describe("#RemoveNetworkState", func() {
+		type command struct {
+			input string
+			name  string
+		}
+
+		var commands []command
+
+		BeforeEach(func() {
+			commands = nil
+		})
+
+		It("should remove the CNI directories and the managed iptables chains", func() {
+			DeferCleanup(test.WithVar(&RunCommand, func(_ context.Context, input []byte, name string, _ ...string) ([]byte, error) {
+				commands = append(commands, command{input: string(input), name: name})
+				if name == "iptables-save" {
+					return []byte("*nat\n:KUBE-SERVICES - [0:0]\n-A PREROUTING -j KUBE-SERVICES\nCOMMIT\n"), nil
+				}
+				return []byte("*filter\nCOMMIT\n"), nil
+			}))
+
+			Expect(fakeFS.WriteFile("/etc/cni/net.d/10-calico.conflist", []byte("conf"), 0644)).To(Succeed())
+			Expect(fakeFS.WriteFile("/var/lib/cni/networks/k8s-pod-network/10.1.0.2", []byte("state"), 0644)).To(Succeed())
+
+			Expect(b.RemoveNetworkState(ctx)).To(Succeed())
+
+			Expect(fakeFS.DirExists("/etc/cni/net.d")).To(BeFalse())
+			Expect(fakeFS.DirExists("/var/lib/cni")).To(BeFalse())
+			Expect(commands).To(Equal([]command{
+				{name: "iptables-save"},
+				{name: "iptables-restore", input: "*nat\nCOMMIT\n"},
+				{name: "ip6tables-save"},
+			}))
+		})
+
+		It("should skip binaries which are not installed", func() {
+			DeferCleanup(test.WithVar(&RunCommand, func(_ context.Context, _ []byte, name string, _ ...string) ([]byte, error) {
+				commands = append(commands, command{name: name})
+				return nil, &exec.Error{Name: name, Err: exec.ErrNotFound}
+			}))
+
+			Expect(b.RemoveNetworkState(ctx)).To(Succeed())
+
+			Expect(commands).To(Equal([]command{{name: "iptables-save"}, {name: "ip6tables-save"}}))
+		})
+
+		It("should fail if the rules cannot be saved", func() {
+			DeferCleanup(test.WithVar(&RunCommand, func(_ context.Context, _ []byte, _ string, _ ...string) ([]byte, error) {
+				return nil, fmt.Errorf("fake")
+			}))
+
+			Expect(b.RemoveNetworkState(ctx)).To(MatchError(ContainSubstring("failed saving iptables rules")))
+		})
+	})
+
+	Describe("#FilterIPTablesRules", func() {
+		It("should remove the managed chains and all rules referring to them", func() {
+			Expect(string(FilterIPTablesRules([]byte(`*filter
+:INPUT ACCEPT [0:0]
+:FORWARD DROP [0:0]
+:KUBE-FORWARD - [0:0]
+:cali-FORWARD - [0:0]
+:CILIUM_INPUT - [0:0]
+:my-chain - [0:0]
+-A INPUT -j CILIUM_INPUT
+-A INPUT -p tcp -m tcp --dport 22 -j ACCEPT
+-A FORWARD -m comment --comment "kubernetes forwarding rules" -j KUBE-FORWARD
+-A FORWARD -g cali-FORWARD
+-A FORWARD -j my-chain
+-A KUBE-FORWARD -m conntrack --ctstate INVALID -j DROP
+-A my-chain -j ACCEPT
+COMMIT
+`)))).To(Equal(`*filter
+:INPUT ACCEPT [0:0]
+:FORWARD DROP [0:0]
+:my-chain - [0:0]
+-A INPUT -p tcp -m tcp --dport 22 -j ACCEPT
+-A FORWARD -j my-chain
+-A my-chain -j ACCEPT
+COMMIT
+`))
+		})
+
+		It("should not change rules without managed chains", func() {
+			rules := []byte("*filter\n:INPUT ACCEPT [0:0]\n-A INPUT -j ACCEPT\nCOMMIT\n")
+			Expect(FilterIPTablesRules(rules)).To(Equal(rules))
+		})
+	})
+})
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// CheckKubernetesVersionUpgrade checks whether the cluster can be upgraded from the current to the target Kubernetes
+// version. Downgrades and upgrades skipping a minor version are not supported.
+func CheckKubernetesVersionUpgrade(current, target *semver.Version) error {
+	if target.LessThan(current) {
+		return fmt.Errorf("downgrading the Kubernetes version from %s to %s is not supported", current, target)
+	}
+
+	if target.Major() != current.Major() || target.Minor() > current.Minor()+1 {
+		return fmt.Errorf("upgrading the Kubernetes version from %s to %s is not supported, minor versions must not be skipped", current, target)
+	}
+
+	return nil
+}
+
+// ListNodesForUpgrade returns the nodes of the cluster in the order in which they are upgraded, i.e., the node of this
+// host (the control plane node running `gardenadm upgrade`) comes first, followed by all other nodes sorted by name.
+func (b *AutonomousBotanist) ListNodesForUpgrade(ctx context.Context) ([]corev1.Node, error) {
+	nodeList := &corev1.NodeList{}
+	if err := b.SeedClientSet.Client().List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	nodes := nodeList.Items
+	slices.SortFunc(nodes, func(a, b corev1.Node) int { return strings.Compare(a.Name, b.Name) })
+
+	hostNode, err := nodeagent.FetchNodeByHostName(ctx, b.SeedClientSet.Client(), b.HostName)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching node object via hostname: %w", err)
+	}
+	if hostNode == nil {
+		return nodes, nil
+	}
+
+	return append([]corev1.Node{*hostNode}, slices.DeleteFunc(nodes, func(node corev1.Node) bool { return node.Name == hostNode.Name })...), nil
+}
+
+// CheckNodesHealthy checks whether all nodes of the cluster are healthy. It serves as health gate before the upgrade
+// is started.
+func (b *AutonomousBotanist) CheckNodesHealthy(ctx context.Context) error {
+	nodeList := &corev1.NodeList{}
+	if err := b.SeedClientSet.Client().List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		if err := health.CheckNode(&node); err != nil {
+			return fmt.Errorf("node %s is unhealthy: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// HoldOperatingSystemConfig annotates the nodes with the given names so that gardener-node-agent does not apply new
+// OperatingSystemConfigs to them until they are released via ReleaseOperatingSystemConfig. This allows rolling out the
+// OperatingSystemConfig node by node.
+func (b *AutonomousBotanist) HoldOperatingSystemConfig(ctx context.Context, nodeNames ...string) error {
+	for _, nodeName := range nodeNames {
+		if err := b.patchNodeHoldAnnotation(ctx, nodeName, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReleaseOperatingSystemConfig removes the annotation added by HoldOperatingSystemConfig from the node with the given
+// name so that gardener-node-agent applies the current OperatingSystemConfig.
+func (b *AutonomousBotanist) ReleaseOperatingSystemConfig(ctx context.Context, nodeName string) error {
+	return b.patchNodeHoldAnnotation(ctx, nodeName, false)
+}
+
+func (b *AutonomousBotanist) patchNodeHoldAnnotation(ctx context.Context, nodeName string, hold bool) error {
+	node := &corev1.Node{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("failed reading node %s: %w", nodeName, err)
+	}
+
+	if metav1.HasAnnotation(node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig) == hold {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	if hold {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig, "true")
+	} else {
+		delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig)
+	}
+
+	if err := b.SeedClientSet.Client().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching annotation %s of node %s: %w", nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig, nodeName, err)
+	}
+	return nil
+}
+
+// WaitUntilControlPlaneHealthy checks whether the kube-apiserver reports readiness. It serves as health gate after the
+// static control plane pods have been updated.
+func (b *AutonomousBotanist) WaitUntilControlPlaneHealthy(ctx context.Context) error {
+	result := b.SeedClientSet.RESTClient().Get().AbsPath("/readyz").Do(ctx)
+	if result.Error() != nil {
+		return fmt.Errorf("failed to GET /readyz endpoint of kube-apiserver: %w", result.Error())
+	}
+
+	var statusCode int
+	result.StatusCode(&statusCode)
+	if statusCode != http.StatusOK {
+		return fmt.Errorf("kube-apiserver does not report readiness yet (status code %d)", statusCode)
+	}
+
+	return nil
+}
+
+// WaitUntilNodeUpgraded checks whether the node with the given name runs the target Kubernetes version of the shoot and
+// is healthy. It serves as health gate before the next node is upgraded.
+func (b *AutonomousBotanist) WaitUntilNodeUpgraded(ctx context.Context, nodeName string) error {
+	node := &corev1.Node{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("failed reading node %s: %w", nodeName, err)
+	}
+
+	kubeletVersion, err := semver.NewVersion(node.Status.NodeInfo.KubeletVersion)
+	if err != nil {
+		return fmt.Errorf("failed parsing kubelet version %q of node %s: %w", node.Status.NodeInfo.KubeletVersion, nodeName, err)
+	}
+
+	if !kubeletVersion.Equal(b.Shoot.KubernetesVersion) {
+		return fmt.Errorf("node %s has not yet been upgraded, kubelet version is %s (target version is %s)", nodeName, kubeletVersion, b.Shoot.KubernetesVersion)
+	}
+
+	if err := health.CheckNode(node); err != nil {
+		return fmt.Errorf("node %s is unhealthy after upgrade: %w", nodeName, err)
+	}
+
+	return nil
+}
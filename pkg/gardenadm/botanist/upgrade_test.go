@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+var _ = Describe("Upgrade", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+
+		b *AutonomousBotanist
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		b = &AutonomousBotanist{
+			Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+				Logger:        logr.Discard(),
+				SeedClientSet: fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).Build(),
+				Shoot:         &shootpkg.Shoot{KubernetesVersion: semver.MustParse("1.33.1")},
+			}},
+			HostName: "machine-1",
+		}
+	})
+
+	Describe("#CheckKubernetesVersionUpgrade", func() {
+		It("should allow patch and minor version upgrades", func() {
+			Expect(CheckKubernetesVersionUpgrade(semver.MustParse("1.32.1"), semver.MustParse("1.32.3"))).To(Succeed())
+			Expect(CheckKubernetesVersionUpgrade(semver.MustParse("1.32.1"), semver.MustParse("1.33.0"))).To(Succeed())
+		})
+
+		It("should allow re-applying the same version", func() {
+			Expect(CheckKubernetesVersionUpgrade(semver.MustParse("1.32.1"), semver.MustParse("1.32.1"))).To(Succeed())
+		})
+
+		It("should forbid downgrades", func() {
+			Expect(CheckKubernetesVersionUpgrade(semver.MustParse("1.32.1"), semver.MustParse("1.31.5"))).To(MatchError(ContainSubstring("downgrading")))
+		})
+
+		It("should forbid skipping minor versions", func() {
+			Expect(CheckKubernetesVersionUpgrade(semver.MustParse("1.31.1"), semver.MustParse("1.33.0"))).To(MatchError(ContainSubstring("minor versions must not be skipped")))
+		})
+	})
+
+	Describe("#ListNodesForUpgrade", func() {
+		It("should return the node of this host first and all other nodes sorted by name", func() {
+			for _, node := range []*corev1.Node{
+				newNode("machine-2", "", true),
+				newNode("machine-0", "", true),
+				newNode("machine-1", "", true),
+			} {
+				Expect(fakeClient.Create(ctx, node)).To(Succeed())
+			}
+
+			nodes, err := b.ListNodesForUpgrade(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, node := range nodes {
+				names = append(names, node.Name)
+			}
+			Expect(names).To(Equal([]string{"machine-1", "machine-0", "machine-2"}))
+		})
+	})
+
+	Describe("#CheckNodesHealthy", func() {
+		It("should succeed if all nodes are ready", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.32.1", true))).To(Succeed())
+
+			Expect(b.CheckNodesHealthy(ctx)).To(Succeed())
+		})
+
+		It("should fail if a node is not ready", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.32.1", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("machine-1", "v1.32.1", false))).To(Succeed())
+
+			Expect(b.CheckNodesHealthy(ctx)).To(MatchError(ContainSubstring("node machine-1 is unhealthy")))
+		})
+	})
+
+	Describe("#HoldOperatingSystemConfig", func() {
+		It("should annotate all given nodes", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.32.1", true))).To(Succeed())
+			Expect(fakeClient.Create(ctx, newNode("machine-2", "v1.32.1", true))).To(Succeed())
+
+			Expect(b.HoldOperatingSystemConfig(ctx, "machine-0", "machine-2")).To(Succeed())
+
+			for _, name := range []string{"machine-0", "machine-2"} {
+				node := &corev1.Node{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name}, node)).To(Succeed())
+				Expect(node.Annotations).To(HaveKeyWithValue(nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig, "true"))
+			}
+		})
+
+		It("should fail if a node does not exist", func() {
+			Expect(b.HoldOperatingSystemConfig(ctx, "machine-0")).To(MatchError(ContainSubstring("failed reading node machine-0")))
+		})
+	})
+
+	Describe("#ReleaseOperatingSystemConfig", func() {
+		It("should remove the annotation from the node", func() {
+			node := newNode("machine-0", "v1.32.1", true)
+			node.Annotations = map[string]string{nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig: "true", "foo": "bar"}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+			Expect(b.ReleaseOperatingSystemConfig(ctx, "machine-0")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should succeed if the node is not held back", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.32.1", true))).To(Succeed())
+
+			Expect(b.ReleaseOperatingSystemConfig(ctx, "machine-0")).To(Succeed())
+		})
+	})
+
+	Describe("#WaitUntilNodeUpgraded", func() {
+		It("should fail if the node still runs the old version", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.32.1", true))).To(Succeed())
+
+			Expect(b.WaitUntilNodeUpgraded(ctx, "machine-0")).To(MatchError(ContainSubstring("has not yet been upgraded")))
+		})
+
+		It("should fail if the node is not ready after the upgrade", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.33.1", false))).To(Succeed())
+
+			Expect(b.WaitUntilNodeUpgraded(ctx, "machine-0")).To(MatchError(ContainSubstring("is unhealthy after upgrade")))
+		})
+
+		It("should succeed if the node runs the target version and is ready", func() {
+			Expect(fakeClient.Create(ctx, newNode("machine-0", "v1.33.1", true))).To(Succeed())
+
+			Expect(b.WaitUntilNodeUpgraded(ctx, "machine-0")).To(Succeed())
+		})
+	})
+})
+
+func newNode(name, kubeletVersion string, ready bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{corev1.LabelHostname: name}},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+			NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: kubeletVersion},
+		},
+	}
+}
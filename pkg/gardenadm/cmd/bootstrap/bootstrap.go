@@ -6,10 +6,25 @@ package bootstrap
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	seedsystem "github.com/gardener/gardener/pkg/component/seed/system"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,10 +34,25 @@ func NewCommand(globalOpts *cmd.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bootstrap",
 		Short: "Bootstrap the infrastructure for an Autonomous Shoot Cluster",
-		Long:  "Bootstrap the infrastructure for an Autonomous Shoot Cluster (networks, machines, etc.)",
+		Long: `Bootstrap the infrastructure for an Autonomous Shoot Cluster (networks, machines, etc.)
 
-		Example: `# Bootstrap the infrastructure
-gardenadm bootstrap --kubeconfig ~/.kube/config`,
+The command uses a bootstrap cluster to run the extension controllers which create the infrastructure and the machines
+for the control plane worker pool of the shoot. If no kubeconfig is given (neither via flag nor via the KUBECONFIG
+environment variable), a temporary KinD cluster is created as bootstrap cluster (the kind binary must be available in
+the PATH).
+
+Afterwards, the command connects via SSH to the first machine of the control plane worker pool, verifying it with the
+SSH host key which has been installed via the user data of the machine. It pulls the gardenadm image matching the
+machine's architecture on it, copies the config directory to it, and runs "gardenadm init" on it. Hence, SSH access
+must be enabled for the workers of the shoot, and the machine must be reachable from where the command runs via one of
+the addresses reported in the status of its Machine object. The temporary KinD cluster is deleted once the control
+plane has been initialized.`,
+
+		Example: `# Bootstrap the autonomous shoot cluster using a temporary KinD cluster
+gardenadm bootstrap --config-dir ./config
+
+# Bootstrap the autonomous shoot cluster using an existing bootstrap cluster
+gardenadm bootstrap --kubeconfig ~/.kube/config --config-dir ./config`,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.ParseArgs(args); err != nil {
@@ -46,7 +76,287 @@ gardenadm bootstrap --kubeconfig ~/.kube/config`,
 	return cmd
 }
 
-func run(_ context.Context, opts *Options) error {
-	opts.Log.Info("Not implemented as well")
+func run(ctx context.Context, opts *Options) error {
+	cloudProfile, project, shoot, extensions, err := readManifests(opts)
+	if err != nil {
+		return err
+	}
+
+	initFiles, err := computeInitFiles(opts)
+	if err != nil {
+		return err
+	}
+
+	kubeconfig := opts.Kubeconfig
+	if kubeconfig == "" {
+		tempDir, err := os.MkdirTemp("", "gardenadm-bootstrap-")
+		if err != nil {
+			return fmt.Errorf("failed creating temporary directory: %w", err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		opts.Log.Info("Creating temporary KinD cluster as bootstrap cluster", "name", KindClusterName)
+		if kubeconfig, err = CreateKindCluster(ctx, tempDir); err != nil {
+			return err
+		}
+	}
+
+	if err := bootstrap(ctx, opts, kubeconfig, cloudProfile, project, shoot, extensions, initFiles); err != nil {
+		if opts.Kubeconfig == "" {
+			fmt.Fprintf(opts.ErrOut, "\nThe temporary KinD cluster %[1]q has been kept for investigating the failure. Export its kubeconfig\n"+
+				"with \"kind export kubeconfig --name %[1]s\" and delete it with \"kind delete cluster --name %[1]s\".\n", KindClusterName)
+		}
+		return err
+	}
+
+	if opts.Kubeconfig == "" {
+		opts.Log.Info("Deleting temporary KinD cluster", "name", KindClusterName)
+		if err := DeleteKindCluster(ctx); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your autonomous shoot cluster has been bootstrapped successfully!
+
+The control plane has been initialized on the first machine of the control plane worker pool. The output of
+"gardenadm init" above describes how further machines can join the cluster.
+`)
+
 	return nil
 }
+
+func bootstrap(
+	ctx context.Context,
+	opts *Options,
+	kubeconfig string,
+	cloudProfile *gardencorev1beta1.CloudProfile,
+	project *gardencorev1beta1.Project,
+	shoot *gardencorev1beta1.Shoot,
+	extensions []botanist.Extension,
+	initFiles botanist.InitFiles,
+) error {
+	clientSet, err := kubernetes.NewClientFromFile("", kubeconfig,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.SeedScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed creating client set for bootstrap cluster from kubeconfig %s: %w", kubeconfig, err)
+	}
+
+	b, err := botanist.NewAutonomousBotanist(ctx, opts.Log, botanist.AutonomousBotanistOptions{
+		ClientSet:    clientSet,
+		Project:      project,
+		CloudProfile: cloudProfile,
+		Shoot:        shoot,
+		Extensions:   extensions,
+	})
+	if err != nil {
+		return fmt.Errorf("failed constructing botanist: %w", err)
+	}
+
+	gardenerResourceManager, err := b.NewBootstrapGardenerResourceManager()
+	if err != nil {
+		return fmt.Errorf("failed creating gardener-resource-manager deployer: %w", err)
+	}
+
+	var (
+		conn botanist.MachineConnection
+
+		g = flow.NewGraph("bootstrap")
+
+		deployNamespace = g.Add(flow.Task{
+			Name: "Deploying control plane namespace",
+			Fn:   b.DeployControlPlaneNamespace,
+		})
+		reconcileCustomResourceDefinitions = g.Add(flow.Task{
+			Name: "Reconciling CustomResourceDefinitions",
+			Fn:   b.ReconcileCustomResourceDefinitions,
+		})
+		reconcileMachineControllerManagerCustomResourceDefinitions = g.Add(flow.Task{
+			Name: "Reconciling CustomResourceDefinitions for machine-controller-manager",
+			Fn:   b.ReconcileMachineControllerManagerCustomResourceDefinitions,
+		})
+		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
+			Name:         "Ensuring CustomResourceDefinitions are ready",
+			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions, reconcileMachineControllerManagerCustomResourceDefinitions),
+		})
+		reconcileClusterResource = g.Add(flow.Task{
+			Name: "Reconciling extensions.gardener.cloud/v1alpha1.Cluster resource",
+			Fn: func(ctx context.Context) error {
+				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
+			},
+			Dependencies: flow.NewTaskIDs(deployNamespace, ensureCustomResourceDefinitionsReady),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing secrets management",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		deployCloudProviderSecret = g.Add(flow.Task{
+			Name:         "Deploying cloud provider account secret",
+			Fn:           b.DeployBootstrapCloudProviderSecret,
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		deployGardenerResourceManager = g.Add(flow.Task{
+			Name:         "Deploying gardener-resource-manager",
+			Fn:           gardenerResourceManager.Deploy,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
+			Name:         "Waiting until gardener-resource-manager reports readiness",
+			Fn:           gardenerResourceManager.Wait,
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
+		})
+		deploySeedSystem = g.Add(flow.Task{
+			Name: "Deploying seed system resources",
+			Fn: func(ctx context.Context) error {
+				return seedsystem.New(b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, seedsystem.Values{}).Deploy(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		deployExtensionControllers = g.Add(flow.Task{
+			Name: "Deploying extension controllers",
+			Fn: func(ctx context.Context) error {
+				return b.ReconcileExtensionControllerInstallations(ctx, false)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, reconcileClusterResource),
+		})
+		waitUntilExtensionControllersReady = g.Add(flow.Task{
+			Name:         "Waiting until extension controllers report readiness",
+			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
+		})
+		deployInfrastructure = g.Add(flow.Task{
+			Name:         "Deploying shoot infrastructure",
+			Fn:           flow.TaskFn(b.DeployInfrastructure).RetryUntilTimeout(5*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilExtensionControllersReady),
+		})
+		waitUntilInfrastructureReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot infrastructure has been reconciled",
+			Fn:           b.WaitForInfrastructure,
+			Dependencies: flow.NewTaskIDs(deployInfrastructure),
+		})
+		deployOperatingSystemConfig = g.Add(flow.Task{
+			Name:         "Deploying operating system specific configuration for shoot workers",
+			Fn:           flow.TaskFn(b.DeployBootstrapOperatingSystemConfig).RetryUntilTimeout(5*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilInfrastructureReady),
+		})
+		waitUntilOperatingSystemConfigReady = g.Add(flow.Task{
+			Name:         "Waiting until operating system configurations for worker nodes have been reconciled",
+			Fn:           b.Shoot.Components.Extensions.OperatingSystemConfig.Wait,
+			Dependencies: flow.NewTaskIDs(deployOperatingSystemConfig),
+		})
+		deployMachineControllerManager = g.Add(flow.Task{
+			Name:         "Deploying machine-controller-manager",
+			Fn:           b.DeployMachineControllerManager,
+			Dependencies: flow.NewTaskIDs(deploySeedSystem, deployCloudProviderSecret, waitUntilInfrastructureReady, waitUntilOperatingSystemConfigReady),
+		})
+		waitUntilMachineControllerManagerReady = g.Add(flow.Task{
+			Name:         "Waiting until machine-controller-manager reports readiness",
+			Fn:           b.Shoot.Components.ControlPlane.MachineControllerManager.Wait,
+			Dependencies: flow.NewTaskIDs(deployMachineControllerManager),
+		})
+		deployWorker = g.Add(flow.Task{
+			Name:         "Configuring shoot worker pools",
+			Fn:           flow.TaskFn(b.DeployWorker).RetryUntilTimeout(5*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilMachineControllerManagerReady),
+		})
+		waitUntilWorkerReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot worker nodes have been reconciled",
+			Fn:           b.Shoot.Components.Extensions.Worker.Wait,
+			Dependencies: flow.NewTaskIDs(deployWorker),
+		})
+		deployDNSRecord = g.Add(flow.Task{
+			Name:         "Deploying external domain DNS record",
+			Fn:           flow.TaskFn(b.DeployControlPlaneMachinesDNSRecord).RetryUntilTimeout(5*time.Second, 2*time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilWorkerReady),
+		})
+		connectToControlPlaneMachine = g.Add(flow.Task{
+			Name: "Connecting to first control plane machine",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				var err error
+				conn, err = b.ConnectToControlPlaneMachine(ctx)
+				return err
+			}).RetryUntilTimeout(5*time.Second, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilWorkerReady),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Initializing control plane on first control plane machine",
+			Fn: func(ctx context.Context) error {
+				return botanist.RunInitOnMachine(ctx, conn, initFiles, opts.Out, opts.ErrOut)
+			},
+			Dependencies: flow.NewTaskIDs(deployDNSRecord, connectToControlPlaneMachine),
+		})
+	)
+
+	defer func() {
+		if conn != nil {
+			if err := conn.Close(); err != nil {
+				opts.Log.Error(err, "Failed closing connection to control plane machine")
+			}
+		}
+	}()
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	return nil
+}
+
+func readManifests(opts *Options) (
+	*gardencorev1beta1.CloudProfile,
+	*gardencorev1beta1.Project,
+	*gardencorev1beta1.Shoot,
+	[]botanist.Extension,
+	error,
+) {
+	cloudProfile, project, shoot, controllerRegistrations, controllerDeployments, err := gardenadm.ReadManifests(opts.Log, os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	controlPlaneWorkerPool := v1beta1helper.ControlPlaneWorkerPoolForShoot(shoot)
+	if controlPlaneWorkerPool == nil {
+		return nil, nil, nil, nil, fmt.Errorf("the shoot must have a worker pool with .spec.provider.workers[].controlPlane set")
+	}
+	// Only the machines for the control plane are created by `gardenadm bootstrap`. The machines of all other worker
+	// pools join the cluster after the control plane has been initialized.
+	shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{*controlPlaneWorkerPool}
+
+	extensions, err := botanist.ComputeExtensions(shoot, controllerRegistrations, controllerDeployments)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed computing extensions: %w", err)
+	}
+
+	return cloudProfile, project, shoot, extensions, nil
+}
+
+// computeInitFiles computes the files which are copied to the first control plane machine for running `gardenadm init`:
+// the config directory and the image vector overwrite (if configured). The gardenadm binary is not copied since this
+// process might run on a different operating system or architecture than the machine. Instead, the gardenadm image of
+// the same version is pulled on the machine.
+func computeInitFiles(opts *Options) (botanist.InitFiles, error) {
+	gardenadmImage, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameGardenadm)
+	if err != nil {
+		return botanist.InitFiles{}, fmt.Errorf("failed finding image %q: %w", imagevector.ContainerImageNameGardenadm, err)
+	}
+	gardenadmImage.WithOptionalTag(version.Get().GitVersion)
+
+	var imageVectorOverwrite []byte
+	if path := os.Getenv(imagevectorutils.OverrideEnv); path != "" {
+		if imageVectorOverwrite, err = os.ReadFile(path); err != nil {
+			return botanist.InitFiles{}, fmt.Errorf("failed reading image vector overwrite from %s: %w", path, err)
+		}
+	}
+
+	return botanist.InitFiles{
+		GardenadmImage:       gardenadmImage.String(),
+		ConfigDir:            os.DirFS(opts.ConfigDir),
+		ImageVectorOverwrite: imageVectorOverwrite,
+	}, nil
+}
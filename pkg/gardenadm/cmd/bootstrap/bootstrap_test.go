@@ -32,12 +32,17 @@ var _ = Describe("Bootstrap", func() {
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		It("should return an error because the config directory is not set", func() {
 			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
 
-			Expect(command.RunE(command, nil)).To(Succeed())
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should return an error because the config directory does not exist", func() {
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(command.Flags().Set("config-dir", "some-path-to-nonexisting-config-dir")).To(Succeed())
 
-			Eventually(stdErr).Should(Say("Not implemented as well"))
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed reading Kubernetes resources from config directory")))
 		})
 	})
 })
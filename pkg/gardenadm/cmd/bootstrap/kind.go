@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+)
+
+// KindClusterName is the name of the temporary KinD cluster created by `gardenadm bootstrap`.
+const KindClusterName = "gardenadm-bootstrap"
+
+// RunKind runs the kind binary with the given arguments. Exposed for testing.
+var RunKind = func(ctx context.Context, args ...string) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "kind", args...)
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("command \"kind\" failed: %w (stderr: %s)", err, stderr.String())
+	}
+	return nil
+}
+
+// CreateKindCluster creates the temporary KinD cluster and returns the path to its kubeconfig which is written to the
+// given directory. It requires the kind binary to be available in the PATH.
+func CreateKindCluster(ctx context.Context, dir string) (string, error) {
+	kubeconfigPath := filepath.Join(dir, "kubeconfig")
+
+	if err := RunKind(ctx, "create", "cluster", "--name", KindClusterName, "--kubeconfig", kubeconfigPath, "--wait", "5m"); err != nil {
+		return "", fmt.Errorf("failed creating KinD cluster %s: %w", KindClusterName, err)
+	}
+
+	return kubeconfigPath, nil
+}
+
+// DeleteKindCluster deletes the temporary KinD cluster.
+func DeleteKindCluster(ctx context.Context) error {
+	if err := RunKind(ctx, "delete", "cluster", "--name", KindClusterName); err != nil {
+		return fmt.Errorf("failed deleting KinD cluster %s: %w", KindClusterName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bootstrap_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/bootstrap"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("KinD", func() {
+	var (
+		ctx = context.Background()
+
+		commands [][]string
+		runErr   error
+	)
+
+	BeforeEach(func() {
+		commands = nil
+		runErr = nil
+
+		DeferCleanup(test.WithVar(&RunKind, func(_ context.Context, args ...string) error {
+			commands = append(commands, append([]string{"kind"}, args...))
+			return runErr
+		}))
+	})
+
+	Describe("#CreateKindCluster", func() {
+		It("should create the KinD cluster and return the path to its kubeconfig", func() {
+			Expect(CreateKindCluster(ctx, "/tmp/foo")).To(Equal("/tmp/foo/kubeconfig"))
+			Expect(commands).To(Equal([][]string{
+				{"kind", "create", "cluster", "--name", "gardenadm-bootstrap", "--kubeconfig", "/tmp/foo/kubeconfig", "--wait", "5m"},
+			}))
+		})
+
+		It("should fail if the KinD cluster cannot be created", func() {
+			runErr = fmt.Errorf("fake")
+
+			_, err := CreateKindCluster(ctx, "/tmp/foo")
+			Expect(err).To(MatchError(ContainSubstring("failed creating KinD cluster gardenadm-bootstrap: fake")))
+		})
+	})
+
+	Describe("#DeleteKindCluster", func() {
+		It("should delete the KinD cluster", func() {
+			Expect(DeleteKindCluster(ctx)).To(Succeed())
+			Expect(commands).To(Equal([][]string{
+				{"kind", "delete", "cluster", "--name", "gardenadm-bootstrap"},
+			}))
+		})
+
+		It("should fail if the KinD cluster cannot be deleted", func() {
+			runErr = fmt.Errorf("fake")
+
+			Expect(DeleteKindCluster(ctx)).To(MatchError(ContainSubstring("failed deleting KinD cluster gardenadm-bootstrap: fake")))
+		})
+	})
+})
@@ -16,8 +16,13 @@ import (
 // Options contains options for this command.
 type Options struct {
 	*cmd.Options
-	// Kubeconfig is the path to the kubeconfig file pointing to the KinD cluster.
+	// Kubeconfig is the path to the kubeconfig file pointing to an existing bootstrap cluster (e.g., a KinD cluster). It
+	// defaults to the KUBECONFIG environment variable. If neither is set, a temporary KinD cluster is created and deleted
+	// after the control plane has been initialized.
 	Kubeconfig string
+	// ConfigDir is the path to a directory containing the Gardener configuration files for the bootstrap command, i.e.,
+	// files containing resources like CloudProfile, Shoot, etc.
+	ConfigDir string
 }
 
 // ParseArgs parses the arguments to the options.
@@ -31,8 +36,8 @@ func (o *Options) ParseArgs(_ []string) error {
 
 // Validate validates the options.
 func (o *Options) Validate() error {
-	if len(o.Kubeconfig) == 0 {
-		return fmt.Errorf("must provide a path to a KinD cluster kubeconfig")
+	if len(o.ConfigDir) == 0 {
+		return fmt.Errorf("must provide a path to a config directory")
 	}
 
 	return nil
@@ -42,5 +47,10 @@ func (o *Options) Validate() error {
 func (o *Options) Complete() error { return nil }
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
-	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the KinD cluster")
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to an existing "+
+		"bootstrap cluster (e.g., a KinD cluster). Defaults to the KUBECONFIG environment variable. If neither is set, a "+
+		"temporary KinD cluster is created and deleted after the control plane has been initialized")
+	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to a directory containing "+
+		"the Gardener configuration files for the bootstrap command, i.e., files containing resources like CloudProfile, "+
+		"Shoot, etc. The files must be in YAML/JSON and have .{yaml,yml,json} file extensions to be considered.")
 }
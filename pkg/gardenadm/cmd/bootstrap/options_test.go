@@ -24,17 +24,41 @@ var _ = Describe("Options", func() {
 		It("should return nil", func() {
 			Expect(options.ParseArgs(nil)).To(Succeed())
 		})
+
+		It("should default the kubeconfig path to the KUBECONFIG environment variable", func() {
+			GinkgoT().Setenv("KUBECONFIG", "path-from-env")
+
+			Expect(options.ParseArgs(nil)).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("path-from-env"))
+		})
+
+		It("should not overwrite the kubeconfig path with the KUBECONFIG environment variable", func() {
+			GinkgoT().Setenv("KUBECONFIG", "path-from-env")
+			options.Kubeconfig = "some-path-to-kubeconfig"
+
+			Expect(options.ParseArgs(nil)).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("some-path-to-kubeconfig"))
+		})
 	})
 
 	Describe("#Validate", func() {
 		It("should pass for valid options", func() {
 			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.ConfigDir = "some-path-to-config-dir"
 
 			Expect(options.Validate()).To(Succeed())
 		})
 
-		It("should fail because kubeconfig path is not set", func() {
-			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a KinD cluster kubeconfig")))
+		It("should pass if kubeconfig path is not set", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because config dir path is not set", func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
 		})
 	})
 
@@ -6,10 +6,18 @@ package connect
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,10 +27,16 @@ func NewCommand(globalOpts *cmd.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "connect",
 		Short: "Deploy a gardenlet for further cluster management",
-		Long:  "Deploy a gardenlet for further cluster management",
+		Long: `Deploy a gardenlet for further cluster management
 
-		Example: `# Deploy a gardenlet
-gardenadm connect`,
+The command registers the autonomous shoot cluster with an existing Gardener installation. It creates the Project in
+the garden cluster and deploys a gardenlet into the autonomous shoot cluster which registers the corresponding Seed.
+Only then, the Shoot resource is created in the garden cluster and the locally held state (e.g., the secrets which must
+be persisted) is migrated into the ShootState in the garden cluster. From then on, the autonomous shoot cluster is
+managed via the garden cluster.`,
+
+		Example: `# Deploy a gardenlet and connect the autonomous shoot cluster to the garden cluster
+gardenadm connect --bootstrap-kubeconfig ./garden-kubeconfig --config-dir ./config`,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.ParseArgs(args); err != nil {
@@ -46,7 +60,115 @@ gardenadm connect`,
 	return cmd
 }
 
-func run(_ context.Context, opts *Options) error {
-	opts.Log.Info("Not implemented")
+func run(ctx context.Context, opts *Options) error {
+	b, err := newBotanist(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	gardenClientSet, err := kubernetes.NewClientFromFile("", opts.BootstrapKubeconfig,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.GardenScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed creating client set for garden cluster from bootstrap kubeconfig %s: %w", opts.BootstrapKubeconfig, err)
+	}
+
+	var (
+		g = flow.NewGraph("connect")
+
+		ensureProject = g.Add(flow.Task{
+			Name: "Ensuring project in garden cluster",
+			Fn: func(ctx context.Context) error {
+				return b.EnsureProjectInGarden(ctx, gardenClientSet.Client())
+			},
+		})
+		waitUntilProjectReady = g.Add(flow.Task{
+			Name: "Waiting until project in garden cluster is ready",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return b.WaitUntilProjectReadyInGarden(ctx, gardenClientSet.Client())
+			}).RetryUntilTimeout(2*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(ensureProject),
+		})
+		deployGardenlet = g.Add(flow.Task{
+			Name: "Deploying gardenlet into autonomous shoot cluster",
+			Fn: func(ctx context.Context) error {
+				return b.DeployGardenlet(ctx, gardenClientSet)
+			},
+		})
+		waitUntilSeedRegistered = g.Add(flow.Task{
+			Name: "Waiting until gardenlet has registered the seed in garden cluster",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return b.WaitUntilSeedRegisteredInGarden(ctx, gardenClientSet.Client())
+			}).RetryUntilTimeout(5*time.Second, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(deployGardenlet),
+		})
+		// The Shoot is registered before the ShootState is migrated so that the ShootState is not orphaned if the
+		// registration fails. gardenlet reuses the existing secrets, e.g., CAs, anyways since the technical ID of the Shoot
+		// points to the kube-system namespace in which they are stored.
+		registerShoot = g.Add(flow.Task{
+			Name: "Registering shoot in garden cluster",
+			Fn: func(ctx context.Context) error {
+				return b.RegisterShootInGarden(ctx, gardenClientSet.Client())
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilProjectReady, waitUntilSeedRegistered),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Migrating shoot state into garden cluster",
+			Fn: func(ctx context.Context) error {
+				return b.MigrateShootStateToGarden(ctx, gardenClientSet.Client())
+			},
+			Dependencies: flow.NewTaskIDs(registerShoot),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your autonomous shoot cluster has been connected to the garden cluster successfully!
+
+The gardenlet has registered the seed %[1]q and the shoot %[2]s is now managed via the
+garden cluster. Please use the shoots/adminkubeconfig subresource to retrieve a kubeconfig,
+see https://gardener.cloud/docs/gardener/shoot/shoot_access/.
+`, b.Seed.GetInfo().Name, client.ObjectKeyFromObject(b.Shoot.GetInfo()))
+
 	return nil
 }
+
+func newBotanist(ctx context.Context, opts *Options) (*botanist.AutonomousBotanist, error) {
+	cloudProfile, project, shoot, controllerRegistrations, controllerDeployments, err := gardenadm.ReadManifests(opts.Log, os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	extensions, err := botanist.ComputeExtensions(shoot, controllerRegistrations, controllerDeployments)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing extensions: %w", err)
+	}
+
+	clientSet, err := kubernetes.NewClientFromFile("", botanist.PathKubeconfig,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.SeedScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client set for autonomous shoot cluster from kubeconfig %s: %w", botanist.PathKubeconfig, err)
+	}
+
+	b, err := botanist.NewAutonomousBotanist(ctx, opts.Log, botanist.AutonomousBotanistOptions{
+		ClientSet:        clientSet,
+		Project:          project,
+		CloudProfile:     cloudProfile,
+		Shoot:            shoot,
+		Extensions:       extensions,
+		RunsControlPlane: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed constructing botanist: %w", err)
+	}
+
+	return b, nil
+}
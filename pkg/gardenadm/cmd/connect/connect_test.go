@@ -32,10 +32,23 @@ var _ = Describe("Connect", func() {
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(command.RunE(command, nil)).To(Succeed())
+		It("should return an error because the bootstrap kubeconfig is not set", func() {
+			Expect(command.Flags().Set("config-dir", "some-path-to-config-dir")).To(Succeed())
 
-			Eventually(stdErr).Should(Say("Not implemented"))
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("must provide a path to a bootstrap kubeconfig for the garden cluster")))
+		})
+
+		It("should return an error because the config directory is not set", func() {
+			Expect(command.Flags().Set("bootstrap-kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should return an error because the config directory does not exist", func() {
+			Expect(command.Flags().Set("bootstrap-kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+			Expect(command.Flags().Set("config-dir", "some-path-to-nonexisting-config-dir")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed reading Kubernetes resources from config directory")))
 		})
 	})
 })
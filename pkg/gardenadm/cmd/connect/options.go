@@ -5,6 +5,8 @@
 package connect
 
 import (
+	"fmt"
+
 	"github.com/spf13/pflag"
 
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
@@ -13,15 +15,37 @@ import (
 // Options contains options for this command.
 type Options struct {
 	*cmd.Options
+	// BootstrapKubeconfig is the path to the kubeconfig file pointing to the garden cluster. It is used for creating the
+	// Project and Shoot resources in the garden cluster and for requesting a bootstrap token for gardenlet.
+	BootstrapKubeconfig string
+	// ConfigDir is the path to a directory containing the Gardener configuration files for the connect command, i.e.,
+	// files containing resources like CloudProfile, Shoot, etc.
+	ConfigDir string
 }
 
 // ParseArgs parses the arguments to the options.
 func (o *Options) ParseArgs(_ []string) error { return nil }
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.BootstrapKubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to a bootstrap kubeconfig for the garden cluster")
+	}
+
+	if len(o.ConfigDir) == 0 {
+		return fmt.Errorf("must provide a path to a config directory")
+	}
+
+	return nil
+}
 
 // Complete completes the options.
 func (o *Options) Complete() error { return nil }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.BootstrapKubeconfig, "bootstrap-kubeconfig", "k", "", "Path to the kubeconfig file pointing to "+
+		"the garden cluster. It must be permitted to create Projects, Shoots, and bootstrap tokens.")
+	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to a directory containing "+
+		"the Gardener configuration files for the connect command, i.e., files containing resources like CloudProfile, "+
+		"Shoot, etc. The files must be in YAML/JSON and have .{yaml,yml,json} file extensions to be considered.")
+}
@@ -27,9 +27,24 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		It("should pass for valid options", func() {
+			options.BootstrapKubeconfig = "some-path-to-kubeconfig"
+			options.ConfigDir = "some-path-to-config-dir"
+
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because bootstrap kubeconfig path is not set", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a bootstrap kubeconfig for the garden cluster")))
+		})
+
+		It("should fail because config dir path is not set", func() {
+			options.BootstrapKubeconfig = "some-path-to-kubeconfig"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
 	})
 
 	Describe("#Complete", func() {
@@ -5,11 +5,26 @@
 package discover
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -17,12 +32,22 @@ func NewCommand(globalOpts *cmd.Options) *cobra.Command {
 	opts := &Options{Options: globalOpts}
 
 	cmd := &cobra.Command{
-		Use:   "discover",
+		Use:   "discover <shoot-manifest>",
 		Short: "Conveniently download Gardener configuration resources from an existing garden cluster",
-		Long:  "Conveniently download Gardener configuration resources from an existing garden cluster (CloudProfile, ControllerRegistrations, ControllerDeployments, etc.)",
+		Long: `Conveniently download Gardener configuration resources from an existing garden cluster (CloudProfile, ControllerRegistrations, ControllerDeployments, etc.)
 
-		Example: `# Download the configuration
-gardenadm discover --kubeconfig ~/.kube/config`,
+The command reads the given shoot manifest and downloads the resources it refers to from the garden cluster, i.e., the
+(Namespaced)CloudProfile, the Project, and the ControllerRegistrations and ControllerDeployments of the extensions
+needed by the shoot. Helm charts referenced via OCI repositories are pulled and inlined into the ControllerDeployments.
+The resources are written to the config directory such that "gardenadm init" can consume them without network access
+to the garden cluster.
+A reference to a NamespacedCloudProfile is rewritten to the exported CloudProfile in the shoot manifest written to the
+config directory. Hence, the config directory must differ from the directory of the shoot manifest in this case.`,
+
+		Example: `# Download the configuration for the shoot defined in ./config/shoot.yaml into ./config
+gardenadm discover --kubeconfig ~/.kube/config ./config/shoot.yaml`,
+
+		Args: cobra.MaximumNArgs(1),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.ParseArgs(args); err != nil {
@@ -46,7 +71,227 @@ gardenadm discover --kubeconfig ~/.kube/config`,
 	return cmd
 }
 
-func run(_ context.Context, opts *Options) error {
-	opts.Log.Info("Not implemented")
+func run(ctx context.Context, opts *Options) error {
+	shootManifest, err := os.ReadFile(opts.ShootManifest)
+	if err != nil {
+		return fmt.Errorf("failed reading shoot manifest %s: %w", opts.ShootManifest, err)
+	}
+
+	shoot, err := decodeShoot(shootManifest)
+	if err != nil {
+		return fmt.Errorf("failed decoding shoot manifest %s: %w", opts.ShootManifest, err)
+	}
+
+	// The shoot manifest is not copied if it has been read from the config directory, otherwise the config directory would
+	// contain two shoots. However, a reference to a NamespacedCloudProfile must be rewritten in the config directory, and
+	// the input manifest is never modified.
+	shootManifestInConfigDir := filepath.Clean(filepath.Dir(opts.ShootManifest)) == filepath.Clean(opts.ConfigDir)
+	if shootManifestInConfigDir && referencesNamespacedCloudProfile(shoot) {
+		return fmt.Errorf("shoot manifest %s refers to a NamespacedCloudProfile which is exported as CloudProfile, hence the "+
+			"shoot manifest must be rewritten in the config directory: please specify a config directory other than the "+
+			"directory of the shoot manifest via --config-dir", opts.ShootManifest)
+	}
+
+	clientSet, err := kubernetes.NewClientFromFile("", opts.Kubeconfig,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.GardenScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed creating client set for garden cluster from kubeconfig %s: %w", opts.Kubeconfig, err)
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, clientSet.Client(), shoot)
+	if err != nil {
+		return fmt.Errorf("failed reading cloud profile for shoot: %w", err)
+	}
+	opts.Log.Info("Discovered cloud profile", "cloudProfileName", cloudProfile.Name)
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, clientSet.Client(), shoot.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed reading project for namespace %s: %w", shoot.Namespace, err)
+	}
+	opts.Log.Info("Discovered project", "projectName", project.Name)
+
+	extensions, err := discoverExtensions(ctx, clientSet.Client(), shoot)
+	if err != nil {
+		return err
+	}
+
+	helmRegistry := oci.NewHelmRegistry(clientSet.Client())
+	for _, extension := range extensions {
+		opts.Log.Info("Discovered extension", "controllerRegistrationName", extension.ControllerRegistration.Name, "controllerDeploymentName", extension.ControllerDeployment.Name)
+
+		if err := inlineHelmChart(ctx, helmRegistry, extension.ControllerDeployment); err != nil {
+			return fmt.Errorf("failed inlining Helm chart of ControllerDeployment %s: %w", extension.ControllerDeployment.Name, err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(opts.ConfigDir, "extensions"), 0700); err != nil {
+		return fmt.Errorf("failed creating config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	// NamespacedCloudProfiles are exported as the effective CloudProfile which is computed by the garden cluster.
+	cloudProfile.ObjectMeta = cleanObjectMeta(cloudProfile.ObjectMeta)
+	cloudProfile.Namespace = ""
+	if err := writeManifests(filepath.Join(opts.ConfigDir, "cloudprofile.yaml"), cloudProfile); err != nil {
+		return err
+	}
+
+	project.ObjectMeta = cleanObjectMeta(project.ObjectMeta)
+	project.Status = gardencorev1beta1.ProjectStatus{}
+	if err := writeManifests(filepath.Join(opts.ConfigDir, "project.yaml"), project); err != nil {
+		return err
+	}
+
+	for _, extension := range extensions {
+		extension.ControllerRegistration.ObjectMeta = cleanObjectMeta(extension.ControllerRegistration.ObjectMeta)
+		extension.ControllerDeployment.ObjectMeta = cleanObjectMeta(extension.ControllerDeployment.ObjectMeta)
+
+		if err := writeManifests(
+			filepath.Join(opts.ConfigDir, "extensions", extension.ControllerRegistration.Name+".yaml"),
+			extension.ControllerRegistration,
+			extension.ControllerDeployment,
+		); err != nil {
+			return err
+		}
+	}
+
+	if !shootManifestInConfigDir {
+		if rewriteCloudProfileReference(shoot) {
+			if shootManifest, err = gardenadm.EncodeManifest(shoot); err != nil {
+				return fmt.Errorf("failed encoding shoot manifest: %w", err)
+			}
+		}
+
+		if err := os.WriteFile(filepath.Join(opts.ConfigDir, "shoot.yaml"), shootManifest, 0600); err != nil {
+			return fmt.Errorf("failed writing shoot manifest to config directory %s: %w", opts.ConfigDir, err)
+		}
+	}
+
+	fmt.Fprintf(opts.Out, `
+The configuration for your autonomous shoot cluster has been discovered successfully!
+
+The resources have been written to the config directory %[1]s. Copy it to the
+first control plane node and run the following command as root on it:
+
+  gardenadm init --config-dir %[1]s
+`, opts.ConfigDir)
+
+	return nil
+}
+
+func decodeShoot(data []byte) (*gardencorev1beta1.Shoot, error) {
+	obj, err := runtime.Decode(kubernetes.GardenCodec.UniversalDecoder(gardencorev1beta1.SchemeGroupVersion), data)
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return nil, fmt.Errorf("expected *gardencorev1beta1.Shoot but got %T", obj)
+	}
+
+	return shoot, nil
+}
+
+func discoverExtensions(ctx context.Context, c client.Reader, shoot *gardencorev1beta1.Shoot) ([]botanist.Extension, error) {
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := c.List(ctx, controllerRegistrationList); err != nil {
+		return nil, fmt.Errorf("failed listing ControllerRegistrations: %w", err)
+	}
+
+	controllerDeploymentList := &gardencorev1.ControllerDeploymentList{}
+	if err := c.List(ctx, controllerDeploymentList); err != nil {
+		return nil, fmt.Errorf("failed listing ControllerDeployments: %w", err)
+	}
+
+	var (
+		controllerRegistrations []*gardencorev1beta1.ControllerRegistration
+		controllerDeployments   []*gardencorev1.ControllerDeployment
+	)
+
+	for _, controllerRegistration := range controllerRegistrationList.Items {
+		controllerRegistrations = append(controllerRegistrations, controllerRegistration.DeepCopy())
+	}
+	for _, controllerDeployment := range controllerDeploymentList.Items {
+		controllerDeployments = append(controllerDeployments, controllerDeployment.DeepCopy())
+	}
+
+	extensions, err := botanist.ComputeExtensions(shoot, controllerRegistrations, controllerDeployments)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing extensions: %w", err)
+	}
+
+	return extensions, nil
+}
+
+func referencesNamespacedCloudProfile(shoot *gardencorev1beta1.Shoot) bool {
+	cloudProfileReference := gardenerutils.BuildCloudProfileReference(shoot)
+	return cloudProfileReference != nil && cloudProfileReference.Kind == v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile
+}
+
+// rewriteCloudProfileReference rewrites a reference to a NamespacedCloudProfile in the given shoot to a reference to a
+// CloudProfile with the same name, since NamespacedCloudProfiles are exported as the effective CloudProfile. It returns
+// true if the shoot has been changed.
+func rewriteCloudProfileReference(shoot *gardencorev1beta1.Shoot) bool {
+	if !referencesNamespacedCloudProfile(shoot) {
+		return false
+	}
+
+	shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{
+		Kind: v1beta1constants.CloudProfileReferenceKindCloudProfile,
+		Name: gardenerutils.BuildCloudProfileReference(shoot).Name,
+	}
+	// The deprecated cloudProfileName field refers to the parent CloudProfile of the NamespacedCloudProfile, which is not
+	// exported.
+	shoot.Spec.CloudProfileName = nil
+	return true
+}
+
+// inlineHelmChart pulls the Helm chart referenced via an OCI repository in the given ControllerDeployment and inlines
+// it as raw chart such that no access to the OCI registry is needed when the ControllerDeployment is used later.
+func inlineHelmChart(ctx context.Context, helmRegistry oci.Interface, controllerDeployment *gardencorev1.ControllerDeployment) error {
+	if controllerDeployment.Helm == nil || controllerDeployment.Helm.OCIRepository == nil {
+		return nil
+	}
+
+	archive, err := helmRegistry.Pull(ctx, controllerDeployment.Helm.OCIRepository)
+	if err != nil {
+		return fmt.Errorf("failed pulling Helm chart from OCI repository %s: %w", controllerDeployment.Helm.OCIRepository.GetURL(), err)
+	}
+
+	controllerDeployment.Helm.RawChart = archive
+	controllerDeployment.Helm.OCIRepository = nil
+	return nil
+}
+
+func cleanObjectMeta(objectMeta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        objectMeta.Name,
+		Namespace:   objectMeta.Namespace,
+		Labels:      objectMeta.Labels,
+		Annotations: objectMeta.Annotations,
+	}
+}
+
+func writeManifests(path string, objects ...client.Object) error {
+	var buf bytes.Buffer
+
+	for i, obj := range objects {
+		data, err := gardenadm.EncodeManifest(obj)
+		if err != nil {
+			return fmt.Errorf("failed encoding %T %s: %w", obj, client.ObjectKeyFromObject(obj), err)
+		}
+
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed writing file %s: %w", path, err)
+	}
+
 	return nil
 }
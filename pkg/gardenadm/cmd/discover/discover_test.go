@@ -5,15 +5,27 @@
 package discover_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
 	"github.com/gardener/gardener/pkg/logger"
+	fakeregistry "github.com/gardener/gardener/pkg/utils/oci/fake"
 	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
 )
 
@@ -32,12 +44,201 @@ var _ = Describe("Discover", func() {
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		It("should return an error because the shoot manifest is not set", func() {
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("must provide a path to a shoot manifest")))
+		})
+
+		It("should return an error because the shoot manifest does not exist", func() {
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+
+			Expect(command.RunE(command, []string{"some-path-to-nonexisting-shoot-manifest"})).To(MatchError(ContainSubstring("failed reading shoot manifest")))
+		})
+
+		It("should return an error because the manifest does not contain a shoot", func() {
+			manifest := filepath.Join(GinkgoT().TempDir(), "project.yaml")
+			Expect(os.WriteFile(manifest, []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Project
+metadata:
+  name: foo
+`), 0600)).To(Succeed())
 			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
 
-			Expect(command.RunE(command, nil)).To(Succeed())
+			Expect(command.RunE(command, []string{manifest})).To(MatchError(ContainSubstring("expected *gardencorev1beta1.Shoot but got *v1beta1.Project")))
+		})
+
+		It("should not overwrite a shoot manifest referring to a NamespacedCloudProfile in the config directory", func() {
+			content := []byte(`apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: foo
+  namespace: garden-foo
+spec:
+  cloudProfile:
+    kind: NamespacedCloudProfile
+    name: local-custom
+`)
+			manifest := filepath.Join(GinkgoT().TempDir(), "shoot.yaml")
+			Expect(os.WriteFile(manifest, content, 0600)).To(Succeed())
+			Expect(command.Flags().Set("kubeconfig", "some-path-to-kubeconfig")).To(Succeed())
+
+			Expect(command.RunE(command, []string{manifest})).To(MatchError(ContainSubstring("please specify a config directory other than the directory of the shoot manifest")))
+			Expect(os.ReadFile(manifest)).To(Equal(content))
+		})
+	})
+
+	Describe("#DiscoverExtensions", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			shoot      *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "garden"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Type:    "local",
+						Workers: []gardencorev1beta1.Worker{{ControlPlane: &gardencorev1beta1.WorkerControlPlane{}}},
+					},
+				},
+			}
+
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+						DeploymentRefs: []gardencorev1beta1.DeploymentRef{{Name: "provider-local"}},
+					},
+					Resources: []gardencorev1beta1.ControllerResource{{Kind: "ControlPlane", Type: "local"}},
+				},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{Name: "provider-other"},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{{Kind: "ControlPlane", Type: "other"}},
+				},
+			})).To(Succeed())
+		})
+
+		It("should return the extensions needed by the shoot", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}})).To(Succeed())
+
+			extensions, err := DiscoverExtensions(ctx, fakeClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(extensions).To(HaveLen(1))
+			Expect(extensions[0].ControllerRegistration.Name).To(Equal("provider-local"))
+			Expect(extensions[0].ControllerDeployment.Name).To(Equal("provider-local"))
+		})
+
+		It("should fail if the referenced ControllerDeployment does not exist", func() {
+			_, err := DiscoverExtensions(ctx, fakeClient, shoot)
+			Expect(err).To(MatchError(ContainSubstring("ControllerDeployment provider-local referenced in ControllerRegistration provider-local was not found")))
+		})
+	})
+
+	Describe("#RewriteCloudProfileReference", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: ptr.To("local"),
+					CloudProfile:     &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "local-custom"},
+				},
+			}
+		})
+
+		It("should rewrite a reference to a NamespacedCloudProfile", func() {
+			Expect(RewriteCloudProfileReference(shoot)).To(BeTrue())
+			Expect(shoot.Spec.CloudProfileName).To(BeNil())
+			Expect(shoot.Spec.CloudProfile).To(Equal(&gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "local-custom"}))
+		})
+
+		It("should not change a reference to a CloudProfile", func() {
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "local"}
+
+			Expect(RewriteCloudProfileReference(shoot)).To(BeFalse())
+			Expect(shoot.Spec.CloudProfileName).To(Equal(ptr.To("local")))
+			Expect(shoot.Spec.CloudProfile).To(Equal(&gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "local"}))
+		})
+
+		It("should not change a shoot only referring to a CloudProfile by name", func() {
+			shoot.Spec.CloudProfile = nil
+
+			Expect(RewriteCloudProfileReference(shoot)).To(BeFalse())
+			Expect(shoot.Spec.CloudProfileName).To(Equal(ptr.To("local")))
+			Expect(shoot.Spec.CloudProfile).To(BeNil())
+		})
+	})
+
+	Describe("#InlineHelmChart", func() {
+		var (
+			ctx                  = context.Background()
+			registry             *fakeregistry.Registry
+			ociRepository        *gardencorev1.OCIRepository
+			controllerDeployment *gardencorev1.ControllerDeployment
+		)
+
+		BeforeEach(func() {
+			registry = fakeregistry.NewRegistry()
+			ociRepository = &gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/provider-local:v1.0.0")}
+			controllerDeployment = &gardencorev1.ControllerDeployment{
+				Helm: &gardencorev1.HelmControllerDeployment{OCIRepository: ociRepository},
+			}
+		})
+
+		It("should pull the Helm chart and inline it", func() {
+			registry.AddArtifact(ociRepository, []byte("chart"))
+
+			Expect(InlineHelmChart(ctx, registry, controllerDeployment)).To(Succeed())
+			Expect(controllerDeployment.Helm.RawChart).To(Equal([]byte("chart")))
+			Expect(controllerDeployment.Helm.OCIRepository).To(BeNil())
+		})
+
+		It("should do nothing if the Helm chart is not referenced via an OCI repository", func() {
+			controllerDeployment.Helm = &gardencorev1.HelmControllerDeployment{RawChart: []byte("chart")}
+
+			Expect(InlineHelmChart(ctx, registry, controllerDeployment)).To(Succeed())
+			Expect(controllerDeployment.Helm.RawChart).To(Equal([]byte("chart")))
+		})
+
+		It("should fail if the Helm chart cannot be pulled", func() {
+			Expect(InlineHelmChart(ctx, registry, controllerDeployment)).To(MatchError(ContainSubstring("failed pulling Helm chart from OCI repository example.com/charts/provider-local:v1.0.0")))
+		})
+	})
+
+	Describe("#WriteManifests", func() {
+		It("should write the objects as multi-document YAML file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "manifests.yaml")
+
+			Expect(WriteManifests(path,
+				&gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}},
+				&gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}},
+			)).To(Succeed())
+
+			Expect(os.ReadFile(path)).To(BeEquivalentTo(`apiVersion: core.gardener.cloud/v1beta1
+kind: ControllerRegistration
+metadata:
+  creationTimestamp: null
+  name: provider-local
+spec: {}
+---
+apiVersion: core.gardener.cloud/v1
+kind: ControllerDeployment
+metadata:
+  creationTimestamp: null
+  name: provider-local
+`))
+		})
+
+		It("should fail if the file cannot be written", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "non-existing", "manifests.yaml")
 
-			Eventually(stdErr).Should(Say("Not implemented"))
+			Expect(WriteManifests(path, &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "garden"}})).To(MatchError(ContainSubstring("failed writing file " + path)))
 		})
 	})
 })
@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package discover
+
+// Functions exported for testing.
+
+var (
+	DiscoverExtensions           = discoverExtensions
+	RewriteCloudProfileReference = rewriteCloudProfileReference
+	InlineHelmChart              = inlineHelmChart
+	WriteManifests               = writeManifests
+)
@@ -6,6 +6,8 @@ package discover
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/spf13/pflag"
 
@@ -17,10 +19,21 @@ type Options struct {
 	*cmd.Options
 	// Kubeconfig is the path to the kubeconfig file pointing to the garden cluster.
 	Kubeconfig string
+	// ShootManifest is the path to the manifest file of the Shoot for which the configuration should be discovered.
+	ShootManifest string
+	// ConfigDir is the path to the directory into which the discovered configuration files should be written. It
+	// defaults to the directory of the shoot manifest.
+	ConfigDir string
 }
 
 // ParseArgs parses the arguments to the options.
-func (o *Options) ParseArgs(_ []string) error { return nil }
+func (o *Options) ParseArgs(args []string) error {
+	if len(args) > 0 {
+		o.ShootManifest = strings.TrimSpace(args[0])
+	}
+
+	return nil
+}
 
 // Validate validates the options.
 func (o *Options) Validate() error {
@@ -28,12 +41,24 @@ func (o *Options) Validate() error {
 		return fmt.Errorf("must provide a path to a garden cluster kubeconfig")
 	}
 
+	if len(o.ShootManifest) == 0 {
+		return fmt.Errorf("must provide a path to a shoot manifest")
+	}
+
 	return nil
 }
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if len(o.ConfigDir) == 0 {
+		o.ConfigDir = filepath.Dir(o.ShootManifest)
+	}
+
+	return nil
+}
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the garden cluster")
+	fs.StringVarP(&o.ConfigDir, "config-dir", "d", "", "Path to the directory into which the discovered configuration "+
+		"files are written. It can be passed to the init command afterwards. Defaults to the directory of the shoot manifest.")
 }
@@ -24,23 +24,48 @@ var _ = Describe("Options", func() {
 		It("should return nil", func() {
 			Expect(options.ParseArgs(nil)).To(Succeed())
 		})
+
+		It("should trim the shoot manifest path", func() {
+			Expect(options.ParseArgs([]string{" some-path-to-shoot-manifest "})).To(Succeed())
+			Expect(options.ShootManifest).To(Equal("some-path-to-shoot-manifest"))
+		})
 	})
 
 	Describe("#Validate", func() {
 		It("should pass for valid options", func() {
 			options.Kubeconfig = "some-path-to-kubeconfig"
+			options.ShootManifest = "some-path-to-shoot-manifest"
 
 			Expect(options.Validate()).To(Succeed())
 		})
 
 		It("should fail because kubeconfig path is not set", func() {
+			options.ShootManifest = "some-path-to-shoot-manifest"
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a garden cluster kubeconfig")))
 		})
+
+		It("should fail because shoot manifest path is not set", func() {
+			options.Kubeconfig = "some-path-to-kubeconfig"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a shoot manifest")))
+		})
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the config directory to the directory of the shoot manifest", func() {
+			options.ShootManifest = "some/dir/shoot.yaml"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.ConfigDir).To(Equal("some/dir"))
+		})
+
+		It("should not overwrite the config directory if it is set", func() {
+			options.ShootManifest = "some/dir/shoot.yaml"
+			options.ConfigDir = "other/dir"
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.ConfigDir).To(Equal("other/dir"))
 		})
 	})
 })
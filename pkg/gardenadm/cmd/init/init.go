@@ -6,11 +6,16 @@ package init
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/fs"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/version"
 
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -20,8 +25,13 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/flow/checkpointstore"
 )
 
+// checkpointConfigMapName is the name of the ConfigMap in the kube-system namespace which contains the checkpoints of
+// the init flow.
+const checkpointConfigMapName = "gardenadm-init-checkpoints"
+
 // NewCommand creates a new cobra.Command.
 func NewCommand(globalOpts *cmd.Options) *cobra.Command {
 	opts := &Options{Options: globalOpts}
@@ -67,6 +77,11 @@ func run(ctx context.Context, opts *Options) error {
 		return fmt.Errorf("failed checking whether pod network is already available: %w", err)
 	}
 
+	fingerprint, err := computeFingerprint(os.DirFS(opts.ConfigDir), podNetworkAvailable)
+	if err != nil {
+		return fmt.Errorf("failed computing fingerprint of config directory %s: %w", opts.ConfigDir, err)
+	}
+
 	var (
 		g                = flow.NewGraph("init")
 		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
@@ -82,6 +97,7 @@ func run(ctx context.Context, opts *Options) error {
 		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
 			Name:         "Ensuring CustomResourceDefinitions are ready",
 			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			Fingerprint:  fingerprint,
 			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions),
 		})
 		reconcileClusterResource = g.Add(flow.Task{
@@ -101,6 +117,11 @@ func run(ctx context.Context, opts *Options) error {
 			Fn:           b.BootstrapKubelet,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Publishing cluster information for discovery by gardenadm join",
+			Fn:           b.PublishClusterInfo,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
 		_ = g.Add(flow.Task{
 			Name:         "Approving kubelet server certificate signing request if necessary",
 			Fn:           flow.TaskFn(b.ApproveKubeletServerCertificateSigningRequest).RetryUntilTimeout(2*time.Second, time.Minute),
@@ -117,6 +138,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
 			Name:         "Waiting until gardener-resource-manager reports readiness",
 			Fn:           b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+			Fingerprint:  fingerprint,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
 		})
 		_ = g.Add(flow.Task{
@@ -141,6 +163,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilExtensionControllersReady = g.Add(flow.Task{
 			Name:         "Waiting until extension controllers report readiness",
 			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Fingerprint:  fingerprint,
 			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
 		})
 		deployNetworkPolicies = g.Add(flow.Task{
@@ -156,6 +179,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilShootNamespacesReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespaces have been reconciled",
 			Fn:           b.Shoot.Components.SystemComponents.Namespaces.Wait,
+			Fingerprint:  fingerprint,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, deployShootNamespaces),
 		})
 		_ = g.Add(flow.Task{
@@ -172,6 +196,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilNetworkReady = g.Add(flow.Task{
 			Name:         "Waiting until shoot network plugin has been reconciled",
 			Fn:           b.Shoot.Components.Extensions.Network.Wait,
+			Fingerprint:  fingerprint,
 			Dependencies: flow.NewTaskIDs(deployNetwork),
 		})
 		deployCoreDNS = g.Add(flow.Task{
@@ -182,6 +207,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilCoreDNSReady = g.Add(flow.Task{
 			Name:         "Waiting until CoreDNS system component is ready",
 			Fn:           b.Shoot.Components.SystemComponents.CoreDNS.Wait,
+			Fingerprint:  fingerprint,
 			Dependencies: flow.NewTaskIDs(deployCoreDNS),
 		})
 
@@ -197,6 +223,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilGardenerResourceManagerInPodNetworkReady = g.Add(flow.Task{
 			Name:         "Waiting until gardener-resource-manager (in pod network) reports readiness",
 			Fn:           b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+			Fingerprint:  fingerprint,
 			SkipIf:       podNetworkAvailable,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManagerIntoPodNetwork),
 		})
@@ -211,6 +238,7 @@ func run(ctx context.Context, opts *Options) error {
 		waitUntilExtensionControllersInPodNetworkReady = g.Add(flow.Task{
 			Name:         "Waiting until extension controllers (in pod network) report readiness",
 			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Fingerprint:  fingerprint,
 			SkipIf:       podNetworkAvailable,
 			Dependencies: flow.NewTaskIDs(deployExtensionControllersIntoPodNetwork),
 		})
@@ -246,6 +274,9 @@ func run(ctx context.Context, opts *Options) error {
 
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log: opts.Log,
+		// The checkpoints allow skipping the (slow) waits which already succeeded in a previous run of `gardenadm init`
+		// with the same configuration, e.g., when it is re-run after a failure or after the machine was restarted.
+		CheckpointStore: checkpointstore.NewConfigMap(b.SeedClientSet.Client(), metav1.NamespaceSystem, checkpointConfigMapName),
 	}); err != nil {
 		return flow.Errors(err)
 	}
@@ -263,13 +294,18 @@ To start using your cluster, you need to run the following as a regular user:
 You can now join any number of machines by running the following on each node
 as root:
 
-  gardenadm join <TODO>
+  gardenadm join --bootstrap-token <token> --gardener-node-agent-secret-name <secret-name> \
+    <control-plane-address>
+
+The CA bundle of the cluster is discovered via the bootstrap token if anonymous
+authentication is enabled for kube-apiserver. Otherwise, pass it explicitly via
+--ca-certificate.
 
 Note that the mentioned kubeconfig file will be disabled once you deploy the
 gardenlet and connect this cluster to an existing Gardener installation by
 running on any node:
 
-  gardenadm connect <TODO>
+  gardenadm connect --bootstrap-kubeconfig <garden-kubeconfig> --config-dir <config-dir>
 
 Please use the shoots/adminkubeconfig subresource to retrieve a kubeconfig,
 see https://gardener.cloud/docs/gardener/shoot/shoot_access/.
@@ -278,6 +314,35 @@ see https://gardener.cloud/docs/gardener/shoot/shoot_access/.
 	return nil
 }
 
+// computeFingerprint returns the fingerprint of the inputs of the checkpointed tasks of the init flow. It covers the
+// content of the config directory, the version of gardenadm, and whether the pod network is already available.
+func computeFingerprint(configDir fs.FS, podNetworkAvailable bool) (string, error) {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00%t\x00", version.Get().GitVersion, podNetworkAvailable)
+
+	if err := fs.WalkDir(configDir, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		content, err := fs.ReadFile(configDir, path)
+		if err != nil {
+			return fmt.Errorf("failed reading file %s: %w", path, err)
+		}
+
+		fmt.Fprintf(hash, "%s\x00%d\x00", path, len(content))
+		_, err = hash.Write(content)
+		return err
+	}); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.AutonomousBotanist, error) {
 	cloudProfile, project, shoot, controllerRegistrations, controllerDeployments, err := gardenadm.ReadManifests(opts.Log, os.DirFS(opts.ConfigDir))
 	if err != nil {
@@ -289,7 +354,13 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Autono
 		return nil, fmt.Errorf("failed computing extensions: %w", err)
 	}
 
-	b, err := botanist.NewAutonomousBotanist(ctx, opts.Log, nil, project, cloudProfile, shoot, extensions)
+	b, err := botanist.NewAutonomousBotanist(ctx, opts.Log, botanist.AutonomousBotanistOptions{
+		Project:          project,
+		CloudProfile:     cloudProfile,
+		Shoot:            shoot,
+		Extensions:       extensions,
+		RunsControlPlane: true,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed constructing botanist: %w", err)
 	}
@@ -315,6 +386,7 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Autono
 		writeKubeletBootstrapKubeconfig = g.Add(flow.Task{
 			Name:         "Writing kubelet bootstrap kubeconfig with a fake token to disk to make kubelet start",
 			Fn:           b.WriteKubeletBootstrapKubeconfig,
+			Compensate:   b.RemoveKubeletBootstrapKubeconfig,
 			SkipIf:       kubeconfigFileExists,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
 		})
@@ -327,6 +399,7 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Autono
 		applyOperatingSystemConfig = g.Add(flow.Task{
 			Name:         "Applying OperatingSystemConfig using gardener-node-agent's reconciliation logic",
 			Fn:           b.ApplyOperatingSystemConfig,
+			Compensate:   b.RevertOperatingSystemConfig,
 			SkipIf:       kubeconfigFileExists,
 			Dependencies: flow.NewTaskIDs(writeKubeletBootstrapKubeconfig, deployOperatingSystemConfigSecretForNodeAgent),
 		})
@@ -350,9 +423,19 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Autono
 
 	if err := g.Compile().Run(ctx, flow.Opts{
 		Log: b.Logger,
+		// Without the compensation, a failure after the OperatingSystemConfig has been applied would leave the admin
+		// kubeconfig behind and the next run would skip the initialization of the control plane.
+		CompensateOnFailure: true,
 	}); err != nil {
 		return nil, flow.Errors(err)
 	}
 
-	return botanist.NewAutonomousBotanist(ctx, opts.Log, clientSet, project, cloudProfile, shoot, extensions)
+	return botanist.NewAutonomousBotanist(ctx, opts.Log, botanist.AutonomousBotanistOptions{
+		ClientSet:        clientSet,
+		Project:          project,
+		CloudProfile:     cloudProfile,
+		Shoot:            shoot,
+		Extensions:       extensions,
+		RunsControlPlane: true,
+	})
 }
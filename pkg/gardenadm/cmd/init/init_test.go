@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package init_test
+
+import (
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
+)
+
+var _ = Describe("Init", func() {
+	Describe("#ComputeFingerprint", func() {
+		var configDir fstest.MapFS
+
+		BeforeEach(func() {
+			configDir = fstest.MapFS{
+				"shoot.yaml":        {Data: []byte("kind: Shoot")},
+				"cloudprofile.yaml": {Data: []byte("kind: CloudProfile")},
+			}
+		})
+
+		It("should return the same fingerprint for the same inputs", func() {
+			fingerprint, err := ComputeFingerprint(configDir, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fingerprint).NotTo(BeEmpty())
+
+			Expect(ComputeFingerprint(fstest.MapFS{
+				"cloudprofile.yaml": {Data: []byte("kind: CloudProfile")},
+				"shoot.yaml":        {Data: []byte("kind: Shoot")},
+			}, false)).To(Equal(fingerprint))
+		})
+
+		It("should return a different fingerprint if the content of a file changes", func() {
+			fingerprint, err := ComputeFingerprint(configDir, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			configDir["shoot.yaml"] = &fstest.MapFile{Data: []byte("kind: Shoot\nspec: {}")}
+			Expect(ComputeFingerprint(configDir, false)).NotTo(Equal(fingerprint))
+		})
+
+		It("should return a different fingerprint if a file is added", func() {
+			fingerprint, err := ComputeFingerprint(configDir, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			configDir["extensions/controllerregistration.yaml"] = &fstest.MapFile{Data: []byte("kind: ControllerRegistration")}
+			Expect(ComputeFingerprint(configDir, false)).NotTo(Equal(fingerprint))
+		})
+
+		It("should return a different fingerprint if the availability of the pod network changes", func() {
+			fingerprint, err := ComputeFingerprint(configDir, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ComputeFingerprint(configDir, true)).NotTo(Equal(fingerprint))
+		})
+	})
+})
@@ -6,10 +6,15 @@ package join
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -17,12 +22,17 @@ func NewCommand(globalOpts *cmd.Options) *cobra.Command {
 	opts := &Options{Options: globalOpts}
 
 	cmd := &cobra.Command{
-		Use:   "join",
-		Short: "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
-		Long:  "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
+		Use:   "join [control-plane-address]",
+		Short: "Bootstrap worker nodes and join them to the cluster",
+		Long:  "Bootstrap worker nodes and join them to the cluster",
 
 		Example: `# Bootstrap a worker node and join it to the cluster
-gardenadm join`,
+gardenadm join --bootstrap-token foo123.bar4567890baz123 --ca-certificate <base64-encoded-ca-bundle> --gardener-node-agent-secret-name gardener-node-agent-worker-abcde 10.1.2.3:443
+
+# Bootstrap a worker node and join it to the cluster, discover the certificate authority bundle via the bootstrap token
+gardenadm join --bootstrap-token foo123.bar4567890baz123 --gardener-node-agent-secret-name gardener-node-agent-worker-abcde 10.1.2.3:443`,
+
+		Args: cobra.ExactArgs(1),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := opts.ParseArgs(args); err != nil {
@@ -46,7 +56,83 @@ gardenadm join`,
 	return cmd
 }
 
-func run(_ context.Context, opts *Options) error {
-	opts.Log.Info("Not implemented either")
+func run(ctx context.Context, opts *Options) error {
+	b, err := botanist.NewAutonomousBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed constructing botanist: %w", err)
+	}
+
+	var (
+		certificateAuthority = opts.CertificateAuthority
+
+		g = flow.NewGraph("join")
+
+		discoverCertificateAuthority = g.Add(flow.Task{
+			Name: "Discovering certificate authority bundle of the control plane",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				clusterInfoClientSet, err := botanist.NewClientSetForClusterInfo(opts.ControlPlaneAddress)
+				if err != nil {
+					return fmt.Errorf("failed creating client set for discovery: %w", err)
+				}
+
+				certificateAuthority, err = botanist.DiscoverCertificateAuthority(ctx, clusterInfoClientSet.Client(), opts.BootstrapToken)
+				return err
+			}).RetryUntilTimeout(2*time.Second, time.Minute),
+			SkipIf: len(opts.CertificateAuthority) > 0,
+		})
+		fetchOperatingSystemConfig = g.Add(flow.Task{
+			Name: "Fetching OperatingSystemConfig for gardener-node-agent",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				bootstrapClientSet, err := botanist.NewClientSetFromBootstrapToken(opts.ControlPlaneAddress, certificateAuthority, opts.BootstrapToken, kubernetes.SeedScheme)
+				if err != nil {
+					return fmt.Errorf("failed creating client set with bootstrap token: %w", err)
+				}
+
+				return b.FetchOperatingSystemConfigForNodeAgent(ctx, bootstrapClientSet.Client(), opts.GardenerNodeAgentSecretName)
+			}).RetryUntilTimeout(2*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(discoverCertificateAuthority),
+		})
+		writeBootstrapToken = g.Add(flow.Task{
+			Name: "Writing bootstrap token to disk",
+			Fn: func(_ context.Context) error {
+				return b.WriteJoinBootstrapToken(opts.BootstrapToken)
+			},
+		})
+		writeGardenerNodeAgentConfiguration = g.Add(flow.Task{
+			Name: "Writing gardener-node-agent configuration to disk",
+			Fn: func(_ context.Context) error {
+				return b.WriteGardenerNodeAgentConfiguration(opts.ControlPlaneAddress, certificateAuthority)
+			},
+			Dependencies: flow.NewTaskIDs(fetchOperatingSystemConfig),
+		})
+		extractGardenerNodeAgentBinary = g.Add(flow.Task{
+			Name:         "Extracting gardener-node-agent binary from image",
+			Fn:           flow.TaskFn(b.ExtractGardenerNodeAgentBinary).RetryUntilTimeout(5*time.Second, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(fetchOperatingSystemConfig),
+		})
+		startGardenerNodeAgent = g.Add(flow.Task{
+			Name:         "Starting gardener-node-agent",
+			Fn:           b.StartGardenerNodeAgent,
+			Dependencies: flow.NewTaskIDs(writeBootstrapToken, writeGardenerNodeAgentConfiguration, extractGardenerNodeAgentBinary),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until node has been registered",
+			Fn:           flow.TaskFn(b.WaitUntilNodeIsRegistered).RetryUntilTimeout(5*time.Second, 10*time.Minute),
+			Dependencies: flow.NewTaskIDs(startGardenerNodeAgent),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprint(opts.Out, `
+This node has joined the cluster successfully!
+
+Run 'kubectl get nodes' on a control plane node to see this node join the cluster.
+`)
+
 	return nil
 }
@@ -7,7 +7,6 @@ package join_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	. "github.com/onsi/gomega/gbytes"
 	"github.com/spf13/cobra"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -20,22 +19,19 @@ import (
 var _ = Describe("Join", func() {
 	var (
 		globalOpts *cmd.Options
-		stdErr     *Buffer
 		command    *cobra.Command
 	)
 
 	BeforeEach(func() {
 		globalOpts = &cmd.Options{}
-		globalOpts.IOStreams, _, _, stdErr = clitest.NewTestIOStreams()
-		globalOpts.Log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(stdErr))
+		globalOpts.IOStreams, _, _, _ = clitest.NewTestIOStreams()
+		globalOpts.Log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(globalOpts.ErrOut))
 		command = NewCommand(globalOpts)
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(command.RunE(command, nil)).To(Succeed())
-
-			Eventually(stdErr).Should(Say("Not implemented either"))
+		It("should fail when the options are invalid", func() {
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("must provide the address of the control plane")))
 		})
 	})
 })
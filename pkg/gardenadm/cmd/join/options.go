@@ -5,7 +5,11 @@
 package join
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/pflag"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
 
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 )
@@ -13,15 +17,55 @@ import (
 // Options contains options for this command.
 type Options struct {
 	*cmd.Options
+	// ControlPlaneAddress is the address of the control plane to which the node should be joined.
+	ControlPlaneAddress string
+	// BootstrapToken is the bootstrap token to use for joining the node.
+	BootstrapToken string
+	// CertificateAuthority is the CA bundle of the control plane. If it is not provided, it is discovered via the
+	// cluster-info ConfigMap which is signed for the bootstrap token.
+	CertificateAuthority []byte
+	// GardenerNodeAgentSecretName is the name of the secret from which gardener-node-agent should download its
+	// operating system configuration.
+	GardenerNodeAgentSecretName string
 }
 
 // ParseArgs parses the arguments to the options.
-func (o *Options) ParseArgs(_ []string) error { return nil }
+func (o *Options) ParseArgs(args []string) error {
+	if len(args) > 0 {
+		o.ControlPlaneAddress = strings.TrimSpace(args[0])
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if len(o.ControlPlaneAddress) == 0 {
+		return fmt.Errorf("must provide the address of the control plane")
+	}
+
+	if !bootstraptokenutil.IsValidBootstrapToken(o.BootstrapToken) {
+		return fmt.Errorf("must provide a valid bootstrap token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\"")
+	}
+
+	if len(o.GardenerNodeAgentSecretName) == 0 {
+		return fmt.Errorf("must provide the name of the secret for gardener-node-agent")
+	}
+
+	return nil
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete() error {
+	if !strings.HasPrefix(o.ControlPlaneAddress, "https://") {
+		o.ControlPlaneAddress = "https://" + o.ControlPlaneAddress
+	}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+	return nil
+}
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.BootstrapToken, "bootstrap-token", "", "", "Bootstrap token for joining the cluster (create it with 'gardenadm token create' on a control plane node)")
+	fs.BytesBase64VarP(&o.CertificateAuthority, "ca-certificate", "", nil, "Base64-encoded certificate authority bundle of the control plane. If not provided, it is discovered via the cluster-info ConfigMap which is verified with the bootstrap token")
+	fs.StringVarP(&o.GardenerNodeAgentSecretName, "gardener-node-agent-secret-name", "", "", "Name of the Secret from which gardener-node-agent should download its operating system configuration")
+}
@@ -17,24 +17,63 @@ var _ = Describe("Options", func() {
 	)
 
 	BeforeEach(func() {
-		options = &Options{}
+		options = &Options{
+			ControlPlaneAddress:         "10.1.2.3:443",
+			BootstrapToken:              "foo123.bar4567890baz123",
+			CertificateAuthority:        []byte("ca-bundle"),
+			GardenerNodeAgentSecretName: "gardener-node-agent-worker",
+		}
 	})
 
 	Describe("#ParseArgs", func() {
-		It("should return nil", func() {
-			Expect(options.ParseArgs(nil)).To(Succeed())
+		It("should use the first argument as control plane address", func() {
+			Expect(options.ParseArgs([]string{" 10.4.5.6:443 "})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("10.4.5.6:443"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because control plane address is not set", func() {
+			options.ControlPlaneAddress = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the address of the control plane")))
+		})
+
+		It("should fail because bootstrap token is invalid", func() {
+			options.BootstrapToken = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a valid bootstrap token")))
+		})
+
+		It("should pass without certificate authority since it can be discovered", func() {
+			options.CertificateAuthority = nil
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because gardener-node-agent secret name is not set", func() {
+			options.GardenerNodeAgentSecretName = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the secret for gardener-node-agent")))
+		})
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should add the https scheme to the control plane address", func() {
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://10.1.2.3:443"))
+			Expect(options.CertificateAuthority).To(Equal([]byte("ca-bundle")))
+		})
+
+		It("should not add the https scheme twice", func() {
+			options.ControlPlaneAddress = "https://10.1.2.3:443"
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://10.1.2.3:443"))
 		})
 	})
 })
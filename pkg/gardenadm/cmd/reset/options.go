@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	// Force skips the confirmation prompt before the node is reset.
+	Force bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error { return nil }
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Force, "force", "f", false, "Reset the node without prompting for confirmation")
+}
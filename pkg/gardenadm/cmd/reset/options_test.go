@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should return nil", func() {
+			Expect(options.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Revert the changes made to this node by gardenadm init or gardenadm join",
+		Long: `Revert the changes made to this node by gardenadm init or gardenadm join
+
+The command stops gardener-node-agent, removes the static pod manifests of the control plane components, and removes
+all systemd units and files which have been written by gardener-node-agent. Afterwards, the remaining state on the node
+(etcd data, volumes of the static control plane pods, kubelet state, and the gardener-node-agent directory) is deleted.
+The CNI configuration and state as well as the iptables chains created by kube-proxy, the kubelet, and the CNI plugin
+are removed, too.`,
+
+		Example: `# Reset this node
+gardenadm reset
+
+# Reset this node without prompting for confirmation
+gardenadm reset --force`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	if !opts.Force {
+		confirmed, err := confirm(opts)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			fmt.Fprintln(opts.Out, "Aborted reset of this node.")
+			return nil
+		}
+	}
+
+	b, err := botanist.NewAutonomousBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed constructing botanist: %w", err)
+	}
+
+	var (
+		g = flow.NewGraph("reset")
+
+		stopGardenerNodeAgent = g.Add(flow.Task{
+			Name: "Stopping gardener-node-agent",
+			Fn:   b.StopGardenerNodeAgent,
+		})
+		removeStaticPodManifests = g.Add(flow.Task{
+			Name: "Removing static pod manifests",
+			Fn: func(_ context.Context) error {
+				return b.RemoveStaticPodManifests()
+			},
+			Dependencies: flow.NewTaskIDs(stopGardenerNodeAgent),
+		})
+		waitUntilStaticControlPlanePodsTerminated = g.Add(flow.Task{
+			Name:         "Waiting until static control plane pods have been terminated",
+			Fn:           flow.TaskFn(b.WaitUntilStaticControlPlanePodsTerminated).RetryUntilTimeout(2*time.Second, 2*time.Minute),
+			Dependencies: flow.NewTaskIDs(removeStaticPodManifests),
+		})
+		removeOperatingSystemConfigUnitsAndFiles = g.Add(flow.Task{
+			Name:         "Removing systemd units and files written by gardener-node-agent",
+			Fn:           b.RemoveOperatingSystemConfigUnitsAndFiles,
+			Dependencies: flow.NewTaskIDs(waitUntilStaticControlPlanePodsTerminated),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Removing CNI configuration and iptables rules",
+			Fn:           b.RemoveNetworkState,
+			Dependencies: flow.NewTaskIDs(removeOperatingSystemConfigUnitsAndFiles),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Removing remaining state of the node",
+			Fn: func(_ context.Context) error {
+				return b.RemoveNodeState()
+			},
+			Dependencies: flow.NewTaskIDs(removeOperatingSystemConfigUnitsAndFiles),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprint(opts.Out, `
+This node has been reset successfully!
+
+The Node object has not been removed from the cluster. If the cluster is still
+running, delete it by running the following command on a control plane node:
+
+  kubectl delete node <node-name>
+
+Note that network interfaces created by the CNI plugin are not removed by this
+command. Reboot the node to remove them.
+`)
+
+	return nil
+}
+
+func confirm(opts *Options) (bool, error) {
+	fmt.Fprint(opts.Out, "This node will be reset and all its Kubernetes and Gardener related state will be deleted. Do you want to continue? [y/N]: ")
+
+	answer, err := bufio.NewReader(opts.In).ReadString('\n')
+	if err != nil && answer == "" {
+		return false, fmt.Errorf("failed reading confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/spf13/cobra"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+	"github.com/gardener/gardener/pkg/logger"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		globalOpts *cmd.Options
+		stdIn      *gbytes.Buffer
+		stdOut     *gbytes.Buffer
+		command    *cobra.Command
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{}
+		globalOpts.IOStreams, stdIn, stdOut, _ = clitest.NewTestIOStreams()
+		globalOpts.Log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(globalOpts.ErrOut))
+		command = NewCommand(globalOpts)
+	})
+
+	Describe("#RunE", func() {
+		It("should abort when the reset is not confirmed", func() {
+			_, err := stdIn.Write([]byte("n\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(command.RunE(command, nil)).To(Succeed())
+
+			Eventually(stdOut).Should(gbytes.Say("Do you want to continue"))
+			Eventually(stdOut).Should(gbytes.Say("Aborted reset of this node."))
+		})
+
+		It("should fail when no answer is given", func() {
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed reading confirmation")))
+		})
+	})
+})
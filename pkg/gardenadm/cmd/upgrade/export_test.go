@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+// Functions exported for testing.
+
+var RunAndReleaseNodes = runAndReleaseNodes
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass for valid options", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+			options.KubernetesVersion = "1.33.1"
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail because config dir path is not set", func() {
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should fail because the Kubernetes version is invalid", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+			options.KubernetesVersion = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("invalid Kubernetes version")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the autonomous shoot cluster to a new Kubernetes version",
+		Long: `Upgrade the autonomous shoot cluster to a new Kubernetes version
+
+The command must be run on a control plane node. It redeploys the control plane components and the
+OperatingSystemConfig with the target Kubernetes version. The node running the command is upgraded first, and the
+upgrade only continues once it runs the target version and the control plane reports readiness. Afterwards, the
+system components are redeployed. All further nodes are held back and upgraded one after another, i.e., the next node
+is only upgraded once the previous one runs the target version and is healthy. The upgrade stops at the first node
+which does not become healthy. If the upgrade fails or is interrupted, the remaining nodes are released nevertheless so
+that they keep receiving updates of the OperatingSystemConfig, i.e., they are upgraded without waiting for each other.`,
+
+		Example: `# Upgrade the cluster to the Kubernetes version specified in the Shoot manifest
+gardenadm upgrade --config-dir /gardenadm/resources
+
+# Upgrade the cluster to the given Kubernetes version
+gardenadm upgrade --config-dir /gardenadm/resources --kubernetes-version 1.33.1`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := newBotanist(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	serverVersion, err := b.SeedClientSet.DiscoverVersion()
+	if err != nil {
+		return fmt.Errorf("failed discovering Kubernetes version of the cluster: %w", err)
+	}
+
+	currentVersion, err := semver.NewVersion(serverVersion.GitVersion)
+	if err != nil {
+		return fmt.Errorf("failed parsing Kubernetes version %q of the cluster: %w", serverVersion.GitVersion, err)
+	}
+
+	if err := botanist.CheckKubernetesVersionUpgrade(currentVersion, b.Shoot.KubernetesVersion); err != nil {
+		return err
+	}
+
+	nodes, err := b.ListNodesForUpgrade(ctx)
+	if err != nil {
+		return err
+	}
+	if len(nodes) == 0 {
+		return fmt.Errorf("no nodes found in the cluster")
+	}
+
+	nodeNames := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		nodeNames = append(nodeNames, node.Name)
+	}
+
+	var (
+		g                = flow.NewGraph("upgrade")
+		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
+
+		checkNodesHealthy = g.Add(flow.Task{
+			Name: "Checking health of all nodes before upgrade",
+			Fn:   b.CheckNodesHealthy,
+		})
+		holdOperatingSystemConfig = g.Add(flow.Task{
+			Name: "Holding back OperatingSystemConfig on all nodes except the control plane node running the upgrade",
+			Fn: func(ctx context.Context) error {
+				// The node running the upgrade might have been held back by a previous upgrade run on another node.
+				if err := b.ReleaseOperatingSystemConfig(ctx, nodeNames[0]); err != nil {
+					return err
+				}
+				return b.HoldOperatingSystemConfig(ctx, nodeNames[1:]...)
+			},
+			Dependencies: flow.NewTaskIDs(checkNodesHealthy),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing secrets management",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(checkNodesHealthy),
+		})
+		deployControlPlaneDeployments = g.Add(flow.Task{
+			Name:         "Deploying control plane components as Deployments for static pod translation",
+			Fn:           b.DeployControlPlaneDeployments,
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		activateGardenerNodeAgent = g.Add(flow.Task{
+			Name:         "Deploying OperatingSystemConfig with target Kubernetes version for gardener-node-agent",
+			Fn:           b.ActivateGardenerNodeAgent,
+			Dependencies: flow.NewTaskIDs(holdOperatingSystemConfig, deployControlPlaneDeployments),
+		})
+		waitUntilControlPlaneNodeUpgraded = g.Add(flow.Task{
+			Name: fmt.Sprintf("Waiting until control plane node %s has been upgraded", nodeNames[0]),
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return b.WaitUntilNodeUpgraded(ctx, nodeNames[0])
+			}).RetryUntilTimeout(5*time.Second, 10*time.Minute),
+			Dependencies: flow.NewTaskIDs(activateGardenerNodeAgent),
+		})
+		waitUntilControlPlaneHealthy = g.Add(flow.Task{
+			Name:         "Waiting until control plane reports readiness",
+			Fn:           flow.TaskFn(b.WaitUntilControlPlaneHealthy).RetryUntilTimeout(2*time.Second, 5*time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneNodeUpgraded),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying kube-proxy system component",
+			Fn:           flow.TaskFn(b.DeployKubeProxy).RetryUntilTimeout(5*time.Second, time.Minute),
+			SkipIf:       !kubeProxyEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneHealthy),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying CoreDNS system component",
+			Fn:           flow.TaskFn(b.DeployCoreDNS).RetryUntilTimeout(5*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneHealthy),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying shoot system resources",
+			Fn:           flow.TaskFn(b.DeployShootSystem).RetryUntilTimeout(5*time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneHealthy),
+		})
+
+		previousNodeUpgraded = waitUntilControlPlaneHealthy
+	)
+
+	// The remaining nodes are released one after another so that the upgrade stops at the first node which does not
+	// become healthy with the target Kubernetes version.
+	for _, nodeName := range nodeNames[1:] {
+		releaseNode := g.Add(flow.Task{
+			Name: fmt.Sprintf("Releasing OperatingSystemConfig with target Kubernetes version to node %s", nodeName),
+			Fn: func(ctx context.Context) error {
+				return b.ReleaseOperatingSystemConfig(ctx, nodeName)
+			},
+			Dependencies: flow.NewTaskIDs(previousNodeUpgraded),
+		})
+		previousNodeUpgraded = g.Add(flow.Task{
+			Name: fmt.Sprintf("Waiting until node %s has been upgraded", nodeName),
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return b.WaitUntilNodeUpgraded(ctx, nodeName)
+			}).RetryUntilTimeout(5*time.Second, 10*time.Minute),
+			Dependencies: flow.NewTaskIDs(releaseNode),
+		})
+	}
+
+	if err := runAndReleaseNodes(ctx, b.ReleaseOperatingSystemConfig, nodeNames[1:], func(ctx context.Context) error {
+		if err := g.Compile().Run(ctx, flow.Opts{
+			Log: opts.Log,
+		}); err != nil {
+			return flow.Errors(err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your autonomous shoot cluster has been upgraded successfully to Kubernetes version %s!
+
+Run 'kubectl get nodes' to see the Kubernetes versions of the nodes.
+`, b.Shoot.KubernetesVersion)
+
+	return nil
+}
+
+// runAndReleaseNodes runs the given function and releases the OperatingSystemConfig to the given nodes afterwards. This
+// also happens if the function fails or the context is canceled (e.g., via Ctrl-C), as held back nodes would not receive
+// any updates of the OperatingSystemConfig anymore otherwise.
+func runAndReleaseNodes(ctx context.Context, release func(context.Context, string) error, nodeNames []string, fn func(context.Context) error) error {
+	err := fn(ctx)
+
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+	defer cancel()
+
+	errs := []error{err}
+	for _, nodeName := range nodeNames {
+		if err := release(releaseCtx, nodeName); err != nil {
+			errs = append(errs, fmt.Errorf("failed releasing OperatingSystemConfig to node %s: %w", nodeName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newBotanist(ctx context.Context, opts *Options) (*botanist.AutonomousBotanist, error) {
+	cloudProfile, project, shoot, controllerRegistrations, controllerDeployments, err := gardenadm.ReadManifests(opts.Log, os.DirFS(opts.ConfigDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading Kubernetes resources from config directory %s: %w", opts.ConfigDir, err)
+	}
+
+	if len(opts.KubernetesVersion) > 0 {
+		shoot.Spec.Kubernetes.Version = opts.KubernetesVersion
+	}
+
+	extensions, err := botanist.ComputeExtensions(shoot, controllerRegistrations, controllerDeployments)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing extensions: %w", err)
+	}
+
+	clientSet, err := kubernetes.NewClientFromFile("", botanist.PathKubeconfig,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.SeedScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client set for autonomous shoot cluster from kubeconfig %s: %w", botanist.PathKubeconfig, err)
+	}
+
+	b, err := botanist.NewAutonomousBotanist(ctx, opts.Log, botanist.AutonomousBotanistOptions{
+		ClientSet:        clientSet,
+		Project:          project,
+		CloudProfile:     cloudProfile,
+		Shoot:            shoot,
+		Extensions:       extensions,
+		RunsControlPlane: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed constructing botanist: %w", err)
+	}
+
+	return b, nil
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
+	"github.com/gardener/gardener/pkg/logger"
+	clitest "github.com/gardener/gardener/pkg/utils/test/cli"
+)
+
+var _ = Describe("Upgrade", func() {
+	var (
+		globalOpts *cmd.Options
+		command    *cobra.Command
+	)
+
+	BeforeEach(func() {
+		globalOpts = &cmd.Options{}
+		globalOpts.IOStreams, _, _, _ = clitest.NewTestIOStreams()
+		globalOpts.Log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(globalOpts.ErrOut))
+		command = NewCommand(globalOpts)
+	})
+
+	Describe("#RunE", func() {
+		It("should fail when the options are invalid", func() {
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("must provide a path to a config directory")))
+		})
+
+		It("should fail when the config directory does not exist", func() {
+			Expect(command.Flags().Set("config-dir", "some-path-to-nonexisting-config-dir")).To(Succeed())
+
+			Expect(command.RunE(command, nil)).To(MatchError(ContainSubstring("failed reading Kubernetes resources from config directory")))
+		})
+	})
+
+	Describe("#RunAndReleaseNodes", func() {
+		var (
+			ctx context.Context
+
+			released   []string
+			releaseErr error
+			release    func(context.Context, string) error
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			released = nil
+			releaseErr = nil
+
+			release = func(ctx context.Context, nodeName string) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				released = append(released, nodeName)
+				return releaseErr
+			}
+		})
+
+		It("should release the nodes after the function succeeded", func() {
+			Expect(RunAndReleaseNodes(ctx, release, []string{"node-1", "node-2"}, func(context.Context) error { return nil })).To(Succeed())
+			Expect(released).To(Equal([]string{"node-1", "node-2"}))
+		})
+
+		It("should release the nodes if the function fails", func() {
+			err := RunAndReleaseNodes(ctx, release, []string{"node-1", "node-2"}, func(context.Context) error { return fmt.Errorf("fake") })
+			Expect(err).To(MatchError("fake"))
+			Expect(released).To(Equal([]string{"node-1", "node-2"}))
+		})
+
+		It("should release the nodes if the context is canceled", func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+
+			err := RunAndReleaseNodes(ctx, release, []string{"node-1", "node-2"}, func(ctx context.Context) error {
+				cancel()
+				return ctx.Err()
+			})
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(released).To(Equal([]string{"node-1", "node-2"}))
+		})
+
+		It("should return the errors of the function and of releasing the nodes", func() {
+			releaseErr = fmt.Errorf("release failed")
+
+			err := RunAndReleaseNodes(ctx, release, []string{"node-1", "node-2"}, func(context.Context) error { return fmt.Errorf("fake") })
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+			Expect(err).To(MatchError(ContainSubstring("failed releasing OperatingSystemConfig to node node-1: release failed")))
+			Expect(err).To(MatchError(ContainSubstring("failed releasing OperatingSystemConfig to node node-2: release failed")))
+			Expect(released).To(Equal([]string{"node-1", "node-2"}))
+		})
+	})
+})
@@ -14,8 +14,10 @@ import (
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -120,3 +122,17 @@ func ReadManifests(
 
 	return
 }
+
+// EncodeManifest encodes the given Gardener resource into a YAML manifest which can be read by ReadManifests. The
+// apiVersion and kind fields are determined based on the garden scheme.
+func EncodeManifest(obj client.Object) ([]byte, error) {
+	gvk, err := apiutil.GVKForObject(obj, kubernetes.GardenScheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed determining GroupVersionKind of %T: %w", obj, err)
+	}
+
+	yamlSerializer := json.NewSerializerWithOptions(json.DefaultMetaFactory, kubernetes.GardenScheme, kubernetes.GardenScheme, json.SerializerOptions{Yaml: true, Pretty: false, Strict: false})
+	encoder := kubernetes.GardenCodec.EncoderForVersion(yamlSerializer, gvk.GroupVersion())
+
+	return runtime.Encode(encoder, obj)
+}
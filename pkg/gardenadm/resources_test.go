@@ -10,7 +10,11 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenadm"
 )
 
@@ -134,6 +138,33 @@ var _ = Describe("Resources", func() {
 			})
 		})
 	})
+
+	Describe("#EncodeManifest", func() {
+		It("should encode resources such that they can be read again", func() {
+			for name, obj := range map[string]client.Object{
+				"cloudprofile.yaml":           &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cpfl"}},
+				"project.yaml":                &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}},
+				"shoot.yaml":                  &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden"}},
+				"controllerregistration.yaml": &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "ext"}},
+				"controllerdeployment.yaml":   &gardencorev1.ControllerDeployment{ObjectMeta: metav1.ObjectMeta{Name: "ext"}},
+			} {
+				data, err := gardenadm.EncodeManifest(obj)
+				Expect(err).NotTo(HaveOccurred())
+				fsys[name] = &fstest.MapFile{Data: data}
+			}
+
+			Expect(string(fsys["controllerdeployment.yaml"].Data)).To(HavePrefix("apiVersion: core.gardener.cloud/v1\nkind: ControllerDeployment\n"))
+
+			cloudProfile, project, shoot, controllerRegistrations, controllerDeployments, err := gardenadm.ReadManifests(log, fsys)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cloudProfile.Name).To(Equal("cpfl"))
+			Expect(project.Name).To(Equal("project"))
+			Expect(shoot.Name).To(Equal("shoot"))
+			Expect(controllerRegistrations).To(ConsistOf(HaveField("Name", "ext")))
+			Expect(controllerDeployments).To(ConsistOf(HaveField("Name", "ext")))
+		})
+	})
 })
 
 func createCloudProfile(fsys fstest.MapFS, name string) {
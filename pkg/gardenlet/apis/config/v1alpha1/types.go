@@ -84,6 +84,9 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// OCIRegistry contains optional settings for pulling Helm charts from OCI registries.
+	// +optional
+	OCIRegistry *OCIRegistryConfig `json:"ociRegistry,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -755,3 +758,33 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// OCIRegistryConfig contains settings for pulling Helm charts from OCI registries.
+type OCIRegistryConfig struct {
+	// Cache contains settings for the cache of pulled Helm charts.
+	// +optional
+	Cache *OCICacheConfig `json:"cache,omitempty"`
+	// SignatureVerification contains settings for the verification of the signatures of pulled Helm charts. If not set,
+	// signatures are not verified.
+	// +optional
+	SignatureVerification *OCISignatureVerificationConfig `json:"signatureVerification,omitempty"`
+}
+
+// OCICacheConfig contains settings for the cache of pulled Helm charts.
+type OCICacheConfig struct {
+	// MaxSize is the maximum total size of the cached Helm charts. If it is exceeded, the least recently used Helm charts
+	// are removed from the cache. Defaults to 256Mi.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+	// Directory is the path of a directory in which the cached Helm charts are persisted, so that they are retained
+	// across restarts. If not set, Helm charts are only cached in memory.
+	// +optional
+	Directory *string `json:"directory,omitempty"`
+}
+
+// OCISignatureVerificationConfig contains settings for the verification of the signatures of pulled Helm charts.
+type OCISignatureVerificationConfig struct {
+	// PublicKeys is a list of PEM encoded public keys (ECDSA, RSA or Ed25519). A Helm chart is only deployed if it has a
+	// cosign signature which can be verified with at least one of the keys.
+	PublicKeys []string `json:"publicKeys"`
+}
@@ -21,6 +21,7 @@ import (
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -133,6 +134,33 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.OCIRegistry != nil {
+		allErrs = append(allErrs, validateOCIRegistryConfig(cfg.OCIRegistry, fldPath.Child("ociRegistry"))...)
+	}
+
+	return allErrs
+}
+
+func validateOCIRegistryConfig(cfg *gardenletconfigv1alpha1.OCIRegistryConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.Cache != nil && cfg.Cache.MaxSize != nil && cfg.Cache.MaxSize.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cache", "maxSize"), cfg.Cache.MaxSize.String(), "must be greater than 0"))
+	}
+
+	if cfg.SignatureVerification != nil {
+		publicKeysPath := fldPath.Child("signatureVerification", "publicKeys")
+
+		if len(cfg.SignatureVerification.PublicKeys) == 0 {
+			allErrs = append(allErrs, field.Required(publicKeysPath, "at least one public key is required"))
+		}
+		for i, publicKey := range cfg.SignatureVerification.PublicKeys {
+			if _, err := oci.ParsePublicKey([]byte(publicKey)); err != nil {
+				allErrs = append(allErrs, field.Invalid(publicKeysPath.Index(i), publicKey, fmt.Sprintf("invalid public key: %v", err)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
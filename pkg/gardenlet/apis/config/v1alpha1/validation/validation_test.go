@@ -719,6 +719,63 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("ociRegistry", func() {
+			const publicKey = `-----BEGIN PUBLIC KEY-----
+MCowBQYDK2VwAyEAGb9ECWmEzf6FQbrBZ9w7lshQhqowtrbLDFw4rXAxZuE=
+-----END PUBLIC KEY-----
+`
+
+			It("should pass with valid settings", func() {
+				cfg.OCIRegistry = &gardenletconfigv1alpha1.OCIRegistryConfig{
+					Cache: &gardenletconfigv1alpha1.OCICacheConfig{
+						MaxSize:   ptr.To(resource.MustParse("100Mi")),
+						Directory: ptr.To("/var/cache/charts"),
+					},
+					SignatureVerification: &gardenletconfigv1alpha1.OCISignatureVerificationConfig{
+						PublicKeys: []string{publicKey},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid settings", func() {
+				cfg.OCIRegistry = &gardenletconfigv1alpha1.OCIRegistryConfig{
+					Cache: &gardenletconfigv1alpha1.OCICacheConfig{
+						MaxSize: ptr.To(resource.MustParse("0")),
+					},
+					SignatureVerification: &gardenletconfigv1alpha1.OCISignatureVerificationConfig{
+						PublicKeys: []string{publicKey, "foo"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("ociRegistry.cache.maxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("ociRegistry.signatureVerification.publicKeys[1]"),
+						"Detail": Equal("invalid public key: no PEM block found"),
+					})),
+				))
+			})
+
+			It("should fail if no public key is configured", func() {
+				cfg.OCIRegistry = &gardenletconfigv1alpha1.OCIRegistryConfig{
+					SignatureVerification: &gardenletconfigv1alpha1.OCISignatureVerificationConfig{},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("ociRegistry.signatureVerification.publicKeys"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -501,6 +501,11 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCIRegistry != nil {
+		in, out := &in.OCIRegistry, &out.OCIRegistry
+		*out = new(OCIRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -855,6 +860,79 @@ func (in *NodeToleration) DeepCopy() *NodeToleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCICacheConfig) DeepCopyInto(out *OCICacheConfig) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Directory != nil {
+		in, out := &in.Directory, &out.Directory
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCICacheConfig.
+func (in *OCICacheConfig) DeepCopy() *OCICacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OCICacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistryConfig) DeepCopyInto(out *OCIRegistryConfig) {
+	*out = *in
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(OCICacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(OCISignatureVerificationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistryConfig.
+func (in *OCIRegistryConfig) DeepCopy() *OCIRegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCISignatureVerificationConfig) DeepCopyInto(out *OCISignatureVerificationConfig) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCISignatureVerificationConfig.
+func (in *OCISignatureVerificationConfig) DeepCopy() *OCISignatureVerificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OCISignatureVerificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteWriteMonitoringConfig) DeepCopyInto(out *RemoteWriteMonitoringConfig) {
 	*out = *in
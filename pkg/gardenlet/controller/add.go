@@ -34,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/vpaevictionrequirements"
 	"github.com/gardener/gardener/pkg/healthz"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all gardenlet controllers to the given manager.
@@ -91,12 +92,18 @@ func AddToManager(
 		return fmt.Errorf("failed adding Bastion controller: %w", err)
 	}
 
-	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, *cfg, identity, gardenClusterIdentity); err != nil {
+	helmRegistry, err := newHelmRegistry(gardenCluster.GetClient(), cfg.OCIRegistry)
+	if err != nil {
+		return fmt.Errorf("failed creating Helm registry: %w", err)
+	}
+
+	if err := controllerinstallation.AddToManager(ctx, mgr, gardenCluster, seedCluster, seedClientSet, helmRegistry, *cfg, identity, gardenClusterIdentity); err != nil {
 		return fmt.Errorf("failed adding ControllerInstallation controller: %w", err)
 	}
 
 	if err := (&gardenlet.Reconciler{
-		Config: *cfg,
+		Config:       *cfg,
+		HelmRegistry: helmRegistry,
 	}).AddToManager(mgr, gardenCluster, seedClientSet); err != nil {
 		return fmt.Errorf("failed adding Gardenlet controller: %w", err)
 	}
@@ -140,3 +147,36 @@ func AddToManager(
 
 	return nil
 }
+
+// newHelmRegistry creates the registry for pulling Helm charts with the cache and signature verification configured in
+// the given config.
+func newHelmRegistry(c client.Client, cfg *gardenletconfigv1alpha1.OCIRegistryConfig) (*oci.HelmRegistry, error) {
+	if cfg == nil {
+		return oci.NewHelmRegistry(c), nil
+	}
+
+	var opts []oci.HelmRegistryOption
+
+	if cfg.Cache != nil {
+		maxSize := oci.DefaultCacheMaxSize
+		if cfg.Cache.MaxSize != nil {
+			maxSize = cfg.Cache.MaxSize.Value()
+		}
+
+		cache, err := oci.NewCache(maxSize, ptr.Deref(cfg.Cache.Directory, ""))
+		if err != nil {
+			return nil, fmt.Errorf("failed creating cache: %w", err)
+		}
+		opts = append(opts, oci.WithCache(cache))
+	}
+
+	if cfg.SignatureVerification != nil {
+		verifier, err := oci.NewCosignVerifier(cfg.SignatureVerification.PublicKeys)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating signature verifier: %w", err)
+		}
+		opts = append(opts, oci.WithSignatureVerification(verifier))
+	}
+
+	return oci.NewHelmRegistry(c, opts...), nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/care"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/controllerinstallation"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/controllerinstallation/required"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // AddToManager adds all ControllerInstallation controllers to the given manager.
@@ -26,6 +27,7 @@ func AddToManager(
 	gardenCluster cluster.Cluster,
 	seedCluster cluster.Cluster,
 	seedClientSet kubernetes.Interface,
+	helmRegistry oci.Interface,
 	cfg gardenletconfigv1alpha1.GardenletConfiguration,
 	identity *gardencorev1beta1.Gardener,
 	gardenClusterIdentity string,
@@ -38,6 +40,7 @@ func AddToManager(
 
 	if err := (&controllerinstallation.Reconciler{
 		SeedClientSet:         seedClientSet,
+		HelmRegistry:          helmRegistry,
 		Config:                cfg,
 		Identity:              identity,
 		GardenClusterIdentity: gardenClusterIdentity,
@@ -7,6 +7,7 @@ package controllerinstallation
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -289,7 +290,12 @@ func (r *Reconciler) reconcile(
 		seedSubCtx := context.WithValue(seedCtx, oci.ContextKeyPullSecretNamespace, gardenerutils.ComputeGardenNamespace(seed.Name))
 		archive, err = r.HelmRegistry.Pull(seedSubCtx, controllerDeployment.Helm.OCIRepository)
 		if err != nil {
-			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, "OCIChartCannotBePulled", fmt.Sprintf("chart pulling process failed: %+v", err))
+			reason := "OCIChartCannotBePulled"
+			var verificationErr *oci.SignatureVerificationError
+			if errors.As(err, &verificationErr) {
+				reason = "OCIChartSignatureInvalid"
+			}
+			conditionValid = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionValid, gardencorev1beta1.ConditionFalse, reason, fmt.Sprintf("chart pulling process failed: %+v", err))
 			return reconcile.Result{}, err
 		}
 	}
@@ -5,12 +5,16 @@
 package helper
 
 import (
+	"fmt"
 	"time"
 
+	"k8s.io/component-base/version"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // ComputeOperationType determines which operation should be executed when acting on the given shoot.
@@ -36,3 +40,9 @@ func GetEtcdDeployTimeout(shoot *shoot.Shoot, defaultDuration time.Duration) tim
 	}
 	return timeout
 }
+
+// ComputeCheckpointFingerprint returns the fingerprint of the inputs of the checkpointed tasks of the reconcile flow. It
+// changes whenever the specification of the Shoot or the Seed or the version of gardenlet changes.
+func ComputeCheckpointFingerprint(shoot *gardencorev1beta1.Shoot, seed *gardencorev1beta1.Seed) string {
+	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s/%d/%s/%d/%s", shoot.UID, shoot.Generation, seed.UID, seed.Generation, version.Get().GitVersion)))
+}
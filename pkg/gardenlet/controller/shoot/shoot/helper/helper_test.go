@@ -144,3 +144,31 @@ var _ = Describe("GetEtcdDeployTimeout", func() {
 		Expect(GetEtcdDeployTimeout(s, defaultTimeout)).To(Equal(etcd.DefaultTimeout))
 	})
 })
+
+var _ = Describe("ComputeCheckpointFingerprint", func() {
+	var (
+		shoot *gardencorev1beta1.Shoot
+		seed  *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{UID: "shoot-uid", Generation: 1}}
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{UID: "seed-uid", Generation: 1}}
+	})
+
+	It("should return the same fingerprint for the same inputs", func() {
+		Expect(ComputeCheckpointFingerprint(shoot, seed)).To(Equal(ComputeCheckpointFingerprint(shoot.DeepCopy(), seed.DeepCopy())))
+	})
+
+	It("should return a different fingerprint if the generation of the Shoot changes", func() {
+		fingerprint := ComputeCheckpointFingerprint(shoot, seed)
+		shoot.Generation++
+		Expect(ComputeCheckpointFingerprint(shoot, seed)).NotTo(Equal(fingerprint))
+	})
+
+	It("should return a different fingerprint if the Seed changes", func() {
+		fingerprint := ComputeCheckpointFingerprint(shoot, seed)
+		seed.UID = "other-seed-uid"
+		Expect(ComputeCheckpointFingerprint(shoot, seed)).NotTo(Equal(fingerprint))
+	})
+})
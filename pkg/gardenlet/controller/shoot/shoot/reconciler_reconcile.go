@@ -34,6 +34,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/flow/checkpointstore"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
@@ -121,8 +122,17 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployKubeAPIServerTaskTimeout = defaultTimeout
 		shootSSHAccessEnabled          = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
 		isRestoringHAControlPlane      = botanist.IsRestorePhase() && v1beta1helper.IsHAControlPlaneConfigured(o.Shoot.GetInfo())
+		checkpointFingerprint          = helper.ComputeCheckpointFingerprint(o.Shoot.GetInfo(), o.Seed.GetInfo())
+		checkpointStore                flow.CheckpointStore
 	)
 
+	// The ShootState exists during the restoration. Persisting the checkpoints in it allows resuming an interrupted
+	// restoration, e.g., after a restart of gardenlet, without copying the etcd backups and waiting for the restored
+	// etcds again.
+	if isRestoring {
+		checkpointStore = checkpointstore.NewShootState(o.GardenClient, o.Shoot.GetInfo().Namespace, o.Shoot.GetInfo().Name)
+	}
+
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
 	// different deployment functions call the `Wait` method after the first deployment. Hence, we should use
 	// the respective timeout in this case instead of the (too short) default timeout to prevent undesired and confusing
@@ -265,6 +275,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilSourceBackupEntryInGardenReconciled = g.Add(flow.Task{
 			Name:         "Waiting until the source backup entry has been reconciled",
 			Fn:           botanist.Shoot.Components.SourceBackupEntry.Wait,
+			Fingerprint:  checkpointFingerprint,
 			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(deploySourceBackupEntry),
 		})
@@ -277,18 +288,21 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilBackupEntryInGardenReconciled = g.Add(flow.Task{
 			Name:         "Waiting until the backup entry has been reconciled",
 			Fn:           botanist.Shoot.Components.BackupEntry.Wait,
+			Fingerprint:  checkpointFingerprint,
 			SkipIf:       skipReadiness || !allowBackup,
 			Dependencies: flow.NewTaskIDs(deployBackupEntryInGarden),
 		})
 		copyEtcdBackups = g.Add(flow.Task{
 			Name:         "Copying etcd backups to new seed's backup bucket",
 			Fn:           botanist.DeployEtcdCopyBackupsTask,
+			Fingerprint:  checkpointFingerprint,
 			SkipIf:       !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(initializeSecretsManagement, deployCloudProviderSecret, waitUntilBackupEntryInGardenReconciled, waitUntilSourceBackupEntryInGardenReconciled),
 		})
 		waitUntilEtcdBackupsCopied = g.Add(flow.Task{
 			Name:         "Waiting until etcd backups are copied",
 			Fn:           botanist.Shoot.Components.ControlPlane.EtcdCopyBackupsTask.Wait,
+			Fingerprint:  checkpointFingerprint,
 			SkipIf:       skipReadiness || !isCopyOfBackupsRequired,
 			Dependencies: flow.NewTaskIDs(copyEtcdBackups),
 		})
@@ -318,6 +332,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
 			Fn:           botanist.WaitUntilEtcdsReady,
+			Fingerprint:  checkpointFingerprint,
 			SkipIf:       (!isRestoringHAControlPlane && o.Shoot.HibernationEnabled) || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
@@ -1056,6 +1071,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
+		CheckpointStore:  checkpointStore,
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
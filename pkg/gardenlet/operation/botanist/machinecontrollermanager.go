@@ -13,6 +13,7 @@ import (
 	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -31,6 +32,9 @@ func (b *Botanist) DefaultMachineControllerManager() (machinecontrollermanager.I
 		b.SecretsManager,
 		machinecontrollermanager.Values{
 			Image: image.String(),
+			// When the control plane of an autonomous shoot is not running in kube-system, the machines are created by
+			// `gardenadm bootstrap` before the shoot cluster exists.
+			WithoutTargetCluster: v1beta1helper.IsShootAutonomous(b.Shoot.GetInfo()) && !b.Shoot.RunsControlPlane(),
 		},
 	), nil
 }
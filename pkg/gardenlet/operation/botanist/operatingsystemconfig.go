@@ -57,6 +57,11 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 		valitailEnabled, valiIngressHost = true, b.ComputeValiHost()
 	}
 
+	var maintenanceTimeWindow *gardencorev1beta1.MaintenanceTimeWindow
+	if maintenance := b.Shoot.GetInfo().Spec.Maintenance; maintenance != nil {
+		maintenanceTimeWindow = maintenance.TimeWindow
+	}
+
 	return operatingsystemconfig.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -77,6 +82,7 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 				NodeLocalDNSEnabled:    v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
 				NodeMonitorGracePeriod: *b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod,
 				PrimaryIPFamily:        b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				MaintenanceTimeWindow:  maintenanceTimeWindow,
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -10,6 +10,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -33,6 +34,29 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	if obj.SyncPeriod == nil {
 		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
 	}
+	if obj.Rollback == nil {
+		obj.Rollback = &OperatingSystemConfigRollback{}
+	}
+	if obj.ImageExtractor == nil {
+		obj.ImageExtractor = &ImageExtractor{}
+	}
+}
+
+// SetDefaults_OperatingSystemConfigRollback sets defaults for the OperatingSystemConfigRollback object.
+func SetDefaults_OperatingSystemConfigRollback(obj *OperatingSystemConfigRollback) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(false)
+	}
+	if obj.HealthCheckTimeout == nil {
+		obj.HealthCheckTimeout = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
+// SetDefaults_ImageExtractor sets defaults for the ImageExtractor object.
+func SetDefaults_ImageExtractor(obj *ImageExtractor) {
+	if obj.Type == "" {
+		obj.Type = ImageExtractorTypeContainerd
+	}
 }
 
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
@@ -42,6 +66,19 @@ func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	}
 }
 
+// SetDefaults_RebootControllerConfig sets defaults for the RebootControllerConfig object.
+func SetDefaults_RebootControllerConfig(obj *RebootControllerConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.MaxUnavailable == nil {
+		obj.MaxUnavailable = ptr.To[int32](1)
+	}
+	if obj.DrainTimeout == nil {
+		obj.DrainTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -54,16 +55,63 @@ var _ = Describe("Defaults", func() {
 					SetDefaults_OperatingSystemConfigControllerConfig(obj)
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					Expect(obj.Rollback).To(PointTo(Equal(OperatingSystemConfigRollback{})))
+					Expect(obj.ImageExtractor).To(PointTo(Equal(ImageExtractor{})))
 				})
 
 				It("should not overwrite existing values", func() {
 					obj := &OperatingSystemConfigControllerConfig{
-						SyncPeriod: &metav1.Duration{Duration: time.Second},
+						SyncPeriod:     &metav1.Duration{Duration: time.Second},
+						Rollback:       &OperatingSystemConfigRollback{Enabled: ptr.To(false)},
+						ImageExtractor: &ImageExtractor{Type: ImageExtractorTypeRegistry},
 					}
 
 					SetDefaults_OperatingSystemConfigControllerConfig(obj)
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+					Expect(obj.Rollback).To(PointTo(Equal(OperatingSystemConfigRollback{Enabled: ptr.To(false)})))
+					Expect(obj.ImageExtractor).To(PointTo(Equal(ImageExtractor{Type: ImageExtractorTypeRegistry})))
+				})
+
+				Describe("Rollback", func() {
+					It("should default the object", func() {
+						obj := &OperatingSystemConfigRollback{}
+
+						SetDefaults_OperatingSystemConfigRollback(obj)
+
+						Expect(obj.Enabled).To(PointTo(BeFalse()))
+						Expect(obj.HealthCheckTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &OperatingSystemConfigRollback{
+							Enabled:            ptr.To(true),
+							HealthCheckTimeout: &metav1.Duration{Duration: time.Hour},
+						}
+
+						SetDefaults_OperatingSystemConfigRollback(obj)
+
+						Expect(obj.Enabled).To(PointTo(BeTrue()))
+						Expect(obj.HealthCheckTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+					})
+				})
+
+				Describe("ImageExtractor", func() {
+					It("should default the object", func() {
+						obj := &ImageExtractor{}
+
+						SetDefaults_ImageExtractor(obj)
+
+						Expect(obj.Type).To(Equal(ImageExtractorTypeContainerd))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &ImageExtractor{Type: ImageExtractorTypeRegistry}
+
+						SetDefaults_ImageExtractor(obj)
+
+						Expect(obj.Type).To(Equal(ImageExtractorTypeRegistry))
+					})
 				})
 			})
 
@@ -86,6 +134,33 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 				})
 			})
+
+			Describe("Reboot controller", func() {
+				It("should default the object", func() {
+					obj := &RebootControllerConfig{}
+
+					SetDefaults_RebootControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.MaxUnavailable).To(PointTo(Equal(int32(1))))
+					Expect(obj.DrainTimeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					Expect(obj.MaintenanceTimeWindow).To(BeNil())
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &RebootControllerConfig{
+						SyncPeriod:     &metav1.Duration{Duration: time.Second},
+						MaxUnavailable: ptr.To[int32](3),
+						DrainTimeout:   &metav1.Duration{Duration: time.Hour},
+					}
+
+					SetDefaults_RebootControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+					Expect(obj.MaxUnavailable).To(PointTo(Equal(int32(3))))
+					Expect(obj.DrainTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+				})
+			})
 		})
 
 		Describe("Server configuration", func() {
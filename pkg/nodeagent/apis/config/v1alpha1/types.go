@@ -8,6 +8,8 @@ import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 const (
@@ -32,6 +34,23 @@ const (
 	KubeconfigFilePath = CredentialsDir + "/kubeconfig"
 	// MachineNameFilePath is the file path on the worker node that contains the machine name.
 	MachineNameFilePath = BaseDir + "/machine-name"
+	// LastAppliedOperatingSystemConfigFilePath is the file path on the worker node that contains the last
+	// OperatingSystemConfig which was successfully applied by gardener-node-agent.
+	LastAppliedOperatingSystemConfigFilePath = BaseDir + "/last-applied-osc.yaml"
+	// RebootRequiredFilePath is the file path on the worker node whose existence indicates that the node must be
+	// rebooted, e.g., because OS changes only take effect after a reboot. It is located on a tmpfs and hence removed
+	// automatically by the reboot.
+	RebootRequiredFilePath = "/var/run/reboot-required"
+	// ImagePullSecretFilePath is the file path on the worker node that contains the credentials in the Docker config
+	// JSON format which are used to pull images from which files are extracted. It is typically part of the operating
+	// system config.
+	ImagePullSecretFilePath = CredentialsDir + "/image-pull-secret.json"
+	// ImageCacheDir is the directory on the worker node that contains the cached blobs of images from which files are
+	// extracted.
+	ImageCacheDir = BaseDir + "/cache/images"
+	// StatusSocketPath is the path of the unix socket on the worker node on which gardener-node-agent serves its
+	// status for the local `status` and `diff` subcommands.
+	StatusSocketPath = "/run/gardener-node-agent/status.sock"
 
 	// UnitName is the name of the gardener-node-agent systemd service.
 	UnitName = "gardener-node-agent.service"
@@ -47,6 +66,12 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyChecksumFailedOperatingSystemConfig is a constant for an annotation key on a Node describing the
+	// checksum of the operating system configuration which was rolled back because it left the node unhealthy.
+	AnnotationKeyChecksumFailedOperatingSystemConfig = "node-agent.gardener.cloud/failed-operating-system-config-checksum"
+	// AnnotationKeyHoldOperatingSystemConfig is a constant for an annotation key on a Node. As long as it is present,
+	// new operating system configurations are not applied to the node, e.g., to roll them out node by node.
+	AnnotationKeyHoldOperatingSystemConfig = "node-agent.gardener.cloud/hold-operating-system-config"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -102,6 +127,12 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// HealthCheck is the configuration for the health check controller.
+	// +optional
+	HealthCheck *HealthCheckControllerConfig `json:"healthCheck,omitempty"`
+	// Reboot is the configuration for the reboot controller. If not set, the controller is disabled.
+	// +optional
+	Reboot *RebootControllerConfig `json:"reboot,omitempty"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -115,6 +146,79 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// Rollback is the configuration for rolling back operating system config revisions which leave the node unhealthy.
+	// +optional
+	Rollback *OperatingSystemConfigRollback `json:"rollback,omitempty"`
+	// ImageExtractor is the configuration for extracting files referenced via `imageRef` from container images.
+	// +optional
+	ImageExtractor *ImageExtractor `json:"imageExtractor,omitempty"`
+}
+
+// OperatingSystemConfigRollback defines the configuration for rolling back operating system config revisions.
+type OperatingSystemConfigRollback struct {
+	// Enabled specifies whether the files and units touched by an operating system config revision are snapshotted
+	// before it is applied, and restored if containerd or the kubelet are unhealthy afterwards. Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// HealthCheckTimeout is the duration for which the health of containerd and the kubelet is verified after applying
+	// an operating system config revision before it is rolled back. Defaults to 1m.
+	// +optional
+	HealthCheckTimeout *metav1.Duration `json:"healthCheckTimeout,omitempty"`
+}
+
+// ImageExtractorType is the type of the image extractor.
+type ImageExtractorType string
+
+const (
+	// ImageExtractorTypeContainerd pulls and mounts the image with the running containerd.
+	ImageExtractorTypeContainerd ImageExtractorType = "containerd"
+	// ImageExtractorTypeRegistry pulls the image layers directly from the registry without a container runtime.
+	ImageExtractorTypeRegistry ImageExtractorType = "registry"
+)
+
+// ImageExtractor defines the configuration for extracting files from container images.
+type ImageExtractor struct {
+	// Type is the type of the image extractor. With `containerd`, the image is pulled and mounted by the running
+	// containerd. With `registry`, the image layers are pulled directly from the registry, honoring the registry mirrors
+	// configured in the operating system config and the pull credentials in the ImagePullSecretFilePath file. The layers
+	// are cached on disk and only the requested file is extracted. Defaults to `containerd`.
+	// +optional
+	Type ImageExtractorType `json:"type,omitempty"`
+}
+
+// HealthCheckControllerConfig defines the configuration of the health check controller.
+type HealthCheckControllerConfig struct {
+	// HealthChecks is a list of custom health checks which are executed in addition to the built-in health checks for
+	// containerd and the kubelet. Custom health checks can also be declared in the OperatingSystemConfig. If a health
+	// check with the same name is declared in both places, the one in this configuration takes precedence.
+	// +optional
+	HealthChecks []extensionsv1alpha1.NodeHealthCheck `json:"healthChecks,omitempty"`
+}
+
+// RebootControllerConfig defines the configuration of the reboot controller. It coordinates the reboots of the nodes
+// of a worker pool which are requested via the RebootRequiredFilePath.
+type RebootControllerConfig struct {
+	// SyncPeriod is the duration how often the controller checks whether a reboot is required.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxUnavailable is the maximum number of nodes of the worker pool that are rebooted concurrently. Defaults to 1.
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+	// DrainTimeout is the maximum duration for draining the node before it is rebooted. If the timeout is exceeded, the
+	// node is rebooted even if not all pods were evicted successfully. Defaults to 10m.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+	// MaintenanceTimeWindow is the time window in which nodes are rebooted. If not set, nodes are rebooted at any time.
+	// +optional
+	MaintenanceTimeWindow *MaintenanceTimeWindow `json:"maintenanceTimeWindow,omitempty"`
+}
+
+// MaintenanceTimeWindow contains information about the time window for maintenance operations.
+type MaintenanceTimeWindow struct {
+	// Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
+	Begin string `json:"begin"`
+	// End is the end of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
+	End string `json:"end"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
@@ -5,18 +5,26 @@
 package validation
 
 import (
+	"slices"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	extensionsvalidation "github.com/gardener/gardener/pkg/apis/extensions/validation"
 	"github.com/gardener/gardener/pkg/logger"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
 )
 
+var availableImageExtractorTypes = []nodeagentconfigv1alpha1.ImageExtractorType{
+	nodeagentconfigv1alpha1.ImageExtractorTypeContainerd,
+	nodeagentconfigv1alpha1.ImageExtractorTypeRegistry,
+}
+
 // ValidateNodeAgentConfiguration validates the given `NodeAgentConfiguration`.
 func ValidateNodeAgentConfiguration(conf *nodeagentconfigv1alpha1.NodeAgentConfiguration) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -49,6 +57,14 @@ func validateControllerConfiguration(conf nodeagentconfigv1alpha1.ControllerConf
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
 
+	if conf.HealthCheck != nil {
+		allErrs = append(allErrs, extensionsvalidation.ValidateNodeHealthChecks(conf.HealthCheck.HealthChecks, fldPath.Child("healthCheck", "healthChecks"))...)
+	}
+
+	if conf.Reboot != nil {
+		allErrs = append(allErrs, validateRebootControllerConfiguration(*conf.Reboot, fldPath.Child("reboot"))...)
+	}
+
 	return allErrs
 }
 
@@ -67,6 +83,14 @@ func validateOperatingSystemConfigControllerConfiguration(conf nodeagentconfigv1
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersion"), conf.KubernetesVersion, err.Error()))
 	}
 
+	if conf.Rollback != nil && conf.Rollback.HealthCheckTimeout != nil && conf.Rollback.HealthCheckTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rollback", "healthCheckTimeout"), conf.Rollback.HealthCheckTimeout, "must be positive"))
+	}
+
+	if conf.ImageExtractor != nil && !slices.Contains(availableImageExtractorTypes, conf.ImageExtractor.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("imageExtractor", "type"), conf.ImageExtractor.Type, availableImageExtractorTypes))
+	}
+
 	return allErrs
 }
 
@@ -98,6 +122,28 @@ func validateTokenControllerConfiguration(conf nodeagentconfigv1alpha1.TokenCont
 	return allErrs
 }
 
+func validateRebootControllerConfiguration(conf nodeagentconfigv1alpha1.RebootControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+
+	if conf.MaxUnavailable == nil || *conf.MaxUnavailable < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), conf.MaxUnavailable, "must be at least 1"))
+	}
+
+	if conf.DrainTimeout == nil || conf.DrainTimeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), conf.DrainTimeout, "must not be negative"))
+	}
+
+	if window := conf.MaintenanceTimeWindow; window != nil {
+		if _, err := timewindow.ParseMaintenanceTimeWindow(window.Begin, window.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenanceTimeWindow"), window, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
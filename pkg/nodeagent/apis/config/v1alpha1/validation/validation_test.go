@@ -14,7 +14,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
 )
@@ -107,6 +109,36 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		It("should fail because the rollback health check timeout is not positive", func() {
+			config.Controllers.OperatingSystemConfig.Rollback = &OperatingSystemConfigRollback{HealthCheckTimeout: &metav1.Duration{}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.rollback.healthCheckTimeout"),
+				})),
+			))
+		})
+
+		It("should allow the supported image extractor types", func() {
+			config.Controllers.OperatingSystemConfig.ImageExtractor = &ImageExtractor{Type: ImageExtractorTypeContainerd}
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+
+			config.Controllers.OperatingSystemConfig.ImageExtractor = &ImageExtractor{Type: ImageExtractorTypeRegistry}
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the image extractor type is not supported", func() {
+			config.Controllers.OperatingSystemConfig.ImageExtractor = &ImageExtractor{Type: "foo"}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.operatingSystemConfig.imageExtractor.type"),
+				})),
+			))
+		})
 	})
 
 	Context("Token Controller", func() {
@@ -161,4 +193,79 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+
+	Context("Reboot Controller", func() {
+		BeforeEach(func() {
+			config.Controllers.Reboot = &RebootControllerConfig{
+				SyncPeriod:     &metav1.Duration{Duration: time.Minute},
+				MaxUnavailable: ptr.To[int32](1),
+				DrainTimeout:   &metav1.Duration{Duration: 10 * time.Minute},
+				MaintenanceTimeWindow: &MaintenanceTimeWindow{
+					Begin: "220000+0100",
+					End:   "230000+0100",
+				},
+			}
+		})
+
+		It("should pass because the configuration is valid", func() {
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the configuration is invalid", func() {
+			config.Controllers.Reboot.SyncPeriod = &metav1.Duration{Duration: time.Second}
+			config.Controllers.Reboot.MaxUnavailable = ptr.To[int32](0)
+			config.Controllers.Reboot.DrainTimeout = nil
+			config.Controllers.Reboot.MaintenanceTimeWindow.End = "foo"
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.syncPeriod"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.maxUnavailable"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.drainTimeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.reboot.maintenanceTimeWindow"),
+				})),
+			))
+		})
+	})
+
+	Context("Health Check Controller", func() {
+		It("should pass because the health checks are valid", func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{
+				HealthChecks: []extensionsv1alpha1.NodeHealthCheck{{
+					Name:        "foo",
+					SystemdUnit: &extensionsv1alpha1.NodeHealthCheckSystemdUnit{Name: "foo.service"},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit},
+				}},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because a health check is invalid", func() {
+			config.Controllers.HealthCheck = &HealthCheckControllerConfig{
+				HealthChecks: []extensionsv1alpha1.NodeHealthCheck{{
+					Name:        "foo",
+					HTTPGet:     &extensionsv1alpha1.NodeHealthCheckHTTPGet{URL: "http://localhost:8080/healthz"},
+					Remediation: &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit},
+				}},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.healthChecks[0].remediation.unitName"),
+				})),
+			))
+		})
+	})
 })
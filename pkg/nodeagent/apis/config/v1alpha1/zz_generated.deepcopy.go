@@ -11,6 +11,7 @@ package v1alpha1
 
 import (
 	v3 "github.com/Masterminds/semver/v3"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -63,6 +64,16 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	in.OperatingSystemConfig.DeepCopyInto(&out.OperatingSystemConfig)
 	in.Token.DeepCopyInto(&out.Token)
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reboot != nil {
+		in, out := &in.Reboot, &out.Reboot
+		*out = new(RebootControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -76,6 +87,61 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckControllerConfig) DeepCopyInto(out *HealthCheckControllerConfig) {
+	*out = *in
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = make([]extensionsv1alpha1.NodeHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckControllerConfig.
+func (in *HealthCheckControllerConfig) DeepCopy() *HealthCheckControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageExtractor) DeepCopyInto(out *ImageExtractor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageExtractor.
+func (in *ImageExtractor) DeepCopy() *ImageExtractor {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageExtractor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
+func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -135,6 +201,16 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(OperatingSystemConfigRollback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageExtractor != nil {
+		in, out := &in.ImageExtractor, &out.ImageExtractor
+		*out = new(ImageExtractor)
+		**out = **in
+	}
 	return
 }
 
@@ -148,6 +224,68 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigRollback) DeepCopyInto(out *OperatingSystemConfigRollback) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HealthCheckTimeout != nil {
+		in, out := &in.HealthCheckTimeout, &out.HealthCheckTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigRollback.
+func (in *OperatingSystemConfigRollback) DeepCopy() *OperatingSystemConfigRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebootControllerConfig) DeepCopyInto(out *RebootControllerConfig) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceTimeWindow != nil {
+		in, out := &in.MaintenanceTimeWindow, &out.MaintenanceTimeWindow
+		*out = new(MaintenanceTimeWindow)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebootControllerConfig.
+func (in *RebootControllerConfig) DeepCopy() *RebootControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RebootControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -26,5 +26,14 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.Rollback != nil {
+		SetDefaults_OperatingSystemConfigRollback(in.Controllers.OperatingSystemConfig.Rollback)
+	}
+	if in.Controllers.OperatingSystemConfig.ImageExtractor != nil {
+		SetDefaults_ImageExtractor(in.Controllers.OperatingSystemConfig.ImageExtractor)
+	}
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
+	if in.Controllers.Reboot != nil {
+		SetDefaults_RebootControllerConfig(in.Controllers.Reboot)
+	}
 }
@@ -22,11 +22,13 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/reboot"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 )
 
 // AddToManager adds all controllers to the given manager.
-func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Manager, cfg *nodeagentconfigv1alpha1.NodeAgentConfiguration, hostName, machineName, nodeName string) error {
+func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Manager, cfg *nodeagentconfigv1alpha1.NodeAgentConfiguration, hostName, machineName, nodeName string, statusRegistry *status.Registry) error {
 	nodePredicate, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelHostname: hostName}})
 	if err != nil {
 		return fmt.Errorf("failed computing label selector predicate for node: %w", err)
@@ -55,12 +57,14 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		NodeName:               nodeName,
 		MachineName:            machineName,
 		CancelContext:          cancel,
+		Status:                 statusRegistry,
 	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding operating system config controller: %w", err)
 	}
 
 	if err := (&token.Reconciler{
 		Config: cfg.Controllers.Token,
+		Status: statusRegistry,
 	}).AddToManager(mgr, channel); err != nil {
 		return fmt.Errorf("failed adding token controller: %w", err)
 	}
@@ -73,7 +77,23 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		}
 	}
 
-	if err := (&healthcheck.Reconciler{}).AddToManager(mgr, nodePredicate); err != nil {
+	// Enable reboot controller only if it is configured and gardener-node-agent is authorized by node-agent-authorizer
+	// for the given node. Evicting pods and acquiring reboot slot leases of the worker pool is only allowed for such
+	// gardener-node-agents.
+	rebootControllerEnabled := cfg.Controllers.Reboot != nil && features.DefaultFeatureGate.Enabled(features.NodeAgentAuthorizer) && nodeName != ""
+	if rebootControllerEnabled {
+		if err := (&reboot.Reconciler{
+			Config: *cfg.Controllers.Reboot,
+		}).AddToManager(mgr, nodePredicate); err != nil {
+			return fmt.Errorf("failed adding reboot controller: %w", err)
+		}
+	}
+
+	if err := (&healthcheck.Reconciler{
+		Config:                  cfg.Controllers.HealthCheck,
+		Status:                  statusRegistry,
+		RebootControllerEnabled: rebootControllerEnabled,
+	}).AddToManager(mgr, nodePredicate); err != nil {
 		return fmt.Errorf("failed adding health-check controller: %w", err)
 	}
 
@@ -5,13 +5,9 @@
 package healthcheck
 
 import (
-	"fmt"
 	"net"
-	"os"
 
-	"github.com/containerd/containerd"
-	"github.com/containerd/containerd/defaults"
-	"github.com/containerd/containerd/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -43,6 +39,14 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 		r.DBus = dbus.New(mgr.GetLogger().WithValues("controller", ControllerName))
 	}
 
+	if r.FS.Fs == nil {
+		r.FS = afero.Afero{Fs: afero.NewOsFs()}
+	}
+
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
 	if len(r.HealthCheckers) == 0 {
 		if err := r.setDefaultHealthChecks(); err != nil {
 			return err
@@ -62,26 +66,14 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 }
 
 func (r *Reconciler) setDefaultHealthChecks() error {
-	clock := clock.RealClock{}
-
-	address := os.Getenv("CONTAINERD_ADDRESS")
-	if address == "" {
-		address = defaults.DefaultAddress
-	}
-
-	namespace := os.Getenv(namespaces.NamespaceEnvVar)
-	if namespace == "" {
-		namespace = namespaces.Default
-	}
-
-	client, err := containerd.New(address, containerd.WithDefaultNamespace(namespace))
+	client, err := NewContainerdClient()
 	if err != nil {
-		return fmt.Errorf("error creating containerd client: %w", err)
+		return err
 	}
 
-	containerdHealthChecker := NewContainerdHealthChecker(r.Client, client, clock, r.DBus, r.Recorder)
+	containerdHealthChecker := NewContainerdHealthChecker(r.Client, client, r.Clock, r.DBus, r.Recorder)
 
-	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, clock, r.DBus, r.Recorder, net.InterfaceAddrs)
+	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, r.Clock, r.DBus, r.Recorder, net.InterfaceAddrs)
 	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker}
 	return nil
 }
@@ -7,9 +7,12 @@ package healthcheck
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/defaults"
+	"github.com/containerd/containerd/namespaces"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
@@ -25,11 +28,40 @@ type ContainerdClient interface {
 	Version(context.Context) (containerd.Version, error)
 }
 
+// NewContainerdClient creates a new containerd client. The address and namespace can be overwritten via the
+// CONTAINERD_ADDRESS and CONTAINERD_NAMESPACE environment variables.
+func NewContainerdClient() (*containerd.Client, error) {
+	address := os.Getenv("CONTAINERD_ADDRESS")
+	if address == "" {
+		address = defaults.DefaultAddress
+	}
+
+	namespace := os.Getenv(namespaces.NamespaceEnvVar)
+	if namespace == "" {
+		namespace = namespaces.Default
+	}
+
+	client, err := containerd.New(address, containerd.WithDefaultNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating containerd client: %w", err)
+	}
+	return client, nil
+}
+
+// ProbeContainerd returns an error if containerd is not healthy.
+func ProbeContainerd(ctx context.Context, containerdClient ContainerdClient) error {
+	if _, err := containerdClient.Version(ctx); err != nil {
+		return fmt.Errorf("unable to get containerd version: %w", err)
+	}
+	return nil
+}
+
 type containerdHealthChecker struct {
 	client client.Client
 
 	containerdClient ContainerdClient
 	firstFailure     *time.Time
+	healthy          bool
 	clock            clock.Clock
 	dbus             dbus.DBus
 	recorder         record.EventRecorder
@@ -51,17 +83,23 @@ func (*containerdHealthChecker) Name() string {
 	return "containerd"
 }
 
+// Healthy returns whether containerd was healthy during the last check.
+func (c *containerdHealthChecker) Healthy() bool {
+	return c.healthy
+}
+
 // Check performs the actual health check for containerd.
 func (c *containerdHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
 	log := logf.FromContext(ctx).WithName(c.Name())
 
-	_, err := c.containerdClient.Version(ctx)
+	err := ProbeContainerd(ctx, c.containerdClient)
+	c.healthy = err == nil
 	if err != nil {
 		if c.firstFailure == nil {
 			now := c.clock.Now()
 			c.firstFailure = &now
 
-			log.Error(err, "Unable to get containerd version, considered unhealthy")
+			log.Error(err, "Containerd is unhealthy")
 			c.recorder.Eventf(node, corev1.EventTypeWarning, "containerd", "Containerd is unhealthy: %s", err.Error())
 		}
 
@@ -69,7 +107,7 @@ func (c *containerdHealthChecker) Check(ctx context.Context, node *corev1.Node)
 			return nil
 		}
 
-		log.Error(err, "Unable to get containerd version, restarting it", "failureDuration", maxFailureDuration)
+		log.Error(err, "Containerd is unhealthy, restarting it", "failureDuration", maxFailureDuration)
 		c.recorder.Eventf(node, corev1.EventTypeWarning, "containerd", "Containerd is unhealthy for more than %s, restarting it: %s", maxFailureDuration, err.Error())
 		if err := c.dbus.Restart(ctx, c.recorder, node, v1beta1constants.OperatingSystemConfigUnitNameContainerDService); err != nil {
 			return fmt.Errorf("failed restarting containerd: %w", err)
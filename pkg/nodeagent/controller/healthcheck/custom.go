@@ -0,0 +1,429 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	defaultCustomHealthCheckTimeout          = 10 * time.Second
+	defaultCustomHealthCheckFailureThreshold = 3
+
+	// initialRemediationBackoff is the minimum duration between two remediations of a custom health check which
+	// restart a unit or reboot the node. It doubles with every remediation until the health check succeeds again.
+	initialRemediationBackoff = 5 * time.Minute
+	// maxRemediationBackoff is the maximum duration between two remediations of a custom health check.
+	maxRemediationBackoff = 4 * time.Hour
+	// remediationStatesFilePath is the file path on the worker node that contains the remediation states of the custom
+	// health checks. It is persisted so that the backoff also applies after gardener-node-agent restarts or the node
+	// was rebooted.
+	remediationStatesFilePath = nodeagentconfigv1alpha1.BaseDir + "/health-check-remediations.json"
+
+	// conditionReasonHealthCheckFailing is the reason of node conditions set by failing custom health checks.
+	conditionReasonHealthCheckFailing = "HealthCheckFailing"
+	// conditionReasonHealthCheckSucceeded is the reason of node conditions set by succeeding custom health checks.
+	conditionReasonHealthCheckSucceeded = "HealthCheckSucceeded"
+)
+
+// CommandRunner runs the given command and returns an error if it did not exit successfully. Exported for testing.
+type CommandRunner func(ctx context.Context, name string, args ...string) error
+
+func runCommand(ctx context.Context, name string, args ...string) error {
+	output, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command %q failed: %w (output: %s)", name, err, string(output))
+	}
+	return nil
+}
+
+// remediationState is the state of the remediations of a custom health check.
+type remediationState struct {
+	// LastRemediationTime is the time of the last remediation.
+	LastRemediationTime time.Time `json:"lastRemediationTime"`
+	// Backoff is the duration which must pass after the last remediation before the next remediation is executed.
+	Backoff time.Duration `json:"backoff"`
+}
+
+type customHealthChecker struct {
+	client        client.Client
+	clock         clock.Clock
+	dbus          dbus.DBus
+	recorder      record.EventRecorder
+	fs            afero.Afero
+	commandRunner CommandRunner
+	// rebootControllerEnabled states whether the reboot controller is enabled. Otherwise, reboots requested via the
+	// RebootRequiredFilePath are not executed.
+	rebootControllerEnabled bool
+
+	healthCheck         extensionsv1alpha1.NodeHealthCheck
+	consecutiveFailures int32
+	healthy             bool
+	remediationState    *remediationState
+}
+
+// NewCustomHealthChecker creates a new instance of a health check which is declared in the NodeAgentConfiguration or
+// in the OperatingSystemConfig. The Reboot remediation is only executed if the reboot controller is enabled.
+func NewCustomHealthChecker(
+	client client.Client,
+	clock clock.Clock,
+	dbus dbus.DBus,
+	recorder record.EventRecorder,
+	fs afero.Afero,
+	commandRunner CommandRunner,
+	rebootControllerEnabled bool,
+	healthCheck extensionsv1alpha1.NodeHealthCheck,
+) HealthChecker {
+	return newCustomHealthChecker(client, clock, dbus, recorder, fs, commandRunner, rebootControllerEnabled, healthCheck)
+}
+
+func newCustomHealthChecker(
+	client client.Client,
+	clock clock.Clock,
+	dbus dbus.DBus,
+	recorder record.EventRecorder,
+	fs afero.Afero,
+	commandRunner CommandRunner,
+	rebootControllerEnabled bool,
+	healthCheck extensionsv1alpha1.NodeHealthCheck,
+) *customHealthChecker {
+	if commandRunner == nil {
+		commandRunner = runCommand
+	}
+
+	return &customHealthChecker{
+		client:        client,
+		clock:         clock,
+		dbus:          dbus,
+		recorder:      recorder,
+		fs:            fs,
+		commandRunner: commandRunner,
+		healthCheck:   healthCheck,
+
+		rebootControllerEnabled: rebootControllerEnabled,
+	}
+}
+
+// Name returns the name of this health check.
+func (c *customHealthChecker) Name() string {
+	return c.healthCheck.Name
+}
+
+// Healthy returns whether the declared probe succeeded during the last check.
+func (c *customHealthChecker) Healthy() bool {
+	return c.healthy
+}
+
+// Check performs the declared probe and executes the remediation once the failure threshold is reached.
+func (c *customHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(c.Name())
+
+	timeout := defaultCustomHealthCheckTimeout
+	if c.healthCheck.Timeout != nil {
+		timeout = c.healthCheck.Timeout.Duration
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := c.probe(probeCtx)
+	c.healthy = err == nil
+	if err != nil {
+		c.consecutiveFailures++
+
+		failureThreshold := ptr.Deref(c.healthCheck.FailureThreshold, defaultCustomHealthCheckFailureThreshold)
+		if c.consecutiveFailures < failureThreshold {
+			log.Info("Health check failed", "error", err.Error(), "consecutiveFailures", c.consecutiveFailures, "failureThreshold", failureThreshold)
+			return nil
+		}
+
+		log.Error(err, "Health check failed, remediating", "consecutiveFailures", c.consecutiveFailures)
+		c.recorder.Eventf(node, corev1.EventTypeWarning, c.Name(), "Health check %q failed %d times in a row: %s", c.Name(), c.consecutiveFailures, err.Error())
+		c.consecutiveFailures = 0
+
+		return c.remediate(ctx, log, node, err)
+	}
+
+	if c.consecutiveFailures > 0 {
+		log.Info("Health check succeeded again")
+		c.consecutiveFailures = 0
+	}
+
+	if err := c.resetRemediationBackoff(log); err != nil {
+		return err
+	}
+
+	return c.recover(ctx, node)
+}
+
+func (c *customHealthChecker) probe(ctx context.Context) error {
+	switch hc := c.healthCheck; {
+	case hc.SystemdUnit != nil:
+		activeState, err := c.dbus.ActiveState(ctx, hc.SystemdUnit.Name)
+		if err != nil {
+			return err
+		}
+		if activeState != "active" {
+			return fmt.Errorf("unit %s is %s", hc.SystemdUnit.Name, activeState)
+		}
+
+	case hc.HTTPGet != nil:
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, hc.HTTPGet.URL, nil)
+		if err != nil {
+			return fmt.Errorf("failed creating request for %s: %w", hc.HTTPGet.URL, err)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return fmt.Errorf("failed sending request to %s: %w", hc.HTTPGet.URL, err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("request to %s returned unexpected status code %d", hc.HTTPGet.URL, response.StatusCode)
+		}
+
+	case hc.TCPSocket != nil:
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", hc.TCPSocket.Address)
+		if err != nil {
+			return fmt.Errorf("failed connecting to %s: %w", hc.TCPSocket.Address, err)
+		}
+		return conn.Close()
+
+	case hc.File != nil:
+		fileInfo, err := c.fs.Stat(hc.File.Path)
+		if err != nil {
+			return fmt.Errorf("failed checking file %s: %w", hc.File.Path, err)
+		}
+		if hc.File.MaxAge != nil {
+			if age := c.clock.Since(fileInfo.ModTime()); age > hc.File.MaxAge.Duration {
+				return fmt.Errorf("file %s was last modified %s ago which exceeds the maximum age of %s", hc.File.Path, age.Round(time.Second), hc.File.MaxAge.Duration)
+			}
+		}
+
+	case hc.Exec != nil:
+		return c.commandRunner(ctx, hc.Exec.Command[0], hc.Exec.Command[1:]...)
+
+	default:
+		return fmt.Errorf("no probe defined for health check %q", hc.Name)
+	}
+
+	return nil
+}
+
+func (c *customHealthChecker) remediate(ctx context.Context, log logr.Logger, node *corev1.Node, probeErr error) error {
+	remediation := c.healthCheck.Remediation
+	if remediation == nil {
+		return nil
+	}
+
+	if remediation.Type == extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition {
+		return c.patchNodeCondition(ctx, node, corev1.ConditionTrue, conditionReasonHealthCheckFailing, probeErr.Error())
+	}
+
+	// Restarting units and rebooting the node are disruptive, hence they are backed off to prevent restart or reboot
+	// loops in case the health check keeps failing.
+	state, err := c.loadRemediationState(log)
+	if err != nil {
+		return err
+	}
+	if remaining := state.Backoff - c.clock.Since(state.LastRemediationTime); remaining > 0 {
+		log.Info("Skipping remediation because of backoff", "remediation", remediation.Type, "remaining", remaining.Round(time.Second))
+		return nil
+	}
+
+	switch remediation.Type {
+	case extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit:
+		unitName := c.unitName()
+		if err := c.dbus.Restart(ctx, c.recorder, node, unitName); err != nil {
+			return fmt.Errorf("failed restarting unit %s for health check %q: %w", unitName, c.Name(), err)
+		}
+
+	case extensionsv1alpha1.NodeHealthCheckRemediationReboot:
+		if !c.rebootControllerEnabled {
+			// Nothing would act on the reboot request, hence make it visible that the node is not rebooted. The
+			// remediation is still backed off to prevent emitting the event on every check.
+			log.Info("Not requesting reboot of node because the reboot controller is disabled")
+			c.recorder.Eventf(node, corev1.EventTypeWarning, c.Name(), "Health check %q failed but the node is not rebooted because the reboot controller of gardener-node-agent is disabled", c.Name())
+			break
+		}
+
+		// The reboot is only requested, the reboot controller coordinates it with the other nodes of the worker pool.
+		log.Info("Requesting reboot of node", "path", nodeagentconfigv1alpha1.RebootRequiredFilePath)
+		c.recorder.Eventf(node, corev1.EventTypeWarning, c.Name(), "Requesting reboot of node because health check %q failed", c.Name())
+		if err := c.fs.WriteFile(nodeagentconfigv1alpha1.RebootRequiredFilePath, nil, 0600); err != nil {
+			return fmt.Errorf("failed requesting reboot of node for health check %q: %w", c.Name(), err)
+		}
+	}
+
+	state.LastRemediationTime = c.clock.Now()
+	state.Backoff = min(max(2*state.Backoff, initialRemediationBackoff), maxRemediationBackoff)
+	return c.saveRemediationState(log, state)
+}
+
+// loadRemediationState returns the remediation state of this health check. It is read from the file system if it was
+// not loaded before.
+func (c *customHealthChecker) loadRemediationState(log logr.Logger) (*remediationState, error) {
+	if c.remediationState != nil {
+		return c.remediationState, nil
+	}
+
+	states, err := c.readRemediationStates(log)
+	if err != nil {
+		return nil, err
+	}
+
+	c.remediationState = &remediationState{}
+	if state, ok := states[c.Name()]; ok {
+		c.remediationState = &state
+	}
+	return c.remediationState, nil
+}
+
+// resetRemediationBackoff resets the remediation backoff of this health check once it succeeds again.
+func (c *customHealthChecker) resetRemediationBackoff(log logr.Logger) error {
+	state, err := c.loadRemediationState(log)
+	if err != nil {
+		return err
+	}
+	if state.Backoff == 0 {
+		return nil
+	}
+
+	return c.saveRemediationState(log, &remediationState{})
+}
+
+func (c *customHealthChecker) saveRemediationState(log logr.Logger, state *remediationState) error {
+	c.remediationState = state
+
+	states, err := c.readRemediationStates(log)
+	if err != nil {
+		return err
+	}
+
+	if state.Backoff == 0 {
+		delete(states, c.Name())
+	} else {
+		states[c.Name()] = *state
+	}
+
+	data, err := json.Marshal(states)
+	if err != nil {
+		return fmt.Errorf("failed marshalling remediation states: %w", err)
+	}
+	if err := c.fs.WriteFile(remediationStatesFilePath, data, 0600); err != nil {
+		return fmt.Errorf("failed writing remediation states to %s: %w", remediationStatesFilePath, err)
+	}
+	return nil
+}
+
+func (c *customHealthChecker) readRemediationStates(log logr.Logger) (map[string]remediationState, error) {
+	states := map[string]remediationState{}
+
+	data, err := c.fs.ReadFile(remediationStatesFilePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return states, nil
+		}
+		return nil, fmt.Errorf("failed reading remediation states from %s: %w", remediationStatesFilePath, err)
+	}
+
+	if err := json.Unmarshal(data, &states); err != nil {
+		// A corrupted file must not block the remediations forever, hence it is overwritten with the next state.
+		log.Error(err, "Failed unmarshalling remediation states, ignoring them", "path", remediationStatesFilePath)
+		return map[string]remediationState{}, nil
+	}
+	return states, nil
+}
+
+// recover resets the node condition of a previously failed health check.
+func (c *customHealthChecker) recover(ctx context.Context, node *corev1.Node) error {
+	remediation := c.healthCheck.Remediation
+	if remediation == nil || remediation.Type != extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition {
+		return nil
+	}
+
+	if condition := getNodeCondition(node, c.conditionType()); condition == nil || condition.Status == corev1.ConditionFalse {
+		return nil
+	}
+
+	c.recorder.Eventf(node, corev1.EventTypeNormal, c.Name(), "Health check %q succeeded again", c.Name())
+	return c.patchNodeCondition(ctx, node, corev1.ConditionFalse, conditionReasonHealthCheckSucceeded, fmt.Sprintf("Health check %q succeeded", c.Name()))
+}
+
+func (c *customHealthChecker) patchNodeCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	var (
+		conditionType = c.conditionType()
+		now           = metav1.NewTime(c.clock.Now())
+		patch         = client.StrategicMergeFrom(node.DeepCopy())
+	)
+
+	condition := getNodeCondition(node, conditionType)
+	if condition == nil {
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: conditionType})
+		condition = &node.Status.Conditions[len(node.Status.Conditions)-1]
+	}
+
+	if condition.Status != status {
+		condition.LastTransitionTime = now
+	}
+	condition.Status = status
+	condition.LastHeartbeatTime = now
+	condition.Reason = reason
+	condition.Message = message
+
+	if err := c.client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching node condition %s for health check %q: %w", conditionType, c.Name(), err)
+	}
+	return nil
+}
+
+func (c *customHealthChecker) unitName() string {
+	if c.healthCheck.Remediation != nil && c.healthCheck.Remediation.UnitName != nil {
+		return *c.healthCheck.Remediation.UnitName
+	}
+	if c.healthCheck.SystemdUnit != nil {
+		return c.healthCheck.SystemdUnit.Name
+	}
+	return ""
+}
+
+func (c *customHealthChecker) conditionType() corev1.NodeConditionType {
+	if c.healthCheck.Remediation != nil {
+		return corev1.NodeConditionType(ptr.Deref(c.healthCheck.Remediation.ConditionType, ""))
+	}
+	return ""
+}
+
+func getNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == conditionType {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
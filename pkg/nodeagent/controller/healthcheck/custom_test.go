@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Custom", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+		fakeClock  *testing.FakeClock
+		recorder   *record.FakeRecorder
+
+		node        *corev1.Node
+		healthCheck extensionsv1alpha1.NodeHealthCheck
+		commandErr  error
+
+		rebootControllerEnabled bool
+
+		newHealthChecker = func() HealthChecker {
+			return NewCustomHealthChecker(fakeClient, fakeClock, fakeDBus, recorder, fakeFS, func(_ context.Context, _ string, _ ...string) error {
+				return commandErr
+			}, rebootControllerEnabled, healthCheck)
+		}
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(node).WithStatusSubresource(node).Build()
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testing.NewFakeClock(time.Now())
+		recorder = record.NewFakeRecorder(100)
+		commandErr = nil
+		rebootControllerEnabled = true
+
+		healthCheck = extensionsv1alpha1.NodeHealthCheck{
+			Name:             "foo",
+			SystemdUnit:      &extensionsv1alpha1.NodeHealthCheckSystemdUnit{Name: "foo.service"},
+			FailureThreshold: ptr.To[int32](2),
+			Remediation:      &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationRestartUnit},
+		}
+	})
+
+	Describe("#Name", func() {
+		It("should return the name of the health check", func() {
+			Expect(newHealthChecker().Name()).To(Equal("foo"))
+		})
+	})
+
+	Describe("#Check", func() {
+		Context("systemd unit probe", func() {
+			It("should do nothing if the unit is active", func() {
+				fakeDBus.SetActiveState("foo.service", "active")
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+
+			It("should restart the unit once the failure threshold is reached", func() {
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(BeEmpty())
+
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}}))
+				Expect(recorder.Events).To(Receive(ContainSubstring("failed 2 times in a row")))
+			})
+
+			It("should reset the failure count if the unit becomes active again", func() {
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+
+				fakeDBus.SetActiveState("foo.service", "active")
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+
+				fakeDBus.SetActiveState("foo.service", "failed")
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+
+			It("should report whether the unit was healthy during the last check", func() {
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(healthChecker.(HealthReporter).Healthy()).To(BeFalse())
+
+				fakeDBus.SetActiveState("foo.service", "active")
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(healthChecker.(HealthReporter).Healthy()).To(BeTrue())
+			})
+
+			It("should restart the configured unit", func() {
+				healthCheck.Remediation.UnitName = ptr.To("bar.service")
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"bar.service"}}))
+			})
+
+			It("should return an error if the restart fails", func() {
+				fakeDBus.InjectRestartFailure(errors.New("fake"), "foo.service")
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(healthChecker.Check(ctx, node)).To(MatchError(ContainSubstring("failed restarting unit foo.service")))
+			})
+
+			It("should back off subsequent restarts while the unit keeps failing", func() {
+				healthCheck.FailureThreshold = ptr.To[int32](1)
+				restart := fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}}
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(ConsistOf(restart))
+
+				fakeClock.Step(4 * time.Minute)
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(ConsistOf(restart))
+
+				fakeClock.Step(time.Minute)
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(ConsistOf(restart, restart))
+
+				By("Double the backoff")
+				fakeClock.Step(9 * time.Minute)
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(HaveLen(2))
+
+				fakeClock.Step(time.Minute)
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(HaveLen(3))
+			})
+
+			It("should reset the backoff once the unit is active again", func() {
+				healthCheck.FailureThreshold = ptr.To[int32](1)
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(HaveLen(1))
+
+				fakeDBus.SetActiveState("foo.service", "active")
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+
+				fakeDBus.SetActiveState("foo.service", "failed")
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeDBus.Actions).To(HaveLen(2))
+			})
+		})
+
+		Context("HTTP probe", func() {
+			var (
+				server     *httptest.Server
+				statusCode int
+			)
+
+			BeforeEach(func() {
+				statusCode = http.StatusOK
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(statusCode)
+				}))
+				DeferCleanup(server.Close)
+
+				healthCheck.SystemdUnit = nil
+				healthCheck.HTTPGet = &extensionsv1alpha1.NodeHealthCheckHTTPGet{URL: server.URL}
+				healthCheck.FailureThreshold = nil
+				healthCheck.Remediation = &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationReboot}
+			})
+
+			It("should do nothing if the endpoint is healthy", func() {
+				healthChecker := newHealthChecker()
+				for range 3 {
+					Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				}
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+
+			It("should request a reboot of the node after three consecutive failures by default", func() {
+				statusCode = http.StatusInternalServerError
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeFalse())
+
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeTrue())
+				Expect(fakeDBus.Actions).To(BeEmpty())
+				Expect(recorder.Events).To(Receive(ContainSubstring("failed 3 times in a row")))
+				Expect(recorder.Events).To(Receive(ContainSubstring("Requesting reboot of node")))
+			})
+
+			It("should not request a reboot of the node if the reboot controller is disabled", func() {
+				statusCode = http.StatusInternalServerError
+				rebootControllerEnabled = false
+
+				healthChecker := newHealthChecker()
+				for range 3 {
+					Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				}
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeFalse())
+				Expect(recorder.Events).To(Receive(ContainSubstring("failed 3 times in a row")))
+				Expect(recorder.Events).To(Receive(ContainSubstring("the node is not rebooted because the reboot controller of gardener-node-agent is disabled")))
+
+				By("Check again during the backoff")
+				for range 3 {
+					Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				}
+				Expect(recorder.Events).To(Receive(ContainSubstring("failed 3 times in a row")))
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should not request another reboot during the backoff after the node was rebooted", func() {
+				statusCode = http.StatusInternalServerError
+
+				healthChecker := newHealthChecker()
+				for range 3 {
+					Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				}
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeTrue())
+
+				By("Simulate reboot")
+				Expect(fakeFS.Remove(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(Succeed())
+				fakeClock.Step(2 * time.Minute)
+
+				healthChecker = newHealthChecker()
+				for range 3 {
+					Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				}
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeFalse())
+
+				fakeClock.Step(3 * time.Minute)
+				for range 3 {
+					Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				}
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeTrue())
+			})
+		})
+
+		Context("file probe", func() {
+			BeforeEach(func() {
+				healthCheck.SystemdUnit = nil
+				healthCheck.File = &extensionsv1alpha1.NodeHealthCheckFile{Path: "/var/lib/foo/heartbeat", MaxAge: &metav1.Duration{Duration: time.Minute}}
+				healthCheck.FailureThreshold = ptr.To[int32](1)
+				healthCheck.Remediation = &extensionsv1alpha1.NodeHealthCheckRemediation{Type: extensionsv1alpha1.NodeHealthCheckRemediationReboot}
+			})
+
+			It("should fail if the file does not exist", func() {
+				Expect(newHealthChecker().Check(ctx, node)).To(Succeed())
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeTrue())
+			})
+
+			It("should succeed if the file was modified recently and fail if it is too old", func() {
+				Expect(fakeFS.WriteFile("/var/lib/foo/heartbeat", []byte("alive"), 0600)).To(Succeed())
+				Expect(fakeFS.Chtimes("/var/lib/foo/heartbeat", fakeClock.Now(), fakeClock.Now())).To(Succeed())
+
+				healthChecker := newHealthChecker()
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeFalse())
+
+				fakeClock.Step(2 * time.Minute)
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeFS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(BeTrue())
+			})
+		})
+
+		Context("exec probe with node condition remediation", func() {
+			BeforeEach(func() {
+				healthCheck.SystemdUnit = nil
+				healthCheck.Exec = &extensionsv1alpha1.NodeHealthCheckExec{Command: []string{"/usr/bin/check-foo"}}
+				healthCheck.FailureThreshold = ptr.To[int32](1)
+				healthCheck.Remediation = &extensionsv1alpha1.NodeHealthCheckRemediation{
+					Type:          extensionsv1alpha1.NodeHealthCheckRemediationNodeCondition,
+					ConditionType: ptr.To("FooUnhealthy"),
+				}
+			})
+
+			It("should set the node condition while the command fails and reset it afterwards", func() {
+				healthChecker := newHealthChecker()
+
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Status.Conditions).To(BeEmpty())
+
+				commandErr = errors.New("exit status 1")
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Status.Conditions).To(ConsistOf(matchCondition(corev1.NodeCondition{
+					Type:    "FooUnhealthy",
+					Status:  corev1.ConditionTrue,
+					Reason:  "HealthCheckFailing",
+					Message: "exit status 1",
+				})))
+
+				commandErr = nil
+				Expect(healthChecker.Check(ctx, node)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Status.Conditions).To(ConsistOf(matchCondition(corev1.NodeCondition{
+					Type:    "FooUnhealthy",
+					Status:  corev1.ConditionFalse,
+					Reason:  "HealthCheckSucceeded",
+					Message: `Health check "foo" succeeded`,
+				})))
+				Expect(fakeDBus.Actions).To(BeEmpty())
+			})
+		})
+	})
+})
+
+func matchCondition(expected corev1.NodeCondition) OmegaMatcher {
+	return And(
+		HaveField("Type", expected.Type),
+		HaveField("Status", expected.Status),
+		HaveField("Reason", expected.Reason),
+		HaveField("Message", expected.Message),
+	)
+}
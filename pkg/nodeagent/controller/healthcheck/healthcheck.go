@@ -21,3 +21,10 @@ type HealthChecker interface {
 	// Check executes the health check.
 	Check(ctx context.Context, node *corev1.Node) error
 }
+
+// HealthReporter can be implemented by a HealthChecker to report whether the node component was healthy during the
+// last check. A failing check does not necessarily return an error, e.g., when the failure is tolerated for a while.
+type HealthReporter interface {
+	// Healthy returns whether the node component was healthy during the last check.
+	Healthy() bool
+}
@@ -34,6 +34,25 @@ const (
 	toggleTimeSpan = 10 * time.Minute
 )
 
+// ProbeKubelet returns an error if the given kubelet health endpoint does not succeed.
+func ProbeKubelet(ctx context.Context, httpClient *http.Client, kubeletHealthEndpoint string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, kubeletHealthEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("creating request to kubelet health endpoint failed: %w", err)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("HTTP request to kubelet health endpoint failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("kubelet health endpoint returned status code %d", response.StatusCode)
+	}
+	return nil
+}
+
 // KubeletHealthChecker configures the kubelet healthcheck.
 type KubeletHealthChecker struct {
 	// Clock exported for testing.
@@ -46,6 +65,7 @@ type KubeletHealthChecker struct {
 	client                client.Client
 	httpClient            *http.Client
 	firstFailure          *time.Time
+	healthy               bool
 	dbus                  dbus.DBus
 	recorder              record.EventRecorder
 	lastInternalIP        netip.Addr
@@ -84,6 +104,11 @@ func (k *KubeletHealthChecker) SetKubeletHealthEndpoint(kubeletHealthEndpoint st
 	k.kubeletHealthEndpoint = kubeletHealthEndpoint
 }
 
+// Healthy returns whether the kubelet was healthy during the last check.
+func (k *KubeletHealthChecker) Healthy() bool {
+	return k.healthy
+}
+
 // Check performs the actual health check for the kubelet.
 func (k *KubeletHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
 	log := logf.FromContext(ctx).WithName(k.Name())
@@ -96,18 +121,11 @@ func (k *KubeletHealthChecker) Check(ctx context.Context, node *corev1.Node) err
 		return err
 	}
 
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, k.kubeletHealthEndpoint, nil)
-	if err != nil {
-		log.Error(err, "Creating request to kubelet health endpoint failed")
-		return err
-	}
-	response, err := k.httpClient.Do(request)
-	if err != nil {
-		log.Error(err, "HTTP request to kubelet health endpoint failed")
-	}
-	if err == nil && response.StatusCode == http.StatusOK {
+	err := ProbeKubelet(ctx, k.httpClient, k.kubeletHealthEndpoint)
+	k.healthy = err == nil
+	if k.healthy {
 		if k.firstFailure != nil {
-			log.Info("Kubelet is healthy again", "statusCode", response.StatusCode)
+			log.Info("Kubelet is healthy again")
 			k.recorder.Event(node, corev1.EventTypeNormal, "kubelet", "Kubelet is healthy")
 			k.firstFailure = nil
 		}
@@ -6,25 +6,57 @@ package healthcheck
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+var decoder runtime.Decoder
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(extensionsv1alpha1.AddToScheme(scheme))
+	decoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// Reconciler checks for containerd and kubelet health and restarts them if required. Additionally, it executes the
+// custom health checks declared in the configuration and in the last applied OperatingSystemConfig.
 type Reconciler struct {
 	Client                     client.Client
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
+	FS                         afero.Afero
+	Clock                      clock.Clock
+	Config                     *nodeagentconfigv1alpha1.HealthCheckControllerConfig
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
+	// RebootControllerEnabled states whether the reboot controller is enabled, i.e., whether the Reboot remediation of
+	// custom health checks is executed.
+	RebootControllerEnabled bool
+	// Status is used to report the results of the health checks to the local status API.
+	Status *status.Registry
+
+	customHealthCheckers map[string]*customHealthChecker
 }
 
 // Reconcile executes all defined health checks.
@@ -37,11 +69,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
+	healthCheckers := append(slices.Clone(r.HealthCheckers), r.syncCustomHealthCheckers(log)...)
+
 	var taskFns []flow.TaskFn
-	for _, healthChecker := range r.HealthCheckers {
+	for _, healthChecker := range healthCheckers {
 		f := healthChecker
 
-		taskFns = append(taskFns, func(ctx context.Context) error { return f.Check(ctx, node.DeepCopy()) })
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			err := f.Check(ctx, node.DeepCopy())
+
+			healthy := err == nil
+			if reporter, ok := f.(HealthReporter); ok {
+				healthy = reporter.Healthy()
+			}
+			r.Status.SetHealthCheck(f.Name(), healthy, err)
+
+			return err
+		})
 	}
 
 	if err := flow.Parallel(taskFns...)(ctx); err != nil {
@@ -50,3 +94,69 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	return reconcile.Result{RequeueAfter: time.Duration(r.HealthCheckIntervalSeconds) * time.Second}, nil
 }
+
+// syncCustomHealthCheckers (re-)creates the custom health checkers for the currently declared health checks. Existing
+// health checkers are kept as long as their specification does not change to preserve their failure counts.
+func (r *Reconciler) syncCustomHealthCheckers(log logr.Logger) []HealthChecker {
+	healthChecks, err := r.declaredHealthChecks()
+	if err != nil {
+		// Do not block the remaining health checks, the health checks declared in the configuration are still executed.
+		log.Error(err, "Failed reading health checks from last applied OperatingSystemConfig")
+	}
+
+	customHealthCheckers := make(map[string]*customHealthChecker, len(healthChecks))
+	result := make([]HealthChecker, 0, len(healthChecks))
+
+	for _, healthCheck := range healthChecks {
+		healthChecker, ok := r.customHealthCheckers[healthCheck.Name]
+		if !ok || !apiequality.Semantic.DeepEqual(healthChecker.healthCheck, healthCheck) {
+			healthChecker = newCustomHealthChecker(r.Client, r.Clock, r.DBus, r.Recorder, r.FS, nil, r.RebootControllerEnabled, healthCheck)
+		}
+
+		customHealthCheckers[healthCheck.Name] = healthChecker
+		result = append(result, healthChecker)
+	}
+
+	r.customHealthCheckers = customHealthCheckers
+	return result
+}
+
+// declaredHealthChecks returns the health checks declared in the configuration and in the last applied
+// OperatingSystemConfig. Health checks in the configuration take precedence over those with the same name in the
+// OperatingSystemConfig.
+func (r *Reconciler) declaredHealthChecks() ([]extensionsv1alpha1.NodeHealthCheck, error) {
+	var healthChecks []extensionsv1alpha1.NodeHealthCheck
+	if r.Config != nil {
+		healthChecks = append(healthChecks, r.Config.HealthChecks...)
+	}
+
+	oscHealthChecks, err := r.lastAppliedOperatingSystemConfigHealthChecks()
+	for _, healthCheck := range oscHealthChecks {
+		if !slices.ContainsFunc(healthChecks, func(hc extensionsv1alpha1.NodeHealthCheck) bool { return hc.Name == healthCheck.Name }) {
+			healthChecks = append(healthChecks, healthCheck)
+		}
+	}
+
+	return healthChecks, err
+}
+
+func (r *Reconciler) lastAppliedOperatingSystemConfigHealthChecks() ([]extensionsv1alpha1.NodeHealthCheck, error) {
+	if r.FS.Fs == nil {
+		return nil, nil
+	}
+
+	oscRaw, err := r.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading last applied OperatingSystemConfig from %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("failed decoding last applied OperatingSystemConfig: %w", err)
+	}
+
+	return osc.Spec.HealthChecks, nil
+}
@@ -50,7 +50,11 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		r.FS = afero.Afero{Fs: afero.NewOsFs()}
 	}
 	if r.Extractor == nil {
-		r.Extractor = registry.NewExtractor()
+		if r.Config.ImageExtractor != nil && r.Config.ImageExtractor.Type == nodeagentconfigv1alpha1.ImageExtractorTypeRegistry {
+			r.Extractor = registry.NewRegistryExtractor(r.FS, nodeagentconfigv1alpha1.ImageCacheDir, nodeagentconfigv1alpha1.ImagePullSecretFilePath)
+		} else {
+			r.Extractor = registry.NewExtractor()
+		}
 	}
 
 	return builder.
@@ -64,7 +68,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		Watches(
 			&corev1.Node{},
 			handler.EnqueueRequestsFromMapFunc(r.NodeToSecretMapper()),
-			builder.WithPredicates(r.NodeReadyForUpdate()),
+			builder.WithPredicates(predicate.Or(r.NodeReadyForUpdate(), r.NodeHoldReleased())),
 		).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
 		Complete(r)
@@ -186,6 +190,32 @@ func (r *Reconciler) NodeReadyForUpdate() predicate.Predicate {
 	}
 }
 
+// NodeHoldReleased returns a predicate that returns
+// - true for Update event if the old node has the annotation for holding back operating system configurations and the new node doesn't.
+// - false for Create, Delete and Generic events.
+func (r *Reconciler) NodeHoldReleased() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+
+			_, oldHeld := e.ObjectOld.GetAnnotations()[nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig]
+			_, newHeld := e.ObjectNew.GetAnnotations()[nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig]
+			return oldHeld && !newHeld
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
 func nodeHasInPlaceUpdateConditionWithReasonReadyForUpdate(conditions []corev1.NodeCondition) bool {
 	for _, condition := range conditions {
 		if condition.Type == machinev1alpha1.NodeInPlaceUpdate && condition.Reason == machinev1alpha1.ReadyForUpdate {
@@ -291,6 +291,55 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#NodeHoldReleased", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodeHoldReleased()
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"node-agent.gardener.cloud/hold-operating-system-config": "true"}}}
+		})
+
+		Describe("#Create", func() {
+			It("should return false", func() {
+				Expect(p.Create(event.CreateEvent{Object: node})).To(BeFalse())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because both old and new object have the annotation", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return false because neither old nor new object have the annotation", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: &corev1.Node{}, ObjectNew: &corev1.Node{}})).To(BeFalse())
+			})
+
+			It("should return false because the annotation was added", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: &corev1.Node{}, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return true because the annotation was removed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: &corev1.Node{}})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{Object: node})).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("#NodeReadyForUpdatePredicate", func() {
 		var (
 			p    predicate.Predicate
@@ -5,6 +5,7 @@
 package operatingsystemconfig
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
@@ -13,6 +14,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 )
 
 type operatingSystemConfigChanges struct {
@@ -298,3 +300,42 @@ func loadOSCChanges(fs afero.Afero) (*operatingSystemConfigChanges, error) {
 	changes.fs = fs
 	return &changes, nil
 }
+
+// PendingChanges returns the changes of the operating system config revision with the given checksum, i.e., the revision
+// which was reconciled last, which are not yet applied to the node. The changes are only computed when a revision is
+// reconciled and are removed when it is rolled back, hence, they are reported as not computed if the last computed
+// changes belong to another revision. If the checksum is empty, the last computed changes are returned.
+func PendingChanges(fs afero.Afero, checksum string) (*status.Changes, error) {
+	oscChanges, err := loadOSCChanges(fs)
+	if err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return nil, err
+	}
+
+	if oscChanges == nil || (checksum != "" && oscChanges.OperatingSystemConfigChecksum != checksum) {
+		return &status.Changes{OperatingSystemConfigChecksum: checksum}, nil
+	}
+
+	changes := &status.Changes{
+		OperatingSystemConfigChecksum: oscChanges.OperatingSystemConfigChecksum,
+		Computed:                      true,
+		MustRestartNodeAgent:          oscChanges.MustRestartNodeAgent,
+	}
+
+	for _, file := range oscChanges.Files.Changed {
+		changes.ChangedFiles = append(changes.ChangedFiles, file.Path)
+	}
+	for _, file := range oscChanges.Files.Deleted {
+		changes.DeletedFiles = append(changes.DeletedFiles, file.Path)
+	}
+	for _, unit := range oscChanges.Units.Changed {
+		changes.ChangedUnits = append(changes.ChangedUnits, unit.Name)
+	}
+	for _, unit := range oscChanges.Units.Deleted {
+		changes.DeletedUnits = append(changes.DeletedUnits, unit.Name)
+	}
+	for _, unitCommand := range oscChanges.Units.Commands {
+		changes.UnitCommands = append(changes.UnitCommands, status.UnitCommand{Name: unitCommand.Name, Command: string(unitCommand.Command)})
+	}
+
+	return changes, nil
+}
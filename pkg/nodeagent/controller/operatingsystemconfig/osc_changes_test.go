@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
+)
+
+var _ = Describe("OSC Changes", func() {
+	var fs afero.Afero
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+	})
+
+	Describe("#PendingChanges", func() {
+		It("should report that no changes were computed yet", func() {
+			Expect(PendingChanges(fs, "foo")).To(Equal(&status.Changes{OperatingSystemConfigChecksum: "foo"}))
+		})
+
+		It("should report that no changes were computed if the computed changes belong to another revision", func() {
+			Expect((&operatingSystemConfigChanges{fs: fs, OperatingSystemConfigChecksum: "bar"}).persist()).To(Succeed())
+
+			Expect(PendingChanges(fs, "foo")).To(Equal(&status.Changes{OperatingSystemConfigChecksum: "foo"}))
+		})
+
+		It("should return an error if the changes file cannot be decoded", func() {
+			Expect(fs.WriteFile(lastComputedOperatingSystemConfigChangesFilePath, []byte("{"), 0600)).To(Succeed())
+
+			_, err := PendingChanges(fs, "foo")
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling the changes")))
+		})
+
+		It("should return the pending changes", func() {
+			oscChanges := &operatingSystemConfigChanges{
+				fs:                            fs,
+				OperatingSystemConfigChecksum: "foo",
+				MustRestartNodeAgent:          true,
+			}
+			oscChanges.Files.Changed = []extensionsv1alpha1.File{{Path: "/etc/foo"}}
+			oscChanges.Files.Deleted = []extensionsv1alpha1.File{{Path: "/etc/bar"}}
+			oscChanges.Units.Changed = []changedUnit{{Unit: extensionsv1alpha1.Unit{Name: "foo.service"}}}
+			oscChanges.Units.Deleted = []extensionsv1alpha1.Unit{{Name: "bar.service"}}
+			oscChanges.Units.Commands = []unitCommand{{Name: "foo.service", Command: extensionsv1alpha1.CommandRestart}}
+			Expect(oscChanges.persist()).To(Succeed())
+
+			Expect(PendingChanges(fs, "foo")).To(Equal(&status.Changes{
+				OperatingSystemConfigChecksum: "foo",
+				Computed:                      true,
+				ChangedFiles:                  []string{"/etc/foo"},
+				DeletedFiles:                  []string{"/etc/bar"},
+				ChangedUnits:                  []string{"foo.service"},
+				DeletedUnits:                  []string{"bar.service"},
+				UnitCommands:                  []status.UnitCommand{{Name: "foo.service", Command: "restart"}},
+				MustRestartNodeAgent:          true,
+			}))
+			Expect(PendingChanges(fs, "")).To(HaveField("Computed", BeTrue()))
+		})
+	})
+})
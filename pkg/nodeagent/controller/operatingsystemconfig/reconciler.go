@@ -53,13 +53,14 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
 const (
-	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.BaseDir + "/last-applied-osc.yaml"
+	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath
 	lastComputedOperatingSystemConfigChangesFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-computed-osc-changes.yaml"
 	annotationUpdatingOperatingSystemVersion         = "node-agent.gardener.cloud/updating-operating-system-version"
 	pathKubeletCPUManagerPolicyState                 = kubeletcomponent.PathKubeletDirectory + "/cpu_manager_state"
@@ -104,16 +105,26 @@ type Reconciler struct {
 	NodeName      string
 	MachineName   string
 
+	// ContainerdClient is used to verify the health of containerd after an operating system config revision was applied
+	// with rollback enabled. It is created on first use if not set.
+	ContainerdClient healthcheckcontroller.ContainerdClient
+
 	// Channel and TokenSecretSyncConfigs are used by the reconciler to trigger events for the token reconciler during an in-place service-account-key rotation.
 	Channel                chan event.TypedGenericEvent[*corev1.Secret]
 	TokenSecretSyncConfigs []nodeagentconfigv1alpha1.TokenSecretSyncConfig
+
+	// Status is used to report the outcome of the reconciliations to the local status API.
+	Status *status.Registry
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
-func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
 	log := logf.FromContext(ctx)
 
+	var oscChecksum string
+	defer func() { r.Status.SetOperatingSystemConfigReconciled(oscChecksum, err) }()
+
 	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
 	defer cancel()
 
@@ -137,7 +148,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	osc, oscChecksum, err := extractOSCFromSecret(secret)
+	var osc *extensionsv1alpha1.OperatingSystemConfig
+	osc, oscChecksum, err = extractOSCFromSecret(secret)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
 	}
@@ -160,6 +172,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
 		log.Info("Configuration on this node is up to date, nothing to be done")
+		r.Status.SetOperatingSystemConfigApplied(oscChecksum)
+		return reconcile.Result{}, nil
+	}
+
+	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig] == oscChecksum {
+		log.Info("Configuration was rolled back because it left the node unhealthy, waiting for a new revision or for the annotation to be removed", "annotation", nodeagentconfigv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	if node != nil && metav1.HasAnnotation(node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig) {
+		log.Info("Configuration is held back on this node, waiting for the annotation to be removed", "annotation", nodeagentconfigv1alpha1.AnnotationKeyHoldOperatingSystemConfig)
 		return reconcile.Result{}, nil
 	}
 
@@ -187,9 +210,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		)
 	}
 
+	snapshot, err := r.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed taking snapshot of last known good state: %w", err)
+	}
+
 	log.Info("Applying new or changed inline files")
 	if err := r.applyChangedInlineFiles(log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed inline files: %w", err)
+		return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("failed applying changed inline files: %w", err))
 	}
 
 	log.Info("Applying containerd registries")
@@ -200,27 +228,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	log.Info("Applying new or changed imageRef files")
 	if err := r.applyChangedImageRefFiles(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed imageRef files: %w", err)
+		return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("failed applying changed imageRef files: %w", err))
 	}
 
 	log.Info("Applying new or changed units", "changedUnits", len(oscChanges.Units.Changed))
 	if err := r.applyChangedUnits(ctx, log, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
+		return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("failed applying changed units: %w", err))
 	}
 
 	log.Info("Removing no longer needed units", "deletedUnits", len(oscChanges.Units.Deleted))
 	if err := r.removeDeletedUnits(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
+		return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("failed removing deleted units: %w", err))
 	}
 
 	log.Info("Reloading systemd daemon")
 	if err := r.DBus.DaemonReload(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
+		return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("failed reloading systemd daemon: %w", err))
 	}
 
 	log.Info("Executing unit commands (start/stop)", "unitCommands", len(oscChanges.Units.Commands))
 	if err := r.executeUnitCommands(ctx, log, node, oscChanges); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+		return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("failed executing unit commands: %w", err))
 	}
 
 	if isInPlaceKubeletUpdate(oscChanges) {
@@ -242,6 +270,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
 	}
 
+	if snapshot != nil {
+		log.Info("Verifying that containerd and kubelet are healthy")
+		if err := r.verifyNodeHealth(ctx, log); err != nil {
+			return reconcile.Result{}, r.rollBack(ctx, log, node, snapshot, fmt.Errorf("node is unhealthy after applying operating system config: %w", err))
+		}
+
+		log.Info("Removing snapshot of last known good state", "path", lastKnownGoodDir)
+		if err := r.FS.RemoveAll(lastKnownGoodDir); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed removing snapshot %q: %w", lastKnownGoodDir, err)
+		}
+	}
+
 	if err := r.performInPlaceUpdate(ctx, log, osc, oscChanges, node, osVersion); err != nil {
 		// If the error is retriable, we requeue with a delay.
 		if retriableErrorPatternRegex.MatchString(err.Error()) {
@@ -252,6 +292,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	log.Info("Successfully applied operating system config")
+	r.Status.SetOperatingSystemConfigApplied(oscChecksum)
 
 	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
 	oscRaw, err := runtime.Encode(codec, osc)
@@ -289,6 +330,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
+	delete(node.Annotations, nodeagentconfigv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig)
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
 }
@@ -331,12 +373,17 @@ func getFilePermissions(file extensionsv1alpha1.File) os.FileMode {
 }
 
 func (r *Reconciler) applyChangedImageRefFiles(ctx context.Context, log logr.Logger, changes *operatingSystemConfigChanges) error {
+	extractor := r.Extractor
+	if mirrorAwareExtractor, ok := extractor.(registry.MirrorAwareExtractor); ok {
+		extractor = mirrorAwareExtractor.WithRegistries(changes.Containerd.Registries.Desired)
+	}
+
 	for _, file := range slices.Clone(changes.Files.Changed) {
 		if file.Content.ImageRef == nil {
 			continue
 		}
 
-		if err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, getFilePermissions(file)); err != nil {
+		if err := extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, getFilePermissions(file)); err != nil {
 			return fmt.Errorf("unable to copy file %q from image %q to %q: %w", file.Content.ImageRef.FilePathInImage, file.Content.ImageRef.Image, file.Path, err)
 		}
 
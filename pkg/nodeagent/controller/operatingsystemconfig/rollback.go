@@ -0,0 +1,404 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	filespkg "github.com/gardener/gardener/pkg/nodeagent/files"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	lastKnownGoodDir          = nodeagentconfigv1alpha1.BaseDir + "/last-known-good"
+	lastKnownGoodFilesDir     = lastKnownGoodDir + "/files"
+	lastKnownGoodManifestPath = lastKnownGoodDir + "/manifest.yaml"
+
+	defaultRollbackHealthCheckTimeout = time.Minute
+	rollbackRestoreTimeout            = 2 * time.Minute
+)
+
+// RollbackHealthCheckRetryInterval is the interval at which the health of containerd and the kubelet is checked after
+// an operating system config revision was applied. Exposed for testing.
+var RollbackHealthCheckRetryInterval = 5 * time.Second
+
+// lastKnownGoodSnapshot describes the state of the files and units touched by an operating system config revision
+// before it was applied.
+type lastKnownGoodSnapshot struct {
+	// OperatingSystemConfigChecksum is the checksum of the operating system config revision which is applied.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum"`
+	// Files are the files touched by the revision.
+	Files []snapshotFile `json:"files,omitempty"`
+	// Units are the units touched by the revision.
+	Units []snapshotUnit `json:"units,omitempty"`
+}
+
+type snapshotFile struct {
+	// Path is the path of the file on the node.
+	Path string `json:"path"`
+	// BackupPath is the path of the copy of the file. It is empty if the file did not exist before.
+	BackupPath string `json:"backupPath,omitempty"`
+	// Permissions are the permissions of the file.
+	Permissions os.FileMode `json:"permissions,omitempty"`
+}
+
+type snapshotUnit struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// Created is true if the unit file did not exist before and is created by the revision.
+	Created bool `json:"created,omitempty"`
+	// Enabled specifies whether the unit is enabled in the last applied revision. It is nil if the unit is not part of
+	// the last applied revision.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Active is true if the unit was active before.
+	Active bool `json:"active,omitempty"`
+}
+
+func (r *Reconciler) rollbackEnabled() bool {
+	return r.Config.Rollback != nil && ptr.Deref(r.Config.Rollback.Enabled, false)
+}
+
+// takeLastKnownGoodSnapshot snapshots the files and units which are touched by the given changes. It returns the
+// snapshot persisted by a previous reconciliation if the changes were already partially applied. No snapshot is taken
+// if the node is not registered yet, if no operating system config was applied before, or if the changes are applied
+// in-place or do not affect any units.
+func (r *Reconciler) takeLastKnownGoodSnapshot(ctx context.Context, log logr.Logger, node *corev1.Node, changes *operatingSystemConfigChanges) (*lastKnownGoodSnapshot, error) {
+	if !r.rollbackEnabled() || node == nil || isInPlaceUpdate(changes) {
+		return nil, nil
+	}
+
+	snapshot, err := r.loadLastKnownGoodSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	if snapshot != nil && snapshot.OperatingSystemConfigChecksum == changes.OperatingSystemConfigChecksum {
+		log.Info("Found previously taken snapshot of last known good state on disk", "path", lastKnownGoodDir)
+		return snapshot, nil
+	}
+
+	if err := r.FS.RemoveAll(lastKnownGoodDir); err != nil {
+		return nil, fmt.Errorf("failed removing outdated snapshot %q: %w", lastKnownGoodDir, err)
+	}
+
+	if len(changes.Units.Changed) == 0 && len(changes.Units.Deleted) == 0 && len(changes.Units.Commands) == 0 {
+		return nil, nil
+	}
+
+	oldOSCRaw, err := r.FS.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	oldOSC := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oldOSCRaw, oldOSC); err != nil {
+		return nil, fmt.Errorf("unable to decode the old OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+	oldUnits := mergeUnits(oldOSC.Spec.Units, oldOSC.Status.ExtensionUnits)
+
+	snapshot = &lastKnownGoodSnapshot{OperatingSystemConfigChecksum: changes.OperatingSystemConfigChecksum}
+
+	var filePaths []string
+	addFilePath := func(filePath string) {
+		if !slices.Contains(filePaths, filePath) {
+			filePaths = append(filePaths, filePath)
+		}
+	}
+
+	addUnit := func(name string, hasContent bool) error {
+		if slices.ContainsFunc(snapshot.Units, func(unit snapshotUnit) bool { return unit.Name == name }) {
+			return nil
+		}
+
+		unitFilePath := path.Join(etcSystemdSystem, name)
+		unitFileExists, err := r.FS.Exists(unitFilePath)
+		if err != nil {
+			return fmt.Errorf("unable to check whether unit file %q exists: %w", unitFilePath, err)
+		}
+
+		activeState, err := r.DBus.ActiveState(ctx, name)
+		if err != nil {
+			return fmt.Errorf("unable to get active state of unit %q: %w", name, err)
+		}
+
+		unit := snapshotUnit{
+			Name:    name,
+			Created: hasContent && !unitFileExists,
+			Active:  activeState == "active",
+		}
+		if i := slices.IndexFunc(oldUnits, func(oldUnit extensionsv1alpha1.Unit) bool { return oldUnit.Name == name }); i != -1 {
+			unit.Enabled = ptr.To(name == nodeagentconfigv1alpha1.UnitName || ptr.Deref(oldUnits[i].Enable, true))
+		}
+
+		snapshot.Units = append(snapshot.Units, unit)
+		return nil
+	}
+
+	addUnitFiles := func(name string, newDropIns []extensionsv1alpha1.DropIn) error {
+		unitFilePath := path.Join(etcSystemdSystem, name)
+		addFilePath(unitFilePath)
+
+		dropInDirectory := unitFilePath + ".d"
+		if exists, err := r.FS.DirExists(dropInDirectory); err != nil {
+			return fmt.Errorf("unable to check whether drop-in directory %q exists: %w", dropInDirectory, err)
+		} else if exists {
+			dropInFiles, err := r.FS.ReadDir(dropInDirectory)
+			if err != nil {
+				return fmt.Errorf("unable to read drop-in directory %q: %w", dropInDirectory, err)
+			}
+			for _, dropInFile := range dropInFiles {
+				if !dropInFile.IsDir() {
+					addFilePath(path.Join(dropInDirectory, dropInFile.Name()))
+				}
+			}
+		}
+
+		for _, dropIn := range newDropIns {
+			addFilePath(path.Join(dropInDirectory, dropIn.Name))
+		}
+		return nil
+	}
+
+	for _, file := range append(slices.Clone(changes.Files.Changed), changes.Files.Deleted...) {
+		addFilePath(file.Path)
+	}
+
+	for _, unit := range changes.Units.Changed {
+		if err := addUnit(unit.Name, unit.Content != nil); err != nil {
+			return nil, err
+		}
+		if err := addUnitFiles(unit.Name, unit.DropInsChanges.Changed); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, unit := range changes.Units.Deleted {
+		if err := addUnit(unit.Name, false); err != nil {
+			return nil, err
+		}
+		if err := addUnitFiles(unit.Name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, unit := range changes.Units.Commands {
+		if err := addUnit(unit.Name, false); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.FS.MkdirAll(lastKnownGoodFilesDir, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create directory %q: %w", lastKnownGoodFilesDir, err)
+	}
+
+	for i, filePath := range filePaths {
+		fileInfo, err := r.FS.Stat(filePath)
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				snapshot.Files = append(snapshot.Files, snapshotFile{Path: filePath})
+				continue
+			}
+			return nil, fmt.Errorf("unable to check file %q: %w", filePath, err)
+		}
+
+		if !fileInfo.Mode().IsRegular() {
+			continue
+		}
+
+		backupPath := path.Join(lastKnownGoodFilesDir, strconv.Itoa(i))
+		if err := filespkg.Copy(r.FS, filePath, backupPath, fileInfo.Mode().Perm()); err != nil {
+			return nil, fmt.Errorf("unable to copy file %q to %q: %w", filePath, backupPath, err)
+		}
+
+		snapshot.Files = append(snapshot.Files, snapshotFile{Path: filePath, BackupPath: backupPath, Permissions: fileInfo.Mode().Perm()})
+	}
+
+	snapshotRaw, err := yaml.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal snapshot: %w", err)
+	}
+
+	if err := r.FS.WriteFile(lastKnownGoodManifestPath, snapshotRaw, 0600); err != nil {
+		return nil, fmt.Errorf("unable to write snapshot to file path %q: %w", lastKnownGoodManifestPath, err)
+	}
+
+	log.Info("Took snapshot of last known good state", "path", lastKnownGoodDir, "files", len(snapshot.Files), "units", len(snapshot.Units))
+	return snapshot, nil
+}
+
+func (r *Reconciler) loadLastKnownGoodSnapshot() (*lastKnownGoodSnapshot, error) {
+	snapshotRaw, err := r.FS.ReadFile(lastKnownGoodManifestPath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading snapshot from file path %s: %w", lastKnownGoodManifestPath, err)
+	}
+
+	snapshot := &lastKnownGoodSnapshot{}
+	if err := yaml.Unmarshal(snapshotRaw, snapshot); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal snapshot read from file path %s: %w", lastKnownGoodManifestPath, err)
+	}
+
+	return snapshot, nil
+}
+
+// verifyNodeHealth waits until containerd and the kubelet are healthy after an operating system config revision was
+// applied. It uses the same probes as the built-in health checks of the health check controller.
+func (r *Reconciler) verifyNodeHealth(ctx context.Context, log logr.Logger) error {
+	timeout := defaultRollbackHealthCheckTimeout
+	if r.Config.Rollback.HealthCheckTimeout != nil {
+		timeout = r.Config.Rollback.HealthCheckTimeout.Duration
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	return retryutils.UntilTimeout(ctx, RollbackHealthCheckRetryInterval, timeout, func(ctx context.Context) (done bool, err error) {
+		// The client is created lazily since containerd might not be running when the controller is started.
+		if r.ContainerdClient == nil {
+			containerdClient, err := healthcheckcontroller.NewContainerdClient()
+			if err != nil {
+				return retryutils.MinorError(err)
+			}
+			r.ContainerdClient = containerdClient
+		}
+
+		if err := healthcheckcontroller.ProbeContainerd(ctx, r.ContainerdClient); err != nil {
+			return retryutils.MinorError(err)
+		}
+
+		if err := healthcheckcontroller.ProbeKubelet(ctx, httpClient, healthcheckcontroller.DefaultKubeletHealthEndpoint); err != nil {
+			return retryutils.MinorError(err)
+		}
+
+		log.Info("Containerd and kubelet are healthy")
+		return retryutils.Ok()
+	})
+}
+
+// rollBack restores the last known good state captured in the given snapshot, marks the operating system config
+// revision as failed on the node and returns the given error. It only returns the given error if no snapshot was taken.
+func (r *Reconciler) rollBack(ctx context.Context, log logr.Logger, node *corev1.Node, snapshot *lastKnownGoodSnapshot, err error) error {
+	if snapshot == nil {
+		return err
+	}
+
+	checksum := snapshot.OperatingSystemConfigChecksum
+	log.Error(err, "Failed applying operating system config, rolling back to last known good state", "checksum", checksum)
+
+	// The reconciliation context might already be exceeded, e.g., when waiting for the node to become healthy timed out.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackRestoreTimeout)
+	defer cancel()
+
+	if restoreErr := r.restoreLastKnownGoodSnapshot(ctx, log, node, snapshot); restoreErr != nil {
+		return fmt.Errorf("failed rolling back to last known good state: %w (rollback was triggered by: %w)", restoreErr, err)
+	}
+
+	// The computed changes are only partially applied and must be computed again against the last applied operating
+	// system config once the revision is retried.
+	if removeErr := r.FS.Remove(lastComputedOperatingSystemConfigChangesFilePath); removeErr != nil && !errors.Is(removeErr, afero.ErrFileNotFound) {
+		return fmt.Errorf("failed removing computed changes file %q: %w", lastComputedOperatingSystemConfigChangesFilePath, removeErr)
+	}
+	if removeErr := r.FS.RemoveAll(lastKnownGoodDir); removeErr != nil {
+		return fmt.Errorf("failed removing snapshot %q: %w", lastKnownGoodDir, removeErr)
+	}
+
+	r.Recorder.Eventf(node, corev1.EventTypeWarning, "OSCRolledBack", "Operating system config with checksum %s was rolled back to the last known good state: %s", checksum, err.Error())
+
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumFailedOperatingSystemConfig, checksum)
+	if patchErr := r.Client.Patch(ctx, node, patch); patchErr != nil {
+		return fmt.Errorf("failed marking operating system config with checksum %s as failed: %w", checksum, patchErr)
+	}
+
+	return fmt.Errorf("rolled back operating system config with checksum %s to last known good state: %w", checksum, err)
+}
+
+// restoreLastKnownGoodSnapshot restores the files and units captured in the given snapshot. It continues on errors to
+// restore as much as possible.
+func (r *Reconciler) restoreLastKnownGoodSnapshot(ctx context.Context, log logr.Logger, node *corev1.Node, snapshot *lastKnownGoodSnapshot) error {
+	var errs []error
+
+	for _, unit := range snapshot.Units {
+		if !unit.Created {
+			continue
+		}
+
+		if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
+			errs = append(errs, fmt.Errorf("unable to stop unit %q: %w", unit.Name, err))
+		}
+		if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+			errs = append(errs, fmt.Errorf("unable to disable unit %q: %w", unit.Name, err))
+		}
+	}
+
+	for _, file := range snapshot.Files {
+		if file.BackupPath == "" {
+			if err := r.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				errs = append(errs, fmt.Errorf("unable to remove file %q: %w", file.Path, err))
+			}
+			continue
+		}
+
+		if err := filespkg.Copy(r.FS, file.BackupPath, file.Path, file.Permissions); err != nil {
+			errs = append(errs, fmt.Errorf("unable to restore file %q: %w", file.Path, err))
+		}
+	}
+	log.Info("Restored files", "files", len(snapshot.Files))
+
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed reloading systemd daemon: %w", err))
+	}
+
+	for _, unit := range snapshot.Units {
+		// gardener-node-agent must not restart itself while it is still rolling back.
+		if unit.Created || unit.Name == nodeagentconfigv1alpha1.UnitName {
+			continue
+		}
+
+		if unit.Enabled != nil {
+			if *unit.Enabled {
+				if err := r.DBus.Enable(ctx, unit.Name); err != nil {
+					errs = append(errs, fmt.Errorf("unable to enable unit %q: %w", unit.Name, err))
+				}
+			} else if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+				errs = append(errs, fmt.Errorf("unable to disable unit %q: %w", unit.Name, err))
+			}
+		}
+
+		if unit.Active {
+			if err := r.DBus.Restart(ctx, r.Recorder, node, unit.Name); err != nil {
+				errs = append(errs, fmt.Errorf("unable to restart unit %q: %w", unit.Name, err))
+			}
+		} else if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
+			errs = append(errs, fmt.Errorf("unable to stop unit %q: %w", unit.Name, err))
+		}
+	}
+	log.Info("Restored units", "units", len(snapshot.Units))
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	containerdclient "github.com/containerd/containerd"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	healthcheckcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Rollback", func() {
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		c          client.Client
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+		node       *corev1.Node
+		oscChanges *operatingSystemConfigChanges
+
+		fooUnitFilePath   = "/etc/systemd/system/foo.service"
+		fooDropInDir      = fooUnitFilePath + ".d"
+		barUnitFilePath   = "/etc/systemd/system/bar.service"
+		expectFileContent = func(path, content string) {
+			GinkgoHelper()
+			Expect(fs.ReadFile(path)).To(BeEquivalentTo(content))
+		}
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+		recorder = record.NewFakeRecorder(10)
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test-node"}}
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(node).Build()
+
+		reconciler = &Reconciler{
+			Client:   c,
+			FS:       fs,
+			DBus:     fakeDBus,
+			Recorder: recorder,
+			Config: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{
+				Rollback: &nodeagentconfigv1alpha1.OperatingSystemConfigRollback{
+					Enabled:            ptr.To(true),
+					HealthCheckTimeout: &metav1.Duration{Duration: 50 * time.Millisecond},
+				},
+			},
+		}
+
+		lastAppliedOSC := &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Units: []extensionsv1alpha1.Unit{{Name: "foo.service", Content: ptr.To("old-foo-unit")}},
+				Files: []extensionsv1alpha1.File{{Path: "/etc/foo"}},
+			},
+		}
+		lastAppliedOSCRaw, err := runtime.Encode(codec, lastAppliedOSC)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.WriteFile(lastAppliedOperatingSystemConfigFilePath, lastAppliedOSCRaw, 0600)).To(Succeed())
+
+		Expect(fs.WriteFile("/etc/foo", []byte("old-foo"), 0644)).To(Succeed())
+		Expect(fs.WriteFile(fooUnitFilePath, []byte("old-foo-unit"), 0600)).To(Succeed())
+		Expect(fs.WriteFile(fooDropInDir+"/old.conf", []byte("old-drop-in"), 0600)).To(Succeed())
+		fakeDBus.SetActiveState("foo.service", "active")
+
+		oscChanges = &operatingSystemConfigChanges{
+			fs:                            fs,
+			OperatingSystemConfigChecksum: "new-checksum",
+			Files: files{
+				Changed: []extensionsv1alpha1.File{{Path: "/etc/foo"}, {Path: "/etc/bar"}},
+			},
+			Units: units{
+				Changed: []changedUnit{
+					{
+						Unit:           extensionsv1alpha1.Unit{Name: "foo.service", Content: ptr.To("new-foo-unit")},
+						DropInsChanges: dropIns{Changed: []extensionsv1alpha1.DropIn{{Name: "new.conf"}}},
+					},
+					{Unit: extensionsv1alpha1.Unit{Name: "bar.service", Content: ptr.To("bar-unit")}},
+				},
+				Commands: []unitCommand{
+					{Name: "foo.service", Command: extensionsv1alpha1.CommandRestart},
+					{Name: "bar.service", Command: extensionsv1alpha1.CommandRestart},
+				},
+			},
+		}
+	})
+
+	Describe("#takeLastKnownGoodSnapshot", func() {
+		It("should not take a snapshot if the rollback is disabled", func() {
+			reconciler.Config.Rollback.Enabled = ptr.To(false)
+
+			Expect(reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)).To(BeNil())
+			Expect(fs.DirExists(lastKnownGoodDir)).To(BeFalse())
+		})
+
+		It("should not take a snapshot if the node is not registered yet", func() {
+			Expect(reconciler.takeLastKnownGoodSnapshot(ctx, log, nil, oscChanges)).To(BeNil())
+			Expect(fs.DirExists(lastKnownGoodDir)).To(BeFalse())
+		})
+
+		It("should not take a snapshot if no operating system config was applied before", func() {
+			Expect(fs.Remove(lastAppliedOperatingSystemConfigFilePath)).To(Succeed())
+
+			Expect(reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)).To(BeNil())
+			Expect(fs.DirExists(lastKnownGoodDir)).To(BeFalse())
+		})
+
+		It("should not take a snapshot if no units are affected", func() {
+			oscChanges.Units = units{}
+
+			Expect(reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)).To(BeNil())
+			Expect(fs.DirExists(lastKnownGoodDir)).To(BeFalse())
+		})
+
+		It("should snapshot the affected files and units", func() {
+			snapshot, err := reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(snapshot.OperatingSystemConfigChecksum).To(Equal("new-checksum"))
+			Expect(snapshot.Files).To(ConsistOf(
+				snapshotFile{Path: "/etc/foo", BackupPath: lastKnownGoodFilesDir + "/0", Permissions: 0644},
+				snapshotFile{Path: "/etc/bar"},
+				snapshotFile{Path: fooUnitFilePath, BackupPath: lastKnownGoodFilesDir + "/2", Permissions: 0600},
+				snapshotFile{Path: fooDropInDir + "/old.conf", BackupPath: lastKnownGoodFilesDir + "/3", Permissions: 0600},
+				snapshotFile{Path: fooDropInDir + "/new.conf"},
+				snapshotFile{Path: barUnitFilePath},
+			))
+			Expect(snapshot.Units).To(ConsistOf(
+				snapshotUnit{Name: "foo.service", Enabled: ptr.To(true), Active: true},
+				snapshotUnit{Name: "bar.service", Created: true},
+			))
+
+			expectFileContent(lastKnownGoodFilesDir+"/0", "old-foo")
+			expectFileContent(lastKnownGoodFilesDir+"/2", "old-foo-unit")
+			expectFileContent(lastKnownGoodFilesDir+"/3", "old-drop-in")
+		})
+
+		It("should reuse the snapshot of a partially applied revision", func() {
+			snapshot, err := reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fs.WriteFile("/etc/foo", []byte("new-foo"), 0644)).To(Succeed())
+			oscChanges.Units = units{}
+
+			Expect(reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)).To(Equal(snapshot))
+			expectFileContent(lastKnownGoodFilesDir+"/0", "old-foo")
+		})
+
+		It("should replace the snapshot of another revision", func() {
+			_, err := reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)
+			Expect(err).NotTo(HaveOccurred())
+
+			oscChanges.OperatingSystemConfigChecksum = "newer-checksum"
+			oscChanges.Units = units{}
+
+			Expect(reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)).To(BeNil())
+			Expect(fs.DirExists(lastKnownGoodDir)).To(BeFalse())
+		})
+	})
+
+	Describe("#verifyNodeHealth", func() {
+		var (
+			statusCode       int
+			containerdClient *fakeContainerdClient
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			containerdClient = &fakeContainerdClient{}
+			reconciler.ContainerdClient = containerdClient
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+			DeferCleanup(server.Close)
+
+			DeferCleanup(test.WithVars(
+				&healthcheckcontroller.DefaultKubeletHealthEndpoint, server.URL,
+				&RollbackHealthCheckRetryInterval, time.Millisecond,
+			))
+
+		})
+
+		It("should succeed if containerd and the kubelet are healthy", func() {
+			Expect(reconciler.verifyNodeHealth(ctx, log)).To(Succeed())
+		})
+
+		It("should fail if containerd is not healthy", func() {
+			containerdClient.err = errors.New("fake")
+
+			Expect(reconciler.verifyNodeHealth(ctx, log)).To(MatchError(ContainSubstring("unable to get containerd version: fake")))
+		})
+
+		It("should fail if the kubelet is not healthy", func() {
+			statusCode = http.StatusInternalServerError
+
+			Expect(reconciler.verifyNodeHealth(ctx, log)).To(MatchError(ContainSubstring("kubelet health endpoint returned status code 500")))
+		})
+	})
+
+	Describe("#rollBack", func() {
+		It("should return the error if no snapshot was taken", func() {
+			err := errors.New("fake")
+
+			Expect(reconciler.rollBack(ctx, log, node, nil, err)).To(BeIdenticalTo(err))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should restore the last known good state and mark the revision as failed", func() {
+			snapshot, err := reconciler.takeLastKnownGoodSnapshot(ctx, log, node, oscChanges)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fs.WriteFile("/etc/foo", []byte("new-foo"), 0644)).To(Succeed())
+			Expect(fs.WriteFile("/etc/bar", []byte("bar"), 0644)).To(Succeed())
+			Expect(fs.WriteFile(fooUnitFilePath, []byte("new-foo-unit"), 0600)).To(Succeed())
+			Expect(fs.WriteFile(fooDropInDir+"/new.conf", []byte("new-drop-in"), 0600)).To(Succeed())
+			Expect(fs.WriteFile(barUnitFilePath, []byte("bar-unit"), 0600)).To(Succeed())
+			Expect(fs.WriteFile(lastComputedOperatingSystemConfigChangesFilePath, []byte("changes"), 0600)).To(Succeed())
+
+			Expect(reconciler.rollBack(ctx, log, node, snapshot, errors.New("fake"))).To(MatchError("rolled back operating system config with checksum new-checksum to last known good state: fake"))
+
+			expectFileContent("/etc/foo", "old-foo")
+			expectFileContent(fooUnitFilePath, "old-foo-unit")
+			expectFileContent(fooDropInDir+"/old.conf", "old-drop-in")
+			Expect(fs.Exists("/etc/bar")).To(BeFalse())
+			Expect(fs.Exists(fooDropInDir + "/new.conf")).To(BeFalse())
+			Expect(fs.Exists(barUnitFilePath)).To(BeFalse())
+			Expect(fs.Exists(lastComputedOperatingSystemConfigChangesFilePath)).To(BeFalse())
+			Expect(fs.DirExists(lastKnownGoodDir)).To(BeFalse())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionStop, UnitNames: []string{"bar.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"bar.service"}},
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionEnable, UnitNames: []string{"foo.service"}},
+				{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}},
+			}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Annotations).To(HaveKeyWithValue("node-agent.gardener.cloud/failed-operating-system-config-checksum", "new-checksum"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Operating system config with checksum new-checksum was rolled back to the last known good state: fake")))
+		})
+
+		It("should not restart gardener-node-agent", func() {
+			snapshot := &lastKnownGoodSnapshot{
+				OperatingSystemConfigChecksum: "new-checksum",
+				Units:                         []snapshotUnit{{Name: nodeagentconfigv1alpha1.UnitName, Enabled: ptr.To(true), Active: true}},
+			}
+
+			Expect(reconciler.rollBack(ctx, log, node, snapshot, errors.New("fake"))).To(HaveOccurred())
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{{Action: fakedbus.ActionDaemonReload}}))
+		})
+	})
+})
+
+type fakeContainerdClient struct {
+	err error
+}
+
+func (f *fakeContainerdClient) Version(context.Context) (containerdclient.Version, error) {
+	return containerdclient.Version{}, f.err
+}
@@ -0,0 +1,501 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+const (
+	// NodeConditionTypeReboot is the type of the node condition which reports the progress of a coordinated reboot.
+	NodeConditionTypeReboot corev1.NodeConditionType = "Reboot"
+
+	// ReasonRebootPending is the reason of the reboot condition while the node waits for the maintenance time window or
+	// for a free reboot slot in its worker pool.
+	ReasonRebootPending = "RebootPending"
+	// ReasonDraining is the reason of the reboot condition while the node is cordoned and drained.
+	ReasonDraining = "Draining"
+	// ReasonRebooting is the reason of the reboot condition after the reboot was triggered.
+	ReasonRebooting = "Rebooting"
+	// ReasonRebootCompleted is the reason of the reboot condition after the node was rebooted and uncordoned again.
+	ReasonRebootCompleted = "RebootCompleted"
+	// ReasonRebootCanceled is the reason of the reboot condition if the reboot request was withdrawn before the reboot
+	// was triggered.
+	ReasonRebootCanceled = "RebootCanceled"
+
+	// AnnotationCordonedForReboot is the annotation which is put on nodes cordoned by this controller. It is used to
+	// only uncordon nodes which were not already unschedulable before the reboot.
+	AnnotationCordonedForReboot = "node-agent.gardener.cloud/cordoned-for-reboot"
+
+	// ProcStatFilePath is the path of the file which contains the boot time of the system.
+	ProcStatFilePath = "/proc/stat"
+
+	rebootLeaseDuration = 30 * time.Minute
+	rebootRetryPeriod   = 5 * time.Minute
+	drainRequeuePeriod  = 10 * time.Second
+)
+
+// Reconciler coordinates reboots of the nodes of a worker pool. Nodes request a reboot by creating the
+// /var/run/reboot-required file. Before the node is cordoned, drained and rebooted, it has to acquire one of the reboot
+// slots of its worker pool. The slots are represented by leases in the kube-system namespace, hence at most
+// MaxUnavailable nodes of a worker pool reboot at the same time.
+type Reconciler struct {
+	Client    client.Client
+	APIReader client.Reader
+	Recorder  record.EventRecorder
+	DBus      dbus.DBus
+	FS        afero.Afero
+	Clock     clock.Clock
+	Config    nodeagentconfigv1alpha1.RebootControllerConfig
+	Namespace string
+}
+
+// Reconcile coordinates the reboot of the node if it was requested.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	var (
+		result    = reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}
+		condition = getNodeCondition(node, NodeConditionTypeReboot)
+	)
+
+	if condition != nil && condition.Reason == ReasonRebooting {
+		rebooted, err := r.bootedAfter(condition.LastTransitionTime.Time)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !rebooted {
+			// The reboot slot has to be held until the node was rebooted, otherwise another node of the worker pool could
+			// acquire it after the lease expired and more than MaxUnavailable nodes would be unavailable at the same time.
+			if workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]; workerPoolName != "" {
+				if _, err := r.acquireRebootSlot(ctx, log, workerPoolName, node.Name); err != nil {
+					return reconcile.Result{}, fmt.Errorf("failed renewing reboot slot: %w", err)
+				}
+			}
+
+			if r.Clock.Since(condition.LastTransitionTime.Time) < rebootRetryPeriod {
+				log.Info("Waiting for node to reboot")
+				return result, nil
+			}
+
+			log.Info("Node was not rebooted in time, triggering reboot again")
+			if err := r.DBus.Reboot(); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed rebooting node: %w", err)
+			}
+			return result, nil
+		}
+
+		log.Info("Node was rebooted, completing reboot")
+		return result, r.completeReboot(ctx, node, ReasonRebootCompleted, "Node was rebooted successfully")
+	}
+
+	rebootRequired, err := r.FS.Exists(nodeagentconfigv1alpha1.RebootRequiredFilePath)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether file %s exists: %w", nodeagentconfigv1alpha1.RebootRequiredFilePath, err)
+	}
+
+	if !rebootRequired {
+		if condition != nil && condition.Status == corev1.ConditionTrue {
+			log.Info("Reboot is no longer required, canceling reboot")
+			return result, r.completeReboot(ctx, node, ReasonRebootCanceled, "Reboot is no longer required")
+		}
+		return result, nil
+	}
+
+	workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]
+	if workerPoolName == "" {
+		log.Info("Reboot is required but node has no worker pool label, cannot coordinate reboot", "label", v1beta1constants.LabelWorkerPool)
+		return result, nil
+	}
+	log = log.WithValues("workerPool", workerPoolName)
+
+	draining := condition != nil && condition.Reason == ReasonDraining
+
+	// Once the node is draining, the reboot is continued even if the maintenance time window has ended in the meantime.
+	if !draining {
+		if inWindow, err := r.inMaintenanceTimeWindow(); err != nil {
+			return reconcile.Result{}, err
+		} else if !inWindow {
+			log.Info("Reboot is required, waiting for maintenance time window")
+			return result, r.patchNodeCondition(ctx, node, corev1.ConditionTrue, ReasonRebootPending, "Reboot is required, waiting for maintenance time window")
+		}
+	}
+
+	leaseName, err := r.acquireRebootSlot(ctx, log, workerPoolName, node.Name)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed acquiring reboot slot: %w", err)
+	}
+	if leaseName == "" {
+		log.Info("Reboot is required, waiting for free reboot slot", "maxUnavailable", *r.Config.MaxUnavailable)
+		return result, r.patchNodeCondition(ctx, node, corev1.ConditionTrue, ReasonRebootPending, fmt.Sprintf("Reboot is required, waiting for free reboot slot in worker pool %q", workerPoolName))
+	}
+
+	if !draining {
+		log.Info("Acquired reboot slot, cordoning and draining node", "lease", leaseName)
+		r.Recorder.Eventf(node, corev1.EventTypeNormal, ReasonDraining, "Acquired reboot slot %s, cordoning and draining node", leaseName)
+
+		if err := r.cordon(ctx, node); err != nil {
+			return reconcile.Result{}, err
+		}
+		if err := r.patchNodeCondition(ctx, node, corev1.ConditionTrue, ReasonDraining, "Node is cordoned and drained before reboot"); err != nil {
+			return reconcile.Result{}, err
+		}
+		condition = getNodeCondition(node, NodeConditionTypeReboot)
+	}
+
+	remainingPods, err := r.drain(ctx, log, node.Name)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed draining node: %w", err)
+	}
+
+	if remainingPods > 0 {
+		if drainingFor := r.Clock.Since(condition.LastTransitionTime.Time); drainingFor < r.Config.DrainTimeout.Duration {
+			log.Info("Waiting for pods to be evicted", "remainingPods", remainingPods)
+			return reconcile.Result{RequeueAfter: drainRequeuePeriod}, nil
+		}
+
+		log.Info("Drain timeout expired, rebooting node anyway", "remainingPods", remainingPods, "drainTimeout", r.Config.DrainTimeout.Duration)
+		r.Recorder.Eventf(node, corev1.EventTypeWarning, ReasonDraining, "Drain timeout of %s expired with %d pods remaining, rebooting node anyway", r.Config.DrainTimeout.Duration, remainingPods)
+	}
+
+	if err := r.patchNodeCondition(ctx, node, corev1.ConditionTrue, ReasonRebooting, "Node is rebooting"); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	log.Info("Rebooting node")
+	r.Recorder.Event(node, corev1.EventTypeNormal, ReasonRebooting, "Rebooting node")
+	if err := r.DBus.Reboot(); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed rebooting node: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *Reconciler) inMaintenanceTimeWindow() (bool, error) {
+	if r.Config.MaintenanceTimeWindow == nil {
+		return true, nil
+	}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(r.Config.MaintenanceTimeWindow.Begin, r.Config.MaintenanceTimeWindow.End)
+	if err != nil {
+		return false, fmt.Errorf("failed parsing maintenance time window: %w", err)
+	}
+
+	return maintenanceTimeWindow.Contains(r.Clock.Now()), nil
+}
+
+// acquireRebootSlot returns the name of the reboot slot lease held by this node. If the node does not hold a slot yet,
+// it tries to acquire a free one. An empty name is returned if all slots are occupied by other nodes.
+func (r *Reconciler) acquireRebootSlot(ctx context.Context, log logr.Logger, workerPoolName, nodeName string) (string, error) {
+	var (
+		maxUnavailable = int(*r.Config.MaxUnavailable)
+		leases         = make([]*coordinationv1.Lease, maxUnavailable)
+	)
+
+	for slot := range maxUnavailable {
+		lease := &coordinationv1.Lease{}
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Name: gardenerutils.NodeRebootLeaseName(workerPoolName, slot), Namespace: r.Namespace}, lease); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("failed reading reboot slot lease %s: %w", gardenerutils.NodeRebootLeaseName(workerPoolName, slot), err)
+			}
+			continue
+		}
+
+		if ptr.Deref(lease.Spec.HolderIdentity, "") == nodeName {
+			return lease.Name, r.renewRebootSlot(ctx, lease)
+		}
+		leases[slot] = lease
+	}
+
+	for slot, lease := range leases {
+		if lease == nil {
+			lease = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: gardenerutils.NodeRebootLeaseName(workerPoolName, slot), Namespace: r.Namespace}}
+			r.setHolder(lease, nodeName)
+
+			if err := r.Client.Create(ctx, lease); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					log.V(1).Info("Reboot slot was acquired concurrently by another node", "lease", lease.Name)
+					continue
+				}
+				return "", fmt.Errorf("failed creating reboot slot lease %s: %w", lease.Name, err)
+			}
+			return lease.Name, nil
+		}
+
+		if !r.isFree(lease) {
+			continue
+		}
+
+		// The update fails with a conflict if another node acquired the slot in the meantime.
+		r.setHolder(lease, nodeName)
+		if err := r.Client.Update(ctx, lease); err != nil {
+			if apierrors.IsConflict(err) {
+				log.V(1).Info("Reboot slot was acquired concurrently by another node", "lease", lease.Name)
+				continue
+			}
+			return "", fmt.Errorf("failed updating reboot slot lease %s: %w", lease.Name, err)
+		}
+		return lease.Name, nil
+	}
+
+	return "", nil
+}
+
+func (r *Reconciler) isFree(lease *coordinationv1.Lease) bool {
+	if ptr.Deref(lease.Spec.HolderIdentity, "") == "" || lease.Spec.RenewTime == nil {
+		return true
+	}
+
+	leaseDuration := time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second
+	return r.Clock.Now().After(lease.Spec.RenewTime.Add(leaseDuration))
+}
+
+func (r *Reconciler) setHolder(lease *coordinationv1.Lease, nodeName string) {
+	now := metav1.NewMicroTime(r.Clock.Now().UTC())
+
+	lease.Spec = coordinationv1.LeaseSpec{
+		HolderIdentity:       &nodeName,
+		LeaseDurationSeconds: ptr.To(int32(rebootLeaseDuration / time.Second)),
+		AcquireTime:          &now,
+		RenewTime:            &now,
+	}
+}
+
+func (r *Reconciler) renewRebootSlot(ctx context.Context, lease *coordinationv1.Lease) error {
+	lease.Spec.RenewTime = &metav1.MicroTime{Time: r.Clock.Now().UTC()}
+	if err := r.Client.Update(ctx, lease); err != nil {
+		return fmt.Errorf("failed renewing reboot slot lease %s: %w", lease.Name, err)
+	}
+	return nil
+}
+
+// releaseRebootSlots releases all reboot slots held by this node. The leases are not deleted but their holder identity
+// is cleared, so that other nodes can acquire them.
+func (r *Reconciler) releaseRebootSlots(ctx context.Context, workerPoolName, nodeName string) error {
+	for slot := range int(*r.Config.MaxUnavailable) {
+		lease := &coordinationv1.Lease{}
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Name: gardenerutils.NodeRebootLeaseName(workerPoolName, slot), Namespace: r.Namespace}, lease); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed reading reboot slot lease %s: %w", gardenerutils.NodeRebootLeaseName(workerPoolName, slot), err)
+		}
+
+		if ptr.Deref(lease.Spec.HolderIdentity, "") != nodeName {
+			continue
+		}
+
+		lease.Spec.HolderIdentity = nil
+		lease.Spec.AcquireTime = nil
+		lease.Spec.RenewTime = nil
+		if err := r.Client.Update(ctx, lease); err != nil {
+			return fmt.Errorf("failed releasing reboot slot lease %s: %w", lease.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) cordon(ctx context.Context, node *corev1.Node) error {
+	if node.Spec.Unschedulable {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = true
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationCordonedForReboot, "true")
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed cordoning node: %w", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) uncordon(ctx context.Context, node *corev1.Node) error {
+	if _, ok := node.Annotations[AnnotationCordonedForReboot]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = false
+	delete(node.Annotations, AnnotationCordonedForReboot)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed uncordoning node: %w", err)
+	}
+	return nil
+}
+
+// drain evicts all pods from the node which are not managed by a DaemonSet and returns the number of pods which are
+// still running on the node. Evictions are subject to PodDisruptionBudgets, hence pods which cannot be evicted yet are
+// retried in the next reconciliation.
+func (r *Reconciler) drain(ctx context.Context, log logr.Logger, nodeName string) (int, error) {
+	podList := &corev1.PodList{}
+	if err := r.Client.List(ctx, podList, client.MatchingFields{indexer.PodNodeName: nodeName}); err != nil {
+		return 0, fmt.Errorf("failed listing pods for node %s: %w", nodeName, err)
+	}
+
+	var remainingPods int
+
+	for _, pod := range podList.Items {
+		if !mustBeEvicted(&pod) {
+			continue
+		}
+
+		remainingPods++
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := r.Client.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}); err != nil {
+			switch {
+			case apierrors.IsNotFound(err):
+				remainingPods--
+			case apierrors.IsTooManyRequests(err):
+				log.Info("Pod cannot be evicted yet due to its disruption budget", "pod", client.ObjectKeyFromObject(&pod))
+			default:
+				return 0, fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+			}
+			continue
+		}
+
+		log.Info("Evicted pod", "pod", client.ObjectKeyFromObject(&pod))
+	}
+
+	return remainingPods, nil
+}
+
+func mustBeEvicted(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+
+	if ownerRef := metav1.GetControllerOf(pod); ownerRef != nil && ownerRef.Kind == "DaemonSet" {
+		return false
+	}
+
+	return true
+}
+
+func (r *Reconciler) completeReboot(ctx context.Context, node *corev1.Node, reason, message string) error {
+	if err := r.uncordon(ctx, node); err != nil {
+		return err
+	}
+
+	if workerPoolName := node.Labels[v1beta1constants.LabelWorkerPool]; workerPoolName != "" {
+		if err := r.releaseRebootSlots(ctx, workerPoolName, node.Name); err != nil {
+			return err
+		}
+	}
+
+	r.Recorder.Event(node, corev1.EventTypeNormal, reason, message)
+	return r.patchNodeCondition(ctx, node, corev1.ConditionFalse, reason, message)
+}
+
+// bootedAfter returns true if the system was booted after the given time.
+func (r *Reconciler) bootedAfter(t time.Time) (bool, error) {
+	content, err := r.FS.ReadFile(ProcStatFilePath)
+	if err != nil {
+		return false, fmt.Errorf("failed reading file %s: %w", ProcStatFilePath, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), "btime ")
+		if !ok {
+			continue
+		}
+
+		bootTime, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("failed parsing boot time %q: %w", value, err)
+		}
+
+		// The boot time has a precision of seconds only, hence compare with the truncated time.
+		return time.Unix(bootTime, 0).After(t.Truncate(time.Second)), nil
+	}
+
+	return false, errors.New("boot time not found in " + ProcStatFilePath)
+}
+
+func (r *Reconciler) patchNodeCondition(ctx context.Context, node *corev1.Node, status corev1.ConditionStatus, reason, message string) error {
+	var (
+		now   = metav1.NewTime(r.Clock.Now())
+		patch = client.StrategicMergeFrom(node.DeepCopy())
+	)
+
+	condition := getNodeCondition(node, NodeConditionTypeReboot)
+	if condition == nil {
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: NodeConditionTypeReboot})
+		condition = &node.Status.Conditions[len(node.Status.Conditions)-1]
+	}
+
+	if condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return nil
+	}
+
+	// The transition time is also updated when the reason changes since it is used to determine how long the node has
+	// been draining or rebooting.
+	if condition.Status != status || condition.Reason != reason {
+		condition.LastTransitionTime = now
+	}
+	condition.Status = status
+	condition.LastHeartbeatTime = now
+	condition.Reason = reason
+	condition.Message = message
+
+	if err := r.Client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching node condition %s: %w", NodeConditionTypeReboot, err)
+	}
+	return nil
+}
+
+func getNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType) *corev1.NodeCondition {
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == conditionType {
+			return &node.Status.Conditions[i]
+		}
+	}
+	return nil
+}
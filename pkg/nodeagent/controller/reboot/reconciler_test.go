@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/reboot"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		fakeDBus   *fakedbus.DBus
+		fakeFS     afero.Afero
+		fakeClock  *testing.FakeClock
+		recorder   *record.FakeRecorder
+		reconciler *Reconciler
+
+		node    *corev1.Node
+		pod     *corev1.Pod
+		request reconcile.Request
+
+		rebootAction = fakedbus.SystemdAction{Action: fakedbus.ActionReboot, UnitNames: []string{"reboot"}}
+
+		reconcileNode = func() (reconcile.Result, error) {
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			return result, err
+		}
+
+		rebootCondition = func() *corev1.NodeCondition {
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == NodeConditionTypeReboot {
+					return &condition
+				}
+			}
+			return nil
+		}
+
+		getLease = func(name string) *coordinationv1.Lease {
+			lease := &coordinationv1.Lease{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "kube-system"}, lease)).To(Succeed())
+			return lease
+		}
+
+		writeBootTime = func(t time.Time) {
+			Expect(fakeFS.WriteFile(ProcStatFilePath, []byte(fmt.Sprintf("cpu  1 2 3 4\nbtime %d\nprocesses 42\n", t.Unix())), 0644)).To(Succeed())
+		}
+
+		// drainAndReboot reconciles the node twice: the first reconciliation evicts the pods, the second one triggers the
+		// reboot after the pods are gone.
+		drainAndReboot = func() {
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			Expect(rebootCondition().Reason).To(Equal(ReasonDraining))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testing.NewFakeClock(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   "node",
+			Labels: map[string]string{v1beta1constants.LabelWorkerPool: "pool"},
+		}}
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: node.Name},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetesscheme.Scheme).
+			WithObjects(node, pod).
+			WithStatusSubresource(node).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, indexer.PodNodeNameIndexerFunc).
+			Build()
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		recorder = record.NewFakeRecorder(100)
+
+		writeBootTime(fakeClock.Now().Add(-time.Hour))
+
+		reconciler = &Reconciler{
+			Client:    fakeClient,
+			APIReader: fakeClient,
+			Recorder:  recorder,
+			DBus:      fakeDBus,
+			FS:        fakeFS,
+			Clock:     fakeClock,
+			Config: nodeagentconfigv1alpha1.RebootControllerConfig{
+				SyncPeriod:     &metav1.Duration{Duration: time.Minute},
+				MaxUnavailable: ptr.To[int32](1),
+				DrainTimeout:   &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			Namespace: "kube-system",
+		}
+	})
+
+	It("should do nothing if no reboot is required", func() {
+		Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(rebootCondition()).To(BeNil())
+		Expect(node.Spec.Unschedulable).To(BeFalse())
+		Expect(fakeDBus.Actions).To(BeEmpty())
+	})
+
+	Context("reboot is required", func() {
+		BeforeEach(func() {
+			Expect(fakeFS.WriteFile(nodeagentconfigv1alpha1.RebootRequiredFilePath, nil, 0644)).To(Succeed())
+		})
+
+		It("should acquire a reboot slot, drain and reboot the node, and complete the reboot afterwards", func() {
+			drainAndReboot()
+
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+			Expect(node.Annotations).To(HaveKeyWithValue(AnnotationCordonedForReboot, "true"))
+			Expect(rebootCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionTrue),
+				"Reason": Equal(ReasonRebooting),
+			})))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(getLease("gardener-node-reboot-pool-0").Spec.HolderIdentity).To(PointTo(Equal("node")))
+			Expect(fakeDBus.Actions).To(ConsistOf(rebootAction))
+
+			By("Waiting for the reboot")
+			fakeClock.Step(time.Minute)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(rebootCondition().Reason).To(Equal(ReasonRebooting))
+			Expect(fakeDBus.Actions).To(HaveLen(1))
+
+			By("Completing the reboot")
+			Expect(fakeFS.Remove(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(Succeed())
+			writeBootTime(fakeClock.Now())
+			fakeClock.Step(time.Minute)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationCordonedForReboot))
+			Expect(rebootCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionFalse),
+				"Reason": Equal(ReasonRebootCompleted),
+			})))
+			Expect(getLease("gardener-node-reboot-pool-0").Spec.HolderIdentity).To(BeNil())
+			Expect(fakeDBus.Actions).To(HaveLen(1))
+		})
+
+		It("should trigger the reboot again if the node was not rebooted in time", func() {
+			drainAndReboot()
+			Expect(fakeDBus.Actions).To(HaveLen(1))
+
+			fakeClock.Step(10 * time.Minute)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(fakeDBus.Actions).To(HaveLen(2))
+		})
+
+		It("should renew the reboot slot while waiting for the reboot", func() {
+			drainAndReboot()
+			acquireTime := getLease("gardener-node-reboot-pool-0").Spec.AcquireTime
+
+			fakeClock.Step(2 * time.Minute)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(getLease("gardener-node-reboot-pool-0").Spec).To(MatchFields(IgnoreExtras, Fields{
+				"HolderIdentity": PointTo(Equal("node")),
+				"AcquireTime":    Equal(acquireTime),
+				"RenewTime":      PointTo(HaveField("Time", BeTemporally("==", fakeClock.Now()))),
+			}))
+
+			By("Retrying the reboot")
+			fakeClock.Step(time.Hour)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(fakeDBus.Actions).To(HaveLen(2))
+			Expect(getLease("gardener-node-reboot-pool-0").Spec.RenewTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+
+		It("should not uncordon a node which was already unschedulable before", func() {
+			node.Spec.Unschedulable = true
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			drainAndReboot()
+			Expect(node.Annotations).NotTo(HaveKey(AnnotationCordonedForReboot))
+
+			writeBootTime(fakeClock.Now().Add(time.Second))
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+			Expect(rebootCondition().Reason).To(Equal(ReasonRebootCompleted))
+		})
+
+		It("should not evict DaemonSet pods and mirror pods", func() {
+			daemonSetPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "daemonset-pod",
+					Namespace:       "default",
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "1", Controller: ptr.To(true)}},
+				},
+				Spec: corev1.PodSpec{NodeName: node.Name},
+			}
+			mirrorPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "mirror-pod",
+					Namespace:   "default",
+					Annotations: map[string]string{corev1.MirrorPodAnnotationKey: "foo"},
+				},
+				Spec: corev1.PodSpec{NodeName: node.Name},
+			}
+			Expect(fakeClient.Create(ctx, daemonSetPod)).To(Succeed())
+			Expect(fakeClient.Create(ctx, mirrorPod)).To(Succeed())
+
+			drainAndReboot()
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(daemonSetPod), daemonSetPod)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mirrorPod), mirrorPod)).To(Succeed())
+			Expect(fakeDBus.Actions).To(ConsistOf(rebootAction))
+		})
+
+		It("should wait for pods to terminate and reboot after the drain timeout", func() {
+			pod.Finalizers = []string{"foo"}
+			Expect(fakeClient.Update(ctx, pod)).To(Succeed())
+
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			Expect(rebootCondition().Reason).To(Equal(ReasonDraining))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			fakeClock.Step(5 * time.Minute)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			fakeClock.Step(5 * time.Minute)
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(rebootCondition().Reason).To(Equal(ReasonRebooting))
+			Expect(fakeDBus.Actions).To(ConsistOf(rebootAction))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Acquired reboot slot gardener-node-reboot-pool-0")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Drain timeout of 10m0s expired with 1 pods remaining")))
+		})
+
+		It("should wait for a free reboot slot", func() {
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-pool-0", Namespace: "kube-system"},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other-node"),
+					LeaseDurationSeconds: ptr.To[int32](1800),
+					RenewTime:            &metav1.MicroTime{Time: fakeClock.Now()},
+				},
+			})).To(Succeed())
+
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(rebootCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionTrue),
+				"Reason":  Equal(ReasonRebootPending),
+				"Message": ContainSubstring("waiting for free reboot slot"),
+			})))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			By("Acquiring the slot after the lease of the other node expired")
+			fakeClock.Step(31 * time.Minute)
+			drainAndReboot()
+			Expect(getLease("gardener-node-reboot-pool-0").Spec.HolderIdentity).To(PointTo(Equal("node")))
+			Expect(fakeDBus.Actions).To(ConsistOf(rebootAction))
+		})
+
+		It("should acquire the next slot if maxUnavailable allows it", func() {
+			reconciler.Config.MaxUnavailable = ptr.To[int32](2)
+			Expect(fakeClient.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-reboot-pool-0", Namespace: "kube-system"},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other-node"),
+					LeaseDurationSeconds: ptr.To[int32](1800),
+					RenewTime:            &metav1.MicroTime{Time: fakeClock.Now()},
+				},
+			})).To(Succeed())
+
+			drainAndReboot()
+			Expect(getLease("gardener-node-reboot-pool-1").Spec.HolderIdentity).To(PointTo(Equal("node")))
+			Expect(fakeDBus.Actions).To(ConsistOf(rebootAction))
+		})
+
+		It("should wait for the maintenance time window", func() {
+			reconciler.Config.MaintenanceTimeWindow = &nodeagentconfigv1alpha1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}
+
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(rebootCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(corev1.ConditionTrue),
+				"Reason":  Equal(ReasonRebootPending),
+				"Message": ContainSubstring("waiting for maintenance time window"),
+			})))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			fakeClock.Step(12*time.Hour + 30*time.Minute)
+			drainAndReboot()
+			Expect(fakeDBus.Actions).To(ConsistOf(rebootAction))
+		})
+
+		It("should cancel the reboot and uncordon the node if the reboot is no longer required", func() {
+			pod.Finalizers = []string{"foo"}
+			Expect(fakeClient.Update(ctx, pod)).To(Succeed())
+
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+
+			Expect(fakeFS.Remove(nodeagentconfigv1alpha1.RebootRequiredFilePath)).To(Succeed())
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(node.Spec.Unschedulable).To(BeFalse())
+			Expect(rebootCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(corev1.ConditionFalse),
+				"Reason": Equal(ReasonRebootCanceled),
+			})))
+			Expect(getLease("gardener-node-reboot-pool-0").Spec.HolderIdentity).To(BeNil())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should not reboot nodes without worker pool label", func() {
+			delete(node.Labels, v1beta1constants.LabelWorkerPool)
+			Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+			Expect(reconcileNode()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(rebootCondition()).To(BeNil())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+	})
+})
@@ -20,6 +20,7 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/status"
 )
 
 // Reconciler fetches the shoot access token for gardener-node-agent and writes it to disk.
@@ -27,14 +28,18 @@ type Reconciler struct {
 	APIReader client.Reader
 	Config    nodeagentconfigv1alpha1.TokenControllerConfig
 	FS        afero.Afero
+	// Status is used to report the results of the token syncs to the local status API.
+	Status *status.Registry
 
 	secretNameToPath map[string]string
 }
 
 // Reconcile fetches the shoot access token for gardener-node-agent and writes it to disk.
-func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
 	log := logf.FromContext(ctx)
 
+	defer func() { r.Status.SetTokenSync(request.Name, r.secretNameToPath[request.Name], err) }()
+
 	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
 	defer cancel()
 
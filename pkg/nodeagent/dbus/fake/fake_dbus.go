@@ -44,8 +44,9 @@ type SystemdAction struct {
 
 // DBus is a fake implementation for the dbus.DBus interface.
 type DBus struct {
-	Actions  []SystemdAction
-	failures map[string]error
+	Actions      []SystemdAction
+	failures     map[string]error
+	activeStates map[string]string
 
 	mutex sync.Mutex
 }
@@ -55,7 +56,8 @@ var _ dbus.DBus = &DBus{}
 // New returns a simple implementation of dbus.DBus which can be used to fake the dbus actions in unit tests.
 func New() *DBus {
 	return &DBus{
-		failures: map[string]error{},
+		failures:     map[string]error{},
+		activeStates: map[string]string{},
 	}
 }
 
@@ -68,6 +70,15 @@ func (d *DBus) InjectRestartFailure(err error, unitNames ...string) {
 	d.failures[key] = err
 }
 
+// SetActiveState sets the active state which is returned for the given unit. Units without an explicitly set state
+// are considered "inactive".
+func (d *DBus) SetActiveState(unitName, activeState string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.activeStates[unitName] = activeState
+}
+
 func (d *DBus) maybeError(action SystemdAction) error {
 	key := failureKey(action)
 	err, ok := d.failures[key]
@@ -166,6 +177,17 @@ func (d *DBus) Stop(_ context.Context, _ record.EventRecorder, _ runtime.Object,
 	return nil
 }
 
+// ActiveState implements dbus.DBus.
+func (d *DBus) ActiveState(_ context.Context, unitName string) (string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if activeState, ok := d.activeStates[unitName]; ok {
+		return activeState, nil
+	}
+	return "inactive", nil
+}
+
 func failureKey(action SystemdAction) string {
 	return strings.Join(action.UnitNames, "-") + strconv.Itoa(int(action.Action))
 }
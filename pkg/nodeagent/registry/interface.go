@@ -7,6 +7,8 @@ package registry
 import (
 	"context"
 	"os"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
 // Extractor is an interface for extracting files from a container image.
@@ -14,3 +16,11 @@ type Extractor interface {
 	// CopyFromImage copies a file from a given image reference to the destination file.
 	CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error
 }
+
+// MirrorAwareExtractor is an Extractor which pulls images via the registry hosts configured in the operating system
+// config.
+type MirrorAwareExtractor interface {
+	Extractor
+	// WithRegistries returns an Extractor which pulls images via the hosts of the given registries.
+	WithRegistries(registries []extensionsv1alpha1.RegistryConfig) Extractor
+}
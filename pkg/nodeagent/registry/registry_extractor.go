@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/containerd/containerd/archive/compression"
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/content/local"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/containerd/platforms"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/spf13/afero"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/files"
+)
+
+const (
+	whiteoutPrefix = ".wh."
+	whiteoutOpaque = whiteoutPrefix + whiteoutPrefix + ".opq"
+
+	// maxSymlinks is the maximum number of symbolic links which are followed when looking up a file in an image.
+	maxSymlinks = 16
+)
+
+type registryExtractor struct {
+	fs                 afero.Afero
+	cacheDir           string
+	pullSecretFilePath string
+	registries         []extensionsv1alpha1.RegistryConfig
+}
+
+// NewRegistryExtractor creates a new instance of an extractor which pulls the image layers directly from the registry
+// without requiring a container runtime. The blobs are verified against their digests and cached in the given
+// directory. The credentials are read from the given file in the Docker config JSON format, if it exists.
+func NewRegistryExtractor(fs afero.Afero, cacheDir, pullSecretFilePath string) MirrorAwareExtractor {
+	return &registryExtractor{
+		fs:                 fs,
+		cacheDir:           cacheDir,
+		pullSecretFilePath: pullSecretFilePath,
+	}
+}
+
+// WithRegistries returns a copy of the extractor which pulls images via the hosts of the given registries.
+func (e *registryExtractor) WithRegistries(registries []extensionsv1alpha1.RegistryConfig) Extractor {
+	out := *e
+	out.registries = registries
+	return &out
+}
+
+// CopyFromImage copies a file from a given image reference to the destination file.
+func (e *registryExtractor) CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error {
+	credentials, err := readCredentials(e.fs, e.pullSecretFilePath)
+	if err != nil {
+		return err
+	}
+
+	store, err := local.NewStore(e.cacheDir)
+	if err != nil {
+		return fmt.Errorf("error creating blob cache in %s: %w", e.cacheDir, err)
+	}
+
+	resolver := docker.NewResolver(docker.ResolverOptions{Hosts: registryHosts(e.fs, e.registries, credentials.lookup)})
+
+	name, desc, err := resolver.Resolve(ctx, imageRef)
+	if err != nil {
+		return fmt.Errorf("error resolving image %s: %w", imageRef, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return fmt.Errorf("error creating fetcher for image %s: %w", imageRef, err)
+	}
+
+	// Only the manifests and layers for the platform of the node are fetched. Blobs which are already cached are not
+	// fetched again, fetched blobs are only added to the cache if their digest matches.
+	platform := platforms.Default()
+	handler := images.Handlers(
+		remotes.FetchHandler(store, fetcher),
+		images.LimitManifests(images.FilterPlatforms(images.ChildrenHandler(store), platform), platform, 1),
+	)
+	if err := images.Dispatch(ctx, handler, nil, desc); err != nil {
+		return fmt.Errorf("error pulling image %s: %w", imageRef, err)
+	}
+
+	manifest, err := images.Manifest(ctx, store, desc, platform)
+	if err != nil {
+		return fmt.Errorf("error reading manifest of image %s: %w", imageRef, err)
+	}
+
+	tempDir, err := e.fs.TempDir(nodeagentconfigv1alpha1.TempDir, "extract-image-")
+	if err != nil {
+		return fmt.Errorf("error creating temp directory: %w", err)
+	}
+
+	defer func() { utilruntime.HandleError(e.fs.RemoveAll(tempDir)) }()
+
+	source := path.Join(tempDir, path.Base(filePathInImage))
+	if err := e.extractFile(ctx, store, manifest.Layers, filePathInImage, source); err != nil {
+		return fmt.Errorf("error extracting file %s from image %s: %w", filePathInImage, imageRef, err)
+	}
+
+	if err := files.Copy(e.fs, source, destination, permissions); err != nil {
+		return fmt.Errorf("error copying file %s to %s: %w", source, destination, err)
+	}
+
+	return nil
+}
+
+// extractFile writes the file with the given path in the image to the given destination. Symbolic links are followed.
+func (e *registryExtractor) extractFile(ctx context.Context, provider content.Provider, layers []ocispec.Descriptor, filePath, destination string) error {
+	filePath = path.Clean("/" + filePath)
+
+	for range maxSymlinks {
+		header, err := findFile(ctx, provider, layers, filePath, func(r io.Reader) error {
+			return e.fs.WriteReader(destination, r)
+		})
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeReg:
+			return nil
+		case tar.TypeSymlink:
+			if path.IsAbs(header.Linkname) {
+				filePath = path.Clean(header.Linkname)
+			} else {
+				filePath = path.Join(path.Dir(filePath), header.Linkname)
+			}
+		case tar.TypeLink:
+			filePath = path.Clean("/" + header.Linkname)
+		default:
+			return fmt.Errorf("%s is not a regular file", filePath)
+		}
+	}
+
+	return fmt.Errorf("too many levels of symbolic links")
+}
+
+// findFile looks up the file with the given path in the layers, starting with the topmost one. If the file is a
+// regular file, its content is passed to the given write function. Whiteouts of the file or of its parent directories
+// in upper layers hide the file in the lower layers.
+func findFile(ctx context.Context, provider content.Provider, layers []ocispec.Descriptor, filePath string, write func(io.Reader) error) (*tar.Header, error) {
+	for i := len(layers) - 1; i >= 0; i-- {
+		header, hidden, err := findFileInLayer(ctx, provider, layers[i], filePath, write)
+		if err != nil {
+			return nil, fmt.Errorf("error reading layer %s: %w", layers[i].Digest, err)
+		}
+		if header != nil {
+			return header, nil
+		}
+		if hidden {
+			break
+		}
+	}
+
+	return nil, fmt.Errorf("%s does not exist in image", filePath)
+}
+
+func findFileInLayer(ctx context.Context, provider content.Provider, layer ocispec.Descriptor, filePath string, write func(io.Reader) error) (*tar.Header, bool, error) {
+	readerAt, err := provider.ReaderAt(ctx, layer)
+	if err != nil {
+		return nil, false, err
+	}
+	defer readerAt.Close()
+
+	reader, err := compression.DecompressStream(content.NewReader(readerAt))
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+
+	var (
+		tarReader = tar.NewReader(reader)
+		hidden    bool
+	)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, hidden, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		name := path.Clean("/" + header.Name)
+		if name == filePath {
+			if header.Typeflag == tar.TypeReg {
+				if err := write(tarReader); err != nil {
+					return nil, false, err
+				}
+			}
+			return header, false, nil
+		}
+
+		hidden = hidden || isWhiteout(name, filePath)
+	}
+}
+
+// isWhiteout returns whether the given entry of a layer hides the file with the given path in the lower layers, i.e.,
+// whether it is a whiteout of the file or one of its parent directories, or an opaque whiteout of a parent directory.
+func isWhiteout(name, filePath string) bool {
+	dir, base := path.Split(name)
+	dir = path.Clean(dir)
+
+	for p := filePath; p != "/"; p = path.Dir(p) {
+		if base == whiteoutOpaque && dir == path.Dir(p) {
+			return true
+		}
+		if base == whiteoutPrefix+path.Base(p) && dir == path.Dir(p) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,350 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/registry"
+)
+
+var _ = Describe("RegistryExtractor", func() {
+	var (
+		ctx = context.Background()
+
+		fakeFS             afero.Afero
+		cacheDir           string
+		pullSecretFilePath = "/var/lib/gardener-node-agent/credentials/image-pull-secret.json"
+		extractor          MirrorAwareExtractor
+
+		handler     http.Handler
+		server      *httptest.Server
+		host        string
+		blobGets    atomic.Int32
+		wrapHandler func(http.Handler) http.Handler
+
+		destination = "/opt/bin/foo"
+	)
+
+	BeforeEach(func() {
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		cacheDir = GinkgoT().TempDir()
+		extractor = NewRegistryExtractor(fakeFS, cacheDir, pullSecretFilePath)
+
+		blobGets.Store(0)
+		wrapHandler = func(h http.Handler) http.Handler { return h }
+		handler = ggcrregistry.New(ggcrregistry.Logger(log.New(io.Discard, "", 0)))
+	})
+
+	JustBeforeEach(func() {
+		registryHandler := wrapHandler(handler)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet && strings.Contains(r.URL.Path, "/blobs/") {
+				blobGets.Add(1)
+			}
+			registryHandler.ServeHTTP(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		host = strings.TrimPrefix(server.URL, "http://")
+	})
+
+	// pushImage pushes an image with the given layers to the test registry. The last layer is the topmost one.
+	pushImage := func(repository string, layers ...gcrv1.Layer) string {
+		image, err := mutate.ConfigFile(empty.Image, &gcrv1.ConfigFile{
+			OS:           runtime.GOOS,
+			Architecture: runtime.GOARCH,
+			RootFS:       gcrv1.RootFS{Type: "layers"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		image, err = mutate.AppendLayers(image, layers...)
+		Expect(err).NotTo(HaveOccurred())
+
+		imageRef := host + "/" + repository + ":v1"
+		ref, err := name.ParseReference(imageRef)
+		Expect(err).NotTo(HaveOccurred())
+		// The push bypasses the wrapped handler, e.g., to not require authentication.
+		pushServer := httptest.NewServer(handler)
+		defer pushServer.Close()
+		pushRef, err := name.ParseReference(strings.TrimPrefix(pushServer.URL, "http://") + "/" + repository + ":v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Write(pushRef, image)).To(Succeed())
+
+		return ref.String()
+	}
+
+	It("should extract the file from the topmost layer which contains it", func() {
+		imageRef := pushImage("foo/bar",
+			newLayer(regularFile("bin/foo", "old")),
+			newLayer(regularFile("bin/foo", "new"), regularFile("bin/bar", "bar")),
+		)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0750)).To(Succeed())
+		checkExtractedFile(fakeFS, destination, "new", 0750)
+	})
+
+	It("should extract the file from a lower layer", func() {
+		imageRef := pushImage("foo/bar",
+			newLayer(regularFile("bin/foo", "foo")),
+			newLayer(regularFile("bin/bar", "bar")),
+		)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "bin/foo", destination, 0755)).To(Succeed())
+		checkExtractedFile(fakeFS, destination, "foo", 0755)
+	})
+
+	It("should follow symbolic links", func() {
+		imageRef := pushImage("foo/bar",
+			newLayer(regularFile("opt/foo", "foo")),
+			newLayer(symlink("bin/foo", "../opt/foo")),
+		)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(Succeed())
+		checkExtractedFile(fakeFS, destination, "foo", 0755)
+	})
+
+	It("should fail if the file was removed by a whiteout in an upper layer", func() {
+		imageRef := pushImage("foo/bar",
+			newLayer(regularFile("bin/foo", "foo")),
+			newLayer(regularFile("bin/.wh.foo", "")),
+		)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("/bin/foo does not exist in image")))
+	})
+
+	It("should fail if the parent directory is opaque in an upper layer", func() {
+		imageRef := pushImage("foo/bar",
+			newLayer(regularFile("bin/foo", "foo")),
+			newLayer(regularFile("bin/.wh..wh..opq", ""), regularFile("bin/bar", "bar")),
+		)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("/bin/foo does not exist in image")))
+	})
+
+	It("should fail if the path is not a regular file", func() {
+		imageRef := pushImage("foo/bar",
+			newLayer(directory("bin/foo")),
+		)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("/bin/foo is not a regular file")))
+	})
+
+	It("should cache the blobs on disk", func() {
+		layer := newLayer(regularFile("bin/foo", "foo"))
+		imageRef := pushImage("foo/bar", layer)
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(Succeed())
+
+		digest, err := layer.Digest()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filepath.Join(cacheDir, "blobs", digest.Algorithm, digest.Hex)).To(BeARegularFile())
+
+		blobGetsAfterFirstPull := blobGets.Load()
+		Expect(blobGetsAfterFirstPull).To(BeNumerically(">", 0))
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", "/opt/bin/bar", 0755)).To(Succeed())
+		checkExtractedFile(fakeFS, "/opt/bin/bar", "foo", 0755)
+		Expect(blobGets.Load()).To(Equal(blobGetsAfterFirstPull))
+	})
+
+	Context("digest verification", func() {
+		var layer gcrv1.Layer
+
+		BeforeEach(func() {
+			layer = newLayer(regularFile("bin/foo", "foo"))
+
+			digest, err := layer.Digest()
+			Expect(err).NotTo(HaveOccurred())
+
+			wrapHandler = func(h http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if strings.HasSuffix(r.URL.Path, "/blobs/"+digest.String()) {
+						recorder := httptest.NewRecorder()
+						h.ServeHTTP(recorder, r)
+
+						// Flip the last byte to corrupt the blob while keeping its size.
+						body := recorder.Body.Bytes()
+						if len(body) > 0 {
+							body[len(body)-1] ^= 0xff
+						}
+						for key, values := range recorder.Header() {
+							w.Header()[key] = values
+						}
+						w.WriteHeader(recorder.Code)
+						_, _ = w.Write(body)
+						return
+					}
+					h.ServeHTTP(w, r)
+				})
+			}
+		})
+
+		It("should fail and not cache blobs whose digest does not match", func() {
+			imageRef := pushImage("foo/bar", layer)
+
+			Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("error pulling image")))
+
+			digest, err := layer.Digest()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filepath.Join(cacheDir, "blobs", digest.Algorithm, digest.Hex)).NotTo(BeAnExistingFile())
+			Expect(fakeFS.Exists(destination)).To(BeFalse())
+		})
+	})
+
+	Context("registry mirrors", func() {
+		It("should pull the image via the configured hosts of the upstream", func() {
+			pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+
+			Expect(extractor.WithRegistries([]extensionsv1alpha1.RegistryConfig{
+				{Upstream: "other.example.com", Hosts: []extensionsv1alpha1.RegistryHost{{URL: "http://other.invalid"}}},
+				{Upstream: "registry.invalid", Hosts: []extensionsv1alpha1.RegistryHost{{URL: server.URL}}},
+			}).CopyFromImage(ctx, "registry.invalid/foo/bar:v1", "/bin/foo", destination, 0755)).To(Succeed())
+			checkExtractedFile(fakeFS, destination, "foo", 0755)
+		})
+
+		It("should pull the image via the configured server of the upstream", func() {
+			pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+
+			Expect(extractor.WithRegistries([]extensionsv1alpha1.RegistryConfig{
+				{Upstream: "registry.invalid", Server: &server.URL},
+			}).CopyFromImage(ctx, "registry.invalid/foo/bar:v1", "/bin/foo", destination, 0755)).To(Succeed())
+			checkExtractedFile(fakeFS, destination, "foo", 0755)
+		})
+
+		It("should pull the image via the hosts of the default upstream", func() {
+			pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+
+			Expect(extractor.WithRegistries([]extensionsv1alpha1.RegistryConfig{
+				{Upstream: "_default", Hosts: []extensionsv1alpha1.RegistryHost{{URL: server.URL}}},
+			}).CopyFromImage(ctx, "registry.invalid/foo/bar:v1", "/bin/foo", destination, 0755)).To(Succeed())
+			checkExtractedFile(fakeFS, destination, "foo", 0755)
+		})
+
+		It("should not modify the original extractor", func() {
+			pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+
+			_ = extractor.WithRegistries([]extensionsv1alpha1.RegistryConfig{
+				{Upstream: "registry.invalid", Hosts: []extensionsv1alpha1.RegistryHost{{URL: server.URL}}},
+			})
+
+			Expect(extractor.CopyFromImage(ctx, "registry.invalid/foo/bar:v1", "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("error resolving image")))
+		})
+	})
+
+	Context("pull secrets", func() {
+		BeforeEach(func() {
+			wrapHandler = func(h http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+						w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					h.ServeHTTP(w, r)
+				})
+			}
+		})
+
+		It("should fail without credentials", func() {
+			imageRef := pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+
+			Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("error resolving image")))
+		})
+
+		It("should use the credentials from the pull secret file", func() {
+			imageRef := pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+			auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+			Expect(fakeFS.WriteFile(pullSecretFilePath, []byte(`{"auths":{"`+host+`":{"auth":"`+auth+`"}}}`), 0600)).To(Succeed())
+
+			Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(Succeed())
+			checkExtractedFile(fakeFS, destination, "foo", 0755)
+		})
+
+		It("should use the username and password from the pull secret file", func() {
+			imageRef := pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+			Expect(fakeFS.WriteFile(pullSecretFilePath, []byte(`{"auths":{"http://`+host+`":{"username":"user","password":"pass"}}}`), 0600)).To(Succeed())
+
+			Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(Succeed())
+			checkExtractedFile(fakeFS, destination, "foo", 0755)
+		})
+
+		It("should fail if the pull secret file cannot be decoded", func() {
+			imageRef := pushImage("foo/bar", newLayer(regularFile("bin/foo", "foo")))
+			Expect(fakeFS.WriteFile(pullSecretFilePath, []byte(`{`), 0600)).To(Succeed())
+
+			Expect(extractor.CopyFromImage(ctx, imageRef, "/bin/foo", destination, 0755)).To(MatchError(ContainSubstring("failed decoding pull secret file")))
+		})
+	})
+})
+
+type layerEntry struct {
+	header  *tar.Header
+	content string
+}
+
+func newLayer(entries ...layerEntry) gcrv1.Layer {
+	buffer := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(buffer)
+
+	for _, entry := range entries {
+		Expect(tarWriter.WriteHeader(entry.header)).To(Succeed())
+		_, err := tarWriter.Write([]byte(entry.content))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+
+	layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buffer.Bytes())), nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return layer
+}
+
+func regularFile(name, content string) layerEntry {
+	return layerEntry{header: &tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0644, Size: int64(len(content))}, content: content}
+}
+
+func symlink(name, target string) layerEntry {
+	return layerEntry{header: &tar.Header{Typeflag: tar.TypeSymlink, Name: name, Linkname: target, Mode: 0777}}
+}
+
+func directory(name string) layerEntry {
+	return layerEntry{header: &tar.Header{Typeflag: tar.TypeDir, Name: name + "/", Mode: 0755}}
+}
+
+func checkExtractedFile(fakeFS afero.Afero, path, content string, permissions fs.FileMode) {
+	GinkgoHelper()
+
+	Expect(fakeFS.ReadFile(path)).To(BeEquivalentTo(content))
+
+	fileInfo, err := fakeFS.Stat(path)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(fileInfo.Mode()).To(Equal(permissions))
+}
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	upstreamDefault = "_default"
+
+	dockerHubUpstream = "docker.io"
+	dockerHubHost     = "registry-1.docker.io"
+)
+
+// registryHosts returns the hosts to pull images from for the given registries. It follows the semantics of
+// containerd's `hosts.toml` files which are written for the same registries: The configured hosts are tried in the given
+// order before falling back to the server of the registry. Registries without configuration are pulled from directly.
+func registryHosts(fs afero.Afero, registries []extensionsv1alpha1.RegistryConfig, credentials func(string) (string, string, error)) docker.RegistryHosts {
+	return func(host string) ([]docker.RegistryHost, error) {
+		registryConfig := findRegistryConfig(registries, host)
+
+		client := newHTTPClient(nil)
+		authorizer := docker.NewDockerAuthorizer(docker.WithAuthClient(client), docker.WithAuthCreds(credentials))
+
+		var hosts []docker.RegistryHost
+		if registryConfig != nil {
+			for _, registryHost := range registryConfig.Hosts {
+				hostURL, err := parseRegistryURL(registryHost.URL)
+				if err != nil {
+					return nil, fmt.Errorf("failed parsing URL of host %q for upstream %q: %w", registryHost.URL, registryConfig.Upstream, err)
+				}
+
+				hostClient := client
+				if len(registryHost.CACerts) > 0 {
+					rootCAs, err := loadCACerts(fs, registryHost.CACerts)
+					if err != nil {
+						return nil, fmt.Errorf("failed loading CA certificates of host %q for upstream %q: %w", registryHost.URL, registryConfig.Upstream, err)
+					}
+					hostClient = newHTTPClient(rootCAs)
+				}
+
+				hosts = append(hosts, docker.RegistryHost{
+					Client:       hostClient,
+					Authorizer:   docker.NewDockerAuthorizer(docker.WithAuthClient(hostClient), docker.WithAuthCreds(credentials)),
+					Host:         hostURL.Host,
+					Scheme:       hostURL.Scheme,
+					Path:         hostURL.Path,
+					Capabilities: capabilities(registryHost.Capabilities),
+				})
+			}
+		}
+
+		server := defaultServer(host)
+		if registryConfig != nil && registryConfig.Server != nil {
+			serverURL, err := parseRegistryURL(*registryConfig.Server)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing server %q for upstream %q: %w", *registryConfig.Server, registryConfig.Upstream, err)
+			}
+			server = serverURL
+		}
+
+		return append(hosts, docker.RegistryHost{
+			Client:       client,
+			Authorizer:   authorizer,
+			Host:         server.Host,
+			Scheme:       server.Scheme,
+			Path:         server.Path,
+			Capabilities: docker.HostCapabilityPull | docker.HostCapabilityResolve,
+		}), nil
+	}
+}
+
+func findRegistryConfig(registries []extensionsv1alpha1.RegistryConfig, host string) *extensionsv1alpha1.RegistryConfig {
+	var defaultRegistryConfig *extensionsv1alpha1.RegistryConfig
+
+	for i, registryConfig := range registries {
+		switch registryConfig.Upstream {
+		case host:
+			return &registries[i]
+		case upstreamDefault:
+			defaultRegistryConfig = &registries[i]
+		}
+	}
+
+	return defaultRegistryConfig
+}
+
+func defaultServer(host string) *url.URL {
+	switch {
+	case host == dockerHubUpstream:
+		return &url.URL{Scheme: "https", Host: dockerHubHost, Path: "/v2"}
+	case docker.IsLocalhost(host):
+		return &url.URL{Scheme: "http", Host: host, Path: "/v2"}
+	default:
+		return &url.URL{Scheme: "https", Host: host, Path: "/v2"}
+	}
+}
+
+// parseRegistryURL parses the given URL of a registry host. Like in containerd's `hosts.toml` files, `https` is assumed
+// if no scheme is given and the `/v2` API path is appended if it is missing.
+func parseRegistryURL(rawURL string) (*url.URL, error) {
+	if !strings.HasPrefix(rawURL, "http://") && !strings.HasPrefix(rawURL, "https://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("host must not be empty")
+	}
+
+	u.Path = path.Clean("/" + u.Path)
+	if !strings.HasSuffix(u.Path, "/v2") {
+		u.Path = path.Join(u.Path, "v2")
+	}
+
+	return u, nil
+}
+
+func capabilities(registryCapabilities []extensionsv1alpha1.RegistryCapability) docker.HostCapabilities {
+	if len(registryCapabilities) == 0 {
+		return docker.HostCapabilityPull | docker.HostCapabilityResolve
+	}
+
+	var out docker.HostCapabilities
+	for _, capability := range registryCapabilities {
+		switch capability {
+		case extensionsv1alpha1.PullCapability:
+			out |= docker.HostCapabilityPull
+		case extensionsv1alpha1.ResolveCapability:
+			out |= docker.HostCapabilityResolve
+		case extensionsv1alpha1.PushCapability:
+			out |= docker.HostCapabilityPush
+		}
+	}
+
+	return out
+}
+
+func loadCACerts(fs afero.Afero, caCerts []string) (*x509.CertPool, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, fmt.Errorf("failed loading system certificate pool: %w", err)
+	}
+
+	for _, caCert := range caCerts {
+		data, err := fs.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading CA certificate %s: %w", caCert, err)
+		}
+		if !rootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("failed adding CA certificate %s", caCert)
+		}
+	}
+
+	return rootCAs, nil
+}
+
+func newHTTPClient(rootCAs *x509.CertPool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			TLSClientConfig:       &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
+			ExpectContinueTimeout: 5 * time.Second,
+		},
+	}
+}
+
+// dockerConfig is the Docker config JSON format of pull secrets.
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// credentials are the registry credentials indexed by host.
+type credentials map[string]dockerAuth
+
+// readCredentials reads the credentials from the given file in the Docker config JSON format. No credentials are
+// returned if the file does not exist.
+func readCredentials(fs afero.Afero, filePath string) (credentials, error) {
+	if filePath == "" {
+		return nil, nil
+	}
+
+	data, err := fs.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading pull secret file %s: %w", filePath, err)
+	}
+
+	config := &dockerConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed decoding pull secret file %s: %w", filePath, err)
+	}
+
+	out := make(credentials, len(config.Auths))
+	for server, auth := range config.Auths {
+		host := server
+		if u, err := url.Parse(server); err == nil && u.Host != "" {
+			host = u.Host
+		}
+
+		// Docker Hub credentials are typically stored for the legacy index server.
+		if host == "index.docker.io" || host == dockerHubUpstream {
+			host = dockerHubHost
+		}
+
+		out[host] = auth
+	}
+
+	return out, nil
+}
+
+// lookup returns the username and the password for the given host.
+func (c credentials) lookup(host string) (string, string, error) {
+	auth, ok := c[host]
+	if !ok {
+		return "", "", nil
+	}
+
+	if auth.Auth == "" {
+		return auth.Username, auth.Password, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+	if err != nil {
+		return "", "", fmt.Errorf("failed decoding credentials for host %s: %w", host, err)
+	}
+
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", fmt.Errorf("invalid credentials for host %s, expected format <username>:<password>", host)
+	}
+
+	return username, password, nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const none = "<none>"
+
+// PrintStatus writes the given status in a human-readable format to the given writer.
+func PrintStatus(w io.Writer, status *Status) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "OPERATING SYSTEM CONFIG")
+	fmt.Fprintf(tw, "  Checksum:\t%s\n", orNone(status.OperatingSystemConfig.Checksum))
+	fmt.Fprintf(tw, "  Applied Checksum:\t%s\n", orNone(status.OperatingSystemConfig.AppliedChecksum))
+	fmt.Fprintf(tw, "  Last Reconcile Time:\t%s\n", formatTime(status.OperatingSystemConfig.LastReconcileTime))
+	fmt.Fprintf(tw, "  Last Error:\t%s\n", orNone(status.OperatingSystemConfig.LastError))
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "HEALTH CHECKS")
+	if len(status.HealthChecks) == 0 {
+		fmt.Fprintln(tw, "  "+none)
+	} else {
+		fmt.Fprintln(tw, "  NAME\tHEALTHY\tLAST CHECK\tLAST ERROR")
+		for _, healthCheck := range status.HealthChecks {
+			fmt.Fprintf(tw, "  %s\t%t\t%s\t%s\n", healthCheck.Name, healthCheck.Healthy, formatTime(&healthCheck.LastCheckTime), orNone(healthCheck.LastError))
+		}
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "TOKEN SYNCS")
+	if len(status.TokenSyncs) == 0 {
+		fmt.Fprintln(tw, "  "+none)
+	} else {
+		fmt.Fprintln(tw, "  SECRET\tPATH\tLAST SYNC\tLAST ERROR")
+		for _, tokenSync := range status.TokenSyncs {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", tokenSync.SecretName, orNone(tokenSync.Path), formatTime(&tokenSync.LastSyncTime), orNone(tokenSync.LastError))
+		}
+	}
+
+	return tw.Flush()
+}
+
+// PrintChanges writes the given changes in a human-readable format to the given writer.
+func PrintChanges(w io.Writer, changes *Changes) error {
+	if !changes.Computed {
+		_, err := fmt.Fprintf(w, "Changes of operating system config with checksum %s were not computed yet\n", orNone(changes.OperatingSystemConfigChecksum))
+		return err
+	}
+
+	if len(changes.ChangedFiles) == 0 && len(changes.DeletedFiles) == 0 &&
+		len(changes.ChangedUnits) == 0 && len(changes.DeletedUnits) == 0 &&
+		len(changes.UnitCommands) == 0 && !changes.MustRestartNodeAgent {
+		_, err := fmt.Fprintln(w, "No pending changes")
+		return err
+	}
+
+	fmt.Fprintf(w, "Pending changes of operating system config with checksum %s:\n", orNone(changes.OperatingSystemConfigChecksum))
+
+	printList := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "\n%s:\n", title)
+		for _, item := range items {
+			fmt.Fprintf(w, "  %s\n", item)
+		}
+	}
+
+	var unitCommands []string
+	for _, unitCommand := range changes.UnitCommands {
+		unitCommands = append(unitCommands, fmt.Sprintf("%s %s", orNone(unitCommand.Command), unitCommand.Name))
+	}
+
+	printList("Changed files", changes.ChangedFiles)
+	printList("Deleted files", changes.DeletedFiles)
+	printList("Changed units", changes.ChangedUnits)
+	printList("Deleted units", changes.DeletedUnits)
+	printList("Unit commands", unitCommands)
+
+	if changes.MustRestartNodeAgent {
+		fmt.Fprintln(w, "\ngardener-node-agent restarts itself after the changes are applied.")
+	}
+
+	return nil
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return none
+	}
+	return value
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return none
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/status"
+)
+
+var _ = Describe("Print", func() {
+	var (
+		buffer *bytes.Buffer
+		now    = metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	)
+
+	BeforeEach(func() {
+		buffer = &bytes.Buffer{}
+	})
+
+	Describe("#PrintStatus", func() {
+		It("should print an empty status", func() {
+			Expect(PrintStatus(buffer, &Status{})).To(Succeed())
+			Expect(buffer.String()).To(Equal(`OPERATING SYSTEM CONFIG
+  Checksum:             <none>
+  Applied Checksum:     <none>
+  Last Reconcile Time:  <none>
+  Last Error:           <none>
+
+HEALTH CHECKS
+  <none>
+
+TOKEN SYNCS
+  <none>
+`))
+		})
+
+		It("should print the status", func() {
+			Expect(PrintStatus(buffer, &Status{
+				OperatingSystemConfig: OperatingSystemConfigStatus{
+					Checksum:          "new",
+					AppliedChecksum:   "old",
+					LastReconcileTime: &now,
+					LastError:         "failed applying changed units",
+				},
+				HealthChecks: []HealthCheckStatus{
+					{Name: "containerd", Healthy: true, LastCheckTime: now},
+					{Name: "kubelet", LastCheckTime: now, LastError: "failed restarting kubelet"},
+				},
+				TokenSyncs: []TokenSyncStatus{
+					{SecretName: "gardener-node-agent", Path: "/var/lib/gardener-node-agent/credentials/token", LastSyncTime: now},
+				},
+			})).To(Succeed())
+			Expect(buffer.String()).To(Equal(`OPERATING SYSTEM CONFIG
+  Checksum:             new
+  Applied Checksum:     old
+  Last Reconcile Time:  2025-01-01T12:00:00Z
+  Last Error:           failed applying changed units
+
+HEALTH CHECKS
+  NAME        HEALTHY  LAST CHECK            LAST ERROR
+  containerd  true     2025-01-01T12:00:00Z  <none>
+  kubelet     false    2025-01-01T12:00:00Z  failed restarting kubelet
+
+TOKEN SYNCS
+  SECRET               PATH                                            LAST SYNC             LAST ERROR
+  gardener-node-agent  /var/lib/gardener-node-agent/credentials/token  2025-01-01T12:00:00Z  <none>
+`))
+		})
+	})
+
+	Describe("#PrintChanges", func() {
+		It("should print that there are no pending changes", func() {
+			Expect(PrintChanges(buffer, &Changes{OperatingSystemConfigChecksum: "foo", Computed: true})).To(Succeed())
+			Expect(buffer.String()).To(Equal("No pending changes\n"))
+		})
+
+		It("should print that the changes were not computed", func() {
+			Expect(PrintChanges(buffer, &Changes{OperatingSystemConfigChecksum: "foo"})).To(Succeed())
+			Expect(buffer.String()).To(Equal("Changes of operating system config with checksum foo were not computed yet\n"))
+		})
+
+		It("should print the pending changes", func() {
+			Expect(PrintChanges(buffer, &Changes{
+				OperatingSystemConfigChecksum: "foo",
+				Computed:                      true,
+				ChangedFiles:                  []string{"/etc/foo", "/etc/bar"},
+				DeletedUnits:                  []string{"bar.service"},
+				UnitCommands:                  []UnitCommand{{Name: "foo.service", Command: "restart"}},
+				MustRestartNodeAgent:          true,
+			})).To(Succeed())
+			Expect(buffer.String()).To(Equal(`Pending changes of operating system config with checksum foo:
+
+Changed files:
+  /etc/foo
+  /etc/bar
+
+Deleted units:
+  bar.service
+
+Unit commands:
+  restart foo.service
+
+gardener-node-agent restarts itself after the changes are applied.
+`))
+		})
+	})
+})
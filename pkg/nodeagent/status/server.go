@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// PathStatus is the HTTP path under which the status of gardener-node-agent is served.
+	PathStatus = "/status"
+	// PathChanges is the HTTP path under which the pending changes of the operating system config are served.
+	PathChanges = "/changes"
+)
+
+// Server serves the status of gardener-node-agent via HTTP on a unix socket which is only accessible for root. It
+// implements the manager.Runnable interface.
+type Server struct {
+	// Log is the logger of the server.
+	Log logr.Logger
+	// SocketPath is the path of the unix socket.
+	SocketPath string
+	// Registry contains the states reported by the controllers.
+	Registry *Registry
+	// Changes returns the changes of the operating system config revision which are not yet applied to the node.
+	Changes func() (*Changes, error)
+}
+
+// Start listens on the unix socket and serves the status until the given context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	if err := os.MkdirAll(filepath.Dir(s.SocketPath), 0700); err != nil {
+		return fmt.Errorf("failed creating directory for socket %q: %w", s.SocketPath, err)
+	}
+
+	// The socket of a previous gardener-node-agent process is not removed if it was not shut down gracefully.
+	if err := os.Remove(s.SocketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed removing stale socket %q: %w", s.SocketPath, err)
+	}
+
+	listener, err := net.Listen("unix", s.SocketPath)
+	if err != nil {
+		return fmt.Errorf("failed listening on socket %q: %w", s.SocketPath, err)
+	}
+
+	if err := os.Chmod(s.SocketPath, 0600); err != nil {
+		return errors.Join(fmt.Errorf("failed restricting permissions of socket %q: %w", s.SocketPath, err), listener.Close())
+	}
+
+	server := &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.Log.Error(err, "Failed shutting down status server")
+		}
+	}()
+
+	s.Log.Info("Serving status", "socket", s.SocketPath)
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed serving status: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(http.MethodGet+" "+PathStatus, func(w http.ResponseWriter, _ *http.Request) {
+		s.writeJSON(w, s.Registry.Status())
+	})
+
+	mux.HandleFunc(http.MethodGet+" "+PathChanges, func(w http.ResponseWriter, _ *http.Request) {
+		changes := &Changes{}
+		if s.Changes != nil {
+			pendingChanges, err := s.Changes()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if pendingChanges != nil {
+				changes = pendingChanges
+			}
+		}
+
+		s.writeJSON(w, changes)
+	})
+
+	return mux
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, obj any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		s.Log.Error(err, "Failed writing response")
+	}
+}
+
+// Client requests the status of a running gardener-node-agent via its unix socket.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient returns a new Client which connects to the given unix socket.
+func NewClient(socketPath string) *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
+
+// Status returns the status of gardener-node-agent.
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	status := &Status{}
+	return status, c.get(ctx, PathStatus, status)
+}
+
+// Changes returns the changes of the operating system config revision which are not yet applied to the node.
+func (c *Client) Changes(ctx context.Context) (*Changes, error) {
+	changes := &Changes{}
+	return changes, c.get(ctx, PathChanges, changes)
+}
+
+func (c *Client) get(ctx context.Context, path string, obj any) error {
+	// The host is ignored since the client always connects to the unix socket.
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://gardener-node-agent"+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating request: %w", err)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed connecting to gardener-node-agent (is it running?): %w", err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("failed reading response: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("gardener-node-agent responded with status code %d: %s", response.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.Unmarshal(body, obj); err != nil {
+		return fmt.Errorf("failed decoding response: %w", err)
+	}
+
+	return nil
+}
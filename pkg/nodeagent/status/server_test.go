@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/status"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		socketPath string
+		registry   *Registry
+		changes    *Changes
+		changesErr error
+		client     *Client
+		serverDone chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		socketPath = filepath.Join(GinkgoT().TempDir(), "run", "status.sock")
+		registry = NewRegistry(testing.NewFakeClock(time.Now()))
+		changes, changesErr = nil, nil
+		client = NewClient(socketPath)
+	})
+
+	JustBeforeEach(func() {
+		server := &Server{
+			Log:        logr.Discard(),
+			SocketPath: socketPath,
+			Registry:   registry,
+			Changes:    func() (*Changes, error) { return changes, changesErr },
+		}
+
+		serverDone = make(chan error, 1)
+		go func() { serverDone <- server.Start(ctx) }()
+
+		Eventually(func() error {
+			_, err := client.Status(ctx)
+			return err
+		}).Should(Succeed())
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(serverDone).Should(Receive(BeNil()))
+	})
+
+	It("should restrict the permissions of the socket", func() {
+		fileInfo, err := os.Stat(socketPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileInfo.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("should serve the status", func() {
+		registry.SetOperatingSystemConfigReconciled("foo", errors.New("fake"))
+		registry.SetHealthCheck("kubelet", true, nil)
+
+		status, err := client.Status(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.OperatingSystemConfig.Checksum).To(Equal("foo"))
+		Expect(status.OperatingSystemConfig.LastError).To(Equal("fake"))
+		Expect(status.HealthChecks).To(ConsistOf(HaveField("Name", "kubelet")))
+	})
+
+	It("should serve the pending changes", func() {
+		changes = &Changes{
+			OperatingSystemConfigChecksum: "foo",
+			Computed:                      true,
+			ChangedFiles:                  []string{"/etc/foo"},
+			UnitCommands:                  []UnitCommand{{Name: "foo.service", Command: "restart"}},
+		}
+
+		Expect(client.Changes(ctx)).To(Equal(changes))
+	})
+
+	It("should serve empty changes if none were computed yet", func() {
+		Expect(client.Changes(ctx)).To(Equal(&Changes{}))
+	})
+
+	It("should return the error if the pending changes cannot be determined", func() {
+		changesErr = errors.New("fake")
+
+		_, err := client.Changes(ctx)
+		Expect(err).To(MatchError("gardener-node-agent responded with status code 500: fake"))
+	})
+
+	Context("stale socket", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(socketPath), 0700)).To(Succeed())
+			Expect(os.WriteFile(socketPath, nil, 0600)).To(Succeed())
+		})
+
+		It("should replace the stale socket", func() {
+			Expect(client.Status(ctx)).NotTo(BeNil())
+		})
+	})
+})
+
+var _ = Describe("Client", func() {
+	It("should fail if gardener-node-agent is not running", func() {
+		_, err := NewClient(filepath.Join(GinkgoT().TempDir(), "status.sock")).Status(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("failed connecting to gardener-node-agent")))
+	})
+})
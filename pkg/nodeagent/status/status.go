@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+)
+
+// Status describes the current state of a running gardener-node-agent.
+type Status struct {
+	// OperatingSystemConfig is the state of the operating system config controller.
+	OperatingSystemConfig OperatingSystemConfigStatus `json:"operatingSystemConfig"`
+	// HealthChecks are the states of the health checkers, sorted by name.
+	HealthChecks []HealthCheckStatus `json:"healthChecks,omitempty"`
+	// TokenSyncs are the states of the access token syncs, sorted by secret name.
+	TokenSyncs []TokenSyncStatus `json:"tokenSyncs,omitempty"`
+}
+
+// OperatingSystemConfigStatus describes the state of the operating system config controller.
+type OperatingSystemConfigStatus struct {
+	// Checksum is the checksum of the operating system config revision which was reconciled last.
+	Checksum string `json:"checksum,omitempty"`
+	// AppliedChecksum is the checksum of the operating system config revision which was applied last.
+	AppliedChecksum string `json:"appliedChecksum,omitempty"`
+	// LastReconcileTime is the time of the last reconciliation.
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// LastError is the error of the last reconciliation. It is empty if the last reconciliation succeeded.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// HealthCheckStatus describes the state of a health checker.
+type HealthCheckStatus struct {
+	// Name is the name of the health checker.
+	Name string `json:"name"`
+	// Healthy is true if the last check succeeded.
+	Healthy bool `json:"healthy"`
+	// LastCheckTime is the time of the last check.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// LastError is the error of the last check, e.g., if the remediation failed.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// TokenSyncStatus describes the state of an access token sync.
+type TokenSyncStatus struct {
+	// SecretName is the name of the secret containing the access token.
+	SecretName string `json:"secretName"`
+	// Path is the path of the file the access token is synced to.
+	Path string `json:"path,omitempty"`
+	// LastSyncTime is the time of the last sync.
+	LastSyncTime metav1.Time `json:"lastSyncTime"`
+	// LastError is the error of the last sync. It is empty if the last sync succeeded.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Changes describes the changes of an operating system config revision which are not yet applied to the node.
+type Changes struct {
+	// OperatingSystemConfigChecksum is the checksum of the operating system config revision the changes were computed
+	// for.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum"`
+	// Computed is false if the changes of the operating system config revision which was reconciled last were not
+	// computed (yet), e.g., after they were rolled back. In this case, the other fields except the checksum are empty.
+	Computed bool `json:"computed"`
+	// ChangedFiles are the paths of the files which are created or updated.
+	ChangedFiles []string `json:"changedFiles,omitempty"`
+	// DeletedFiles are the paths of the files which are deleted.
+	DeletedFiles []string `json:"deletedFiles,omitempty"`
+	// ChangedUnits are the names of the units which are created or updated.
+	ChangedUnits []string `json:"changedUnits,omitempty"`
+	// DeletedUnits are the names of the units which are deleted.
+	DeletedUnits []string `json:"deletedUnits,omitempty"`
+	// UnitCommands are the commands which are executed for units.
+	UnitCommands []UnitCommand `json:"unitCommands,omitempty"`
+	// MustRestartNodeAgent is true if gardener-node-agent restarts itself after the changes were applied.
+	MustRestartNodeAgent bool `json:"mustRestartNodeAgent,omitempty"`
+}
+
+// UnitCommand is a command which is executed for a unit.
+type UnitCommand struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// Command is the command, e.g., "restart" or "stop".
+	Command string `json:"command"`
+}
+
+// Registry collects the states reported by the controllers of gardener-node-agent. All methods can be called on a nil
+// Registry, in which case nothing is recorded.
+type Registry struct {
+	clock clock.Clock
+
+	lock                  sync.RWMutex
+	operatingSystemConfig OperatingSystemConfigStatus
+	healthChecks          map[string]HealthCheckStatus
+	tokenSyncs            map[string]TokenSyncStatus
+}
+
+// NewRegistry returns a new Registry.
+func NewRegistry(clock clock.Clock) *Registry {
+	return &Registry{
+		clock:        clock,
+		healthChecks: map[string]HealthCheckStatus{},
+		tokenSyncs:   map[string]TokenSyncStatus{},
+	}
+}
+
+// SetOperatingSystemConfigReconciled records the result of a reconciliation of the operating system config revision
+// with the given checksum.
+func (r *Registry) SetOperatingSystemConfigReconciled(checksum string, err error) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := metav1.NewTime(r.clock.Now())
+	r.operatingSystemConfig.LastReconcileTime = &now
+	r.operatingSystemConfig.LastError = errorString(err)
+	if checksum != "" {
+		r.operatingSystemConfig.Checksum = checksum
+	}
+}
+
+// SetOperatingSystemConfigApplied records that the operating system config revision with the given checksum is applied.
+func (r *Registry) SetOperatingSystemConfigApplied(checksum string) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.operatingSystemConfig.AppliedChecksum = checksum
+}
+
+// SetHealthCheck records the result of a check of the health checker with the given name.
+func (r *Registry) SetHealthCheck(name string, healthy bool, err error) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.healthChecks[name] = HealthCheckStatus{
+		Name:          name,
+		Healthy:       healthy && err == nil,
+		LastCheckTime: metav1.NewTime(r.clock.Now()),
+		LastError:     errorString(err),
+	}
+}
+
+// SetTokenSync records the result of a sync of the access token in the secret with the given name.
+func (r *Registry) SetTokenSync(secretName, path string, err error) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.tokenSyncs[secretName] = TokenSyncStatus{
+		SecretName:   secretName,
+		Path:         path,
+		LastSyncTime: metav1.NewTime(r.clock.Now()),
+		LastError:    errorString(err),
+	}
+}
+
+// Status returns a snapshot of the recorded states.
+func (r *Registry) Status() Status {
+	if r == nil {
+		return Status{}
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	status := Status{OperatingSystemConfig: r.operatingSystemConfig}
+	if lastReconcileTime := r.operatingSystemConfig.LastReconcileTime; lastReconcileTime != nil {
+		status.OperatingSystemConfig.LastReconcileTime = lastReconcileTime.DeepCopy()
+	}
+
+	for _, healthCheck := range r.healthChecks {
+		status.HealthChecks = append(status.HealthChecks, healthCheck)
+	}
+	slices.SortFunc(status.HealthChecks, func(a, b HealthCheckStatus) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, tokenSync := range r.tokenSyncs {
+		status.TokenSyncs = append(status.TokenSyncs, tokenSync)
+	}
+	slices.SortFunc(status.TokenSyncs, func(a, b TokenSyncStatus) int { return strings.Compare(a.SecretName, b.SecretName) })
+
+	return status
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package status_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/status"
+)
+
+var _ = Describe("Registry", func() {
+	var (
+		fakeClock *testing.FakeClock
+		registry  *Registry
+		now       metav1.Time
+	)
+
+	BeforeEach(func() {
+		fakeClock = testing.NewFakeClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+		registry = NewRegistry(fakeClock)
+		now = metav1.NewTime(fakeClock.Now())
+	})
+
+	It("should return an empty status if nothing was recorded", func() {
+		Expect(registry.Status()).To(Equal(Status{}))
+	})
+
+	It("should do nothing if the registry is nil", func() {
+		var nilRegistry *Registry
+
+		nilRegistry.SetOperatingSystemConfigReconciled("foo", nil)
+		nilRegistry.SetOperatingSystemConfigApplied("foo")
+		nilRegistry.SetHealthCheck("kubelet", true, nil)
+		nilRegistry.SetTokenSync("token", "/var/lib/token", nil)
+
+		Expect(nilRegistry.Status()).To(Equal(Status{}))
+	})
+
+	It("should record the operating system config reconciliations", func() {
+		registry.SetOperatingSystemConfigReconciled("foo", errors.New("fake"))
+		Expect(registry.Status().OperatingSystemConfig).To(Equal(OperatingSystemConfigStatus{
+			Checksum:          "foo",
+			LastReconcileTime: &now,
+			LastError:         "fake",
+		}))
+
+		fakeClock.Step(time.Minute)
+		later := metav1.NewTime(fakeClock.Now())
+
+		registry.SetOperatingSystemConfigApplied("foo")
+		registry.SetOperatingSystemConfigReconciled("", nil)
+		Expect(registry.Status().OperatingSystemConfig).To(Equal(OperatingSystemConfigStatus{
+			Checksum:          "foo",
+			AppliedChecksum:   "foo",
+			LastReconcileTime: &later,
+		}))
+	})
+
+	It("should record the health checks sorted by name", func() {
+		registry.SetHealthCheck("kubelet", false, nil)
+		registry.SetHealthCheck("containerd", true, nil)
+		registry.SetHealthCheck("custom", true, errors.New("fake"))
+
+		Expect(registry.Status().HealthChecks).To(Equal([]HealthCheckStatus{
+			{Name: "containerd", Healthy: true, LastCheckTime: now},
+			{Name: "custom", Healthy: false, LastCheckTime: now, LastError: "fake"},
+			{Name: "kubelet", Healthy: false, LastCheckTime: now},
+		}))
+
+		registry.SetHealthCheck("kubelet", true, nil)
+		Expect(registry.Status().HealthChecks).To(ContainElement(HealthCheckStatus{Name: "kubelet", Healthy: true, LastCheckTime: now}))
+	})
+
+	It("should record the token syncs sorted by secret name", func() {
+		registry.SetTokenSync("token-b", "/var/lib/b", errors.New("fake"))
+		registry.SetTokenSync("token-a", "/var/lib/a", nil)
+
+		Expect(registry.Status().TokenSyncs).To(Equal([]TokenSyncStatus{
+			{SecretName: "token-a", Path: "/var/lib/a", LastSyncTime: now},
+			{SecretName: "token-b", Path: "/var/lib/b", LastSyncTime: now, LastError: "fake"},
+		}))
+	})
+})
@@ -42,10 +42,12 @@ func (e *ensurer) EnsureMachineControllerManagerDeployment(_ context.Context, _
 		return err
 	}
 
-	newObj.Spec.Template.Spec.Containers = webhook.EnsureContainerWithName(
-		newObj.Spec.Template.Spec.Containers,
-		machinecontrollermanager.ProviderSidecarContainer(newObj.Namespace, local.Name, image.String()),
-	)
+	sidecarContainer := machinecontrollermanager.ProviderSidecarContainer(newObj.Namespace, local.Name, image.String())
+	if machinecontrollermanager.RunsWithoutTargetCluster(newObj) {
+		sidecarContainer = machinecontrollermanager.ProviderSidecarContainerWithoutTargetCluster(newObj.Namespace, local.Name, image.String())
+	}
+
+	newObj.Spec.Template.Spec.Containers = webhook.EnsureContainerWithName(newObj.Spec.Template.Spec.Containers, sidecarContainer)
 	return nil
 }
 
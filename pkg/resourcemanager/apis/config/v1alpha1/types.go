@@ -141,6 +141,29 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Rules is a list of health and progressing rules written in the Common Expression Language (CEL). They are
+	// evaluated for all objects of the respective kind in addition to the built-in checks.
+	// +optional
+	Rules []HealthRule `json:"rules,omitempty"`
+}
+
+// HealthRule contains CEL expressions for checking the health of objects of a kind. The expressions can access the
+// object via the `object` variable.
+type HealthRule struct {
+	// Group is the API group of the objects. It is empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the objects.
+	Kind string `json:"kind"`
+	// Healthy is an expression evaluating to a bool which indicates whether the object is healthy.
+	// +optional
+	Healthy *string `json:"healthy,omitempty"`
+	// Progressing is an expression evaluating to a bool which indicates whether the object is progressing.
+	// +optional
+	Progressing *string `json:"progressing,omitempty"`
+	// Message is an expression evaluating to a string which describes why the object is unhealthy or progressing.
+	// +optional
+	Message *string `json:"message,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -96,6 +96,7 @@ func validateResourceManagerControllerConfiguration(conf resourcemanagerconfigv1
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateHealthRules(conf.Health.Rules, fldPath.Child("health", "rules"))...)
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -211,6 +212,31 @@ func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorLi
 	return allErrs
 }
 
+func validateHealthRules(rules []resourcemanagerconfigv1alpha1.HealthRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	groupKinds := sets.New[string]()
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if len(rule.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must provide a kind"))
+		}
+
+		groupKind := rule.Kind + "." + rule.Group
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind))
+		}
+		groupKinds.Insert(groupKind)
+
+		if ptr.Deref(rule.Healthy, "") == "" && ptr.Deref(rule.Progressing, "") == "" {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide at least one of healthy or progressing expression"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateSystemComponentsConfigWebhookConfig(conf *resourcemanagerconfigv1alpha1.SystemComponentsConfigWebhookConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -303,6 +303,39 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid health rules", func() {
+					conf.Controllers.Health.Rules = []resourcemanagerconfigv1alpha1.HealthRule{
+						{Group: "cert.gardener.cloud", Kind: "Certificate", Healthy: ptr.To("object.status.state == 'Ready'")},
+						{Kind: "Service", Progressing: ptr.To("false")},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because health rules are invalid", func() {
+					conf.Controllers.Health.Rules = []resourcemanagerconfigv1alpha1.HealthRule{
+						{Group: "cert.gardener.cloud", Healthy: ptr.To("true")},
+						{Group: "cert.gardener.cloud", Kind: "Certificate", Healthy: ptr.To("true")},
+						{Group: "cert.gardener.cloud", Kind: "Certificate", Progressing: ptr.To("false")},
+						{Kind: "Service", Message: ptr.To("'foo'")},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.rules[0].kind"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("controllers.health.rules[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.rules[3]"),
+						})),
+					))
+				})
 			})
 
 			Context("managed resources", func() {
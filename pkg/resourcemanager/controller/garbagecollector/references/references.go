@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"strings"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -143,7 +144,7 @@ func InjectAnnotations(obj runtime.Object, additional ...string) error {
 		o.Spec.JobTemplate.Spec.Template.Annotations = mergeAnnotations(o.Spec.JobTemplate.Spec.Template.Annotations, referenceAnnotations)
 
 	case *resourcesv1alpha1.ManagedResource:
-		referenceAnnotations := computeAnnotationsFromLocalObjRefs(o.Spec.SecretRefs, KindSecret, additional...)
+		referenceAnnotations := computeAnnotationsFromManagedResourceSpec(o.Spec, additional...)
 		o.Annotations = mergeAnnotations(o.Annotations, referenceAnnotations)
 
 	case *monitoringv1.Prometheus:
@@ -174,6 +175,33 @@ func computeAnnotationsFromLocalObjRefs(refs []corev1.LocalObjectReference, kind
 	return out
 }
 
+func computeAnnotationsFromManagedResourceSpec(spec resourcesv1alpha1.ManagedResourceSpec, additional ...string) map[string]string {
+	var (
+		secretRefs    = slices.Clone(spec.SecretRefs)
+		configMapRefs = slices.Clone(spec.ConfigMapRefs)
+	)
+
+	for _, chunkedSource := range spec.ChunkedSources {
+		for _, name := range chunkedSource.Names {
+			switch chunkedSource.Kind {
+			case "Secret":
+				secretRefs = append(secretRefs, corev1.LocalObjectReference{Name: name})
+			case "ConfigMap":
+				configMapRefs = append(configMapRefs, corev1.LocalObjectReference{Name: name})
+			}
+		}
+	}
+
+	if spec.OCIArtifact != nil && spec.OCIArtifact.PullSecretRef != nil {
+		secretRefs = append(secretRefs, *spec.OCIArtifact.PullSecretRef)
+	}
+
+	return utils.MergeStringMaps(
+		computeAnnotationsFromLocalObjRefs(secretRefs, KindSecret, additional...),
+		computeAnnotationsFromLocalObjRefs(configMapRefs, KindConfigMap),
+	)
+}
+
 func computeAnnotations(spec corev1.PodSpec, additional ...string) map[string]string {
 	out := make(map[string]string)
 
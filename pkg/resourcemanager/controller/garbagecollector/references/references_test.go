@@ -362,6 +362,27 @@ var _ = Describe("References", func() {
 					},
 				},
 			}
+			managedResourceWithOtherSources = &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: annotations,
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					SecretRefs: []corev1.LocalObjectReference{
+						{Name: secret2},
+					},
+					ConfigMapRefs: []corev1.LocalObjectReference{
+						{Name: configMap1},
+					},
+					ChunkedSources: []resourcesv1alpha1.ChunkedSource{
+						{Kind: "Secret", Names: []string{secret3, secret4}, Key: "data"},
+						{Kind: "ConfigMap", Names: []string{configMap2}, Key: "data"},
+					},
+					OCIArtifact: &resourcesv1alpha1.OCIArtifactSource{
+						Repository:    "example.com/manifests",
+						PullSecretRef: &corev1.LocalObjectReference{Name: secret5},
+					},
+				},
+			}
 
 			prometheus = &monitoringv1.Prometheus{
 				ObjectMeta: metav1.ObjectMeta{
@@ -493,6 +514,22 @@ var _ = Describe("References", func() {
 					}))
 				},
 			),
+			Entry("resourcesv1alpha1.ManagedResource with other sources",
+				managedResourceWithOtherSources,
+				func() {
+					Expect(managedResourceWithOtherSources.Annotations).To(Equal(map[string]string{
+						"some-existing":                          "annotation",
+						AnnotationKey(KindConfigMap, configMap1): configMap1,
+						AnnotationKey(KindConfigMap, configMap2): configMap2,
+						AnnotationKey(KindSecret, secret2):       secret2,
+						AnnotationKey(KindSecret, secret3):       secret3,
+						AnnotationKey(KindSecret, secret4):       secret4,
+						AnnotationKey(KindSecret, secret5):       secret5,
+						additionalAnnotation1:                    "",
+						additionalAnnotation2:                    "",
+					}))
+				},
+			),
 			Entry("monitoringv1.Prometheus",
 				prometheus,
 				func() {
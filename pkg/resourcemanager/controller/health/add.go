@@ -14,14 +14,21 @@ import (
 	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/health"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/progressing"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
 // AddToManager adds all health controllers to the given manager.
 func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster, cfg resourcemanagerconfigv1alpha1.ResourceManagerConfiguration) error {
+	healthRules, err := utils.NewHealthRuleChecker(cfg.Controllers.Health.Rules)
+	if err != nil {
+		return fmt.Errorf("failed creating health rule checker: %w", err)
+	}
+
 	if err := (&health.Reconciler{
 		Config:      cfg.Controllers.Health,
 		ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		HealthRules: healthRules,
 	}).AddToManager(mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
 		return fmt.Errorf("failed adding health reconciler: %w", err)
 	}
@@ -29,6 +36,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 	if err := (&progressing.Reconciler{
 		Config:      cfg.Controllers.Health,
 		ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		HealthRules: healthRules,
 	}).AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
 		return fmt.Errorf("failed adding progressing reconciler: %w", err)
 	}
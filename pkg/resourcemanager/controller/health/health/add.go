@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
@@ -74,12 +75,22 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 		return err
 	}
 
+	// Objects of the same GVK might be watched both as metadata-only and as unstructured objects, e.g., if only some of
+	// them have health rules, hence, both kinds of watches are tracked separately.
+	type watchKey struct {
+		gvk                schema.GroupVersionKind
+		unstructuredObject bool
+	}
+
 	lock := sync.RWMutex{}
-	watchedObjectGVKs := make(map[schema.GroupVersionKind]struct{})
+	watchedObjectGVKs := make(map[watchKey]struct{})
 	r.ensureWatchForGVK = func(gvk schema.GroupVersionKind, obj client.Object) error {
+		_, isUnstructured := obj.(*unstructured.Unstructured)
+		key := watchKey{gvk: gvk, unstructuredObject: isUnstructured}
+
 		// fast-check: have we already added watch for this GVK?
 		lock.RLock()
-		if _, ok := watchedObjectGVKs[gvk]; ok {
+		if _, ok := watchedObjectGVKs[key]; ok {
 			lock.RUnlock()
 			return nil
 		}
@@ -89,7 +100,7 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 		// the watch and the second one should return now.
 		lock.Lock()
 		defer lock.Unlock()
-		if _, ok := watchedObjectGVKs[gvk]; ok {
+		if _, ok := watchedObjectGVKs[key]; ok {
 			return nil
 		}
 
@@ -100,12 +111,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 			targetCluster.GetCache(),
 			obj,
 			handler.EnqueueRequestsFromMapFunc(utils.MapToOriginManagedResource(c.GetLogger(), clusterID)),
-			utils.HealthStatusChanged(c.GetLogger()),
+			utils.HealthStatusChanged(c.GetLogger(), r.HealthRules, gvk.GroupKind()),
 		)); err != nil {
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
 
-		watchedObjectGVKs[gvk] = struct{}{}
+		watchedObjectGVKs[key] = struct{}{}
 		return nil
 	}
 
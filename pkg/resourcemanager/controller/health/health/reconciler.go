@@ -13,6 +13,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
@@ -37,6 +38,7 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	HealthRules  *utils.HealthRuleChecker
 
 	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
 	// ManagedResources on health status changes.
@@ -107,7 +109,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
 		)
 
-		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
+		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK, r.HealthRules.HasHealthRule(objectGVK.GroupKind(), ref.Annotations))
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
 		}
@@ -140,7 +142,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
-		if checked, err := utils.CheckHealth(obj); err != nil {
+		if checked, err := utils.CheckHealthWithRules(r.HealthRules, objectGVK.GroupKind(), obj); err != nil {
 			var (
 				reason  = ref.Kind + "Unhealthy"
 				message = fmt.Sprintf("%s %q is unhealthy: %v", ref.Kind, objectKey.String(), err)
@@ -186,10 +188,10 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
-func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
+func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind, hasHealthRule bool) (client.Object, error) {
 	// Create a typed object if GVK is registered in scheme. This object will be fully watched in the target cluster.
-	// If we don't know the GVK, we definitely don't have a dedicated health check for it.
-	// I.e., we only care about whether the object is present or not.
+	// If we don't know the GVK, we definitely don't have a dedicated health check for it. Unless there is a health rule
+	// for the object, which requires the full object, we only care about whether the object is present or not.
 	// Hence, we can use metadata-only requests/watches instead of watching the entire object, which saves bandwidth and
 	// memory.
 	// If the target cache is disabled, no watches will be started.
@@ -199,6 +201,13 @@ func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema
 			return nil, err
 		}
 
+		if hasHealthRule {
+			log.V(1).Info("Falling back to unstructured object for health rules (not registered in the target scheme)", "groupVersionKind", gvk)
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			return obj, nil
+		}
+
 		log.V(1).Info("Falling back to metadata-only object for health checks (not registered in the target scheme)", "groupVersionKind", gvk, "err", err.Error())
 		obj := &metav1.PartialObjectMetadata{}
 		obj.SetGroupVersionKind(gvk)
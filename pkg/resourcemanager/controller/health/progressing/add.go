@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -117,6 +118,35 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		}
 	}
 
+	// Watch objects of kinds with configured progressing rules which are not covered by the dedicated progressing checks.
+	// Objects with progressing rules in annotations are only checked periodically.
+	for _, groupKind := range r.HealthRules.ProgressingRuleGroupKinds() {
+		mapping, err := targetCluster.GetRESTMapper().RESTMapping(groupKind)
+		if err != nil {
+			if !meta.IsNoMatchError(err) {
+				return err
+			}
+			c.GetLogger().Info("Kind is not available/enabled API of the target cluster, skip adding watches", "groupKind", groupKind)
+
+			continue
+		}
+
+		obj, ok := r.newObjectForProgressingCheck(mapping.GroupVersionKind, nil).(*unstructured.Unstructured)
+		if !ok {
+			// object is already watched for its dedicated progressing checks
+			continue
+		}
+
+		if err := c.Watch(source.Kind[client.Object](
+			targetCluster.GetCache(),
+			obj,
+			handler.EnqueueRequestsFromMapFunc(utils.MapToOriginManagedResource(c.GetLogger(), clusterID)),
+			r.ProgressingStatusChanged(ctx),
+		)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
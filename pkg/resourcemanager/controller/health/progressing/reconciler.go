@@ -15,9 +15,12 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -38,6 +41,7 @@ type Reconciler struct {
 	Config       resourcemanagerconfigv1alpha1.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	HealthRules  *utils.HealthRuleChecker
 }
 
 // Reconcile performs the progressing checks.
@@ -96,29 +100,9 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
 
 	for _, ref := range mr.Status.Resources {
-		// Skip API groups that are irrelevant for progressing checks.
-		if !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group) {
-			continue
-		}
-
-		var obj client.Object
-
-		switch ref.Kind {
-		case "Deployment":
-			obj = &appsv1.Deployment{}
-		case "StatefulSet":
-			obj = &appsv1.StatefulSet{}
-		case "DaemonSet":
-			obj = &appsv1.DaemonSet{}
-		case "Prometheus":
-			obj = &monitoringv1.Prometheus{}
-		case "Alertmanager":
-			obj = &monitoringv1.Alertmanager{}
-		case "Certificate":
-			obj = &certv1alpha1.Certificate{}
-		case "Issuer":
-			obj = &certv1alpha1.Issuer{}
-		default:
+		obj := r.newObjectForProgressingCheck(ref.GroupVersionKind(), ref.Annotations)
+		if obj == nil {
+			// there is no progressing check for this object, skip
 			continue
 		}
 
@@ -225,5 +209,46 @@ func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (b
 		progressing, reason = health.IsCertificateIssuerProgressing(o)
 	}
 
-	return progressing, reason, nil
+	if progressing {
+		return progressing, reason, nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, r.TargetClient.Scheme())
+	if err != nil {
+		return false, "", fmt.Errorf("failed determining GroupVersionKind of object: %w", err)
+	}
+	return r.HealthRules.CheckProgressing(gvk.GroupKind(), obj)
+}
+
+// newObjectForProgressingCheck returns a new object of the given GroupVersionKind if there is a progressing check for
+// it. Objects without dedicated progressing checks are only checked as unstructured objects if there is a progressing
+// rule for them. It returns nil if there is no progressing check for the object.
+func (r *Reconciler) newObjectForProgressingCheck(gvk schema.GroupVersionKind, annotations map[string]string) client.Object {
+	// Only check API groups that are relevant for the dedicated progressing checks.
+	if sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(gvk.Group) {
+		switch gvk.Kind {
+		case "Deployment":
+			return &appsv1.Deployment{}
+		case "StatefulSet":
+			return &appsv1.StatefulSet{}
+		case "DaemonSet":
+			return &appsv1.DaemonSet{}
+		case "Prometheus":
+			return &monitoringv1.Prometheus{}
+		case "Alertmanager":
+			return &monitoringv1.Alertmanager{}
+		case "Certificate":
+			return &certv1alpha1.Certificate{}
+		case "Issuer":
+			return &certv1alpha1.Issuer{}
+		}
+	}
+
+	if r.HealthRules.HasProgressingRule(gvk.GroupKind(), annotations) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		return obj
+	}
+
+	return nil
 }
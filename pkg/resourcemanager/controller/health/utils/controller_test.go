@@ -13,13 +13,17 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/logger"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 )
 
@@ -31,7 +35,7 @@ var _ = Describe("HealthStatusChanged", func() {
 
 	BeforeEach(func() {
 		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
-		p = HealthStatusChanged(log)
+		p = HealthStatusChanged(log, nil, appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind())
 	})
 
 	Context("metadata-only events", func() {
@@ -151,6 +155,44 @@ var _ = Describe("HealthStatusChanged", func() {
 		})
 	})
 
+	Context("unstructured events with health rules", func() {
+		var (
+			healthy, unhealthy *unstructured.Unstructured
+		)
+
+		BeforeEach(func() {
+			healthRules, err := NewHealthRuleChecker([]resourcemanagerconfigv1alpha1.HealthRule{{
+				Group:   "example.com",
+				Kind:    "Foo",
+				Healthy: ptr.To("object.status.ready"),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			p = HealthStatusChanged(log, healthRules, schema.GroupKind{Group: "example.com", Kind: "Foo"})
+
+			healthy = &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"ready": true}}}
+			healthy.SetResourceVersion("1")
+			unhealthy = &unstructured.Unstructured{Object: map[string]any{"status": map[string]any{"ready": false}}}
+			unhealthy.SetResourceVersion("1")
+		})
+
+		It("should return true for Update, if the health status has changed", func() {
+			healthyOld := healthy.DeepCopy()
+			healthyOld.SetResourceVersion("2")
+			unhealthyOld := unhealthy.DeepCopy()
+			unhealthyOld.SetResourceVersion("2")
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthyOld, ObjectNew: unhealthy})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: unhealthyOld, ObjectNew: healthy})).To(BeTrue())
+		})
+
+		It("should ignore Update, if the health status has not changed", func() {
+			healthyOld := healthy.DeepCopy()
+			healthyOld.SetResourceVersion("2")
+
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthyOld, ObjectNew: healthy})).To(BeFalse())
+		})
+	})
+
 	Describe("#MapToOriginManagedResource", func() {
 		var (
 			ctx = context.TODO()
@@ -0,0 +1,506 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+)
+
+const (
+	// healthRuleObjectVariable is the name of the variable holding the checked object in health rules.
+	healthRuleObjectVariable = "object"
+	// healthRuleCostLimit limits the cost of evaluating a single health rule expression to prevent expensive
+	// expressions from blocking the health controllers.
+	healthRuleCostLimit = 1000000
+	// maxCachedAnnotationPrograms is the maximum number of compiled expressions from annotations which are cached.
+	maxCachedAnnotationPrograms = 500
+)
+
+// HealthRuleChecker checks the health of objects with rules written in the Common Expression Language (CEL). The rules
+// are either configured per kind or specified per object via the resources.gardener.cloud/health-rule and
+// resources.gardener.cloud/progressing-rule annotations. All methods are safe to call on a nil HealthRuleChecker.
+type HealthRuleChecker struct {
+	env   *cel.Env
+	rules map[schema.GroupKind]*compiledHealthRule
+
+	lock               sync.Mutex
+	annotationPrograms map[string]*compiledExpression
+}
+
+type compiledHealthRule struct {
+	healthy, progressing, message *compiledExpression
+}
+
+type compiledExpression struct {
+	expression string
+	program    cel.Program
+	// presence is a program testing with has() whether all fields read by the expression exist in the object. It is nil
+	// if the expression does not read any fields of the object.
+	presence cel.Program
+}
+
+// NewHealthRuleChecker compiles the given health rules and returns a HealthRuleChecker for them.
+func NewHealthRuleChecker(rules []resourcemanagerconfigv1alpha1.HealthRule) (*HealthRuleChecker, error) {
+	env, err := cel.NewEnv(cel.Variable(healthRuleObjectVariable, cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	checker := &HealthRuleChecker{
+		env:                env,
+		rules:              make(map[schema.GroupKind]*compiledHealthRule, len(rules)),
+		annotationPrograms: make(map[string]*compiledExpression),
+	}
+
+	for _, rule := range rules {
+		groupKind := schema.GroupKind{Group: rule.Group, Kind: rule.Kind}
+		compiled := &compiledHealthRule{}
+
+		if rule.Healthy != nil {
+			if compiled.healthy, err = checker.compile(*rule.Healthy, cel.BoolType); err != nil {
+				return nil, fmt.Errorf("failed compiling healthy expression of rule for %s: %w", groupKind, err)
+			}
+		}
+		if rule.Progressing != nil {
+			if compiled.progressing, err = checker.compile(*rule.Progressing, cel.BoolType); err != nil {
+				return nil, fmt.Errorf("failed compiling progressing expression of rule for %s: %w", groupKind, err)
+			}
+		}
+		if rule.Message != nil {
+			if compiled.message, err = checker.compile(*rule.Message, cel.StringType); err != nil {
+				return nil, fmt.Errorf("failed compiling message expression of rule for %s: %w", groupKind, err)
+			}
+		}
+
+		checker.rules[groupKind] = compiled
+	}
+
+	return checker, nil
+}
+
+// HasHealthRule returns true if a health rule exists for objects of the given kind or objects with the given
+// annotations.
+func (h *HealthRuleChecker) HasHealthRule(groupKind schema.GroupKind, annotations map[string]string) bool {
+	if annotations[resourcesv1alpha1.HealthRule] != "" {
+		return true
+	}
+	return h != nil && h.rules[groupKind] != nil && h.rules[groupKind].healthy != nil
+}
+
+// HasProgressingRule returns true if a progressing rule exists for objects of the given kind or objects with the given
+// annotations.
+func (h *HealthRuleChecker) HasProgressingRule(groupKind schema.GroupKind, annotations map[string]string) bool {
+	if annotations[resourcesv1alpha1.ProgressingRule] != "" {
+		return true
+	}
+	return h != nil && h.rules[groupKind] != nil && h.rules[groupKind].progressing != nil
+}
+
+// ProgressingRuleGroupKinds returns the kinds for which progressing rules are configured.
+func (h *HealthRuleChecker) ProgressingRuleGroupKinds() []schema.GroupKind {
+	if h == nil {
+		return nil
+	}
+
+	var groupKinds []schema.GroupKind
+	for groupKind, rule := range h.rules {
+		if rule.progressing != nil {
+			groupKinds = append(groupKinds, groupKind)
+		}
+	}
+	return groupKinds
+}
+
+// CheckHealth checks whether the given object is healthy according to its health rule.
+// It returns a bool indicating whether the object was actually checked and an error if the object is unhealthy.
+// If the rule could not be evaluated, the returned bool is false.
+func (h *HealthRuleChecker) CheckHealth(groupKind schema.GroupKind, obj client.Object) (bool, error) {
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, nil
+	}
+
+	healthy, message, err := h.evaluate(groupKind, obj, resourcesv1alpha1.HealthRule, func(rule *compiledHealthRule) *compiledExpression { return rule.healthy }, false)
+	if err != nil || healthy == nil {
+		return false, err
+	}
+
+	if !*healthy {
+		if message == "" {
+			message = "health rule is not satisfied"
+		}
+		return true, errors.New(message)
+	}
+	return true, nil
+}
+
+// CheckHealthWithRules checks whether the given object of the given kind is healthy according to the built-in health
+// checks (see CheckHealth) and the given health rules.
+// It returns a bool indicating whether the object was actually checked and an error if any health check failed.
+func CheckHealthWithRules(healthRules *HealthRuleChecker, groupKind schema.GroupKind, obj client.Object) (bool, error) {
+	checked, err := CheckHealth(obj)
+	if err != nil {
+		return checked, err
+	}
+
+	ruleChecked, err := healthRules.CheckHealth(groupKind, obj)
+	return checked || ruleChecked, err
+}
+
+// CheckProgressing checks whether the given object is progressing according to its progressing rule.
+// It returns a bool indicating whether the object is progressing and a description why.
+func (h *HealthRuleChecker) CheckProgressing(groupKind schema.GroupKind, obj client.Object) (bool, string, error) {
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, "", nil
+	}
+
+	progressing, message, err := h.evaluate(groupKind, obj, resourcesv1alpha1.ProgressingRule, func(rule *compiledHealthRule) *compiledExpression { return rule.progressing }, true)
+	if err != nil || progressing == nil || !*progressing {
+		return false, "", err
+	}
+
+	if message == "" {
+		message = "progressing rule is satisfied"
+	}
+	return true, message, nil
+}
+
+// evaluate evaluates the rule from the given annotation or, if the annotation is not set, the configured rule for the
+// given kind. The message expression of the rule is only evaluated if the rule evaluates to messageWhen. It returns nil
+// if there is no rule for the object.
+func (h *HealthRuleChecker) evaluate(groupKind schema.GroupKind, obj client.Object, annotation string, ruleExpression func(*compiledHealthRule) *compiledExpression, messageWhen bool) (*bool, string, error) {
+	var (
+		expression, message *compiledExpression
+		err                 error
+	)
+
+	if value := obj.GetAnnotations()[annotation]; value != "" {
+		if expression, err = h.compileAnnotation(value); err != nil {
+			return nil, "", fmt.Errorf("failed compiling expression of annotation %s: %w", annotation, err)
+		}
+	} else if h != nil && h.rules[groupKind] != nil {
+		expression, message = ruleExpression(h.rules[groupKind]), h.rules[groupKind].message
+	}
+
+	if expression == nil {
+		return nil, "", nil
+	}
+
+	activation, err := objectActivation(obj)
+	if err != nil {
+		return nil, "", err
+	}
+
+	result, err := expression.eval(activation)
+	if err != nil {
+		if !expression.readsMissingField(activation) {
+			return nil, "", err
+		}
+		// Fields which are not set (yet) are omitted from the object, e.g., the status of a new object or fields with
+		// zero values of typed objects. A rule reading such a field is not satisfied.
+		result = false
+	}
+	value, ok := result.(bool)
+	if !ok {
+		return nil, "", fmt.Errorf("expression %q evaluated to %T instead of bool", expression.expression, result)
+	}
+
+	// the message is only relevant if the object is not in the expected state
+	if message == nil || value != messageWhen {
+		return &value, "", nil
+	}
+
+	result, err = message.eval(activation)
+	if err != nil {
+		if !message.readsMissingField(activation) {
+			return nil, "", err
+		}
+		// fall back to the default message
+		return &value, "", nil
+	}
+	text, ok := result.(string)
+	if !ok {
+		return nil, "", fmt.Errorf("expression %q evaluated to %T instead of string", message.expression, result)
+	}
+	return &value, text, nil
+}
+
+// defaultHealthRuleChecker is used for evaluating rules from annotations on a nil HealthRuleChecker. It is shared so
+// that the CEL environment is only created once and compiled expressions are cached.
+var defaultHealthRuleChecker = sync.OnceValues(func() (*HealthRuleChecker, error) {
+	return NewHealthRuleChecker(nil)
+})
+
+func (h *HealthRuleChecker) compileAnnotation(expression string) (*compiledExpression, error) {
+	if h == nil {
+		// Annotations can be used without configured rules, hence, compile them in a checker without rules.
+		var err error
+		if h, err = defaultHealthRuleChecker(); err != nil {
+			return nil, err
+		}
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if compiled, ok := h.annotationPrograms[expression]; ok {
+		return compiled, nil
+	}
+
+	compiled, err := h.compile(expression, cel.BoolType)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(h.annotationPrograms) >= maxCachedAnnotationPrograms {
+		clear(h.annotationPrograms)
+	}
+	h.annotationPrograms[expression] = compiled
+	return compiled, nil
+}
+
+func (h *HealthRuleChecker) compile(expression string, outputType *cel.Type) (*compiledExpression, error) {
+	ast, issues := h.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression %q must evaluate to %s but evaluates to %s", expression, outputType, ast.OutputType())
+	}
+
+	program, err := h.env.Program(ast, cel.CostLimit(healthRuleCostLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	compiled := &compiledExpression{expression: expression, program: program}
+
+	if presenceExpression := fieldPresenceExpression(ast); presenceExpression != "" {
+		presenceAST, issues := h.env.Compile(presenceExpression)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("failed compiling field presence test %q: %w", presenceExpression, issues.Err())
+		}
+		if compiled.presence, err = h.env.Program(presenceAST, cel.CostLimit(healthRuleCostLimit)); err != nil {
+			return nil, err
+		}
+	}
+
+	return compiled, nil
+}
+
+func (c *compiledExpression) eval(activation map[string]any) (any, error) {
+	result, _, err := c.program.Eval(activation)
+	if err != nil {
+		return nil, fmt.Errorf("failed evaluating expression %q: %w", c.expression, err)
+	}
+	return result.Value(), nil
+}
+
+// readsMissingField returns true if the expression reads a field which does not exist in the object of the given
+// activation. Fields which are not set (yet) are omitted from the object, hence, this is used for distinguishing such
+// evaluation errors from other errors.
+func (c *compiledExpression) readsMissingField(activation map[string]any) bool {
+	if c.presence == nil {
+		return false
+	}
+
+	result, _, err := c.presence.Eval(activation)
+	if err != nil {
+		return false
+	}
+	present, ok := result.Value().(bool)
+	return ok && !present
+}
+
+// presenceScope collects the has() tests for the fields read from a variable, i.e., from the object variable or from
+// the iteration variable of a comprehension (e.g., "c" in "object.status.conditions.exists(c, c.type == 'Ready')").
+type presenceScope struct {
+	variable string
+	tests    []string
+	seen     sets.Set[string]
+}
+
+func newPresenceScope(variable string) *presenceScope {
+	return &presenceScope{variable: variable, seen: sets.New[string]()}
+}
+
+func (p *presenceScope) add(test string) {
+	if !p.seen.Has(test) {
+		p.seen.Insert(test)
+		p.tests = append(p.tests, test)
+	}
+}
+
+// fieldPathElement is an element of the path of a field read by an expression. It is either selected by name
+// (object.status) or by a constant string index (object.metadata.labels["foo"]).
+type fieldPathElement struct {
+	name  string
+	index bool
+}
+
+// fieldPresenceExpression returns an expression which tests whether all fields read by the given expression exist in
+// the object, e.g., "has(object.status) && has(object.status.phase)" for "object.status.phase == 'Ready'". Fields read
+// from the elements of lists in the object are tested in a comprehension over the list. Only fields which are read via
+// selections or constant string indices are considered. It returns an empty string if the expression does not read any
+// such fields.
+func fieldPresenceExpression(checked *cel.Ast) string {
+	root := newPresenceScope(healthRuleObjectVariable)
+	collectPresenceTests(checked.NativeRep().Expr(), map[string]*presenceScope{healthRuleObjectVariable: root})
+	return strings.Join(root.tests, " && ")
+}
+
+func collectPresenceTests(e celast.Expr, scopes map[string]*presenceScope) {
+	if e.Kind() == celast.SelectKind && !e.AsSelect().IsTestOnly() || isConstantStringIndex(e) {
+		if scope, path, ok := fieldPath(e, scopes); ok {
+			for i := range path {
+				parent := fieldPathExpression(scope.variable, path[:i])
+				if path[i].index {
+					scope.add(strconv.Quote(path[i].name) + " in " + parent)
+				} else {
+					scope.add("has(" + parent + "." + path[i].name + ")")
+				}
+			}
+		}
+	}
+
+	switch e.Kind() {
+	case celast.SelectKind:
+		collectPresenceTests(e.AsSelect().Operand(), scopes)
+	case celast.CallKind:
+		if e.AsCall().IsMemberFunction() {
+			collectPresenceTests(e.AsCall().Target(), scopes)
+		}
+		for _, arg := range e.AsCall().Args() {
+			collectPresenceTests(arg, scopes)
+		}
+	case celast.ListKind:
+		for _, element := range e.AsList().Elements() {
+			collectPresenceTests(element, scopes)
+		}
+	case celast.MapKind:
+		for _, entry := range e.AsMap().Entries() {
+			collectPresenceTests(entry.AsMapEntry().Key(), scopes)
+			collectPresenceTests(entry.AsMapEntry().Value(), scopes)
+		}
+	case celast.StructKind:
+		for _, field := range e.AsStruct().Fields() {
+			collectPresenceTests(field.AsStructField().Value(), scopes)
+		}
+	case celast.ComprehensionKind:
+		collectComprehensionPresenceTests(e.AsComprehension(), scopes)
+	}
+}
+
+// collectComprehensionPresenceTests adds a test like "object.status.conditions.all(c, has(c.type))" for the fields read
+// from the iteration variable if the comprehension iterates over a field of the object.
+func collectComprehensionPresenceTests(comprehension celast.ComprehensionExpr, scopes map[string]*presenceScope) {
+	collectPresenceTests(comprehension.IterRange(), scopes)
+	collectPresenceTests(comprehension.AccuInit(), scopes)
+
+	rangeScope, rangePath, rangeIsField := fieldPath(comprehension.IterRange(), scopes)
+	// two-variable comprehensions are not supported by the all() macro
+	rangeIsField = rangeIsField && !comprehension.HasIterVar2()
+
+	var (
+		iterScope  = newPresenceScope(comprehension.IterVar())
+		loopScopes = make(map[string]*presenceScope, len(scopes)+1)
+	)
+
+	for variable, scope := range scopes {
+		loopScopes[variable] = scope
+	}
+	// the iteration and accumulation variables shadow other variables with the same names
+	delete(loopScopes, comprehension.IterVar())
+	delete(loopScopes, comprehension.IterVar2())
+	delete(loopScopes, comprehension.AccuVar())
+	if rangeIsField {
+		loopScopes[comprehension.IterVar()] = iterScope
+	}
+
+	collectPresenceTests(comprehension.LoopCondition(), loopScopes)
+	collectPresenceTests(comprehension.LoopStep(), loopScopes)
+
+	resultScopes := make(map[string]*presenceScope, len(scopes))
+	for variable, scope := range scopes {
+		resultScopes[variable] = scope
+	}
+	delete(resultScopes, comprehension.AccuVar())
+	collectPresenceTests(comprehension.Result(), resultScopes)
+
+	if rangeIsField && len(iterScope.tests) > 0 {
+		rangeScope.add(fieldPathExpression(rangeScope.variable, rangePath) + ".all(" + iterScope.variable + ", " + strings.Join(iterScope.tests, " && ") + ")")
+	}
+}
+
+// fieldPath returns the path of the field read by the given expression and the scope of the variable it is read from,
+// if the expression is only composed of selections and constant string indices of a variable in the given scopes.
+func fieldPath(e celast.Expr, scopes map[string]*presenceScope) (*presenceScope, []fieldPathElement, bool) {
+	switch {
+	case e.Kind() == celast.IdentKind:
+		scope, ok := scopes[e.AsIdent()]
+		return scope, nil, ok
+	case e.Kind() == celast.SelectKind && !e.AsSelect().IsTestOnly():
+		scope, path, ok := fieldPath(e.AsSelect().Operand(), scopes)
+		return scope, append(path, fieldPathElement{name: e.AsSelect().FieldName()}), ok
+	case isConstantStringIndex(e):
+		scope, path, ok := fieldPath(e.AsCall().Args()[0], scopes)
+		return scope, append(path, fieldPathElement{name: e.AsCall().Args()[1].AsLiteral().Value().(string), index: true}), ok
+	default:
+		return nil, nil, false
+	}
+}
+
+func isConstantStringIndex(e celast.Expr) bool {
+	if e.Kind() != celast.CallKind || e.AsCall().FunctionName() != operators.Index || len(e.AsCall().Args()) != 2 {
+		return false
+	}
+
+	key := e.AsCall().Args()[1]
+	if key.Kind() != celast.LiteralKind {
+		return false
+	}
+	_, ok := key.AsLiteral().Value().(string)
+	return ok
+}
+
+func fieldPathExpression(variable string, path []fieldPathElement) string {
+	expression := variable
+	for _, element := range path {
+		if element.index {
+			expression += "[" + strconv.Quote(element.name) + "]"
+		} else {
+			expression += "." + element.name
+		}
+	}
+	return expression
+}
+
+func objectActivation(obj client.Object) (map[string]any, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return map[string]any{healthRuleObjectVariable: u.Object}, nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting object to unstructured: %w", err)
+	}
+	return map[string]any{healthRuleObjectVariable: content}, nil
+}
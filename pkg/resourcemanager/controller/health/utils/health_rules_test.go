@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+var _ = Describe("HealthRuleChecker", func() {
+	var (
+		fooGroupKind = schema.GroupKind{Group: "example.com", Kind: "Foo"}
+		barGroupKind = schema.GroupKind{Group: "example.com", Kind: "Bar"}
+
+		checker *HealthRuleChecker
+		obj     *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		var err error
+		checker, err = NewHealthRuleChecker([]resourcemanagerconfigv1alpha1.HealthRule{
+			{
+				Group:       "example.com",
+				Kind:        "Foo",
+				Healthy:     ptr.To("has(object.status) && object.status.phase == 'Ready'"),
+				Progressing: ptr.To("object.metadata.generation != object.status.observedGeneration"),
+				Message:     ptr.To("'phase is ' + object.status.phase"),
+			},
+			{
+				Group:   "example.com",
+				Kind:    "Bar",
+				Healthy: ptr.To("object.spec.replicas > 0"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		obj = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata":   map[string]any{"name": "foo", "generation": int64(2)},
+			"status":     map[string]any{"phase": "Ready", "observedGeneration": int64(2)},
+		}}
+	})
+
+	Describe("#NewHealthRuleChecker", func() {
+		It("should fail if an expression cannot be compiled", func() {
+			_, err := NewHealthRuleChecker([]resourcemanagerconfigv1alpha1.HealthRule{{Kind: "Foo", Healthy: ptr.To("object.status.")}})
+			Expect(err).To(MatchError(ContainSubstring("failed compiling healthy expression of rule for Foo")))
+		})
+
+		It("should fail if an expression does not evaluate to the expected type", func() {
+			_, err := NewHealthRuleChecker([]resourcemanagerconfigv1alpha1.HealthRule{{Kind: "Foo", Progressing: ptr.To("'foo'")}})
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to bool")))
+		})
+	})
+
+	Describe("#HasHealthRule", func() {
+		It("should return true if a rule is configured for the kind", func() {
+			Expect(checker.HasHealthRule(fooGroupKind, nil)).To(BeTrue())
+			Expect(checker.HasHealthRule(barGroupKind, nil)).To(BeTrue())
+		})
+
+		It("should return true if the rule annotation is set", func() {
+			Expect(checker.HasHealthRule(schema.GroupKind{Kind: "ConfigMap"}, map[string]string{resourcesv1alpha1.HealthRule: "true"})).To(BeTrue())
+		})
+
+		It("should return false if there is no rule", func() {
+			Expect(checker.HasHealthRule(schema.GroupKind{Kind: "ConfigMap"}, nil)).To(BeFalse())
+			Expect((*HealthRuleChecker)(nil).HasHealthRule(fooGroupKind, nil)).To(BeFalse())
+		})
+	})
+
+	Describe("#HasProgressingRule", func() {
+		It("should return true if a rule is configured for the kind", func() {
+			Expect(checker.HasProgressingRule(fooGroupKind, nil)).To(BeTrue())
+		})
+
+		It("should return true if the rule annotation is set", func() {
+			Expect(checker.HasProgressingRule(barGroupKind, map[string]string{resourcesv1alpha1.ProgressingRule: "false"})).To(BeTrue())
+		})
+
+		It("should return false if there is no rule", func() {
+			Expect(checker.HasProgressingRule(barGroupKind, nil)).To(BeFalse())
+		})
+	})
+
+	Describe("#ProgressingRuleGroupKinds", func() {
+		It("should return the kinds with progressing rules", func() {
+			Expect(checker.ProgressingRuleGroupKinds()).To(ConsistOf(fooGroupKind))
+		})
+	})
+
+	Describe("#CheckHealth", func() {
+		It("should return no error if the object is healthy", func() {
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the message if the object is unhealthy", func() {
+			Expect(unstructured.SetNestedField(obj.Object, "Pending", "status", "phase")).To(Succeed())
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("phase is Pending"))
+		})
+
+		It("should return a default message if the rule has no message", func() {
+			obj.Object["spec"] = map[string]any{"replicas": int64(0)}
+
+			checked, err := checker.CheckHealth(barGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+
+		It("should prefer the rule from the annotation", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "object.metadata.name == 'bar'"})
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+
+		It("should evaluate the rule from the annotation without configured rules", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "object.metadata.name == 'foo'"})
+
+			checked, err := (*HealthRuleChecker)(nil).CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should evaluate rules for typed objects", func() {
+			checker, err := NewHealthRuleChecker([]resourcemanagerconfigv1alpha1.HealthRule{{
+				Kind:    "Service",
+				Healthy: ptr.To("object.spec.type == 'ClusterIP'"),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			service := &corev1.Service{Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}}
+
+			checked, err := checker.CheckHealth(schema.GroupKind{Kind: "Service"}, service)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not check the object if there is no rule", func() {
+			checked, err := checker.CheckHealth(schema.GroupKind{Kind: "ConfigMap"}, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check the object if health checks are skipped", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.SkipHealthCheck: "true"})
+			Expect(unstructured.SetNestedField(obj.Object, "Pending", "status", "phase")).To(Succeed())
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if the rule cannot be evaluated", func() {
+			obj.Object["spec"] = map[string]any{"replicas": "foo"}
+
+			checked, err := checker.CheckHealth(barGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("failed evaluating expression")))
+		})
+
+		It("should consider the rule as not satisfied if it reads a field which does not exist", func() {
+			checked, err := checker.CheckHealth(barGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+
+		It("should consider the rule as not satisfied if it reads a map key which does not exist", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "object.metadata.labels['foo'] == 'bar'"})
+			obj.SetLabels(map[string]string{"baz": "bar"})
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+
+		It("should consider the rule as not satisfied if it reads a field of a list element which does not exist", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "object.status.conditions.all(c, c.status == 'True')"})
+			Expect(unstructured.SetNestedSlice(obj.Object, []any{
+				map[string]any{"type": "Ready", "status": "True"},
+				map[string]any{"type": "Available"},
+			}, "status", "conditions")).To(Succeed())
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+
+		It("should evaluate rules which test the presence of fields themselves", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "!has(object.spec) || object.spec.replicas > 0"})
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the default message if the message reads a field which does not exist", func() {
+			delete(obj.Object, "status")
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+
+		It("should return an error if the rule from the annotation cannot be compiled", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "object.metadata.name =="})
+
+			checked, err := checker.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).To(MatchError(ContainSubstring("failed compiling expression of annotation")))
+		})
+	})
+
+	Describe("#CheckHealthWithRules", func() {
+		It("should consider the built-in health checks", func() {
+			deployment := &appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionFalse,
+			}}}}
+
+			checked, err := CheckHealthWithRules(checker, appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), deployment)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should consider the health rules", func() {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{resourcesv1alpha1.HealthRule: "object.metadata.name == 'foo'"}},
+				Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+					Type:   appsv1.DeploymentAvailable,
+					Status: corev1.ConditionTrue,
+				}}},
+			}
+
+			checked, err := CheckHealthWithRules(checker, appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), deployment)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError("health rule is not satisfied"))
+		})
+	})
+
+	Describe("#CheckProgressing", func() {
+		It("should return false if the object is not progressing", func() {
+			progressing, description, err := checker.CheckProgressing(fooGroupKind, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+			Expect(description).To(BeEmpty())
+		})
+
+		It("should return the message if the object is progressing", func() {
+			Expect(unstructured.SetNestedField(obj.Object, int64(1), "status", "observedGeneration")).To(Succeed())
+
+			progressing, description, err := checker.CheckProgressing(fooGroupKind, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(description).To(Equal("phase is Ready"))
+		})
+
+		It("should prefer the rule from the annotation", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.ProgressingRule: "true"})
+
+			progressing, description, err := checker.CheckProgressing(fooGroupKind, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeTrue())
+			Expect(description).To(Equal("progressing rule is satisfied"))
+		})
+
+		It("should return false if the rule reads a field which does not exist", func() {
+			delete(obj.Object, "status")
+
+			progressing, _, err := checker.CheckProgressing(fooGroupKind, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+		})
+
+		It("should return false if there is no rule", func() {
+			progressing, _, err := checker.CheckProgressing(barGroupKind, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progressing).To(BeFalse())
+		})
+	})
+})
@@ -6,6 +6,7 @@ package managedresource
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +26,7 @@ import (
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 // ControllerName is the name of the controller.
@@ -50,6 +52,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 	if r.RequeueAfterOnDeletionPending == nil {
 		r.RequeueAfterOnDeletionPending = ptr.To(5 * time.Second)
 	}
+	if r.OCIRegistry == nil {
+		r.OCIRegistry = oci.NewHelmRegistry(r.SourceClient)
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
@@ -85,6 +90,16 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 				),
 			)),
 		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.MapConfigMapToManagedResources(
+				r.ClassFilter,
+				predicate.Or(
+					resourcemanagerpredicate.NotIgnored(),
+					predicateutils.IsDeleting(),
+				),
+			)),
+		).
 		Complete(reconcilerutils.OperationAnnotationWrapper(
 			mgr,
 			func() client.Object { return &resourcesv1alpha1.ManagedResource{} },
@@ -95,37 +110,71 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, sourceCluster, targetClus
 // MapSecretToManagedResources maps secrets to relevant ManagedResources.
 func (r *Reconciler) MapSecretToManagedResources(managedResourcePredicates ...predicate.Predicate) handler.MapFunc {
 	return func(ctx context.Context, obj client.Object) []reconcile.Request {
-		if obj == nil {
-			return nil
-		}
-
 		secret, ok := obj.(*corev1.Secret)
 		if !ok {
 			return nil
 		}
 
-		managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
-		if err := r.SourceClient.List(ctx, managedResourceList, client.InNamespace(secret.Namespace)); err != nil {
+		return r.mapToReferencingManagedResources(ctx, secret, func(mr *resourcesv1alpha1.ManagedResource) []string {
+			names := referencedNames(mr.Spec.SecretRefs)
+			for _, chunkedSource := range mr.Spec.ChunkedSources {
+				if chunkedSource.Kind == chunkedSourceKindSecret {
+					names = append(names, chunkedSource.Names...)
+				}
+			}
+			return names
+		}, managedResourcePredicates...)
+	}
+}
+
+// MapConfigMapToManagedResources maps configmaps to relevant ManagedResources.
+func (r *Reconciler) MapConfigMapToManagedResources(managedResourcePredicates ...predicate.Predicate) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		configMap, ok := obj.(*corev1.ConfigMap)
+		if !ok {
 			return nil
 		}
 
-		var requests []reconcile.Request
-		for _, mr := range managedResourceList.Items {
-			if !predicateutils.EvalGeneric(&mr, managedResourcePredicates...) {
-				continue
-			}
-
-			for _, secretRef := range mr.Spec.SecretRefs {
-				if secretRef.Name == secret.Name {
-					requests = append(requests, reconcile.Request{
-						NamespacedName: types.NamespacedName{
-							Namespace: mr.Namespace,
-							Name:      mr.Name,
-						},
-					})
+		return r.mapToReferencingManagedResources(ctx, configMap, func(mr *resourcesv1alpha1.ManagedResource) []string {
+			names := referencedNames(mr.Spec.ConfigMapRefs)
+			for _, chunkedSource := range mr.Spec.ChunkedSources {
+				if chunkedSource.Kind == chunkedSourceKindConfigMap {
+					names = append(names, chunkedSource.Names...)
 				}
 			}
+			return names
+		}, managedResourcePredicates...)
+	}
+}
+
+func (r *Reconciler) mapToReferencingManagedResources(ctx context.Context, obj client.Object, namesReferencedBy func(*resourcesv1alpha1.ManagedResource) []string, managedResourcePredicates ...predicate.Predicate) []reconcile.Request {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := r.SourceClient.List(ctx, managedResourceList, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, mr := range managedResourceList.Items {
+		if !predicateutils.EvalGeneric(&mr, managedResourcePredicates...) {
+			continue
+		}
+
+		if slices.Contains(namesReferencedBy(&mr), obj.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: mr.Namespace,
+					Name:      mr.Name,
+				},
+			})
 		}
-		return requests
 	}
+	return requests
+}
+
+func referencedNames(refs []corev1.LocalObjectReference) []string {
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		names = append(names, ref.Name)
+	}
+	return names
 }
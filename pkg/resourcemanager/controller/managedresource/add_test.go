@@ -122,4 +122,130 @@ var _ = Describe("#MapSecretToManagedResources", func() {
 			}},
 		))
 	})
+	It("should correctly map to ManagedResources that reference the secret in a chunked source", func() {
+		mr := resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mr",
+				Namespace: secret.Namespace,
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class: ptr.To(filter.ResourceClass()),
+				ChunkedSources: []resourcesv1alpha1.ChunkedSource{
+					{Kind: "ConfigMap", Names: []string{secret.Name}, Key: "data"},
+					{Kind: "Secret", Names: []string{"chunk-0", secret.Name}, Key: "data"},
+				},
+			},
+		}
+
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResourceList{}), client.InNamespace(secret.Namespace)).
+			DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOption) error {
+				list.(*resourcesv1alpha1.ManagedResourceList).Items = []resourcesv1alpha1.ManagedResource{mr}
+				return nil
+			})
+
+		requests := m(ctx, secret)
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      mr.Name,
+				Namespace: mr.Namespace,
+			}},
+		))
+	})
+})
+
+var _ = Describe("#MapConfigMapToManagedResources", func() {
+	var (
+		ctx       = context.TODO()
+		c         *mockclient.MockClient
+		ctrl      *gomock.Controller
+		m         handler.MapFunc
+		configMap *corev1.ConfigMap
+		filter    *predicate.ClassFilter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		c = mockclient.NewMockClient(ctrl)
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mr-configmap",
+				Namespace: "mr-namespace",
+			},
+		}
+
+		filter = predicate.NewClassFilter("seed")
+
+		m = (&Reconciler{SourceClient: c}).MapConfigMapToManagedResources(filter)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should do nothing, if Object is not a ConfigMap", func() {
+		requests := m(ctx, &corev1.Secret{})
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should do nothing, if list fails", func() {
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResourceList{}), client.InNamespace(configMap.Namespace)).
+			Return(errors.New("fake"))
+
+		requests := m(ctx, configMap)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should do nothing, if there are no ManagedResources referencing the configmap", func() {
+		mr := resourcesv1alpha1.ManagedResource{
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class:      ptr.To(filter.ResourceClass()),
+				SecretRefs: []corev1.LocalObjectReference{{Name: configMap.Name}},
+			},
+		}
+
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResourceList{}), client.InNamespace(configMap.Namespace)).
+			DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOption) error {
+				list.(*resourcesv1alpha1.ManagedResourceList).Items = []resourcesv1alpha1.ManagedResource{mr}
+				return nil
+			})
+
+		requests := m(ctx, configMap)
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should correctly map to ManagedResources that reference the configmap", func() {
+		mr1 := resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mr1",
+				Namespace: configMap.Namespace,
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class:         ptr.To(filter.ResourceClass()),
+				ConfigMapRefs: []corev1.LocalObjectReference{{Name: configMap.Name}},
+			},
+		}
+		mr2 := resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mr2",
+				Namespace: configMap.Namespace,
+			},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				Class:          ptr.To(filter.ResourceClass()),
+				ChunkedSources: []resourcesv1alpha1.ChunkedSource{{Kind: "ConfigMap", Names: []string{configMap.Name}, Key: "data"}},
+			},
+		}
+
+		c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&resourcesv1alpha1.ManagedResourceList{}), client.InNamespace(configMap.Namespace)).
+			DoAndReturn(func(_ context.Context, list runtime.Object, _ ...client.ListOption) error {
+				list.(*resourcesv1alpha1.ManagedResourceList).Items = []resourcesv1alpha1.ManagedResource{mr1, mr2}
+				return nil
+			})
+
+		requests := m(ctx, configMap)
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: mr1.Name, Namespace: mr1.Namespace}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: mr2.Name, Namespace: mr2.Namespace}},
+		))
+	})
 })
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"gomodules.xyz/jsonpatch/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// ChangeType is the type of a pending change of an object of a ManagedResource.
+type ChangeType string
+
+const (
+	// ChangeTypeCreate is the type of changes which create an object.
+	ChangeTypeCreate ChangeType = "Create"
+	// ChangeTypeUpdate is the type of changes which update an object.
+	ChangeTypeUpdate ChangeType = "Update"
+	// ChangeTypeDelete is the type of changes which delete an object.
+	ChangeTypeDelete ChangeType = "Delete"
+)
+
+// Preview contains the changes which the next reconciliation of a ManagedResource would apply to the target cluster.
+type Preview struct {
+	// Changes are the pending changes of the objects of the ManagedResource.
+	Changes []ObjectChange `json:"changes"`
+	// DecodingErrors are the errors which occurred while decoding the objects of the ManagedResource. Objects which
+	// cannot be decoded are not part of the changes.
+	DecodingErrors []string `json:"decodingErrors,omitempty"`
+}
+
+// ObjectChange is a pending change of an object of a ManagedResource.
+type ObjectChange struct {
+	// Type is the type of the change.
+	Type ChangeType `json:"type"`
+	// Object identifies the object in the format `<apiVersion>/<kind>/<namespace>/<name>`.
+	Object string `json:"object"`
+	// Diff is the JSON patch from the current to the desired state of the object. It is empty for deletions.
+	Diff []jsonpatch.Operation `json:"diff,omitempty"`
+	// Conflicts are the conflicts with fields owned by other field managers which prevent applying the object via
+	// server-side apply.
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// String returns a human-readable representation of the preview.
+func (p *Preview) String() string {
+	var out strings.Builder
+
+	if len(p.Changes) == 0 {
+		out.WriteString("No changes.\n")
+	}
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&out, "%s %s\n", strings.ToLower(string(change.Type)), change.Object)
+
+		for _, operation := range change.Diff {
+			fmt.Fprintf(&out, "  %s %s", operation.Operation, operation.Path)
+			if operation.Value != nil {
+				value, err := json.Marshal(operation.Value)
+				if err != nil {
+					value = []byte(fmt.Sprintf("%v", operation.Value))
+				}
+				fmt.Fprintf(&out, ": %s", value)
+			}
+			out.WriteString("\n")
+		}
+
+		for _, conflict := range change.Conflicts {
+			fmt.Fprintf(&out, "  conflict: %s\n", conflict)
+		}
+	}
+
+	for _, decodingError := range p.DecodingErrors {
+		fmt.Fprintf(&out, "decoding error: %s\n", decodingError)
+	}
+
+	return out.String()
+}
+
+// Preview computes the changes which the next reconciliation of the given ManagedResource would apply to the target
+// cluster. It decodes the objects from the sources of the ManagedResource and determines the objects to be created,
+// updated and deleted in the same way as the reconciliation, but does not change any object.
+// Objects applied via server-side apply are compared with the result of a server-side dry-run. The migration of managed
+// fields of objects updated in the `Merge` apply mode before is not considered.
+func (r *Reconciler) Preview(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource) (*Preview, error) {
+	sources, err := r.readContentSources(ctx, mr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		equivalences           = NewEquivalences(mr.Spec.Equivalences...)
+		existingResourcesIndex = NewObjectIndex(mr.Status.Resources, equivalences)
+		origin                 = resourcesv1alpha1helper.OriginForManagedResource(r.ClusterID, mr)
+		decoded                = r.decodeObjects(log, mr, sources, existingResourcesIndex)
+		labelsToInject         = mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+		preview                = &Preview{Changes: []ObjectChange{}}
+	)
+
+	for _, dErr := range decoded.decodingErrors {
+		preview.DecodingErrors = append(preview.DecodingErrors, dErr.StringShort())
+	}
+
+	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+	}
+
+	for _, obj := range sortByKind(decoded.objects) {
+		var (
+			change             *ObjectChange
+			err                error
+			scaledHorizontally = isScaled(obj.obj, horizontallyScaledObjects, equivalences)
+		)
+
+		if obj.serverSideApply {
+			change, err = r.previewServerSideApply(ctx, origin, obj, labelsToInject, scaledHorizontally)
+		} else {
+			change, err = r.previewMerge(ctx, origin, obj, labelsToInject, scaledHorizontally)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if change != nil {
+			preview.Changes = append(preview.Changes, *change)
+		}
+	}
+
+	deletions, err := r.previewDeletions(ctx, existingResourcesIndex)
+	if err != nil {
+		return nil, err
+	}
+	preview.Changes = append(preview.Changes, deletions...)
+
+	return preview, nil
+}
+
+// previewMerge computes the change of the given object in the `Merge` apply mode. It runs the same create or update
+// logic as the reconciliation with a client which does not execute any creation or update.
+func (r *Reconciler) previewMerge(ctx context.Context, origin string, obj object, labelsToInject map[string]string, scaledHorizontally bool) (*ObjectChange, error) {
+	var (
+		resource = unstructuredToString(obj.obj)
+		current  = obj.obj.DeepCopy()
+		existing *unstructured.Unstructured
+	)
+
+	operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, &noWriteClient{Client: r.TargetClient}, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
+		existing = current.DeepCopy()
+		return mergeIntoCurrent(origin, obj, current, labelsToInject, scaledHorizontally)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error during preview of object %q: %w", resource, err)
+	}
+
+	switch operationResult {
+	case controllerutil.OperationResultCreated:
+		diff, err := objectDiff(nil, current)
+		if err != nil {
+			return nil, fmt.Errorf("error computing diff of object %q: %w", resource, err)
+		}
+		return &ObjectChange{Type: ChangeTypeCreate, Object: resource, Diff: diff}, nil
+	case controllerutil.OperationResultUpdated:
+		diff, err := objectDiff(existing, current)
+		if err != nil {
+			return nil, fmt.Errorf("error computing diff of object %q: %w", resource, err)
+		}
+		// objects are always updated if configured, but only changed objects are relevant for the preview
+		if len(diff) == 0 {
+			return nil, nil
+		}
+		return &ObjectChange{Type: ChangeTypeUpdate, Object: resource, Diff: diff}, nil
+	}
+
+	return nil, nil
+}
+
+// noWriteClient is a client which does not execute any creation or update.
+type noWriteClient struct {
+	client.Client
+}
+
+func (c *noWriteClient) Create(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+	return nil
+}
+
+func (c *noWriteClient) Update(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+	return nil
+}
+
+// previewServerSideApply computes the change of the given object in the `ServerSideApply` apply mode. Existing objects
+// are applied in dry-run mode to determine the desired state.
+func (r *Reconciler) previewServerSideApply(ctx context.Context, origin string, obj object, labelsToInject map[string]string, preserveReplicas bool) (*ObjectChange, error) {
+	var (
+		desired  = obj.obj
+		resource = unstructuredToString(desired)
+		current  = &unstructured.Unstructured{}
+	)
+
+	current.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting object %q: %w", resource, err)
+		}
+
+		if err := prepareForServerSideApply(origin, desired, nil, labelsToInject, preserveReplicas); err != nil {
+			return nil, err
+		}
+
+		diff, err := objectDiff(nil, desired)
+		if err != nil {
+			return nil, fmt.Errorf("error computing diff of object %q: %w", resource, err)
+		}
+		return &ObjectChange{Type: ChangeTypeCreate, Object: resource, Diff: diff}, nil
+	}
+
+	// if the ignore annotation is set to true, the object is only created but never updated
+	if ignore(desired) {
+		return nil, nil
+	}
+
+	if err := prepareForServerSideApply(origin, desired, current, labelsToInject, preserveReplicas); err != nil {
+		return nil, err
+	}
+
+	if err := r.TargetClient.Patch(ctx, desired, client.Apply, client.FieldOwner(fieldManagerForClass(r.ClassFilter.ResourceClass())), client.DryRunAll); err != nil {
+		if conflicts := fieldOwnershipConflicts(err); len(conflicts) > 0 {
+			return &ObjectChange{Type: ChangeTypeUpdate, Object: resource, Conflicts: conflicts}, nil
+		}
+		return nil, fmt.Errorf("error during dry-run apply of object %q: %w", resource, err)
+	}
+
+	diff, err := objectDiff(withoutServerManagedFields(current), withoutServerManagedFields(desired))
+	if err != nil {
+		return nil, fmt.Errorf("error computing diff of object %q: %w", resource, err)
+	}
+	if len(diff) == 0 {
+		return nil, nil
+	}
+	return &ObjectChange{Type: ChangeTypeUpdate, Object: resource, Diff: diff}, nil
+}
+
+// previewDeletions computes the deletions of the objects in the given index which are no longer part of the
+// ManagedResource. Objects which are kept by the reconciliation are not considered.
+func (r *Reconciler) previewDeletions(ctx context.Context, index *objectIndex) ([]ObjectChange, error) {
+	var changes []ObjectChange
+
+	for _, oldResource := range index.Objects() {
+		if index.Found(oldResource) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(oldResource.APIVersion)
+		obj.SetKind(oldResource.Kind)
+		obj.SetNamespace(oldResource.Namespace)
+		obj.SetName(oldResource.Name)
+
+		if err := r.TargetClient.Get(ctx, client.ObjectKey{Namespace: oldResource.Namespace, Name: oldResource.Name}, obj); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error getting object %q: %w", unstructuredToString(obj), err)
+		}
+
+		if keepObject(obj) || (r.GarbageCollectorActivated && isGarbageCollectableResource(obj)) {
+			continue
+		}
+
+		changes = append(changes, ObjectChange{Type: ChangeTypeDelete, Object: unstructuredToString(obj)})
+	}
+
+	// the index is a map, sort the deletions to keep a consistent ordering
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Object < changes[j].Object })
+	return changes, nil
+}
+
+// objectDiff returns the JSON patch from the current to the desired object sorted by the paths. The current object is
+// nil for objects which do not exist yet.
+func objectDiff(current, desired *unstructured.Unstructured) ([]jsonpatch.Operation, error) {
+	currentJSON := []byte("{}")
+	if current != nil {
+		var err error
+		if currentJSON, err = current.MarshalJSON(); err != nil {
+			return nil, err
+		}
+	}
+
+	desiredJSON, err := desired.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := jsonpatch.CreatePatch(currentJSON, desiredJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Stable(jsonpatch.ByPath(diff))
+	return diff, nil
+}
+
+// withoutServerManagedFields returns a copy of the given object without the fields which are changed by the
+// kube-apiserver on every update.
+func withoutServerManagedFields(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	return obj
+}
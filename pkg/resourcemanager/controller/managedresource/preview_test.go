@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/predicate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Preview", func() {
+	const manifests = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unchanged
+  namespace: default
+data:
+  foo: bar
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: changed
+  namespace: default
+data:
+  foo: bar
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: new
+  namespace: default
+data:
+  foo: bar
+`
+
+	var (
+		ctx          = context.Background()
+		sourceClient client.Client
+		targetClient client.Client
+		r            *Reconciler
+		mr           *resourcesv1alpha1.ManagedResource
+
+		managedMetadata = func(name string) metav1.ObjectMeta {
+			return metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{resourcesv1alpha1.ManagedBy: "gardener"},
+				Annotations: map[string]string{
+					descriptionAnnotation:              descriptionAnnotationText,
+					resourcesv1alpha1.OriginAnnotation: "garden/mr",
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		sourceClient = fakeclient.NewClientBuilder().Build()
+		targetClient = fakeclient.NewClientBuilder().Build()
+
+		r = &Reconciler{
+			SourceClient:     sourceClient,
+			TargetClient:     targetClient,
+			TargetScheme:     kubernetesscheme.Scheme,
+			TargetRESTMapper: targetClient.RESTMapper(),
+			Config:           resourcemanagerconfigv1alpha1.ManagedResourceControllerConfig{ManagedByLabelValue: ptr.To("gardener")},
+			ClassFilter:      predicate.NewClassFilter(""),
+		}
+
+		Expect(sourceClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"},
+			Data:       map[string][]byte{"objects.yaml": []byte(manifests)},
+		})).To(Succeed())
+
+		mr = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"},
+			Spec: resourcesv1alpha1.ManagedResourceSpec{
+				SecretRefs: []corev1.LocalObjectReference{{Name: "mr"}},
+			},
+			Status: resourcesv1alpha1.ManagedResourceStatus{
+				Resources: []resourcesv1alpha1.ObjectReference{
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "unchanged"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "changed"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "old"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "kept"}},
+					{ObjectReference: corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "gone"}},
+				},
+			},
+		}
+
+		unchanged := &corev1.ConfigMap{ObjectMeta: managedMetadata("unchanged"), Data: map[string]string{"foo": "bar"}}
+		changed := &corev1.ConfigMap{ObjectMeta: managedMetadata("changed"), Data: map[string]string{"foo": "baz"}}
+		old := &corev1.ConfigMap{ObjectMeta: managedMetadata("old")}
+		kept := &corev1.ConfigMap{ObjectMeta: managedMetadata("kept")}
+		kept.Annotations[resourcesv1alpha1.KeepObject] = "true"
+
+		for _, obj := range []client.Object{unchanged, changed, old, kept} {
+			Expect(targetClient.Create(ctx, obj)).To(Succeed())
+		}
+	})
+
+	It("should compute the pending changes without changing any object", func() {
+		preview, err := r.Preview(ctx, logr.Discard(), mr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(preview.DecodingErrors).To(BeEmpty())
+		Expect(preview.Changes).To(HaveLen(3))
+
+		Expect(preview.Changes[0].Type).To(Equal(ChangeTypeUpdate))
+		Expect(preview.Changes[0].Object).To(Equal("v1/ConfigMap/default/changed"))
+		Expect(preview.Changes[0].Diff).To(ConsistOf(jsonpatch.NewOperation("replace", "/data/foo", "bar")))
+
+		Expect(preview.Changes[1].Type).To(Equal(ChangeTypeCreate))
+		Expect(preview.Changes[1].Object).To(Equal("v1/ConfigMap/default/new"))
+		Expect(preview.Changes[1].Diff).To(ContainElement(jsonpatch.NewOperation("add", "/data", map[string]any{"foo": "bar"})))
+
+		Expect(preview.Changes[2]).To(Equal(ObjectChange{Type: ChangeTypeDelete, Object: "v1/ConfigMap/default/old"}))
+
+		configMap := &corev1.ConfigMap{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "changed"}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"foo": "baz"}))
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "new"}, configMap)).To(BeNotFoundError())
+	})
+
+	It("should report objects which cannot be decoded", func() {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: "garden"}}
+		Expect(sourceClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+		secret.Data["invalid.yaml"] = []byte("foo")
+		Expect(sourceClient.Update(ctx, secret)).To(Succeed())
+
+		preview, err := r.Preview(ctx, logr.Discard(), mr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.DecodingErrors).To(ConsistOf(ContainSubstring("Could not decode resource at index 0 in 'invalid.yaml' in secret 'garden/mr'")))
+	})
+
+	It("should compute the creation of objects applied via server-side apply", func() {
+		mr.Spec.ApplyMode = ptr.To(resourcesv1alpha1.ApplyModeServerSideApply)
+		Expect(targetClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "changed", Namespace: "default"}})).To(Succeed())
+		Expect(targetClient.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unchanged", Namespace: "default"}})).To(Succeed())
+
+		preview, err := r.Preview(ctx, logr.Discard(), mr)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(preview.Changes).To(HaveLen(4))
+		for _, change := range preview.Changes[:3] {
+			Expect(change.Type).To(Equal(ChangeTypeCreate))
+			Expect(change.Diff).To(ContainElement(jsonpatch.NewOperation("add", "/data", map[string]any{"foo": "bar"})))
+		}
+		Expect(preview.Changes[3].Type).To(Equal(ChangeTypeDelete))
+	})
+
+	Describe("#String", func() {
+		It("should return a human-readable representation", func() {
+			preview := &Preview{
+				Changes: []ObjectChange{
+					{Type: ChangeTypeCreate, Object: "v1/ConfigMap/default/new", Diff: []jsonpatch.Operation{jsonpatch.NewOperation("add", "/data", map[string]any{"foo": "bar"})}},
+					{Type: ChangeTypeUpdate, Object: "v1/ConfigMap/default/changed", Diff: []jsonpatch.Operation{jsonpatch.NewOperation("remove", "/data/foo", nil)}},
+					{Type: ChangeTypeUpdate, Object: "v1/ConfigMap/default/conflict", Conflicts: []string{"conflict with \"kubectl\": .data.foo"}},
+					{Type: ChangeTypeDelete, Object: "v1/ConfigMap/default/old"},
+				},
+				DecodingErrors: []string{"Could not decode resource"},
+			}
+
+			Expect(preview.String()).To(Equal(`create v1/ConfigMap/default/new
+  add /data: {"foo":"bar"}
+update v1/ConfigMap/default/changed
+  remove /data/foo
+update v1/ConfigMap/default/conflict
+  conflict: conflict with "kubectl": .data.foo
+delete v1/ConfigMap/default/old
+decoding error: Could not decode resource
+`))
+		})
+
+		It("should state that there are no changes", func() {
+			Expect((&Preview{}).String()).To(Equal("No changes.\n"))
+		})
+	})
+})
@@ -5,7 +5,6 @@
 package managedresource
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -18,7 +17,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/andybalholm/brotli"
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
 	appsv1 "k8s.io/api/apps/v1"
@@ -54,6 +52,7 @@ import (
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	utilclient "github.com/gardener/gardener/pkg/utils/kubernetes/client"
+	"github.com/gardener/gardener/pkg/utils/oci"
 )
 
 var (
@@ -73,6 +72,7 @@ type Reconciler struct {
 	ClusterID                     string
 	GarbageCollectorActivated     bool
 	RequeueAfterOnDeletionPending *time.Duration
+	OCIRegistry                   oci.Interface
 }
 
 // Reconcile manages the resources reference by ManagedResources.
@@ -127,18 +127,158 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	var (
-		newResourcesObjects          []object
-		newResourcesObjectReferences []resourcesv1alpha1.ObjectReference
-		orphanedObjectReferences     []resourcesv1alpha1.ObjectReference
-
 		equivalences           = NewEquivalences(mr.Spec.Equivalences...)
 		existingResourcesIndex = NewObjectIndex(mr.Status.Resources, equivalences)
 		origin                 = resourcesv1alpha1helper.OriginForManagedResource(r.ClusterID, mr)
+	)
+
+	reconcileCtx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	// Initialize condition based on the current status.
+	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
+
+	sources, err := r.readContentSources(reconcileCtx, mr)
+	if err != nil {
+		var (
+			reason = "CannotReadSource"
+			sErr   *sourceError
+		)
+		if errors.As(err, &sErr) {
+			reason = sErr.reason
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, reason, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, err
+	}
+
+	var (
+		decoded                      = r.decodeObjects(log, mr, sources, existingResourcesIndex)
+		newResourcesObjects          = decoded.objects
+		newResourcesObjectReferences = decoded.references
+		secretsDataChecksum          = decoded.checksum
+	)
+
+	// sort object references before updating status, to keep consistent ordering
+	// (otherwise, the order will be different on each update)
+	sortObjectReferences(newResourcesObjectReferences)
+
+	// invalidate conditions, if resources have been added/removed from the managed resource
+	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
+		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
+		conditionResourcesHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesHealthy, gardencorev1beta1.ConditionUnknown,
+			resourcesv1alpha1.ConditionChecksPending, "The health checks have not yet been executed for the current set of resources.")
+		conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
+		conditionResourcesProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesProgressing, gardencorev1beta1.ConditionUnknown,
+			resourcesv1alpha1.ConditionChecksPending, "Checks have not yet been executed for the current set of resources.")
+
+		reason := resourcesv1alpha1.ConditionApplyProgressing
+		msg := "The resources are currently being reconciled."
+		switch conditionResourcesApplied.Reason {
+		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
+			// keep condition reason and message if last reconciliation failed
+			reason = conditionResourcesApplied.Reason
+			msg = conditionResourcesApplied.Message
+		}
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, reason, msg)
+
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+	}
+
+	if deletionPending, err := r.cleanOldResources(reconcileCtx, log, mr, existingResourcesIndex); err != nil {
+		var (
+			reason string
+			status gardencorev1beta1.ConditionStatus
+		)
+		if deletionPending {
+			reason = resourcesv1alpha1.ConditionDeletionPending
+			status = gardencorev1beta1.ConditionProgressing
+			log.Info("Deletion is still pending", "err", err)
+		} else {
+			reason = resourcesv1alpha1.ConditionDeletionFailed
+			status = gardencorev1beta1.ConditionFalse
+			log.Error(err, "Deletion of old resources failed")
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, status, reason, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		if deletionPending {
+			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnDeletionPending}, nil
+		} else {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := r.releaseOrphanedResources(ctx, log, decoded.orphaned, origin); err != nil {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ReleaseOfOrphanedResourcesFailed, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not release all orphaned resources: %+v", err)
+	}
+
+	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
+	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
+		reason := resourcesv1alpha1.ConditionApplyFailed
+		if errors.As(err, new(*fieldOwnershipConflictError)) {
+			reason = resourcesv1alpha1.ConditionFieldOwnershipConflict
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, reason, err.Error())
+		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+		}
+
+		return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
+	}
+
+	if len(decoded.decodingErrors) != 0 {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDecodingFailed, fmt.Sprintf("Could not decode all new resources: %v", decoded.decodingErrors))
+	} else {
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
+	}
+
+	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+	}
+
+	log.Info("Finished to reconcile ManagedResource")
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// decodedObjects contains the objects decoded from the sources of a ManagedResource.
+type decodedObjects struct {
+	// objects are the objects which are applied.
+	objects []object
+	// references are the references to the applied objects.
+	references []resourcesv1alpha1.ObjectReference
+	// orphaned are the references to objects which are marked to be ignored and were applied before.
+	orphaned []resourcesv1alpha1.ObjectReference
+	// decodingErrors are the errors which occurred while decoding the objects.
+	decodingErrors []*decodingError
+	// checksum is the checksum of the content of all sources.
+	checksum string
+}
+
+// decodeObjects decodes the objects from the given sources of the given ManagedResource. The information of the
+// objects applied before is looked up in the given index.
+func (r *Reconciler) decodeObjects(log logr.Logger, mr *resourcesv1alpha1.ManagedResource, sources []contentSource, existingResourcesIndex *objectIndex) *decodedObjects {
+	var (
+		decoded = &decodedObjects{}
 
 		forceOverwriteLabels      bool
 		forceOverwriteAnnotations bool
-
-		decodingErrors []*decodingError
+		serverSideApply           = ptr.Deref(mr.Spec.ApplyMode, resourcesv1alpha1.ApplyModeMerge) == resourcesv1alpha1.ApplyModeServerSideApply
 
 		hash = sha256.New()
 	)
@@ -150,43 +290,22 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		forceOverwriteAnnotations = *v
 	}
 
-	reconcileCtx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
-	defer cancel()
-
-	// Initialize condition based on the current status.
-	conditionResourcesApplied := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesApplied)
-
-	for _, ref := range mr.Spec.SecretRefs {
-		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: mr.Namespace}}
-		if err := r.SourceClient.Get(reconcileCtx, client.ObjectKeyFromObject(secret), secret); err != nil {
-			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, "CannotReadSecret", err.Error())
-			if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-			}
-
-			return reconcile.Result{}, fmt.Errorf("could not read secret '%s': %+v", secret.Name, err)
-		}
-
-		// Sort secret's data key to keep consistent ordering while calculating checksum
-		secretKeys := make([]string, 0, len(secret.Data))
-		for secretKey := range secret.Data {
-			secretKeys = append(secretKeys, secretKey)
+	for _, source := range sources {
+		// Sort source's data key to keep consistent ordering while calculating checksum
+		dataKeys := make([]string, 0, len(source.data))
+		for dataKey := range source.data {
+			dataKeys = append(dataKeys, dataKey)
 		}
-		slices.Sort(secretKeys)
-
-		for _, secretKey := range secretKeys {
-			var reader io.Reader = bytes.NewReader(secret.Data[secretKey])
-			if strings.HasSuffix(secretKey, resourcesv1alpha1.BrotliCompressionSuffix) {
-				reader = brotli.NewReader(reader)
-			}
+		slices.Sort(dataKeys)
 
+		for _, dataKey := range dataKeys {
 			var (
-				decoder    = yaml.NewYAMLOrJSONDecoder(reader, 1024)
+				decoder    = yaml.NewYAMLOrJSONDecoder(newContentReader(dataKey, source.data[dataKey]), 1024)
 				decodedObj map[string]any
 			)
 
 			for indexInFile := 0; true; indexInFile++ {
-				objLog := log.WithValues("secret", client.ObjectKeyFromObject(secret), "secretKey", secretKey, "indexInFile", indexInFile)
+				objLog := log.WithValues("source", source.description, "dataKey", dataKey, "indexInFile", indexInFile)
 
 				err := decoder.Decode(&decodedObj)
 				if err == io.EOF {
@@ -195,11 +314,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 				if err != nil {
 					dErr := &decodingError{
 						err:         err,
-						secret:      client.ObjectKeyFromObject(secret),
-						secretKey:   secretKey,
+						source:      source.description,
+						dataKey:     dataKey,
 						indexInFile: indexInFile,
 					}
-					decodingErrors = append(decodingErrors, dErr)
+					decoded.decodingErrors = append(decoded.decodingErrors, dErr)
 					objLog.Error(dErr.err, "Could not decode resource")
 					continue
 				}
@@ -244,6 +363,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 						obj:                       obj,
 						forceOverwriteLabels:      forceOverwriteLabels,
 						forceOverwriteAnnotations: forceOverwriteAnnotations,
+						serverSideApply:           serverSideApply,
 					}
 					objectReference = resourcesv1alpha1.ObjectReference{
 						ObjectReference: corev1.ObjectReference{
@@ -265,109 +385,24 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 				if ignoreMode(obj) {
 					if found {
-						orphanedObjectReferences = append(orphanedObjectReferences, objectReference)
+						decoded.orphaned = append(decoded.orphaned, objectReference)
 					}
 
 					objLog.Info("Skipping object because it is marked to be ignored")
 					continue
 				}
 
-				hash.Write(secret.Data[secretKey])
-				newResourcesObjects = append(newResourcesObjects, newObj)
-				newResourcesObjectReferences = append(newResourcesObjectReferences, objectReference)
+				hash.Write(source.data[dataKey])
+				decoded.objects = append(decoded.objects, newObj)
+				decoded.references = append(decoded.references, objectReference)
 			}
 		}
 	}
 
-	// calculate the checksum for the referenced secrets data.
-	secretsDataChecksum := hex.EncodeToString(hash.Sum(nil))
-
-	// sort object references before updating status, to keep consistent ordering
-	// (otherwise, the order will be different on each update)
-	sortObjectReferences(newResourcesObjectReferences)
-
-	// invalidate conditions, if resources have been added/removed from the managed resource
-	if !apiequality.Semantic.DeepEqual(mr.Status.Resources, newResourcesObjectReferences) || mr.Status.SecretsDataChecksum == nil || *mr.Status.SecretsDataChecksum != secretsDataChecksum {
-		conditionResourcesHealthy := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesHealthy)
-		conditionResourcesHealthy = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesHealthy, gardencorev1beta1.ConditionUnknown,
-			resourcesv1alpha1.ConditionChecksPending, "The health checks have not yet been executed for the current set of resources.")
-		conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
-		conditionResourcesProgressing = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesProgressing, gardencorev1beta1.ConditionUnknown,
-			resourcesv1alpha1.ConditionChecksPending, "Checks have not yet been executed for the current set of resources.")
+	// calculate the checksum for the content of all sources.
+	decoded.checksum = hex.EncodeToString(hash.Sum(nil))
 
-		reason := resourcesv1alpha1.ConditionApplyProgressing
-		msg := "The resources are currently being reconciled."
-		switch conditionResourcesApplied.Reason {
-		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
-			// keep condition reason and message if last reconciliation failed
-			reason = conditionResourcesApplied.Reason
-			msg = conditionResourcesApplied.Message
-		}
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionProgressing, reason, msg)
-
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-	}
-
-	if deletionPending, err := r.cleanOldResources(reconcileCtx, log, mr, existingResourcesIndex); err != nil {
-		var (
-			reason string
-			status gardencorev1beta1.ConditionStatus
-		)
-		if deletionPending {
-			reason = resourcesv1alpha1.ConditionDeletionPending
-			status = gardencorev1beta1.ConditionProgressing
-			log.Info("Deletion is still pending", "err", err)
-		} else {
-			reason = resourcesv1alpha1.ConditionDeletionFailed
-			status = gardencorev1beta1.ConditionFalse
-			log.Error(err, "Deletion of old resources failed")
-		}
-
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, status, reason, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-
-		if deletionPending {
-			return reconcile.Result{RequeueAfter: *r.RequeueAfterOnDeletionPending}, nil
-		} else {
-			return reconcile.Result{}, err
-		}
-	}
-
-	if err := r.releaseOrphanedResources(ctx, log, orphanedObjectReferences, origin); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ReleaseOfOrphanedResourcesFailed, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-
-		return reconcile.Result{}, fmt.Errorf("could not release all orphaned resources: %+v", err)
-	}
-
-	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
-		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
-			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-		}
-
-		return reconcile.Result{}, fmt.Errorf("could not apply all new resources: %+v", err)
-	}
-
-	if len(decodingErrors) != 0 {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionDecodingFailed, fmt.Sprintf("Could not decode all new resources: %v", decodingErrors))
-	} else {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
-	}
-
-	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
-		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
-	}
-
-	log.Info("Finished to reconcile ManagedResource")
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	return decoded
 }
 
 func (r *Reconciler) delete(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource) (reconcile.Result, error) {
@@ -476,6 +511,11 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		return fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
 	}
 
+	var (
+		fieldManager            = fieldManagerForClass(r.ClassFilter.ResourceClass())
+		fieldOwnershipConflicts []string
+	)
+
 	for _, obj := range newResourcesObjects {
 		var (
 			current            = obj.obj.DeepCopy()
@@ -487,25 +527,17 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger.V(1).Info("Applying")
 
-		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
-			metadata, err := meta.Accessor(obj.obj)
+		if obj.serverSideApply {
+			conflicts, err := r.serverSideApply(ctx, resourceLogger, fieldManager, origin, obj, labelsToInject, scaledHorizontally)
 			if err != nil {
-				return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
-			}
-
-			// if the ignore annotation is set to false, do nothing (ignore the resource)
-			if ignore(metadata) {
-				annotations := current.GetAnnotations()
-				delete(annotations, descriptionAnnotation)
-				current.SetAnnotations(annotations)
-				return nil
-			}
-
-			if err := injectLabels(obj.obj, labelsToInject); err != nil {
-				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+				return err
 			}
+			fieldOwnershipConflicts = append(fieldOwnershipConflicts, conflicts...)
+			continue
+		}
 
-			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
+			return mergeIntoCurrent(origin, obj, current, labelsToInject, scaledHorizontally)
 		})
 		if err != nil {
 			if apierrors.IsConflict(err) {
@@ -533,9 +565,38 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		}
 	}
 
+	if len(fieldOwnershipConflicts) > 0 {
+		return &fieldOwnershipConflictError{conflicts: fieldOwnershipConflicts}
+	}
+
 	return nil
 }
 
+// mergeIntoCurrent merges the desired state of the given object into the current object when applying it in the `Merge`
+// apply mode.
+func mergeIntoCurrent(origin string, obj object, current *unstructured.Unstructured, labelsToInject map[string]string, scaledHorizontally bool) error {
+	resource := unstructuredToString(obj.obj)
+
+	metadata, err := meta.Accessor(obj.obj)
+	if err != nil {
+		return fmt.Errorf("error getting metadata of object %q: %s", resource, err)
+	}
+
+	// if the ignore annotation is set to false, do nothing (ignore the resource)
+	if ignore(metadata) {
+		annotations := current.GetAnnotations()
+		delete(annotations, descriptionAnnotation)
+		current.SetAnnotations(annotations)
+		return nil
+	}
+
+	if err := injectLabels(obj.obj, labelsToInject); err != nil {
+		return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+	}
+
+	return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
+}
+
 // computeHorizontallyScaledObjectKeys returns a set of object keys (in the form `Group/Kind/Namespace/Name`)
 // to objects that are horizontally scaled by HPA.
 // VPAs are not checked, as they don't update the spec of Deployments/StatefulSets/... and only mutate resource
@@ -983,17 +1044,18 @@ type object struct {
 	oldInformation            resourcesv1alpha1.ObjectReference
 	forceOverwriteLabels      bool
 	forceOverwriteAnnotations bool
+	serverSideApply           bool
 }
 
 type decodingError struct {
 	err         error
-	secret      client.ObjectKey
-	secretKey   string
+	source      string
+	dataKey     string
 	indexInFile int
 }
 
 func (d *decodingError) StringShort() string {
-	return fmt.Sprintf("Could not decode resource at index %d in '%s' in secret '%s'", d.indexInFile, d.secretKey, d.secret.String())
+	return fmt.Sprintf("Could not decode resource at index %d in '%s' in %s", d.indexInFile, d.dataKey, d.source)
 }
 
 func (d *decodingError) String() string {
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcemanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/resourcemanager/apis/config/v1alpha1"
+)
+
+// fieldManagerName is the name of the field manager used for applying objects of ManagedResources with the default
+// resource class.
+const fieldManagerName = "gardener-resource-manager"
+
+// fieldManagerForClass returns the name of the field manager used for applying objects of ManagedResources with the
+// given resource class via server-side apply.
+func fieldManagerForClass(class string) string {
+	if class == "" || class == resourcemanagerconfigv1alpha1.DefaultResourceClass || class == resourcemanagerconfigv1alpha1.AllResourceClass {
+		return fieldManagerName
+	}
+	return fieldManagerName + "-" + class
+}
+
+// clientSideApplyFieldManagers returns the names of the field managers which own the fields of objects updated in the
+// `Merge` apply mode. As no field manager is specified for these updates, the kube-apiserver derives it from the user
+// agent of the client.
+func clientSideApplyFieldManagers() sets.Set[string] {
+	return sets.New(fieldManagerName, strings.Split(rest.DefaultKubernetesUserAgent(), "/")[0])
+}
+
+// fieldOwnershipConflictError is returned if objects could not be applied because fields are owned by other field
+// managers.
+type fieldOwnershipConflictError struct {
+	conflicts []string
+}
+
+func (e *fieldOwnershipConflictError) Error() string {
+	return fmt.Sprintf("applying resources conflicts with fields owned by other field managers: %s", strings.Join(e.conflicts, "; "))
+}
+
+// serverSideApply applies the given object via server-side apply with the given field manager. Before the first apply,
+// the managed fields of the existing object are migrated so that the fields owned by earlier updates of the resource
+// manager are owned by the field manager. Conflicts with fields owned by other field managers are returned instead of an
+// error so that the remaining objects can still be applied.
+func (r *Reconciler) serverSideApply(ctx context.Context, log logr.Logger, fieldManager, origin string, obj object, labelsToInject map[string]string, preserveReplicas bool) ([]string, error) {
+	var (
+		desired  = obj.obj
+		resource = unstructuredToString(desired)
+		current  = &unstructured.Unstructured{}
+	)
+
+	current.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting object %q: %w", resource, err)
+		}
+		current = nil
+	}
+
+	if current != nil {
+		// if the ignore annotation is set to true, the object is only created but never updated
+		if ignore(desired) {
+			log.V(1).Info("Skipping apply because object is marked to be ignored")
+			return nil, nil
+		}
+
+		if err := upgradeManagedFields(ctx, r.TargetClient, current, fieldManager); err != nil {
+			return nil, fmt.Errorf("error upgrading managed fields of object %q: %w", resource, err)
+		}
+	}
+
+	if err := prepareForServerSideApply(origin, desired, current, labelsToInject, preserveReplicas); err != nil {
+		return nil, err
+	}
+
+	if err := r.TargetClient.Patch(ctx, desired, client.Apply, client.FieldOwner(fieldManager)); err != nil {
+		if conflicts := fieldOwnershipConflicts(err); len(conflicts) > 0 {
+			log.Info("Could not apply resource because of conflicts with fields owned by other field managers", "conflicts", conflicts)
+			for i, conflict := range conflicts {
+				conflicts[i] = fmt.Sprintf("%s: %s", resource, conflict)
+			}
+			return conflicts, nil
+		}
+
+		if apierrors.IsInvalid(err) && current != nil && deleteOnInvalidUpdate(desired, err) {
+			if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
+				return nil, fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+			}
+			// return error directly, so that the create after delete will be retried
+			return nil, fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+		}
+
+		return nil, fmt.Errorf("error during apply of object %q: %s", resource, err)
+	}
+
+	switch {
+	case current == nil:
+		log.Info("Created resource because it was not existing before")
+	case current.GetResourceVersion() != desired.GetResourceVersion():
+		log.Info("Updated resource because its actual state differed from the desired state")
+	default:
+		log.V(1).Info("Resource was not updated because its actual state matches with the desired state")
+	}
+
+	return nil, nil
+}
+
+// prepareForServerSideApply injects the labels and annotations of the resource manager into the desired object and
+// preserves the fields of the current object which must not be overwritten. The current object is nil if the object
+// does not exist yet.
+func prepareForServerSideApply(origin string, desired, current *unstructured.Unstructured, labelsToInject map[string]string, preserveReplicas bool) error {
+	resource := unstructuredToString(desired)
+
+	if err := injectLabels(desired, labelsToInject); err != nil {
+		return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+	}
+
+	annotations := desired.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	desired.SetAnnotations(annotations)
+
+	if current != nil {
+		if err := preserveFields(desired, current, preserveReplicas); err != nil {
+			return fmt.Errorf("error preserving fields of object %q: %w", resource, err)
+		}
+	}
+
+	return nil
+}
+
+// upgradeManagedFields transfers the ownership of all fields owned by client-side updates of the resource manager to
+// the given field manager. Otherwise, fields which are removed from the desired state would never be removed from the
+// object, and fields changed by other controllers would conflict with the field manager.
+func upgradeManagedFields(ctx context.Context, c client.Client, obj *unstructured.Unstructured, fieldManager string) error {
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(obj, clientSideApplyFieldManagers(), fieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+
+	return c.Patch(ctx, obj, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// fieldOwnershipConflicts returns the messages of the field manager conflicts contained in the given error.
+func fieldOwnershipConflicts(err error) []string {
+	var apiStatus apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &apiStatus) || apiStatus.Status().Details == nil {
+		return nil
+	}
+
+	var conflicts []string
+	for _, cause := range apiStatus.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeFieldManagerConflict {
+			conflicts = append(conflicts, cause.Message)
+		}
+	}
+	return conflicts
+}
+
+// preserveFields copies the fields of workload resources which must not be overwritten from the current into the
+// desired object. This is the server-side apply equivalent of `merge`: the field manager shares the ownership of these
+// fields with the controllers changing them instead of dropping them from the object.
+func preserveFields(desired, current *unstructured.Unstructured, preserveReplicas bool) error {
+	annotations := desired.GetAnnotations()
+	if annotations[resourcesv1alpha1.PreserveReplicas] == "true" {
+		preserveReplicas = true
+	}
+	preserveResources := annotations[resourcesv1alpha1.PreserveResources] == "true"
+
+	switch desired.GroupVersionKind().GroupKind() {
+	case appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), extensionsv1beta1.SchemeGroupVersion.WithKind("Deployment").GroupKind(),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(), extensionsv1beta1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind():
+		if err := preserveReplicasField(desired, current, preserveReplicas); err != nil {
+			return err
+		}
+		return preservePodTemplateFields(desired, current, preserveResources, "spec", "template")
+	case appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind(), batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
+		return preservePodTemplateFields(desired, current, preserveResources, "spec", "template")
+	case batchv1.SchemeGroupVersion.WithKind("CronJob").GroupKind():
+		return preservePodTemplateFields(desired, current, preserveResources, "spec", "jobTemplate", "spec", "template")
+	}
+
+	return nil
+}
+
+func preserveReplicasField(desired, current *unstructured.Unstructured, preserveReplicas bool) error {
+	// Do not overwrite '.spec.replicas' if it is unset in the desired object or we are asked to preserve the replicas
+	// (e.g. the object is scaled by HPA).
+	if _, found, err := unstructured.NestedFieldNoCopy(desired.Object, "spec", "replicas"); err != nil || (found && !preserveReplicas) {
+		return err
+	}
+
+	replicas, found, err := unstructured.NestedFieldCopy(current.Object, "spec", "replicas")
+	if err != nil || !found {
+		return err
+	}
+	return unstructured.SetNestedField(desired.Object, replicas, "spec", "replicas")
+}
+
+func preservePodTemplateFields(desired, current *unstructured.Unstructured, preserveResources bool, podTemplateFields ...string) error {
+	path := func(fields ...string) []string {
+		return append(slices.Clone(podTemplateFields), fields...)
+	}
+
+	// Do not overwrite the "kubectl.kubernetes.io/restartedAt" annotation as it is used by the
+	// "kubectl rollout restart <RESOURCE>" command to trigger rollouts.
+	restartedAt, found, err := unstructured.NestedString(current.Object, path("metadata", "annotations", restartedAtAnnotation)...)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := unstructured.SetNestedField(desired.Object, restartedAt, path("metadata", "annotations", restartedAtAnnotation)...); err != nil {
+			return err
+		}
+	}
+
+	if !preserveResources {
+		return nil
+	}
+
+	desiredContainers, found, err := unstructured.NestedSlice(desired.Object, path("spec", "containers")...)
+	if err != nil || !found {
+		return err
+	}
+	currentContainers, _, err := unstructured.NestedSlice(current.Object, path("spec", "containers")...)
+	if err != nil {
+		return err
+	}
+
+	// Do not overwrite the resource requests / limits of containers when we are asked to preserve the resources.
+	for _, desiredContainer := range desiredContainers {
+		desiredContainerMap, ok := desiredContainer.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, currentContainer := range currentContainers {
+			currentContainerMap, ok := currentContainer.(map[string]any)
+			if !ok || currentContainerMap["name"] != desiredContainerMap["name"] {
+				continue
+			}
+
+			for _, resourceType := range []string{"requests", "limits"} {
+				for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+					value, found, err := unstructured.NestedFieldCopy(currentContainerMap, "resources", resourceType, string(resourceName))
+					if err != nil {
+						return err
+					}
+					if !found {
+						continue
+					}
+					if err := unstructured.SetNestedField(desiredContainerMap, value, "resources", resourceType, string(resourceName)); err != nil {
+						return err
+					}
+				}
+			}
+			break
+		}
+	}
+
+	return unstructured.SetNestedSlice(desired.Object, desiredContainers, path("spec", "containers")...)
+}
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+var _ = Describe("server-side apply", func() {
+	Describe("#fieldManagerForClass", func() {
+		It("should return the default field manager for the default and all resource classes", func() {
+			Expect(fieldManagerForClass("")).To(Equal("gardener-resource-manager"))
+			Expect(fieldManagerForClass("resources")).To(Equal("gardener-resource-manager"))
+			Expect(fieldManagerForClass("*")).To(Equal("gardener-resource-manager"))
+		})
+
+		It("should return a dedicated field manager for other resource classes", func() {
+			Expect(fieldManagerForClass("shoot")).To(Equal("gardener-resource-manager-shoot"))
+		})
+	})
+
+	Describe("#fieldOwnershipConflicts", func() {
+		It("should return the messages of the field manager conflicts", func() {
+			err := apierrors.NewApplyConflict([]metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit" using v1: .data.foo`, Field: ".data.foo"},
+				{Type: metav1.CauseTypeFieldValueInvalid, Message: "some other cause"},
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit" using v1: .data.bar`, Field: ".data.bar"},
+			}, "Apply failed with 2 conflicts")
+
+			Expect(fieldOwnershipConflicts(fmt.Errorf("wrapped: %w", err))).To(ConsistOf(
+				`conflict with "kubectl-edit" using v1: .data.foo`,
+				`conflict with "kubectl-edit" using v1: .data.bar`,
+			))
+		})
+
+		It("should return nothing for other errors", func() {
+			Expect(fieldOwnershipConflicts(apierrors.NewConflict(corev1.Resource("configmaps"), "foo", fmt.Errorf("object has been modified")))).To(BeEmpty())
+			Expect(fieldOwnershipConflicts(fmt.Errorf("foo"))).To(BeEmpty())
+		})
+	})
+
+	Describe("#upgradeManagedFields", func() {
+		var (
+			ctx = context.Background()
+
+			obj         *unstructured.Unstructured
+			patches     []client.Patch
+			fakeClient  client.Client
+			fieldsEntry = func(manager string, operation metav1.ManagedFieldsOperationType, fields string) metav1.ManagedFieldsEntry {
+				return metav1.ManagedFieldsEntry{
+					Manager:    manager,
+					Operation:  operation,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			patches = nil
+			fakeClient = fakeclient.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, patch client.Patch, _ ...client.PatchOption) error {
+					patches = append(patches, patch)
+					return nil
+				},
+			}).Build()
+
+			obj = &unstructured.Unstructured{}
+			obj.SetAPIVersion("v1")
+			obj.SetKind("ConfigMap")
+			obj.SetName("foo")
+			obj.SetNamespace("default")
+			obj.SetResourceVersion("42")
+		})
+
+		It("should transfer the fields owned by updates of the resource manager to the field manager", func() {
+			obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+				fieldsEntry("gardener-resource-manager", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:foo":{}}}`),
+				fieldsEntry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:bar":{}}}`),
+			})
+
+			Expect(upgradeManagedFields(ctx, fakeClient, obj, "gardener-resource-manager-shoot")).To(Succeed())
+
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].Type()).To(Equal(types.JSONPatchType))
+
+			data, err := patches[0].Data(obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			var operations []struct {
+				Op    string          `json:"op"`
+				Path  string          `json:"path"`
+				Value json.RawMessage `json:"value"`
+			}
+			Expect(json.Unmarshal(data, &operations)).To(Succeed())
+			Expect(operations).To(HaveLen(2))
+			Expect(operations[0].Path).To(Equal("/metadata/managedFields"))
+			Expect(operations[1].Path).To(Equal("/metadata/resourceVersion"))
+			Expect(string(operations[1].Value)).To(Equal(`"42"`))
+
+			var managedFields []metav1.ManagedFieldsEntry
+			Expect(json.Unmarshal(operations[0].Value, &managedFields)).To(Succeed())
+			Expect(managedFields).To(ConsistOf(
+				HaveField("Manager", "gardener-resource-manager-shoot"),
+				HaveField("Manager", "kubectl-edit"),
+			))
+			Expect(managedFields[0].Operation).To(Equal(metav1.ManagedFieldsOperationApply))
+			Expect(string(managedFields[0].FieldsV1.Raw)).To(Equal(`{"f:data":{"f:foo":{}}}`))
+		})
+
+		It("should do nothing if the fields are already owned by the field manager", func() {
+			obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+				fieldsEntry("gardener-resource-manager", metav1.ManagedFieldsOperationApply, `{"f:data":{"f:foo":{}}}`),
+				fieldsEntry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, `{"f:data":{"f:bar":{}}}`),
+			})
+
+			Expect(upgradeManagedFields(ctx, fakeClient, obj, "gardener-resource-manager")).To(Succeed())
+			Expect(patches).To(BeEmpty())
+		})
+	})
+
+	Describe("#preserveFields", func() {
+		var desired, current *unstructured.Unstructured
+
+		replicas := func(u *unstructured.Unstructured) int64 {
+			replicas, _, err := unstructured.NestedInt64(u.Object, "spec", "replicas")
+			Expect(err).NotTo(HaveOccurred())
+			return replicas
+		}
+
+		toUnstructured := func(obj runtime.Object) *unstructured.Unstructured {
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			Expect(err).NotTo(HaveOccurred())
+			return &unstructured.Unstructured{Object: u}
+		}
+
+		newDeployment := func(replicas *int32, resources corev1.ResourceRequirements) *unstructured.Unstructured {
+			return toUnstructured(&appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: replicas,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "foo", Resources: resources}},
+						},
+					},
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			desired = newDeployment(ptr.To[int32](1), corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")},
+			})
+			current = newDeployment(ptr.To[int32](3), corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("20m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+			})
+			Expect(unstructured.SetNestedField(current.Object, "2025-01-01T00:00:00Z", "spec", "template", "metadata", "annotations", restartedAtAnnotation)).To(Succeed())
+		})
+
+		It("should only keep the restartedAt annotation of the pod template", func() {
+			Expect(preserveFields(desired, current, false)).To(Succeed())
+
+			Expect(replicas(desired)).To(Equal(int64(1)))
+			Expect(desired.Object).To(HaveKeyWithValue("spec", HaveKeyWithValue("template", HaveKeyWithValue("metadata",
+				HaveKeyWithValue("annotations", HaveKeyWithValue(restartedAtAnnotation, "2025-01-01T00:00:00Z"))))))
+			containers, _, err := unstructured.NestedSlice(desired.Object, "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers[0]).To(HaveKeyWithValue("resources", map[string]any{"requests": map[string]any{"cpu": "10m"}}))
+		})
+
+		It("should keep the replicas if they are not set in the desired object", func() {
+			unstructured.RemoveNestedField(desired.Object, "spec", "replicas")
+
+			Expect(preserveFields(desired, current, false)).To(Succeed())
+			Expect(replicas(desired)).To(Equal(int64(3)))
+		})
+
+		It("should keep the replicas if the object is scaled horizontally", func() {
+			Expect(preserveFields(desired, current, true)).To(Succeed())
+			Expect(replicas(desired)).To(Equal(int64(3)))
+		})
+
+		It("should keep the replicas and resources if the desired object is annotated accordingly", func() {
+			desired.SetAnnotations(map[string]string{
+				resourcesv1alpha1.PreserveReplicas:  "true",
+				resourcesv1alpha1.PreserveResources: "true",
+			})
+
+			Expect(preserveFields(desired, current, false)).To(Succeed())
+
+			Expect(replicas(desired)).To(Equal(int64(3)))
+			containers, _, err := unstructured.NestedSlice(desired.Object, "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers[0]).To(HaveKeyWithValue("resources", map[string]any{
+				"requests": map[string]any{"cpu": "20m", "memory": "1Gi"},
+				"limits":   map[string]any{"memory": "2Gi"},
+			}))
+		})
+	})
+
+	Describe("#serverSideApply", func() {
+		var (
+			ctx = context.Background()
+			log = logr.Discard()
+
+			applied     []*unstructured.Unstructured
+			applyOpts   []*client.PatchOptions
+			applyErr    error
+			fakeClient  client.Client
+			reconciler  *Reconciler
+			configMap   *corev1.ConfigMap
+			desiredObj  object
+			injectLabel = map[string]string{"resources.gardener.cloud/managed-by": "gardener"}
+		)
+
+		BeforeEach(func() {
+			applied, applyOpts, applyErr = nil, nil, nil
+
+			fakeClient = fakeclient.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if patch.Type() != types.ApplyPatchType {
+						return c.Patch(ctx, obj, patch, opts...)
+					}
+
+					applied = append(applied, obj.(*unstructured.Unstructured).DeepCopy())
+					applyOpts = append(applyOpts, (&client.PatchOptions{}).ApplyOptions(opts))
+					return applyErr
+				},
+			}).Build()
+
+			reconciler = &Reconciler{TargetClient: fakeClient}
+
+			configMap = &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", Labels: map[string]string{"foo": "bar"}},
+				Data:       map[string]string{"foo": "bar"},
+			}
+
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(configMap)
+			Expect(err).NotTo(HaveOccurred())
+			desiredObj = object{obj: &unstructured.Unstructured{Object: u}, serverSideApply: true}
+		})
+
+		It("should apply the object with the field manager", func() {
+			Expect(reconciler.serverSideApply(ctx, log, "gardener-resource-manager-shoot", "origin", desiredObj, injectLabel, false)).To(BeEmpty())
+
+			Expect(applied).To(HaveLen(1))
+			Expect(applied[0].GetLabels()).To(Equal(map[string]string{"foo": "bar", "resources.gardener.cloud/managed-by": "gardener"}))
+			Expect(applied[0].GetAnnotations()).To(Equal(map[string]string{
+				"resources.gardener.cloud/description": descriptionAnnotationText,
+				"resources.gardener.cloud/origin":      "origin",
+			}))
+			Expect(applied[0].Object).To(HaveKeyWithValue("data", map[string]any{"foo": "bar"}))
+
+			Expect(applyOpts[0].FieldManager).To(Equal("gardener-resource-manager-shoot"))
+			Expect(applyOpts[0].Force).To(BeNil())
+		})
+
+		It("should not apply existing objects which are marked to be ignored", func() {
+			Expect(fakeClient.Create(ctx, configMap.DeepCopy())).To(Succeed())
+			desiredObj.obj.SetAnnotations(map[string]string{resourcesv1alpha1.Ignore: "true"})
+
+			Expect(reconciler.serverSideApply(ctx, log, "gardener-resource-manager", "origin", desiredObj, injectLabel, false)).To(BeEmpty())
+			Expect(applied).To(BeEmpty())
+		})
+
+		It("should return the field ownership conflicts", func() {
+			applyErr = apierrors.NewApplyConflict([]metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit" using v1: .data.foo`, Field: ".data.foo"},
+			}, "Apply failed with 1 conflict")
+
+			Expect(reconciler.serverSideApply(ctx, log, "gardener-resource-manager", "origin", desiredObj, injectLabel, false)).To(ConsistOf(
+				`v1/ConfigMap/default/foo: conflict with "kubectl-edit" using v1: .data.foo`,
+			))
+		})
+
+		It("should return other errors", func() {
+			applyErr = fmt.Errorf("fake")
+
+			_, err := reconciler.serverSideApply(ctx, log, "gardener-resource-manager", "origin", desiredObj, injectLabel, false)
+			Expect(err).To(MatchError(`error during apply of object "v1/ConfigMap/default/foo": fake`))
+		})
+	})
+
+	Describe("#fieldOwnershipConflictError", func() {
+		It("should list all conflicts", func() {
+			err := &fieldOwnershipConflictError{conflicts: []string{"foo", "bar"}}
+			Expect(err).To(MatchError("applying resources conflicts with fields owned by other field managers: foo; bar"))
+		})
+	})
+})
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+const (
+	// ociArtifactDataKey is the data key of the content of OCI artifacts.
+	ociArtifactDataKey = "manifests.yaml"
+	// chunkedSourceKindSecret is the kind of chunked sources stored in Secrets.
+	chunkedSourceKindSecret = "Secret"
+	// chunkedSourceKindConfigMap is the kind of chunked sources stored in ConfigMaps.
+	chunkedSourceKindConfigMap = "ConfigMap"
+)
+
+// contentSource is the content of a source of the objects of a ManagedResource.
+type contentSource struct {
+	// description describes the source in messages, e.g. "secret 'foo/bar'".
+	description string
+	// data maps the data keys of the source to the content. Data with keys ending with the Brotli compression suffix is
+	// decompressed when decoding the objects.
+	data map[string][]byte
+}
+
+// sourceError is returned if a source of a ManagedResource cannot be read.
+type sourceError struct {
+	// reason is the reason used for the ResourcesApplied condition.
+	reason string
+	err    error
+}
+
+func (e *sourceError) Error() string {
+	return e.err.Error()
+}
+
+func (e *sourceError) Unwrap() error {
+	return e.err
+}
+
+// readContentSources reads the content of all sources of the given ManagedResource in a stable order.
+func (r *Reconciler) readContentSources(ctx context.Context, mr *resourcesv1alpha1.ManagedResource) ([]contentSource, error) {
+	var sources []contentSource
+
+	for _, ref := range mr.Spec.SecretRefs {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: mr.Namespace}}
+		if err := r.SourceClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+			return nil, &sourceError{reason: "CannotReadSecret", err: fmt.Errorf("could not read secret '%s': %w", secret.Name, err)}
+		}
+
+		sources = append(sources, contentSource{
+			description: fmt.Sprintf("secret '%s'", client.ObjectKeyFromObject(secret)),
+			data:        secret.Data,
+		})
+	}
+
+	for _, ref := range mr.Spec.ConfigMapRefs {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ref.Name, Namespace: mr.Namespace}}
+		if err := r.SourceClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap); err != nil {
+			return nil, &sourceError{reason: "CannotReadConfigMap", err: fmt.Errorf("could not read configmap '%s': %w", configMap.Name, err)}
+		}
+
+		data := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+		for key, value := range configMap.Data {
+			data[key] = []byte(value)
+		}
+		for key, value := range configMap.BinaryData {
+			data[key] = value
+		}
+
+		sources = append(sources, contentSource{
+			description: fmt.Sprintf("configmap '%s'", client.ObjectKeyFromObject(configMap)),
+			data:        data,
+		})
+	}
+
+	for i, chunkedSource := range mr.Spec.ChunkedSources {
+		content, err := r.readChunks(ctx, mr.Namespace, chunkedSource)
+		if err != nil {
+			return nil, &sourceError{reason: "CannotReadChunkedSource", err: fmt.Errorf("could not read chunked source %d: %w", i, err)}
+		}
+
+		sources = append(sources, contentSource{
+			description: fmt.Sprintf("chunked source %d (%s %s)", i, strings.ToLower(chunkedSource.Kind), strings.Join(chunkedSource.Names, ", ")),
+			data:        map[string][]byte{chunkedSource.Key: content},
+		})
+	}
+
+	if artifact := mr.Spec.OCIArtifact; artifact != nil {
+		if r.OCIRegistry == nil {
+			return nil, &sourceError{reason: "CannotPullOCIArtifact", err: fmt.Errorf("pulling OCI artifacts is not supported")}
+		}
+
+		// pull secrets are read from the namespace of the ManagedResource
+		content, err := r.OCIRegistry.PullLayer(context.WithValue(ctx, oci.ContextKeyPullSecretNamespace, mr.Namespace), &gardencorev1.OCIRepository{
+			Repository:    ptr.To(artifact.Repository),
+			Digest:        ptr.To(artifact.Digest),
+			PullSecretRef: artifact.PullSecretRef,
+		}, resourcesv1alpha1.OCIArtifactManifestsMediaType)
+		if err != nil {
+			return nil, &sourceError{reason: "CannotPullOCIArtifact", err: fmt.Errorf("could not pull OCI artifact '%s@%s': %w", artifact.Repository, artifact.Digest, err)}
+		}
+
+		sources = append(sources, contentSource{
+			description: fmt.Sprintf("OCI artifact '%s@%s'", artifact.Repository, artifact.Digest),
+			data:        map[string][]byte{ociArtifactDataKey: content},
+		})
+	}
+
+	return sources, nil
+}
+
+// readChunks reads the chunks of the given chunked source and returns the concatenated content.
+func (r *Reconciler) readChunks(ctx context.Context, namespace string, chunkedSource resourcesv1alpha1.ChunkedSource) ([]byte, error) {
+	var content bytes.Buffer
+
+	for _, name := range chunkedSource.Names {
+		key := client.ObjectKey{Namespace: namespace, Name: name}
+
+		var (
+			chunk []byte
+			found bool
+		)
+
+		switch chunkedSource.Kind {
+		case chunkedSourceKindSecret:
+			secret := &corev1.Secret{}
+			if err := r.SourceClient.Get(ctx, key, secret); err != nil {
+				return nil, fmt.Errorf("could not read secret '%s': %w", name, err)
+			}
+			chunk, found = secret.Data[chunkedSource.Key]
+		case chunkedSourceKindConfigMap:
+			configMap := &corev1.ConfigMap{}
+			if err := r.SourceClient.Get(ctx, key, configMap); err != nil {
+				return nil, fmt.Errorf("could not read configmap '%s': %w", name, err)
+			}
+			chunk, found = configMap.BinaryData[chunkedSource.Key]
+		default:
+			return nil, fmt.Errorf("unsupported kind %q", chunkedSource.Kind)
+		}
+
+		if !found {
+			return nil, fmt.Errorf("%s '%s' does not contain key %q", strings.ToLower(chunkedSource.Kind), name, chunkedSource.Key)
+		}
+		content.Write(chunk)
+	}
+
+	return content.Bytes(), nil
+}
+
+// newContentReader returns a reader for the content with the given data key. The content is decompressed if the key has
+// the Brotli compression suffix.
+func newContentReader(key string, content []byte) io.Reader {
+	var reader io.Reader = bytes.NewReader(content)
+	if strings.HasSuffix(key, resourcesv1alpha1.BrotliCompressionSuffix) {
+		reader = brotli.NewReader(reader)
+	}
+	return reader
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	ocifake "github.com/gardener/gardener/pkg/utils/oci/fake"
+)
+
+var _ = Describe("sources", func() {
+	const (
+		namespace = "namespace"
+		digest    = "sha256:f5d5b6ee3e79ad1a2a1e4a6dd4e6bbd5f3c8d14fc66da3b0dcc0f4b6bd6a4e24"
+	)
+
+	var (
+		ctx          = context.Background()
+		sourceClient client.Client
+		registry     *ocifake.Registry
+		r            *Reconciler
+		mr           *resourcesv1alpha1.ManagedResource
+	)
+
+	BeforeEach(func() {
+		sourceClient = fakeclient.NewClientBuilder().Build()
+		registry = ocifake.NewRegistry()
+		r = &Reconciler{SourceClient: sourceClient, OCIRegistry: registry}
+
+		mr = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "mr", Namespace: namespace}}
+	})
+
+	Describe("#readContentSources", func() {
+		It("should read all sources in a stable order", func() {
+			Expect(sourceClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Data:       map[string][]byte{"secret.yaml": []byte("secret")},
+			})).To(Succeed())
+			Expect(sourceClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "configmap", Namespace: namespace},
+				Data:       map[string]string{"configmap.yaml": "configmap"},
+				BinaryData: map[string][]byte{"configmap.yaml.br": []byte("binary")},
+			})).To(Succeed())
+			for _, chunk := range []string{"chunk-0", "chunk-1"} {
+				Expect(sourceClient.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: chunk, Namespace: namespace},
+					BinaryData: map[string][]byte{"bundle.yaml": []byte(chunk + ";")},
+				})).To(Succeed())
+			}
+			registry.AddArtifact(&gardencorev1.OCIRepository{Repository: ptr.To("example.com/manifests"), Digest: ptr.To(digest)}, []byte("artifact"))
+			registry.SetExpectedPullSecretNamespace(namespace)
+
+			mr.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: "secret"}}
+			mr.Spec.ConfigMapRefs = []corev1.LocalObjectReference{{Name: "configmap"}}
+			mr.Spec.ChunkedSources = []resourcesv1alpha1.ChunkedSource{{Kind: "ConfigMap", Names: []string{"chunk-0", "chunk-1"}, Key: "bundle.yaml"}}
+			mr.Spec.OCIArtifact = &resourcesv1alpha1.OCIArtifactSource{Repository: "example.com/manifests", Digest: digest}
+
+			sources, err := r.readContentSources(ctx, mr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sources).To(Equal([]contentSource{
+				{description: "secret 'namespace/secret'", data: map[string][]byte{"secret.yaml": []byte("secret")}},
+				{description: "configmap 'namespace/configmap'", data: map[string][]byte{"configmap.yaml": []byte("configmap"), "configmap.yaml.br": []byte("binary")}},
+				{description: "chunked source 0 (configmap chunk-0, chunk-1)", data: map[string][]byte{"bundle.yaml": []byte("chunk-0;chunk-1;")}},
+				{description: "OCI artifact 'example.com/manifests@" + digest + "'", data: map[string][]byte{"manifests.yaml": []byte("artifact")}},
+			}))
+		})
+
+		DescribeTable("should return the reason if a source cannot be read",
+			func(mutate func(), reason string) {
+				mutate()
+
+				_, err := r.readContentSources(ctx, mr)
+
+				var sErr *sourceError
+				Expect(errors.As(err, &sErr)).To(BeTrue())
+				Expect(sErr.reason).To(Equal(reason))
+			},
+
+			Entry("secret", func() { mr.Spec.SecretRefs = []corev1.LocalObjectReference{{Name: "secret"}} }, "CannotReadSecret"),
+			Entry("configmap", func() { mr.Spec.ConfigMapRefs = []corev1.LocalObjectReference{{Name: "configmap"}} }, "CannotReadConfigMap"),
+			Entry("chunked source", func() {
+				mr.Spec.ChunkedSources = []resourcesv1alpha1.ChunkedSource{{Kind: "Secret", Names: []string{"chunk-0"}, Key: "bundle.yaml"}}
+			}, "CannotReadChunkedSource"),
+			Entry("OCI artifact", func() {
+				mr.Spec.OCIArtifact = &resourcesv1alpha1.OCIArtifactSource{Repository: "example.com/manifests", Digest: digest}
+			}, "CannotPullOCIArtifact"),
+			Entry("OCI artifact without registry", func() {
+				r.OCIRegistry = nil
+				mr.Spec.OCIArtifact = &resourcesv1alpha1.OCIArtifactSource{Repository: "example.com/manifests", Digest: digest}
+			}, "CannotPullOCIArtifact"),
+		)
+	})
+
+	Describe("#readChunks", func() {
+		It("should concatenate the chunks in the given order", func() {
+			for _, chunk := range []string{"chunk-0", "chunk-1", "chunk-2"} {
+				Expect(sourceClient.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: chunk, Namespace: namespace},
+					Data:       map[string][]byte{"bundle.yaml": []byte(chunk + ";")},
+				})).To(Succeed())
+			}
+
+			content, err := r.readChunks(ctx, namespace, resourcesv1alpha1.ChunkedSource{Kind: "Secret", Names: []string{"chunk-2", "chunk-0", "chunk-1"}, Key: "bundle.yaml"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("chunk-2;chunk-0;chunk-1;"))
+		})
+
+		It("should fail if a chunk does not contain the key", func() {
+			Expect(sourceClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "chunk-0", Namespace: namespace},
+				Data:       map[string]string{"bundle.yaml": "foo"},
+			})).To(Succeed())
+
+			_, err := r.readChunks(ctx, namespace, resourcesv1alpha1.ChunkedSource{Kind: "ConfigMap", Names: []string{"chunk-0"}, Key: "bundle.yaml"})
+			Expect(err).To(MatchError(`configmap 'chunk-0' does not contain key "bundle.yaml"`))
+		})
+
+		It("should fail for unsupported kinds", func() {
+			_, err := r.readChunks(ctx, namespace, resourcesv1alpha1.ChunkedSource{Kind: "Pod", Names: []string{"chunk-0"}, Key: "bundle.yaml"})
+			Expect(err).To(MatchError(`unsupported kind "Pod"`))
+		})
+	})
+
+	Describe("#newContentReader", func() {
+		It("should return the content as is", func() {
+			content, err := io.ReadAll(newContentReader("bundle.yaml", []byte("foo")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("foo"))
+		})
+
+		It("should decompress content with the Brotli compression suffix", func() {
+			var compressed bytes.Buffer
+			writer := brotli.NewWriter(&compressed)
+			_, err := writer.Write([]byte("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writer.Close()).To(Succeed())
+
+			content, err := io.ReadAll(newContentReader("bundle.yaml.br", compressed.Bytes()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("foo"))
+		})
+	})
+})
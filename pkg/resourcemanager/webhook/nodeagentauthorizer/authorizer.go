@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"github.com/go-logr/logr"
@@ -23,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // NewAuthorizer returns a new authorizer for requests from gardener-node-agents. It never has an opinion on the request.
@@ -155,7 +158,7 @@ func (a *authorizer) authorizeLease(ctx context.Context, log logr.Logger, machin
 	}
 
 	allowedLease := "gardener-node-agent-" + node
-	if (attrs.GetVerb() != "create" && attrs.GetName() != allowedLease) || attrs.GetNamespace() != metav1.NamespaceSystem {
+	if (attrs.GetVerb() != "create" && attrs.GetName() != allowedLease && !isRebootLeaseOfWorkerPool(attrs.GetName(), machine)) || attrs.GetNamespace() != metav1.NamespaceSystem {
 		log.Info("Denying authorization because gardener-node-agent is not allowed to access the lease", "nodeName", node, "machineName", machineName, "leaseName", attrs.GetName())
 		return auth.DecisionDeny, fmt.Sprintf("this gardener-node-agent can only access lease %q in %q namespace", allowedLease, metav1.NamespaceSystem), nil
 	}
@@ -163,6 +166,25 @@ func (a *authorizer) authorizeLease(ctx context.Context, log logr.Logger, machin
 	return auth.DecisionAllow, "", nil
 }
 
+// isRebootLeaseOfWorkerPool returns true if the given lease name belongs to the leases which are used by the
+// gardener-node-agents of the machine's worker pool to coordinate reboots. The remainder of the name after the prefix
+// must be a slot number, otherwise the leases of worker pools whose names start with the same prefix (e.g., "a" and
+// "a-b") could be accessed.
+func isRebootLeaseOfWorkerPool(leaseName string, machine *machinev1alpha1.Machine) bool {
+	workerPoolName := machine.Spec.NodeTemplateSpec.Labels[v1beta1constants.LabelWorkerPool]
+	if workerPoolName == "" {
+		return false
+	}
+
+	slot, ok := strings.CutPrefix(leaseName, gardenerutils.NodeRebootLeaseNamePrefix(workerPoolName))
+	if !ok {
+		return false
+	}
+
+	n, err := strconv.Atoi(slot)
+	return err == nil && n >= 0 && strconv.Itoa(n) == slot
+}
+
 func (a *authorizer) authorizeNode(ctx context.Context, log logr.Logger, machineName string, attrs auth.Attributes) (auth.Decision, string, error) {
 	if ok, reason := a.checkSubresource(log, attrs, "status"); !ok {
 		return auth.DecisionDeny, reason, nil
@@ -193,11 +215,15 @@ func (a *authorizer) authorizeNode(ctx context.Context, log logr.Logger, machine
 }
 
 func (a *authorizer) authorizePod(ctx context.Context, log logr.Logger, machineName string, attrs auth.Attributes) (auth.Decision, string, error) {
-	if ok, reason := a.checkSubresource(log, attrs); !ok {
+	if ok, reason := a.checkSubresource(log, attrs, "eviction"); !ok {
 		return auth.DecisionDeny, reason, nil
 	}
 
 	allowedVerbs := []string{"get", "list", "watch", "delete"}
+	if attrs.GetSubresource() == "eviction" {
+		// Evictions are required for draining the node before it is rebooted.
+		allowedVerbs = []string{"create"}
+	}
 	if allowed, reason := a.checkVerb(log, attrs, allowedVerbs...); !allowed {
 		return auth.DecisionDeny, reason, nil
 	}
@@ -240,7 +266,7 @@ func (a *authorizer) authorizePod(ctx context.Context, log logr.Logger, machineN
 		log.Info("Denying request because only listing/watching pods with spec.nodeName field selector for the same node is allowed")
 		return auth.DecisionDeny, fmt.Sprintf("can only list/watch pods with spec.nodeName=%s field selector", node), nil
 
-	case "get", "delete":
+	case "get", "delete", "create":
 		return a.authorizeSinglePod(ctx, log, node, attrs)
 	}
 
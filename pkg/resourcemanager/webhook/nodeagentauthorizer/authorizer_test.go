@@ -83,7 +83,10 @@ var _ = Describe("Authorizer", func() {
 			Spec: machinev1alpha1.MachineSpec{
 				NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
-						Labels: map[string]string{v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: machineSecretName},
+						Labels: map[string]string{
+							v1beta1constants.LabelWorkerPoolGardenerNodeAgentSecretName: machineSecretName,
+							v1beta1constants.LabelWorkerPool:                            "pool",
+						},
 					},
 				},
 			},
@@ -402,6 +405,72 @@ var _ = Describe("Authorizer", func() {
 				Entry("watch", "watch"),
 			)
 
+			DescribeTable("should allow accessing the reboot leases of the worker pool of the gardener-node-agent instance", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "gardener-node-reboot-pool-0",
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionAllow))
+				Expect(reason).To(BeEmpty())
+			},
+				Entry("get", "get"),
+				Entry("update", "update"),
+				Entry("list", "list"),
+				Entry("watch", "watch"),
+			)
+
+			DescribeTable("should deny accessing the reboot leases of a different worker pool", func(verb string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            "gardener-node-reboot-other-pool-0",
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            verb,
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("this gardener-node-agent can only access lease \"gardener-node-agent-%s\" in \"kube-system\" namespace", nodeName)))
+			},
+				Entry("get", "get"),
+				Entry("update", "update"),
+				Entry("list", "list"),
+				Entry("watch", "watch"),
+			)
+
+			DescribeTable("should deny accessing leases which only share the prefix of the reboot leases of the worker pool", func(name string) {
+				attrs := &auth.AttributesRecord{
+					User:            nodeAgentUser,
+					Name:            name,
+					Namespace:       "kube-system",
+					APIGroup:        "coordination.k8s.io",
+					Resource:        "leases",
+					ResourceRequest: true,
+					Verb:            "update",
+				}
+				decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decision).To(Equal(auth.DecisionDeny))
+				Expect(reason).To(Equal(fmt.Sprintf("this gardener-node-agent can only access lease \"gardener-node-agent-%s\" in \"kube-system\" namespace", nodeName)))
+			},
+				Entry("lease of worker pool with same prefix", "gardener-node-reboot-pool-b-0"),
+				Entry("missing slot", "gardener-node-reboot-pool-"),
+				Entry("negative slot", "gardener-node-reboot-pool--1"),
+				Entry("non-canonical slot", "gardener-node-reboot-pool-01"),
+			)
+
 			DescribeTable("should deny accessing a lease in a different namespace", func(verb string) {
 				attrs := &auth.AttributesRecord{
 					User:            nodeAgentUser,
@@ -835,6 +904,54 @@ var _ = Describe("Authorizer", func() {
 				Entry("deletecollection", "deletecollection"),
 			)
 
+			Context("eviction subresource", func() {
+				BeforeEach(func() {
+					attrs.Subresource = "eviction"
+					attrs.Verb = "create"
+				})
+
+				It("should allow evicting pods which belong to the same node", func() {
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionAllow))
+					Expect(reason).To(BeEmpty())
+				})
+
+				It("should deny evicting pods which belong to a different node", func() {
+					pod.Spec.NodeName = "different-node"
+					Expect(targetClient.Update(ctx, pod)).To(Succeed())
+
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionDeny))
+					Expect(reason).To(ContainSubstring(fmt.Sprintf("pod %q does not belong to node %q", client.ObjectKeyFromObject(pod), nodeName)))
+				})
+
+				DescribeTable("should deny because no allowed verb", func(verb string) {
+					attrs.Verb = verb
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionDeny))
+					Expect(reason).To(ContainSubstring("only the following verbs are allowed for this resource type: [create]"))
+				},
+					Entry("get", "get"),
+					Entry("delete", "delete"),
+					Entry("update", "update"),
+				)
+
+				It("should deny accessing a random subresource", func() {
+					attrs.Subresource = "foo-subresource"
+					decision, reason, err := authorizer.Authorize(ctx, attrs)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(decision).To(Equal(auth.DecisionDeny))
+					Expect(reason).To(Equal("only the following subresources are allowed for this resource type: [eviction]"))
+				})
+			})
+
 			DescribeTable("should deny access if the machine does not exist", func(verb string) {
 				attrs.Verb = verb
 				Expect(sourceClient.Delete(ctx, machine)).To(Succeed())
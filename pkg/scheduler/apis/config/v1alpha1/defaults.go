@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 )
 
 // SetDefaults_SchedulerConfiguration sets defaults for the configuration of the Gardener scheduler.
@@ -44,6 +45,30 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	if obj.Shoot.ConcurrentSyncs == 0 {
 		obj.Shoot.ConcurrentSyncs = 5
 	}
+
+	if obj.Shoot.Plugins != nil {
+		for i := range obj.Shoot.Plugins.Score {
+			if obj.Shoot.Plugins.Score[i].Weight == nil {
+				obj.Shoot.Plugins.Score[i].Weight = ptr.To[int32](1)
+			}
+		}
+	}
+
+	if obj.Shoot.SchedulingPreview != nil {
+		if obj.Shoot.SchedulingPreview.Server == nil {
+			obj.Shoot.SchedulingPreview.Server = &Server{}
+		}
+		if obj.Shoot.SchedulingPreview.Server.BindAddress == "" {
+			obj.Shoot.SchedulingPreview.Server.BindAddress = "127.0.0.1"
+		}
+		if obj.Shoot.SchedulingPreview.Server.Port == 0 {
+			obj.Shoot.SchedulingPreview.Server.Port = 10253
+		}
+
+		if obj.Shoot.SchedulingPreview.RequestsPerMinute == nil {
+			obj.Shoot.SchedulingPreview.RequestsPerMinute = ptr.To[int32](10)
+		}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
@@ -88,6 +88,53 @@ var _ = Describe("Defaults", func() {
 				},
 			}))
 		})
+
+		It("should default the weight of score plugins", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				Plugins: &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Score: []schedulerconfigv1alpha1.PluginConfiguration{
+						{Name: "ZoneCount"},
+						{Name: "SeedUtilization", Weight: ptr.To[int32](3)},
+					},
+				},
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.Plugins.Score).To(Equal([]schedulerconfigv1alpha1.PluginConfiguration{
+				{Name: "ZoneCount", Weight: ptr.To[int32](1)},
+				{Name: "SeedUtilization", Weight: ptr.To[int32](3)},
+			}))
+		})
+
+		It("should default the scheduling preview configuration", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				SchedulingPreview: &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{},
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.SchedulingPreview).To(Equal(&schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+				Server:            &schedulerconfigv1alpha1.Server{BindAddress: "127.0.0.1", Port: 10253},
+				RequestsPerMinute: ptr.To[int32](10),
+			}))
+		})
+
+		It("should not overwrite already set values for the scheduling preview configuration", func() {
+			obj.Schedulers.Shoot = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{
+				SchedulingPreview: &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+					Server:            &schedulerconfigv1alpha1.Server{BindAddress: "0.0.0.0", Port: 1234},
+					RequestsPerMinute: ptr.To[int32](3),
+				},
+			}
+
+			schedulerconfigv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.SchedulingPreview).To(Equal(&schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+				Server:            &schedulerconfigv1alpha1.Server{BindAddress: "0.0.0.0", Port: 1234},
+				RequestsPerMinute: ptr.To[int32](3),
+			}))
+		})
 	})
 
 	Describe("ServerConfiguration defaulting", func() {
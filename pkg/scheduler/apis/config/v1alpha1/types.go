@@ -8,6 +8,7 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
@@ -40,6 +41,24 @@ const (
 	LogFormatJSON = "json"
 	// LogFormatText outputs the log as human-readable text.
 	LogFormatText = "text"
+
+	// PluginSeedLabelSelector is a filter plugin which only considers seeds matching the label selector configured in
+	// its SeedLabelSelectorArgs.
+	PluginSeedLabelSelector = "SeedLabelSelector"
+	// PluginSeedUtilization is a score plugin which prefers seeds with a lower utilization, i.e., with fewer shoots in
+	// relation to their allocatable shoots.
+	PluginSeedUtilization = "SeedUtilization"
+	// PluginZoneCount is a score plugin which prefers seeds with more zones.
+	PluginZoneCount = "ZoneCount"
+	// PluginLabelAffinity is a score plugin which prefers seeds matching the label selector configured in its
+	// LabelAffinityArgs.
+	PluginLabelAffinity = "LabelAffinity"
+	// PluginCost is a score plugin which prefers seeds with lower costs. The costs are read from the seed label
+	// configured in its CostArgs.
+	PluginCost = "Cost"
+
+	// DefaultCostLabelKey is the default label key of seeds containing their costs for the Cost score plugin.
+	DefaultCostLabelKey = "scheduling.gardener.cloud/cost"
 )
 
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
@@ -105,6 +124,74 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Plugins configures additional filter and score plugins. Filter plugins are run after the built-in filters and
+	// further restrict the seed candidates. Score plugins rank the candidates determined by the strategy. If no score
+	// plugins are configured, the candidate with the least shoots is chosen.
+	// +optional
+	Plugins *ShootSchedulerPlugins `json:"plugins,omitempty"`
+	// SchedulingPreview enables and configures the scheduling preview endpoint. It accepts a Shoot specification and
+	// returns for every seed whether it would be chosen, which filter rejected it and its score, without scheduling the
+	// shoot. The endpoint is disabled if not set.
+	// +optional
+	SchedulingPreview *SchedulingPreviewConfiguration `json:"schedulingPreview,omitempty"`
+}
+
+// SchedulingPreviewConfiguration contains the configuration of the scheduling preview endpoint.
+type SchedulingPreviewConfiguration struct {
+	// Server is the configuration of the dedicated server serving the endpoint. It is separate from the metrics server
+	// and binds to 127.0.0.1:10253 by default, i.e., the endpoint is only reachable from within the pod, e.g. via
+	// `kubectl port-forward`.
+	// +optional
+	Server *Server `json:"server,omitempty"`
+	// RequestsPerMinute is the maximum number of previews served per minute. Since every preview lists all seeds and
+	// shoots, further requests are rejected with status code 429. Defaults to 10.
+	// +optional
+	RequestsPerMinute *int32 `json:"requestsPerMinute,omitempty"`
+}
+
+// ShootSchedulerPlugins contains the filter and score plugins of the Shoot to Seed scheduler.
+type ShootSchedulerPlugins struct {
+	// Filter is the list of filter plugins. A seed is only considered if all filter plugins accept it.
+	// +optional
+	Filter []PluginConfiguration `json:"filter,omitempty"`
+	// Score is the list of score plugins. The seed with the highest weighted sum of all scores is chosen. If multiple
+	// seeds have the same score, the one with the least shoots is chosen.
+	// +optional
+	Score []PluginConfiguration `json:"score,omitempty"`
+}
+
+// PluginConfiguration configures a filter or score plugin.
+type PluginConfiguration struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+	// Weight is the weight of the score of a score plugin. It is ignored for filter plugins.
+	// Defaults to 1.
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+	// Args contains the arguments of the plugin.
+	// +optional
+	Args *runtime.RawExtension `json:"args,omitempty"`
+}
+
+// SeedLabelSelectorArgs are the arguments of the SeedLabelSelector filter plugin.
+type SeedLabelSelectorArgs struct {
+	// LabelSelector is the label selector which seeds must match.
+	LabelSelector metav1.LabelSelector `json:"labelSelector"`
+}
+
+// LabelAffinityArgs are the arguments of the LabelAffinity score plugin.
+type LabelAffinityArgs struct {
+	// LabelSelector is the label selector of the preferred seeds.
+	LabelSelector metav1.LabelSelector `json:"labelSelector"`
+}
+
+// CostArgs are the arguments of the Cost score plugin.
+type CostArgs struct {
+	// LabelKey is the key of the seed label containing the costs of the seed as decimal number. Seeds without this
+	// label get the lowest score.
+	// Defaults to "scheduling.gardener.cloud/cost".
+	// +optional
+	LabelKey string `json:"labelKey,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
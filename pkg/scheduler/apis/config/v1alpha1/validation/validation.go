@@ -7,6 +7,7 @@ package validation
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/logger"
@@ -51,6 +52,8 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validatePlugins(schedulers.Shoot.Plugins, fldPath.Child("shoot", "plugins"))...)
+		allErrs = append(allErrs, validateSchedulingPreview(schedulers.Shoot.SchedulingPreview, fldPath.Child("shoot", "schedulingPreview"))...)
 	}
 
 	return allErrs
@@ -74,3 +77,66 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+func validatePlugins(plugins *schedulerconfigv1alpha1.ShootSchedulerPlugins, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if plugins == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validatePluginConfigurations(plugins.Filter, false, fldPath.Child("filter"))...)
+	allErrs = append(allErrs, validatePluginConfigurations(plugins.Score, true, fldPath.Child("score"))...)
+
+	return allErrs
+}
+
+func validateSchedulingPreview(schedulingPreview *schedulerconfigv1alpha1.SchedulingPreviewConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schedulingPreview == nil {
+		return allErrs
+	}
+
+	if schedulingPreview.Server != nil {
+		for _, msg := range validation.IsValidPortNum(schedulingPreview.Server.Port) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("server", "port"), schedulingPreview.Server.Port, msg))
+		}
+	}
+
+	if schedulingPreview.RequestsPerMinute != nil && *schedulingPreview.RequestsPerMinute <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("requestsPerMinute"), *schedulingPreview.RequestsPerMinute, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// validatePluginConfigurations validates the given plugin configurations. Whether the plugins exist is not validated
+// here since the plugins are pluggable, see the `Validate` function of the scheduler framework's `Registry`.
+func validatePluginConfigurations(plugins []schedulerconfigv1alpha1.PluginConfiguration, weighted bool, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, plugin := range plugins {
+		idxPath := fldPath.Index(i)
+
+		if plugin.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "plugin name is required"))
+		} else if names.Has(plugin.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		names.Insert(plugin.Name)
+
+		if plugin.Weight != nil {
+			if !weighted {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("weight"), "weight is only supported for score plugins"))
+			} else if *plugin.Weight <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "must be greater than 0"))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -161,5 +161,98 @@ var _ = Describe("#ValidateConfiguration", func() {
 				"Field": Equal("schedulers.shoot.concurrentSyncs"),
 			}))))
 		})
+
+		Context("scheduling preview", func() {
+			It("should allow a valid scheduling preview configuration", func() {
+				conf.Schedulers.Shoot.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+					Server:            &schedulerconfigv1alpha1.Server{BindAddress: "127.0.0.1", Port: 10253},
+					RequestsPerMinute: ptr.To[int32](10),
+				}
+
+				Expect(ValidateConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should forbid invalid ports and non-positive rate limits", func() {
+				conf.Schedulers.Shoot.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+					Server:            &schedulerconfigv1alpha1.Server{BindAddress: "127.0.0.1", Port: 0},
+					RequestsPerMinute: ptr.To[int32](0),
+				}
+
+				Expect(ValidateConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.schedulingPreview.server.port"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.schedulingPreview.requestsPerMinute"),
+					})),
+				))
+			})
+		})
+
+		Context("plugins", func() {
+			It("should allow valid plugin configurations", func() {
+				conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "SeedLabelSelector"}},
+					Score: []schedulerconfigv1alpha1.PluginConfiguration{
+						{Name: "SeedUtilization", Weight: ptr.To[int32](2)},
+						{Name: "ZoneCount"},
+						{Name: "LabelAffinity"},
+						{Name: "Cost"},
+					},
+				}
+
+				Expect(ValidateConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should allow plugins which are not implemented in-tree", func() {
+				conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "Foo"}},
+					Score:  []schedulerconfigv1alpha1.PluginConfiguration{{Name: "Bar"}},
+				}
+
+				Expect(ValidateConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should forbid plugins without name and duplicate plugins", func() {
+				conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: ""}},
+					Score: []schedulerconfigv1alpha1.PluginConfiguration{
+						{Name: "ZoneCount"},
+						{Name: "ZoneCount"},
+					},
+				}
+
+				Expect(ValidateConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.filter[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("schedulers.shoot.plugins.score[1].name"),
+					})),
+				))
+			})
+
+			It("should forbid weights for filter plugins and non-positive weights for score plugins", func() {
+				conf.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "SeedLabelSelector", Weight: ptr.To[int32](1)}},
+					Score:  []schedulerconfigv1alpha1.PluginConfiguration{{Name: "ZoneCount", Weight: ptr.To[int32](0)}},
+				}
+
+				Expect(ValidateConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("schedulers.shoot.plugins.filter[0].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.score[0].weight"),
+					})),
+				))
+			})
+		})
 	})
 })
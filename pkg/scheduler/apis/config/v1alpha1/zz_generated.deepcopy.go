@@ -30,6 +30,65 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CostArgs) DeepCopyInto(out *CostArgs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CostArgs.
+func (in *CostArgs) DeepCopy() *CostArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(CostArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelAffinityArgs) DeepCopyInto(out *LabelAffinityArgs) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelAffinityArgs.
+func (in *LabelAffinityArgs) DeepCopy() *LabelAffinityArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelAffinityArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginConfiguration) DeepCopyInto(out *PluginConfiguration) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginConfiguration.
+func (in *PluginConfiguration) DeepCopy() *PluginConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -86,7 +145,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -101,6 +160,49 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingPreviewConfiguration) DeepCopyInto(out *SchedulingPreviewConfiguration) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(Server)
+		**out = **in
+	}
+	if in.RequestsPerMinute != nil {
+		in, out := &in.RequestsPerMinute, &out.RequestsPerMinute
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingPreviewConfiguration.
+func (in *SchedulingPreviewConfiguration) DeepCopy() *SchedulingPreviewConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingPreviewConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedLabelSelectorArgs) DeepCopyInto(out *SeedLabelSelectorArgs) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedLabelSelectorArgs.
+func (in *SeedLabelSelectorArgs) DeepCopy() *SeedLabelSelectorArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedLabelSelectorArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -146,6 +248,16 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(ShootSchedulerPlugins)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulingPreview != nil {
+		in, out := &in.SchedulingPreview, &out.SchedulingPreview
+		*out = new(SchedulingPreviewConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -158,3 +270,33 @@ func (in *ShootSchedulerConfiguration) DeepCopy() *ShootSchedulerConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSchedulerPlugins) DeepCopyInto(out *ShootSchedulerPlugins) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = make([]PluginConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Score != nil {
+		in, out := &in.Score, &out.Score
+		*out = make([]PluginConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSchedulerPlugins.
+func (in *ShootSchedulerPlugins) DeepCopy() *ShootSchedulerPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSchedulerPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
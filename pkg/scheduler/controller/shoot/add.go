@@ -5,6 +5,8 @@
 package shoot
 
 import (
+	"fmt"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -17,6 +19,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 )
 
 // ControllerName is the name of this controller.
@@ -33,6 +36,19 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.GardenNamespace == "" {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
+	if r.Framework == nil {
+		var err error
+		r.Framework, err = framework.New(framework.NewInTreeRegistry(), r.Config.Plugins)
+		if err != nil {
+			return fmt.Errorf("failed creating scheduler framework: %w", err)
+		}
+	}
+
+	if r.Config.SchedulingPreview != nil {
+		if err := mgr.Add(r.SchedulingPreviewServer(mgr.GetLogger().WithName("scheduling-preview"))); err != nil {
+			return fmt.Errorf("failed adding scheduling preview server: %w", err)
+		}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
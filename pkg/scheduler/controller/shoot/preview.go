@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// SchedulingPreviewPath is the path of the scheduling preview endpoint.
+const SchedulingPreviewPath = "/debug/scheduling-preview"
+
+// maxSchedulingPreviewRequestBytes is the maximum size of the Shoot specification accepted by the scheduling preview
+// endpoint.
+const maxSchedulingPreviewRequestBytes = 3 * 1024 * 1024
+
+// SchedulingPreview is the result of a seed determination for a shoot which is not actually scheduled.
+type SchedulingPreview struct {
+	// Seed is the name of the seed the shoot would be scheduled to.
+	Seed string `json:"seed,omitempty"`
+	// Error is the reason why the shoot cannot be scheduled.
+	Error string `json:"error,omitempty"`
+	// Seeds contains the decisions for all seeds, sorted by their names.
+	Seeds []SeedPreview `json:"seeds"`
+}
+
+// SeedPreview contains the decision for a seed in a SchedulingPreview.
+type SeedPreview struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Shoots is the number of shoots currently scheduled to the seed.
+	Shoots int `json:"shoots"`
+	// RejectedBy is the name of the filter which rejected the seed. It is empty if the seed was not rejected, or if
+	// the seed determination failed before reaching the seed.
+	RejectedBy string `json:"rejectedBy,omitempty"`
+	// Reason describes why the seed was rejected.
+	Reason string `json:"reason,omitempty"`
+	// Score is the weighted score of the score plugins. It is only set if score plugins are configured and the seed
+	// was not rejected before scoring.
+	Score *int64 `json:"score,omitempty"`
+	// Selected is true if the shoot would be scheduled to the seed.
+	Selected bool `json:"selected,omitempty"`
+}
+
+// PreviewSeedDetermination runs the seed determination for the given shoot and returns the decisions for all seeds
+// without scheduling the shoot. A seed already referenced in the shoot specification is ignored.
+func (r *Reconciler) PreviewSeedDetermination(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) *SchedulingPreview {
+	preview := &SchedulingPreview{}
+
+	seed, err := r.determineSeed(ctx, log, shoot, preview)
+	if err != nil {
+		preview.Error = err.Error()
+		return preview
+	}
+
+	preview.Seed = seed.Name
+	if seedPreview := preview.seed(seed.Name); seedPreview != nil {
+		seedPreview.Selected = true
+	}
+	return preview
+}
+
+// SchedulingPreviewServer returns the server serving the scheduling preview endpoint according to the configuration.
+// It is separate from the metrics server so that it can be bound to the loopback interface.
+func (r *Reconciler) SchedulingPreviewServer(log logr.Logger) *manager.Server {
+	var (
+		config  = r.Config.SchedulingPreview
+		limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(*config.RequestsPerMinute)), 1)
+		mux     = http.NewServeMux()
+	)
+
+	mux.Handle(SchedulingPreviewPath, r.SchedulingPreviewHandler(log, limiter))
+
+	return &manager.Server{
+		Name: "scheduling-preview",
+		Server: &http.Server{
+			Addr:              net.JoinHostPort(config.Server.BindAddress, strconv.Itoa(config.Server.Port)),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		ShutdownTimeout: ptr.To(5 * time.Second),
+	}
+}
+
+// SchedulingPreviewHandler returns an HTTP handler which accepts a Shoot in JSON or YAML format via POST and responds
+// with the SchedulingPreview for it in JSON format. Requests exceeding the given rate limit are rejected.
+func (r *Reconciler) SchedulingPreviewHandler(log logr.Logger, limiter *rate.Limiter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, fmt.Sprintf("method %s is not allowed", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if !limiter.Allow() {
+			http.Error(w, "too many scheduling preview requests, try again later", http.StatusTooManyRequests)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxSchedulingPreviewRequestBytes+1))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxSchedulingPreviewRequestBytes {
+			http.Error(w, "request body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := yaml.Unmarshal(body, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed decoding shoot: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		ctx, cancel := controllerutils.GetMainReconciliationContext(req.Context(), controllerutils.DefaultReconciliationTimeout)
+		defer cancel()
+
+		previewLog := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+		preview := r.PreviewSeedDetermination(ctx, previewLog, shoot)
+		previewLog.Info("Previewed seed determination", "seed", preview.Seed, "error", preview.Error)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(preview); err != nil {
+			previewLog.Error(err, "Failed writing scheduling preview")
+		}
+	})
+}
+
+func (p *SchedulingPreview) seed(name string) *SeedPreview {
+	i, ok := slices.BinarySearchFunc(p.Seeds, name, func(seed SeedPreview, name string) int {
+		return strings.Compare(seed.Name, name)
+	})
+	if !ok {
+		return nil
+	}
+	return &p.Seeds[i]
+}
+
+func (p *SchedulingPreview) addSeeds(seedList []gardencorev1beta1.Seed, seedUsage map[string]int) {
+	if p == nil {
+		return
+	}
+
+	for _, seed := range seedList {
+		p.Seeds = append(p.Seeds, SeedPreview{Name: seed.Name, Shoots: seedUsage[seed.Name]})
+	}
+	slices.SortFunc(p.Seeds, func(a, b SeedPreview) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+// recordRejections records the seeds which are part of the input but not of the output of the given filter. Individual
+// reasons take precedence over the generic reason of the filter, which in turn takes precedence over the error of the
+// filter.
+func (p *SchedulingPreview) recordRejections(name, reason string, input, output []gardencorev1beta1.Seed, seedNameToErr map[string]error, err error) {
+	if p == nil {
+		return
+	}
+
+	accepted := sets.New[string]()
+	for _, seed := range output {
+		accepted.Insert(seed.Name)
+	}
+
+	for _, seed := range input {
+		seedPreview := p.seed(seed.Name)
+		if accepted.Has(seed.Name) || seedPreview == nil {
+			continue
+		}
+
+		seedPreview.RejectedBy = name
+		switch {
+		case seedNameToErr[seed.Name] != nil:
+			seedPreview.Reason = seedNameToErr[seed.Name].Error()
+		case reason != "":
+			seedPreview.Reason = reason
+		case err != nil:
+			seedPreview.Reason = err.Error()
+		}
+	}
+}
+
+func (p *SchedulingPreview) recordScores(scores map[string]int64) {
+	if p == nil {
+		return
+	}
+
+	for name, score := range scores {
+		if seedPreview := p.seed(name); seedPreview != nil {
+			seedPreview.Score = &score
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+var _ = Describe("Scheduling preview", func() {
+	var (
+		ctx              = context.Background()
+		log              = logr.Discard()
+		fakeGardenClient client.Client
+
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	newSeed := func(name, region string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Nodes:    ptr.To("10.10.0.0/16"),
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true},
+				},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				Conditions: []gardencorev1beta1.Condition{{
+					Type:   gardencorev1beta1.SeedGardenletReady,
+					Status: gardencorev1beta1.ConditionTrue,
+				}},
+				LastOperation: &gardencorev1beta1.LastOperation{},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeGardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		reconciler = &Reconciler{
+			Client: fakeGardenClient,
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: ptr.To("cloudprofile"),
+				Region:           "europe",
+				Provider:         gardencorev1beta1.Provider{Type: "foo"},
+			},
+		}
+
+		Expect(fakeGardenClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+
+		invisibleSeed := newSeed("seed-a", "europe")
+		invisibleSeed.Spec.Settings.Scheduling.Visible = false
+		Expect(fakeGardenClient.Create(ctx, invisibleSeed)).To(Succeed())
+
+		taintedSeed := newSeed("seed-b", "europe")
+		taintedSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+		Expect(fakeGardenClient.Create(ctx, taintedSeed)).To(Succeed())
+
+		Expect(fakeGardenClient.Create(ctx, newSeed("seed-c", "asia"))).To(Succeed())
+		Expect(fakeGardenClient.Create(ctx, newSeed("seed-d", "europe"))).To(Succeed())
+		Expect(fakeGardenClient.Create(ctx, newSeed("seed-e", "europe"))).To(Succeed())
+
+		Expect(fakeGardenClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "garden-dev"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed-d")},
+		})).To(Succeed())
+	})
+
+	Describe("#PreviewSeedDetermination", func() {
+		It("should return the decisions for all seeds", func() {
+			Expect(reconciler.PreviewSeedDetermination(ctx, log, shoot)).To(Equal(&SchedulingPreview{
+				Seed: "seed-e",
+				Seeds: []SeedPreview{
+					{Name: "seed-a", RejectedBy: "Usable", Reason: "seed is deleting, invisible or not ready"},
+					{Name: "seed-b", RejectedBy: "Candidates", Reason: "shoot does not tolerate the seed's taints"},
+					{Name: "seed-c", RejectedBy: "Strategy", Reason: "seed is not a candidate of the SameRegion strategy"},
+					{Name: "seed-d", Shoots: 1, RejectedBy: "LeastShootsDeployed", Reason: "seed manages more shoots than the selected seed"},
+					{Name: "seed-e", Selected: true},
+				},
+			}))
+		})
+
+		It("should return the scores of the score plugins", func() {
+			var err error
+			reconciler.Framework, err = framework.New(framework.NewInTreeRegistry(), &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "SeedUtilization", Weight: ptr.To[int32](1)}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			preview := reconciler.PreviewSeedDetermination(ctx, log, shoot)
+			Expect(preview.Seed).To(Equal("seed-e"))
+			Expect(preview.Seeds[3]).To(Equal(SeedPreview{Name: "seed-d", Shoots: 1, Score: ptr.To[int64](0), RejectedBy: "Score", Reason: "seed does not have the highest score"}))
+			Expect(preview.Seeds[4]).To(Equal(SeedPreview{Name: "seed-e", Score: ptr.To[int64](100), Selected: true}))
+		})
+
+		It("should return the error if no seed can be determined", func() {
+			shoot.Spec.Region = "america"
+
+			preview := reconciler.PreviewSeedDetermination(ctx, log, shoot)
+			Expect(preview.Seed).To(BeEmpty())
+			Expect(preview.Error).To(ContainSubstring("no matching seed candidate found"))
+			Expect(preview.Seeds).To(HaveEach(HaveField("RejectedBy", Not(BeEmpty()))))
+		})
+	})
+
+	Describe("#SchedulingPreviewServer", func() {
+		It("should serve the scheduling preview on the configured address", func() {
+			reconciler.Config.SchedulingPreview = &schedulerconfigv1alpha1.SchedulingPreviewConfiguration{
+				Server:            &schedulerconfigv1alpha1.Server{BindAddress: "127.0.0.1", Port: 10253},
+				RequestsPerMinute: ptr.To[int32](10),
+			}
+
+			server := reconciler.SchedulingPreviewServer(log)
+			Expect(server.Name).To(Equal("scheduling-preview"))
+			Expect(server.Server.Addr).To(Equal("127.0.0.1:10253"))
+			Expect(server.NeedLeaderElection()).To(BeFalse())
+
+			recorder := httptest.NewRecorder()
+			server.Server.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, SchedulingPreviewPath, nil))
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+
+			recorder = httptest.NewRecorder()
+			server.Server.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("#SchedulingPreviewHandler", func() {
+		var handler http.Handler
+
+		BeforeEach(func() {
+			handler = reconciler.SchedulingPreviewHandler(log, rate.NewLimiter(rate.Inf, 0))
+		})
+
+		It("should respond with the scheduling preview", func() {
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(string(body))))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			preview := &SchedulingPreview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), preview)).To(Succeed())
+			Expect(preview.Seed).To(Equal("seed-e"))
+			Expect(preview.Seeds).To(HaveLen(5))
+		})
+
+		It("should accept the shoot in YAML format", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`metadata:
+  name: shoot
+  namespace: garden-dev
+spec:
+  cloudProfileName: cloudprofile
+  region: asia
+  provider:
+    type: foo
+`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"seed":"seed-c"`))
+		})
+
+		It("should reject other methods than POST", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, SchedulingPreviewPath, nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		})
+
+		It("should reject requests exceeding the rate limit", func() {
+			handler = reconciler.SchedulingPreviewHandler(log, rate.NewLimiter(rate.Every(time.Hour), 1))
+
+			body, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(string(body))))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			recorder = httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(string(body))))
+			Expect(recorder.Code).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should reject invalid shoots", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SchedulingPreviewPath, strings.NewReader(`spec: foo`)))
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
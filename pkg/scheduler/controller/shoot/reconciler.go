@@ -30,6 +30,7 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 )
@@ -40,6 +41,8 @@ type Reconciler struct {
 	Config          *schedulerconfigv1alpha1.ShootSchedulerConfiguration
 	GardenNamespace string
 	Recorder        record.EventRecorder
+	// Framework runs the filter and score plugins configured in Config. If nil, no plugins are run.
+	Framework *framework.Framework
 }
 
 // Reconcile schedules shoots to seeds.
@@ -122,6 +125,36 @@ func (r *Reconciler) DetermineSeed(
 ) (
 	*gardencorev1beta1.Seed,
 	error,
+) {
+	return r.determineSeed(ctx, log, shoot, nil)
+}
+
+// seedFilter is a step of the seed determination which filters out seeds not suitable for the shoot.
+type seedFilter struct {
+	// name identifies the filter in scheduling previews.
+	name string
+	// reason describes why a seed was filtered out if the filter does not return individual reasons.
+	reason string
+	filter func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error)
+}
+
+func withoutReasons(filter func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)) func([]gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error) {
+	return func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error) {
+		seeds, err := filter(seedList)
+		return seeds, nil, err
+	}
+}
+
+// determineSeed returns an appropriate Seed cluster (or nil). If a preview is given, the decisions for all seeds are
+// recorded in it.
+func (r *Reconciler) determineSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+	preview *SchedulingPreview,
+) (
+	*gardencorev1beta1.Seed,
+	error,
 ) {
 	seedList := &gardencorev1beta1.SeedList{}
 	if err := r.Client.List(ctx, seedList); err != nil {
@@ -143,39 +176,136 @@ func (r *Reconciler) DetermineSeed(
 		return nil, err
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
-	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
-	if err != nil {
-		return nil, err
+	state := &framework.State{
+		Shoot:        shoot,
+		CloudProfile: cloudProfile,
+		SeedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
+	}
+	preview.addSeeds(seedList.Items, state.SeedUsage)
+
+	filters := []seedFilter{
+		{
+			name:   "Usable",
+			reason: "seed is deleting, invisible or not ready",
+			filter: withoutReasons(filterUsableSeeds),
+		},
+		{
+			name:   "CloudProfileSeedSelector",
+			reason: "seed does not match the seed selector of the CloudProfile",
+			filter: withoutReasons(func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingLabelSelector(seedList, cloudProfile.Spec.SeedSelector, "CloudProfile")
+			}),
+		},
+		{
+			name:   "ShootSeedSelector",
+			reason: "seed does not match the seed selector of the Shoot",
+			filter: withoutReasons(func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingLabelSelector(seedList, shoot.Spec.SeedSelector, "Shoot")
+			}),
+		},
+		{
+			name:   "Provider",
+			reason: fmt.Sprintf("seed provider does not match %q", shoot.Spec.Provider.Type),
+			filter: withoutReasons(func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsMatchingProviders(cloudProfile, shoot, seedList)
+			}),
+		},
+		{
+			name:   "ZonalShootControlPlane",
+			reason: "seed does not have at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'",
+			filter: withoutReasons(func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForZonalShootControlPlanes(seedList, shoot)
+			}),
+		},
+		{
+			name:   "AccessRestrictions",
+			reason: "seed does not support the access restrictions configured in the shoot specification",
+			filter: withoutReasons(func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return filterSeedsForAccessRestrictions(seedList, shoot)
+			}),
+		},
+		{
+			name: "Candidates",
+			filter: func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error) {
+				return filterCandidates(shoot, shootList, seedList)
+			},
+		},
+		{
+			name: "FilterPlugins",
+			filter: func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error) {
+				return r.filterSeedsWithPlugins(ctx, state, seedList)
+			},
+		},
+		{
+			name:   "Strategy",
+			reason: fmt.Sprintf("seed is not a candidate of the %s strategy", r.Config.Strategy),
+			filter: withoutReasons(func(seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+				return applyStrategy(log, shoot, seedList, r.Config.Strategy, regionConfig)
+			}),
+		},
+	}
+
+	filteredSeeds := seedList.Items
+	for _, f := range filters {
+		seeds, seedNameToErr, err := f.filter(filteredSeeds)
+		preview.recordRejections(f.name, f.reason, filteredSeeds, seeds, seedNameToErr, err)
+		if err != nil {
+			return nil, err
+		}
+		filteredSeeds = seeds
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
+
+	if r.Framework.HasScorePlugins() {
+		seeds, scores, err := r.getSeedsWithHighestScore(ctx, log, state, filteredSeeds)
+		if err != nil {
+			return nil, err
+		}
+		preview.recordScores(scores)
+		preview.recordRejections("Score", "seed does not have the highest score", filteredSeeds, seeds, nil, nil)
+		filteredSeeds = seeds
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
+
+	seed, err := getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
+	preview.recordRejections("LeastShootsDeployed", "seed manages more shoots than the selected seed", filteredSeeds, []gardencorev1beta1.Seed{*seed}, nil, nil)
+	return seed, nil
+}
+
+func (r *Reconciler) filterSeedsWithPlugins(ctx context.Context, state *framework.State, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error) {
+	candidates, seedNameToErr := r.Framework.RunFilterPlugins(ctx, state, seedList)
+	if len(candidates) == 0 {
+		return nil, seedNameToErr, fmt.Errorf("0/%d seed cluster candidate(s) are accepted by the filter plugins: %v", len(seedList), errorMapToString(seedNameToErr))
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+	return candidates, seedNameToErr, nil
+}
+
+// getSeedsWithHighestScore returns the seeds with the highest weighted score of all score plugins as well as the scores
+// of all seeds.
+func (r *Reconciler) getSeedsWithHighestScore(ctx context.Context, log logr.Logger, state *framework.State, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]int64, error) {
+	scores, err := r.Framework.RunScorePlugins(ctx, state, seedList)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
+	log.V(1).Info("Scored seed candidates", "scores", scores)
+
+	var (
+		candidates   []gardencorev1beta1.Seed
+		highestScore int64 = -1
+	)
+
+	for _, seed := range seedList {
+		switch score := scores[seed.Name]; {
+		case score > highestScore:
+			highestScore = score
+			candidates = []gardencorev1beta1.Seed{seed}
+		case score == highestScore:
+			candidates = append(candidates, seed)
+		}
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	return candidates, scores, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -329,7 +459,7 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error, error) {
 	var (
 		candidates    []gardencorev1beta1.Seed
 		seedNameToErr = make(map[string]error)
@@ -358,9 +488,9 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	}
 
 	if candidates == nil {
-		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(seedNameToErr))
+		return nil, seedNameToErr, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(seedNameToErr))
 	}
-	return candidates, nil
+	return candidates, seedNameToErr, nil
 }
 
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -22,6 +23,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 )
 
 var _ = Describe("Scheduler_Control", func() {
@@ -273,6 +275,98 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using scheduler plugins", func() {
+		var secondSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+			secondSeed.Labels = map[string]string{"environment": "preferred"}
+			secondSeed.Spec.Provider.Zones = []string{"1", "2", "3"}
+
+			secondShoot := shootBase.DeepCopy()
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &secondSeed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondShoot)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			reconciler.Framework, err = framework.New(framework.NewInTreeRegistry(), schedulerConfiguration.Schedulers.Shoot.Plugins)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should choose the seed with the least shoots if no score plugins are configured", func() {
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		Context("score plugins", func() {
+			BeforeEach(func() {
+				schedulerConfiguration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Score: []schedulerconfigv1alpha1.PluginConfiguration{
+						{Name: "SeedUtilization", Weight: ptr.To[int32](1)},
+						{Name: "ZoneCount", Weight: ptr.To[int32](2)},
+					},
+				}
+			})
+
+			It("should choose the seed with the highest weighted score", func() {
+				bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+			})
+
+			Context("equal scores", func() {
+				BeforeEach(func() {
+					schedulerConfiguration.Schedulers.Shoot.Plugins.Score[1].Weight = ptr.To[int32](1)
+				})
+
+				It("should choose the seed with the least shoots if the scores are equal", func() {
+					bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(bestSeed.Name).To(Equal(seed.Name))
+				})
+			})
+		})
+
+		Context("filter plugins", func() {
+			BeforeEach(func() {
+				schedulerConfiguration.Schedulers.Shoot.Plugins = &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+					Filter: []schedulerconfigv1alpha1.PluginConfiguration{{
+						Name: "SeedLabelSelector",
+						Args: &runtime.RawExtension{Raw: []byte(`{"labelSelector":{"matchLabels":{"environment":"preferred"}}}`)},
+					}},
+				}
+			})
+
+			It("should only consider seeds accepted by the filter plugins", func() {
+				bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+			})
+
+			It("should fail if no seed is accepted by the filter plugins", func() {
+				secondSeed.Labels = nil
+				Expect(fakeGardenClient.Update(ctx, secondSeed)).To(Succeed())
+
+				bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+				Expect(err).To(MatchError(ContainSubstring("0/2 seed cluster candidate(s) are accepted by the filter plugins")))
+				Expect(bestSeed).To(BeNil())
+			})
+		})
+	})
+
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using 'MinimalDistance' seed determination strategy", func() {
 		var anotherType = "another-type"
 		var anotherRegion = "another-region"
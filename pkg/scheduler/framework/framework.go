@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// MaxScore is the maximum score a score plugin returns for a seed. The minimum score is 0.
+const MaxScore int64 = 100
+
+// State contains the information about the shoot to be scheduled which is passed to the plugins.
+type State struct {
+	// Shoot is the shoot to be scheduled.
+	Shoot *gardencorev1beta1.Shoot
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// SeedUsage maps the names of seeds to the number of shoots scheduled onto them.
+	SeedUsage map[string]int
+}
+
+// Plugin is the parent type for all scheduler plugins.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// FilterPlugin is a plugin which decides whether a seed is eligible for hosting the shoot.
+type FilterPlugin interface {
+	Plugin
+	// Filter returns an error describing the reason if the seed is not eligible for hosting the shoot.
+	Filter(ctx context.Context, state *State, seed *gardencorev1beta1.Seed) error
+}
+
+// ScorePlugin is a plugin which ranks the seed candidates for the shoot.
+type ScorePlugin interface {
+	Plugin
+	// Score returns the scores of the given seeds by their names. All seeds are passed at once so that the scores can
+	// be normalized across all candidates. Scores must be between 0 and MaxScore.
+	Score(ctx context.Context, state *State, seeds []gardencorev1beta1.Seed) (map[string]int64, error)
+}
+
+// PluginFactory creates a plugin from its arguments.
+type PluginFactory func(args *runtime.RawExtension) (Plugin, error)
+
+// Registry maps the names of plugins to their factories.
+type Registry map[string]PluginFactory
+
+// Register adds the given plugin factory to the registry.
+func (r Registry) Register(name string, factory PluginFactory) error {
+	if _, ok := r[name]; ok {
+		return fmt.Errorf("plugin %q is already registered", name)
+	}
+	r[name] = factory
+	return nil
+}
+
+// NewInTreeRegistry returns a registry containing all plugins implemented in this package.
+func NewInTreeRegistry() Registry {
+	return Registry{
+		schedulerconfigv1alpha1.PluginSeedLabelSelector: NewSeedLabelSelector,
+		schedulerconfigv1alpha1.PluginSeedUtilization:   NewSeedUtilization,
+		schedulerconfigv1alpha1.PluginZoneCount:         NewZoneCount,
+		schedulerconfigv1alpha1.PluginLabelAffinity:     NewLabelAffinity,
+		schedulerconfigv1alpha1.PluginCost:              NewCost,
+	}
+}
+
+// Validate validates that the plugins of the given configuration are registered, can be created with their arguments
+// and implement the extension point they are configured for.
+func (r Registry) Validate(config *schedulerconfigv1alpha1.ShootSchedulerPlugins, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, r.validatePluginConfigurations(config.Filter, "filter", func(plugin Plugin) bool {
+		_, ok := plugin.(FilterPlugin)
+		return ok
+	}, fldPath.Child("filter"))...)
+	allErrs = append(allErrs, r.validatePluginConfigurations(config.Score, "score", func(plugin Plugin) bool {
+		_, ok := plugin.(ScorePlugin)
+		return ok
+	}, fldPath.Child("score"))...)
+
+	return allErrs
+}
+
+func (r Registry) validatePluginConfigurations(pluginConfigs []schedulerconfigv1alpha1.PluginConfiguration, extensionPoint string, implementsExtensionPoint func(Plugin) bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, pluginConfig := range pluginConfigs {
+		idxPath := fldPath.Index(i)
+
+		factory, ok := r[pluginConfig.Name]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), pluginConfig.Name, slices.Sorted(maps.Keys(r))))
+			continue
+		}
+
+		plugin, err := factory(pluginConfig.Args)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("args"), pluginConfig.Args, err.Error()))
+			continue
+		}
+
+		if !implementsExtensionPoint(plugin) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pluginConfig.Name, fmt.Sprintf("plugin is not a %s plugin", extensionPoint)))
+		}
+	}
+
+	return allErrs
+}
+
+type weightedScorePlugin struct {
+	ScorePlugin
+	weight int64
+}
+
+// Framework runs the configured filter and score plugins.
+type Framework struct {
+	filterPlugins []FilterPlugin
+	scorePlugins  []weightedScorePlugin
+}
+
+// New creates a new Framework with the plugins of the given configuration. The plugins are created with the factories
+// of the given registry.
+func New(registry Registry, config *schedulerconfigv1alpha1.ShootSchedulerPlugins) (*Framework, error) {
+	f := &Framework{}
+	if config == nil {
+		return f, nil
+	}
+
+	for _, pluginConfig := range config.Filter {
+		plugin, err := newPlugin(registry, pluginConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		filterPlugin, ok := plugin.(FilterPlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %q is not a filter plugin", pluginConfig.Name)
+		}
+		f.filterPlugins = append(f.filterPlugins, filterPlugin)
+	}
+
+	for _, pluginConfig := range config.Score {
+		plugin, err := newPlugin(registry, pluginConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		scorePlugin, ok := plugin.(ScorePlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %q is not a score plugin", pluginConfig.Name)
+		}
+		f.scorePlugins = append(f.scorePlugins, weightedScorePlugin{scorePlugin, int64(ptr.Deref(pluginConfig.Weight, 1))})
+	}
+
+	return f, nil
+}
+
+func newPlugin(registry Registry, pluginConfig schedulerconfigv1alpha1.PluginConfiguration) (Plugin, error) {
+	factory, ok := registry[pluginConfig.Name]
+	if !ok {
+		return nil, fmt.Errorf("plugin %q is not registered", pluginConfig.Name)
+	}
+
+	plugin, err := factory(pluginConfig.Args)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating plugin %q: %w", pluginConfig.Name, err)
+	}
+	return plugin, nil
+}
+
+// HasScorePlugins returns true if at least one score plugin is configured.
+func (f *Framework) HasScorePlugins() bool {
+	return f != nil && len(f.scorePlugins) > 0
+}
+
+// RunFilterPlugins returns the seeds accepted by all filter plugins. The reasons why seeds were rejected are returned
+// by their names.
+func (f *Framework) RunFilterPlugins(ctx context.Context, state *State, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, map[string]error) {
+	if f == nil || len(f.filterPlugins) == 0 {
+		return seeds, nil
+	}
+
+	var (
+		accepted       []gardencorev1beta1.Seed
+		seedNameToErrs = make(map[string]error)
+	)
+
+	for _, seed := range seeds {
+		var rejected bool
+		for _, plugin := range f.filterPlugins {
+			if err := plugin.Filter(ctx, state, &seed); err != nil {
+				seedNameToErrs[seed.Name] = fmt.Errorf("%s: %w", plugin.Name(), err)
+				rejected = true
+				break
+			}
+		}
+
+		if !rejected {
+			accepted = append(accepted, seed)
+		}
+	}
+
+	return accepted, seedNameToErrs
+}
+
+// RunScorePlugins returns the weighted sum of the scores of all score plugins for the given seeds by their names.
+func (f *Framework) RunScorePlugins(ctx context.Context, state *State, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	totalScores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		totalScores[seed.Name] = 0
+	}
+
+	if f == nil {
+		return totalScores, nil
+	}
+
+	for _, plugin := range f.scorePlugins {
+		scores, err := plugin.Score(ctx, state, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %q: %w", plugin.Name(), err)
+		}
+
+		for name, score := range scores {
+			if score < 0 || score > MaxScore {
+				return nil, fmt.Errorf("score plugin %q returned invalid score %d for seed %q, must be between 0 and %d", plugin.Name(), score, name, MaxScore)
+			}
+			if _, ok := totalScores[name]; ok {
+				totalScores[name] += plugin.weight * score
+			}
+		}
+	}
+
+	return totalScores, nil
+}
@@ -0,0 +1,250 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+type fakePlugin struct {
+	name      string
+	filterErr map[string]error
+	scores    map[string]int64
+	scoreErr  error
+}
+
+func (p *fakePlugin) Name() string { return p.name }
+
+func (p *fakePlugin) Filter(_ context.Context, _ *State, seed *gardencorev1beta1.Seed) error {
+	return p.filterErr[seed.Name]
+}
+
+func (p *fakePlugin) Score(_ context.Context, _ *State, _ []gardencorev1beta1.Seed) (map[string]int64, error) {
+	return p.scores, p.scoreErr
+}
+
+var _ = Describe("Framework", func() {
+	var (
+		ctx   = context.TODO()
+		state *State
+		seeds []gardencorev1beta1.Seed
+
+		registry Registry
+	)
+
+	BeforeEach(func() {
+		state = &State{}
+		seeds = []gardencorev1beta1.Seed{
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-2"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-3"}},
+		}
+
+		registry = Registry{}
+	})
+
+	register := func(plugin *fakePlugin) {
+		ExpectWithOffset(1, registry.Register(plugin.name, func(_ *runtime.RawExtension) (Plugin, error) { return plugin, nil })).To(Succeed())
+	}
+
+	Describe("Registry", func() {
+		It("should fail registering a plugin twice", func() {
+			register(&fakePlugin{name: "foo"})
+			Expect(registry.Register("foo", nil)).To(MatchError(`plugin "foo" is already registered`))
+		})
+
+		It("should contain all in-tree plugins", func() {
+			Expect(NewInTreeRegistry()).To(And(
+				HaveKey("SeedLabelSelector"),
+				HaveKey("SeedUtilization"),
+				HaveKey("ZoneCount"),
+				HaveKey("LabelAffinity"),
+				HaveKey("Cost"),
+			))
+		})
+	})
+
+	Describe("#Validate", func() {
+		var fldPath *field.Path
+
+		BeforeEach(func() {
+			fldPath = field.NewPath("plugins")
+			register(&fakePlugin{name: "foo"})
+			Expect(registry.Register("zoneCount", NewZoneCount)).To(Succeed())
+			Expect(registry.Register("seedLabelSelector", NewSeedLabelSelector)).To(Succeed())
+		})
+
+		It("should succeed without plugin configuration", func() {
+			Expect(registry.Validate(nil, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow registered plugins", func() {
+			Expect(registry.Validate(&schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}, {Name: "seedLabelSelector", Args: &runtime.RawExtension{Raw: []byte(`{"labelSelector":{"matchLabels":{"foo":"bar"}}}`)}}},
+				Score:  []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}, {Name: "zoneCount"}},
+			}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid plugins which are not registered, cannot be created or do not implement the extension point", func() {
+			Expect(registry.Validate(&schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "bar"}, {Name: "zoneCount"}, {Name: "seedLabelSelector"}},
+				Score:  []schedulerconfigv1alpha1.PluginConfiguration{{Name: "seedLabelSelector", Args: &runtime.RawExtension{Raw: []byte(`{"labelSelector":{"matchLabels":{"foo":"bar"}}}`)}}},
+			}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("plugins.filter[0].name"),
+					"BadValue": Equal("bar"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("plugins.filter[1].name"),
+					"Detail": Equal("plugin is not a filter plugin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("plugins.filter[2].args"),
+					"Detail": Equal("label selector must not be empty"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("plugins.score[0].name"),
+					"Detail": Equal("plugin is not a score plugin"),
+				})),
+			))
+		})
+	})
+
+	Describe("#New", func() {
+		It("should succeed without plugin configuration", func() {
+			framework, err := New(registry, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(framework.HasScorePlugins()).To(BeFalse())
+		})
+
+		It("should fail if a plugin is not registered", func() {
+			_, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).To(MatchError(`plugin "foo" is not registered`))
+		})
+
+		It("should fail if the plugin factory fails", func() {
+			Expect(registry.Register("foo", func(_ *runtime.RawExtension) (Plugin, error) { return nil, errors.New("fake") })).To(Succeed())
+
+			_, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).To(MatchError(`failed creating plugin "foo": fake`))
+		})
+
+		It("should fail if a score plugin is configured as filter plugin", func() {
+			Expect(registry.Register("foo", NewZoneCount)).To(Succeed())
+
+			_, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).To(MatchError(`plugin "foo" is not a filter plugin`))
+		})
+
+		It("should fail if a filter plugin is configured as score plugin", func() {
+			Expect(registry.Register("foo", func(_ *runtime.RawExtension) (Plugin, error) {
+				return NewSeedLabelSelector(&runtime.RawExtension{Raw: []byte(`{"labelSelector":{"matchLabels":{"foo":"bar"}}}`)})
+			})).To(Succeed())
+
+			_, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).To(MatchError(`plugin "foo" is not a score plugin`))
+		})
+	})
+
+	Describe("#RunFilterPlugins", func() {
+		It("should return all seeds if no filter plugins are configured", func() {
+			var framework *Framework
+
+			accepted, seedNameToErr := framework.RunFilterPlugins(ctx, state, seeds)
+			Expect(accepted).To(Equal(seeds))
+			Expect(seedNameToErr).To(BeEmpty())
+		})
+
+		It("should return the seeds accepted by all filter plugins", func() {
+			register(&fakePlugin{name: "foo", filterErr: map[string]error{"seed-1": errors.New("foo-error")}})
+			register(&fakePlugin{name: "bar", filterErr: map[string]error{"seed-1": errors.New("bar-error"), "seed-3": errors.New("bar-error")}})
+
+			framework, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Filter: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}, {Name: "bar"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			accepted, seedNameToErr := framework.RunFilterPlugins(ctx, state, seeds)
+			Expect(accepted).To(ConsistOf(seeds[1]))
+			Expect(seedNameToErr).To(HaveLen(2))
+			Expect(seedNameToErr["seed-1"]).To(MatchError("foo: foo-error"))
+			Expect(seedNameToErr["seed-3"]).To(MatchError("bar: bar-error"))
+		})
+	})
+
+	Describe("#RunScorePlugins", func() {
+		It("should return zero scores if no score plugins are configured", func() {
+			var framework *Framework
+
+			scores, err := framework.RunScorePlugins(ctx, state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 0, "seed-3": 0}))
+		})
+
+		It("should return the weighted sum of the scores", func() {
+			register(&fakePlugin{name: "foo", scores: map[string]int64{"seed-1": 100, "seed-2": 50, "seed-3": 0, "unknown": 100}})
+			register(&fakePlugin{name: "bar", scores: map[string]int64{"seed-1": 0, "seed-2": 50, "seed-3": 100}})
+
+			framework, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}, {Name: "bar", Weight: ptr.To[int32](3)}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(framework.HasScorePlugins()).To(BeTrue())
+
+			scores, err := framework.RunScorePlugins(ctx, state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 200, "seed-3": 300}))
+		})
+
+		It("should fail if a score plugin fails", func() {
+			register(&fakePlugin{name: "foo", scoreErr: errors.New("fake")})
+
+			framework, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = framework.RunScorePlugins(ctx, state, seeds)
+			Expect(err).To(MatchError(`failed running score plugin "foo": fake`))
+		})
+
+		It("should fail if a score plugin returns an invalid score", func() {
+			register(&fakePlugin{name: "foo", scores: map[string]int64{"seed-1": MaxScore + 1}})
+
+			framework, err := New(registry, &schedulerconfigv1alpha1.ShootSchedulerPlugins{
+				Score: []schedulerconfigv1alpha1.PluginConfiguration{{Name: "foo"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = framework.RunScorePlugins(ctx, state, seeds)
+			Expect(err).To(MatchError(ContainSubstring(`score plugin "foo" returned invalid score 101 for seed "seed-1"`)))
+		})
+	})
+})
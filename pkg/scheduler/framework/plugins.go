@@ -0,0 +1,260 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+func decodeArgs(args *runtime.RawExtension, into any) error {
+	if args == nil || len(args.Raw) == 0 {
+		return nil
+	}
+
+	if err := yaml.UnmarshalStrict(args.Raw, into); err != nil {
+		return fmt.Errorf("failed decoding arguments: %w", err)
+	}
+	return nil
+}
+
+func labelSelectorFromArgs(labelSelector metav1.LabelSelector) (labels.Selector, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed converting label selector: %w", err)
+	}
+	if selector.Empty() {
+		return nil, errors.New("label selector must not be empty")
+	}
+	return selector, nil
+}
+
+// SeedLabelSelector is a filter plugin which only accepts seeds matching a label selector.
+type SeedLabelSelector struct {
+	selector labels.Selector
+}
+
+var _ FilterPlugin = &SeedLabelSelector{}
+
+// NewSeedLabelSelector creates a new SeedLabelSelector plugin.
+func NewSeedLabelSelector(rawArgs *runtime.RawExtension) (Plugin, error) {
+	args := &schedulerconfigv1alpha1.SeedLabelSelectorArgs{}
+	if err := decodeArgs(rawArgs, args); err != nil {
+		return nil, err
+	}
+
+	selector, err := labelSelectorFromArgs(args.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &SeedLabelSelector{selector: selector}, nil
+}
+
+// Name implements Plugin.
+func (p *SeedLabelSelector) Name() string {
+	return schedulerconfigv1alpha1.PluginSeedLabelSelector
+}
+
+// Filter implements FilterPlugin.
+func (p *SeedLabelSelector) Filter(_ context.Context, _ *State, seed *gardencorev1beta1.Seed) error {
+	if !p.selector.Matches(labels.Set(seed.Labels)) {
+		return fmt.Errorf("seed does not match label selector %q", p.selector.String())
+	}
+	return nil
+}
+
+// SeedUtilization is a score plugin which prefers seeds with a lower utilization. If a seed reports its allocatable
+// shoots, the utilization is the ratio of its shoots to its allocatable shoots. Otherwise, the number of shoots is
+// compared to the seed with the most shoots.
+type SeedUtilization struct{}
+
+var _ ScorePlugin = &SeedUtilization{}
+
+// NewSeedUtilization creates a new SeedUtilization plugin.
+func NewSeedUtilization(_ *runtime.RawExtension) (Plugin, error) {
+	return &SeedUtilization{}, nil
+}
+
+// Name implements Plugin.
+func (p *SeedUtilization) Name() string {
+	return schedulerconfigv1alpha1.PluginSeedUtilization
+}
+
+// Score implements ScorePlugin.
+func (p *SeedUtilization) Score(_ context.Context, state *State, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var maxUsage int
+	for _, seed := range seeds {
+		maxUsage = max(maxUsage, state.SeedUsage[seed.Name])
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		usage := state.SeedUsage[seed.Name]
+
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && allocatableShoots.Value() > 0 {
+			utilization := min(float64(usage)/float64(allocatableShoots.Value()), 1)
+			scores[seed.Name] = int64(math.Round(float64(MaxScore) * (1 - utilization)))
+			continue
+		}
+
+		if maxUsage == 0 {
+			scores[seed.Name] = MaxScore
+			continue
+		}
+		scores[seed.Name] = MaxScore * int64(maxUsage-usage) / int64(maxUsage)
+	}
+
+	return scores, nil
+}
+
+// ZoneCount is a score plugin which prefers seeds with more zones.
+type ZoneCount struct{}
+
+var _ ScorePlugin = &ZoneCount{}
+
+// NewZoneCount creates a new ZoneCount plugin.
+func NewZoneCount(_ *runtime.RawExtension) (Plugin, error) {
+	return &ZoneCount{}, nil
+}
+
+// Name implements Plugin.
+func (p *ZoneCount) Name() string {
+	return schedulerconfigv1alpha1.PluginZoneCount
+}
+
+// Score implements ScorePlugin.
+func (p *ZoneCount) Score(_ context.Context, _ *State, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var maxZones int
+	for _, seed := range seeds {
+		maxZones = max(maxZones, len(seed.Spec.Provider.Zones))
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		if maxZones == 0 {
+			scores[seed.Name] = 0
+			continue
+		}
+		scores[seed.Name] = MaxScore * int64(len(seed.Spec.Provider.Zones)) / int64(maxZones)
+	}
+
+	return scores, nil
+}
+
+// LabelAffinity is a score plugin which prefers seeds matching a label selector.
+type LabelAffinity struct {
+	selector labels.Selector
+}
+
+var _ ScorePlugin = &LabelAffinity{}
+
+// NewLabelAffinity creates a new LabelAffinity plugin.
+func NewLabelAffinity(rawArgs *runtime.RawExtension) (Plugin, error) {
+	args := &schedulerconfigv1alpha1.LabelAffinityArgs{}
+	if err := decodeArgs(rawArgs, args); err != nil {
+		return nil, err
+	}
+
+	selector, err := labelSelectorFromArgs(args.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &LabelAffinity{selector: selector}, nil
+}
+
+// Name implements Plugin.
+func (p *LabelAffinity) Name() string {
+	return schedulerconfigv1alpha1.PluginLabelAffinity
+}
+
+// Score implements ScorePlugin.
+func (p *LabelAffinity) Score(_ context.Context, _ *State, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		scores[seed.Name] = 0
+		if p.selector.Matches(labels.Set(seed.Labels)) {
+			scores[seed.Name] = MaxScore
+		}
+	}
+	return scores, nil
+}
+
+// Cost is a score plugin which prefers seeds with lower costs. The costs are read from a seed label. The cheapest seed
+// gets the maximum score, the most expensive one gets the minimum score. Seeds without valid costs get the minimum
+// score as well.
+type Cost struct {
+	labelKey string
+}
+
+var _ ScorePlugin = &Cost{}
+
+// NewCost creates a new Cost plugin.
+func NewCost(rawArgs *runtime.RawExtension) (Plugin, error) {
+	args := &schedulerconfigv1alpha1.CostArgs{}
+	if err := decodeArgs(rawArgs, args); err != nil {
+		return nil, err
+	}
+
+	if args.LabelKey == "" {
+		args.LabelKey = schedulerconfigv1alpha1.DefaultCostLabelKey
+	}
+	return &Cost{labelKey: args.LabelKey}, nil
+}
+
+// Name implements Plugin.
+func (p *Cost) Name() string {
+	return schedulerconfigv1alpha1.PluginCost
+}
+
+// Score implements ScorePlugin.
+func (p *Cost) Score(_ context.Context, _ *State, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		costs            = make(map[string]float64, len(seeds))
+		minCost, maxCost = math.MaxFloat64, -math.MaxFloat64
+	)
+
+	for _, seed := range seeds {
+		value, ok := seed.Labels[p.labelKey]
+		if !ok {
+			continue
+		}
+
+		// ParseFloat accepts "NaN" and "Inf" which would break the normalization of all scores, hence they are treated
+		// like invalid costs.
+		cost, err := strconv.ParseFloat(value, 64)
+		if err != nil || cost < 0 || math.IsNaN(cost) || math.IsInf(cost, 0) {
+			continue
+		}
+
+		costs[seed.Name] = cost
+		minCost, maxCost = min(minCost, cost), max(maxCost, cost)
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		cost, ok := costs[seed.Name]
+		switch {
+		case !ok:
+			scores[seed.Name] = 0
+		case maxCost == minCost:
+			scores[seed.Name] = MaxScore
+		default:
+			scores[seed.Name] = int64(math.Round(float64(MaxScore) * (maxCost - cost) / (maxCost - minCost)))
+		}
+	}
+
+	return scores, nil
+}
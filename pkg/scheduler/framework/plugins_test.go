@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+var _ = Describe("Plugins", func() {
+	var (
+		ctx   = context.TODO()
+		state *State
+		seeds []gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		state = &State{SeedUsage: map[string]int{}}
+		seeds = []gardencorev1beta1.Seed{
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-1"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-2"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "seed-3"}},
+		}
+	})
+
+	args := func(raw string) *runtime.RawExtension {
+		return &runtime.RawExtension{Raw: []byte(raw)}
+	}
+
+	Describe("SeedLabelSelector", func() {
+		It("should fail without label selector", func() {
+			_, err := NewSeedLabelSelector(nil)
+			Expect(err).To(MatchError("label selector must not be empty"))
+		})
+
+		It("should fail with unknown arguments", func() {
+			_, err := NewSeedLabelSelector(args(`{"foo":"bar"}`))
+			Expect(err).To(MatchError(ContainSubstring("failed decoding arguments")))
+		})
+
+		It("should only accept seeds matching the label selector", func() {
+			plugin, err := NewSeedLabelSelector(args(`{"labelSelector":{"matchLabels":{"environment":"production"}}}`))
+			Expect(err).NotTo(HaveOccurred())
+			filterPlugin := plugin.(FilterPlugin)
+
+			seeds[0].Labels = map[string]string{"environment": "production"}
+			seeds[1].Labels = map[string]string{"environment": "staging"}
+
+			Expect(filterPlugin.Filter(ctx, state, &seeds[0])).To(Succeed())
+			Expect(filterPlugin.Filter(ctx, state, &seeds[1])).To(MatchError(`seed does not match label selector "environment=production"`))
+			Expect(filterPlugin.Filter(ctx, state, &seeds[2])).To(HaveOccurred())
+		})
+	})
+
+	Describe("SeedUtilization", func() {
+		var plugin ScorePlugin
+
+		BeforeEach(func() {
+			p, err := NewSeedUtilization(nil)
+			Expect(err).NotTo(HaveOccurred())
+			plugin = p.(ScorePlugin)
+		})
+
+		It("should score all seeds with the maximum score if there are no shoots", func() {
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 100, "seed-3": 100}))
+		})
+
+		It("should score the seeds relative to the seed with the most shoots", func() {
+			state.SeedUsage = map[string]int{"seed-1": 4, "seed-2": 1}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 75, "seed-3": 100}))
+		})
+
+		It("should score the seeds by their allocatable shoots", func() {
+			state.SeedUsage = map[string]int{"seed-1": 4, "seed-2": 1, "seed-3": 20}
+			seeds[0].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+			seeds[2].Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 60, "seed-2": 95, "seed-3": 0}))
+		})
+	})
+
+	Describe("ZoneCount", func() {
+		var plugin ScorePlugin
+
+		BeforeEach(func() {
+			p, err := NewZoneCount(nil)
+			Expect(err).NotTo(HaveOccurred())
+			plugin = p.(ScorePlugin)
+		})
+
+		It("should score all seeds with the minimum score if no seed has zones", func() {
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 0, "seed-3": 0}))
+		})
+
+		It("should score the seeds relative to the seed with the most zones", func() {
+			seeds[0].Spec.Provider.Zones = []string{"a", "b", "c", "d"}
+			seeds[1].Spec.Provider.Zones = []string{"a"}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 25, "seed-3": 0}))
+		})
+	})
+
+	Describe("LabelAffinity", func() {
+		It("should fail without label selector", func() {
+			_, err := NewLabelAffinity(args(`{}`))
+			Expect(err).To(MatchError("label selector must not be empty"))
+		})
+
+		It("should prefer seeds matching the label selector", func() {
+			p, err := NewLabelAffinity(args(`{"labelSelector":{"matchExpressions":[{"key":"gpu","operator":"Exists"}]}}`))
+			Expect(err).NotTo(HaveOccurred())
+			plugin := p.(ScorePlugin)
+
+			seeds[1].Labels = map[string]string{"gpu": "true"}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 100, "seed-3": 0}))
+		})
+	})
+
+	Describe("Cost", func() {
+		It("should prefer cheaper seeds using the default label key", func() {
+			p, err := NewCost(nil)
+			Expect(err).NotTo(HaveOccurred())
+			plugin := p.(ScorePlugin)
+
+			seeds[0].Labels = map[string]string{"scheduling.gardener.cloud/cost": "2.5"}
+			seeds[1].Labels = map[string]string{"scheduling.gardener.cloud/cost": "10"}
+			seeds[2].Labels = map[string]string{"scheduling.gardener.cloud/cost": "0.5"}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 79, "seed-2": 0, "seed-3": 100}))
+		})
+
+		It("should score seeds without valid costs with the minimum score", func() {
+			p, err := NewCost(args(`{"labelKey":"cost"}`))
+			Expect(err).NotTo(HaveOccurred())
+			plugin := p.(ScorePlugin)
+
+			seeds[0].Labels = map[string]string{"cost": "3"}
+			seeds[1].Labels = map[string]string{"cost": "cheap"}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 0, "seed-3": 0}))
+		})
+
+		DescribeTable("should score seeds with non-finite costs with the minimum score", func(value string) {
+			p, err := NewCost(args(`{"labelKey":"cost"}`))
+			Expect(err).NotTo(HaveOccurred())
+			plugin := p.(ScorePlugin)
+
+			seeds[0].Labels = map[string]string{"cost": "1"}
+			seeds[1].Labels = map[string]string{"cost": value}
+			seeds[2].Labels = map[string]string{"cost": "3"}
+
+			Expect(plugin.Score(ctx, state, seeds)).To(Equal(map[string]int64{"seed-1": 100, "seed-2": 0, "seed-3": 0}))
+		},
+			Entry("NaN", "NaN"),
+			Entry("Inf", "Inf"),
+			Entry("-Inf", "-Inf"),
+		)
+	})
+})
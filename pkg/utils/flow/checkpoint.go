@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+// Checkpoints maps the IDs of tasks which succeeded in a previous execution of a Flow to the fingerprints of their
+// inputs at that time.
+type Checkpoints map[TaskID]string
+
+// CheckpointStore persists the Checkpoints of Flow executions so that a subsequent execution can skip tasks which
+// already succeeded with unchanged inputs.
+type CheckpointStore interface {
+	// Load returns the Checkpoints of the Flow with the given name. It returns empty Checkpoints if there are none.
+	Load(ctx context.Context, flowName string) (Checkpoints, error)
+	// Save persists the given Checkpoints of the Flow with the given name.
+	Save(ctx context.Context, flowName string, checkpoints Checkpoints) error
+	// Delete removes the Checkpoints of the Flow with the given name.
+	Delete(ctx context.Context, flowName string) error
+}
+
+// NewInMemoryCheckpointStore returns a CheckpointStore which keeps the Checkpoints in memory.
+func NewInMemoryCheckpointStore() CheckpointStore {
+	return &inMemoryCheckpointStore{checkpoints: make(map[string]Checkpoints)}
+}
+
+type inMemoryCheckpointStore struct {
+	lock        sync.RWMutex
+	checkpoints map[string]Checkpoints
+}
+
+func (s *inMemoryCheckpointStore) Load(_ context.Context, flowName string) (Checkpoints, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	checkpoints := make(Checkpoints)
+	maps.Copy(checkpoints, s.checkpoints[flowName])
+	return checkpoints, nil
+}
+
+func (s *inMemoryCheckpointStore) Save(_ context.Context, flowName string, checkpoints Checkpoints) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.checkpoints[flowName] = maps.Clone(checkpoints)
+	return nil
+}
+
+func (s *inMemoryCheckpointStore) Delete(_ context.Context, flowName string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.checkpoints, flowName)
+	return nil
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("CheckpointStore", func() {
+	var (
+		ctx   = context.Background()
+		store flow.CheckpointStore
+	)
+
+	BeforeEach(func() {
+		store = flow.NewInMemoryCheckpointStore()
+	})
+
+	Describe("#NewInMemoryCheckpointStore", func() {
+		It("should return empty checkpoints if there are none", func() {
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		})
+
+		It("should save, load and delete checkpoints", func() {
+			Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "1"})).To(Succeed())
+			Expect(store.Save(ctx, "bar", flow.Checkpoints{"y": "2"})).To(Succeed())
+
+			Expect(store.Load(ctx, "foo")).To(Equal(flow.Checkpoints{"x": "1"}))
+			Expect(store.Load(ctx, "bar")).To(Equal(flow.Checkpoints{"y": "2"}))
+
+			Expect(store.Delete(ctx, "foo")).To(Succeed())
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+			Expect(store.Load(ctx, "bar")).To(Equal(flow.Checkpoints{"y": "2"}))
+		})
+
+		It("should not be affected by modifications of saved or loaded checkpoints", func() {
+			checkpoints := flow.Checkpoints{"x": "1"}
+			Expect(store.Save(ctx, "foo", checkpoints)).To(Succeed())
+			checkpoints["x"] = "2"
+
+			loaded, err := store.Load(ctx, "foo")
+			Expect(err).NotTo(HaveOccurred())
+			loaded["y"] = "3"
+
+			Expect(store.Load(ctx, "foo")).To(Equal(flow.Checkpoints{"x": "1"}))
+		})
+	})
+})
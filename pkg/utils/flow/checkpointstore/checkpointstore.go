@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package checkpointstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var invalidKeyCharacters = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// keyForFlow returns a key for the given flow name which is valid both as ConfigMap data key and as name of a
+// GardenerResourceData entry.
+func keyForFlow(flowName string) string {
+	return strings.Trim(invalidKeyCharacters.ReplaceAllString(strings.ToLower(flowName), "-"), "-.")
+}
+
+func encode(checkpoints flow.Checkpoints) ([]byte, error) {
+	data, err := json.Marshal(checkpoints)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling checkpoints: %w", err)
+	}
+	return data, nil
+}
+
+func decode(data []byte) (flow.Checkpoints, error) {
+	checkpoints := make(flow.Checkpoints)
+	if len(data) == 0 {
+		return checkpoints, nil
+	}
+
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling checkpoints: %w", err)
+	}
+	return checkpoints, nil
+}
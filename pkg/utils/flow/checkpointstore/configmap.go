@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package checkpointstore
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewConfigMap returns a flow.CheckpointStore which persists the checkpoints in the ConfigMap with the given namespace
+// and name. The checkpoints of each flow are stored as JSON under a data key derived from the flow name. The ConfigMap
+// is created if it does not exist yet.
+func NewConfigMap(c client.Client, namespace, name string) flow.CheckpointStore {
+	return &configMapStore{
+		client: c,
+		key:    client.ObjectKey{Namespace: namespace, Name: name},
+	}
+}
+
+type configMapStore struct {
+	client client.Client
+	key    client.ObjectKey
+}
+
+func (s *configMapStore) Load(ctx context.Context, flowName string) (flow.Checkpoints, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, s.key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return make(flow.Checkpoints), nil
+		}
+		return nil, fmt.Errorf("failed reading ConfigMap %s: %w", s.key, err)
+	}
+
+	return decode([]byte(configMap.Data[keyForFlow(flowName)]))
+}
+
+func (s *configMapStore) Save(ctx context.Context, flowName string, checkpoints flow.Checkpoints) error {
+	data, err := encode(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	configMap := s.emptyConfigMap()
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, configMap, func() error {
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[keyForFlow(flowName)] = string(data)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed saving checkpoints to ConfigMap %s: %w", s.key, err)
+	}
+
+	return nil
+}
+
+func (s *configMapStore) Delete(ctx context.Context, flowName string) error {
+	configMap := &corev1.ConfigMap{}
+	if err := s.client.Get(ctx, s.key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading ConfigMap %s: %w", s.key, err)
+	}
+
+	key := keyForFlow(flowName)
+	if _, ok := configMap.Data[key]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	delete(configMap.Data, key)
+	if err := s.client.Patch(ctx, configMap, patch); err != nil {
+		return fmt.Errorf("failed deleting checkpoints from ConfigMap %s: %w", s.key, err)
+	}
+
+	return nil
+}
+
+func (s *configMapStore) emptyConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: s.key.Namespace, Name: s.key.Name}}
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package checkpointstore_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/flow/checkpointstore"
+)
+
+var _ = Describe("ConfigMap", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		store      flow.CheckpointStore
+
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		store = NewConfigMap(fakeClient, "namespace", "checkpoints")
+
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "checkpoints"}}
+	})
+
+	It("should return empty checkpoints if the ConfigMap does not exist", func() {
+		Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(BeEmpty())
+	})
+
+	It("should create the ConfigMap and save the checkpoints under a sanitized key", func() {
+		Expect(store.Save(ctx, "Shoot cluster reconciliation", flow.Checkpoints{"x": "1"})).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"shoot-cluster-reconciliation": `{"x":"1"}`}))
+
+		Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(Equal(flow.Checkpoints{"x": "1"}))
+	})
+
+	It("should only delete the checkpoints of the given flow", func() {
+		Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "1"})).To(Succeed())
+		Expect(store.Save(ctx, "bar", flow.Checkpoints{"y": "2"})).To(Succeed())
+
+		Expect(store.Delete(ctx, "foo")).To(Succeed())
+
+		Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		Expect(store.Load(ctx, "bar")).To(Equal(flow.Checkpoints{"y": "2"}))
+	})
+
+	It("should succeed deleting the checkpoints if the ConfigMap does not exist", func() {
+		Expect(store.Delete(ctx, "foo")).To(Succeed())
+	})
+
+	It("should resume a flow after a restart", func() {
+		var executed []string
+
+		// newFlow simulates the construction of the flow by a new process.
+		newFlow := func(failY bool) *flow.Flow {
+			var (
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(context.Context) error { executed = append(executed, "x"); return nil }, Fingerprint: "1"})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(context.Context) error {
+					executed = append(executed, "y")
+					if failY {
+						return errors.New("fake")
+					}
+					return nil
+				}, Fingerprint: "1", Dependencies: flow.NewTaskIDs(x)})
+			)
+			return g.Compile()
+		}
+
+		Expect(newFlow(true).Run(ctx, flow.Opts{CheckpointStore: store})).To(MatchError(ContainSubstring("fake")))
+		Expect(executed).To(Equal([]string{"x", "y"}))
+
+		executed = nil
+		Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: NewConfigMap(fakeClient, "namespace", "checkpoints")})).To(Succeed())
+		Expect(executed).To(Equal([]string{"y"}))
+		Expect(store.Load(ctx, "foo")).To(BeEmpty())
+	})
+
+	It("should fail loading invalid checkpoints", func() {
+		configMap.Data = map[string]string{"foo": "{"}
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+
+		_, err := store.Load(ctx, "foo")
+		Expect(err).To(MatchError(ContainSubstring("failed unmarshalling checkpoints")))
+	})
+})
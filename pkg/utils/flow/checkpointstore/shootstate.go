@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package checkpointstore
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+const (
+	// GardenerResourceDataType is the type of the GardenerResourceData entries in the ShootState which contain the
+	// checkpoints of a flow.
+	GardenerResourceDataType = "flow-checkpoints"
+	// GardenerResourceDataNamePrefix is the prefix for the names of the GardenerResourceData entries in the ShootState
+	// which contain the checkpoints of a flow.
+	GardenerResourceDataNamePrefix = "flow-checkpoints-"
+)
+
+// NewShootState returns a flow.CheckpointStore which persists the checkpoints in the `.spec.gardener` list of the
+// ShootState with the given namespace and name. This way, the checkpoints survive a control plane migration of the
+// shoot. The ShootState must exist for saving checkpoints.
+func NewShootState(c client.Client, namespace, name string) flow.CheckpointStore {
+	return &shootStateStore{
+		client: c,
+		key:    client.ObjectKey{Namespace: namespace, Name: name},
+	}
+}
+
+type shootStateStore struct {
+	client client.Client
+	key    client.ObjectKey
+}
+
+func (s *shootStateStore) Load(ctx context.Context, flowName string) (flow.Checkpoints, error) {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := s.client.Get(ctx, s.key, shootState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return make(flow.Checkpoints), nil
+		}
+		return nil, fmt.Errorf("failed reading ShootState %s: %w", s.key, err)
+	}
+
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	data := gardenerData.Get(gardenerResourceDataName(flowName))
+	if data == nil {
+		return make(flow.Checkpoints), nil
+	}
+
+	return decode(data.Data.Raw)
+}
+
+func (s *shootStateStore) Save(ctx context.Context, flowName string, checkpoints flow.Checkpoints) error {
+	raw, err := encode(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	return s.patch(ctx, func(gardenerData *v1beta1helper.GardenerResourceDataList) {
+		gardenerData.Upsert(&gardencorev1beta1.GardenerResourceData{
+			Name: gardenerResourceDataName(flowName),
+			Type: GardenerResourceDataType,
+			Data: runtime.RawExtension{Raw: raw},
+		})
+	})
+}
+
+func (s *shootStateStore) Delete(ctx context.Context, flowName string) error {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := s.client.Get(ctx, s.key, shootState); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading ShootState %s: %w", s.key, err)
+	}
+
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	if gardenerData.Get(gardenerResourceDataName(flowName)) == nil {
+		return nil
+	}
+
+	return s.patchObject(ctx, shootState, func(gardenerData *v1beta1helper.GardenerResourceDataList) {
+		gardenerData.Delete(gardenerResourceDataName(flowName))
+	})
+}
+
+func (s *shootStateStore) patch(ctx context.Context, mutate func(*v1beta1helper.GardenerResourceDataList)) error {
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := s.client.Get(ctx, s.key, shootState); err != nil {
+		return fmt.Errorf("failed reading ShootState %s: %w", s.key, err)
+	}
+
+	return s.patchObject(ctx, shootState, mutate)
+}
+
+func (s *shootStateStore) patchObject(ctx context.Context, shootState *gardencorev1beta1.ShootState, mutate func(*v1beta1helper.GardenerResourceDataList)) error {
+	// Use an optimistic lock since the list of GardenerResourceData is replaced as a whole and other writers (e.g., the
+	// ShootState secret controller) must not be overwritten.
+	patch := client.MergeFromWithOptions(shootState.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	mutate(&gardenerData)
+	shootState.Spec.Gardener = gardenerData
+
+	if err := s.client.Patch(ctx, shootState, patch); err != nil {
+		return fmt.Errorf("failed patching ShootState %s: %w", s.key, err)
+	}
+
+	return nil
+}
+
+func gardenerResourceDataName(flowName string) string {
+	return GardenerResourceDataNamePrefix + keyForFlow(flowName)
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package checkpointstore_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/flow/checkpointstore"
+)
+
+var _ = Describe("ShootState", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		store      flow.CheckpointStore
+
+		shootState *gardencorev1beta1.ShootState
+		otherData  gardencorev1beta1.GardenerResourceData
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		store = NewShootState(fakeClient, "garden-foo", "bar")
+
+		otherData = gardencorev1beta1.GardenerResourceData{Name: "ca", Type: "secret", Data: runtime.RawExtension{Raw: []byte(`{}`)}}
+		shootState = &gardencorev1beta1.ShootState{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden-foo", Name: "bar"},
+			Spec:       gardencorev1beta1.ShootStateSpec{Gardener: []gardencorev1beta1.GardenerResourceData{otherData}},
+		}
+	})
+
+	It("should return empty checkpoints if the ShootState does not exist", func() {
+		Expect(store.Load(ctx, "foo")).To(BeEmpty())
+	})
+
+	It("should fail saving checkpoints if the ShootState does not exist", func() {
+		Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "1"})).To(MatchError(ContainSubstring("failed reading ShootState")))
+	})
+
+	Context("ShootState exists", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, shootState)).To(Succeed())
+		})
+
+		It("should return empty checkpoints if there are none", func() {
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		})
+
+		It("should save the checkpoints as GardenerResourceData and keep the other data", func() {
+			Expect(store.Save(ctx, "Shoot cluster reconciliation", flow.Checkpoints{"x": "1"})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(ConsistOf(
+				otherData,
+				gardencorev1beta1.GardenerResourceData{
+					Name: "flow-checkpoints-shoot-cluster-reconciliation",
+					Type: "flow-checkpoints",
+					Data: runtime.RawExtension{Raw: []byte(`{"x":"1"}`)},
+				},
+			))
+
+			Expect(store.Load(ctx, "Shoot cluster reconciliation")).To(Equal(flow.Checkpoints{"x": "1"}))
+		})
+
+		It("should overwrite existing checkpoints", func() {
+			Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "1"})).To(Succeed())
+			Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "2", "y": "3"})).To(Succeed())
+
+			Expect(store.Load(ctx, "foo")).To(Equal(flow.Checkpoints{"x": "2", "y": "3"}))
+		})
+
+		It("should resume a flow after a restart", func() {
+			var executed []string
+
+			// newFlow simulates the construction of the flow by a new process.
+			newFlow := func(failY bool) *flow.Flow {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: func(context.Context) error { executed = append(executed, "x"); return nil }, Fingerprint: "1"})
+					_ = g.Add(flow.Task{Name: "y", Fn: func(context.Context) error {
+						executed = append(executed, "y")
+						if failY {
+							return errors.New("fake")
+						}
+						return nil
+					}, Fingerprint: "1", Dependencies: flow.NewTaskIDs(x)})
+				)
+				return g.Compile()
+			}
+
+			Expect(newFlow(true).Run(ctx, flow.Opts{CheckpointStore: store})).To(MatchError(ContainSubstring("fake")))
+			Expect(executed).To(Equal([]string{"x", "y"}))
+
+			executed = nil
+			Expect(newFlow(false).Run(ctx, flow.Opts{CheckpointStore: NewShootState(fakeClient, "garden-foo", "bar")})).To(Succeed())
+			Expect(executed).To(Equal([]string{"y"}))
+			Expect(store.Load(ctx, "foo")).To(BeEmpty())
+		})
+
+		It("should delete the checkpoints and keep the other data", func() {
+			Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "1"})).To(Succeed())
+			Expect(store.Delete(ctx, "foo")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+			Expect(shootState.Spec.Gardener).To(ConsistOf(otherData))
+		})
+	})
+
+	It("should succeed deleting the checkpoints if the ShootState does not exist", func() {
+		Expect(store.Delete(ctx, "foo")).To(Succeed())
+	})
+})
@@ -9,10 +9,13 @@ package flow
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -40,6 +43,22 @@ func (ns nodes) rootIDs() TaskIDs {
 	return roots
 }
 
+// dependents returns the IDs of all tasks which depend on the given task, directly or transitively.
+func (ns nodes) dependents(id TaskID) TaskIDs {
+	out := NewTaskIDs()
+	queue := ns[id].targetIDs.UnsortedList()
+	for len(queue) > 0 {
+		target := queue[0]
+		queue = queue[1:]
+		if out.Has(target) {
+			continue
+		}
+		out.Insert(target)
+		queue = append(queue, ns[target].targetIDs.UnsortedList()...)
+	}
+	return out
+}
+
 func (ns nodes) getOrCreate(id TaskID) *node {
 	n, ok := ns[id]
 	if !ok {
@@ -71,10 +90,12 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs   TaskIDs
+	required    int
+	fn          TaskFn
+	skip        bool
+	fingerprint string
+	compensate  TaskFn
 }
 
 func (n *node) String() string {
@@ -102,6 +123,17 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// CheckpointStore is used to persist the fingerprints of succeeded tasks. If set, tasks which succeeded in a
+	// previous run with an unchanged fingerprint are skipped unless one of their dependencies with a fingerprint was
+	// executed in the current run. The checkpoints are persisted after every task and deleted once the flow succeeds.
+	CheckpointStore CheckpointStore
+	// TracerProvider is used to create a span for the Flow execution and child spans for all tasks. The task spans are
+	// propagated to the task functions via their context. Defaults to the global TracerProvider.
+	TracerProvider trace.TracerProvider
+	// CompensateOnFailure specifies whether the compensation functions of all tasks which succeeded in this execution
+	// are run in reverse order if any task fails. This allows aborting cleanly instead of leaving partially created
+	// state behind. Compensations are not run if the execution was canceled.
+	CompensateOnFailure bool
 }
 
 // Run starts an execution of a Flow.
@@ -111,9 +143,10 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID       TaskID
+	Error        error
+	skipped      bool
+	checkpointed bool
 
 	delay    time.Duration
 	duration time.Duration
@@ -128,6 +161,8 @@ type Stats struct {
 	Running   TaskIDs
 	Skipped   TaskIDs
 	Pending   TaskIDs
+	// Durations contains the durations of all finished tasks which have been executed.
+	Durations map[TaskID]time.Duration
 }
 
 // ProgressPercent retrieves the progress of a Flow execution in percent.
@@ -146,6 +181,7 @@ func (s *Stats) Copy() *Stats {
 		s.Running.Copy(),
 		s.Skipped.Copy(),
 		s.Pending.Copy(),
+		maps.Clone(s.Durations),
 	}
 }
 
@@ -160,6 +196,7 @@ func InitialStats(flowName string, all TaskIDs) *Stats {
 		NewTaskIDs(),
 		NewTaskIDs(),
 		all.Copy(),
+		make(map[TaskID]time.Duration),
 	}
 }
 
@@ -185,6 +222,12 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.CheckpointStore,
+		nil,
+		NewTaskIDs(),
+		newTracer(opts.TracerProvider),
+		opts.CompensateOnFailure,
+		nil,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -200,6 +243,16 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	checkpointStore  CheckpointStore
+	checkpoints      Checkpoints
+	// invalidated contains the tasks which must not be skipped because of a checkpoint since one of their (transitive)
+	// dependencies was executed in this execution.
+	invalidated TaskIDs
+	tracer      trace.Tracer
+
+	compensateOnFailure bool
+	// completed contains the tasks which succeeded in this execution in the order of their completion.
+	completed []TaskID
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -213,6 +266,8 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	if node.skip {
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
+		_, span := e.startTaskSpan(ctx, id, attributeKeySkipped.Bool(true))
+		endSpan(span, nil)
 
 		go func() {
 			e.done <- &nodeResult{TaskID: id, Error: nil, skipped: true, delay: taskStartDelay}
@@ -228,10 +283,24 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	if !e.invalidated.Has(id) && e.isCheckpointed(id) {
+		log.V(1).Info("Skipped, succeeded with unchanged fingerprint in previous run")
+		_, span := e.startTaskSpan(ctx, id, attributeKeyCheckpointed.Bool(true))
+		endSpan(span, nil)
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, checkpointed: true, delay: taskStartDelay}
+		}()
+
+		return
+	}
+
 	go func() {
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
-		err := node.fn(ctx)
+		taskCtx, span := e.startTaskSpan(ctx, id)
+		err := node.fn(taskCtx)
+		endSpan(span, err)
 		duration := e.flow.clock.Now().UTC().Sub(start)
 		log.V(1).Info("Finished", "duration", duration)
 
@@ -246,19 +315,100 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	}()
 }
 
-func (e *execution) updateSuccess(id TaskID) {
+func (e *execution) updateSuccess(ctx context.Context, id TaskID, checkpointed bool) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
+
+	if e.checkpoints != nil && !checkpointed {
+		if fingerprint := e.flow.nodes[id].fingerprint; fingerprint != "" {
+			e.checkpoints[id] = fingerprint
+			e.saveCheckpoints(ctx)
+		}
+	}
 }
 
-func (e *execution) updateFailure(id TaskID) {
+func (e *execution) updateFailure(ctx context.Context, id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Failed.Insert(id)
+
+	if _, ok := e.checkpoints[id]; ok {
+		delete(e.checkpoints, id)
+		e.saveCheckpoints(ctx)
+	}
+}
+
+func (e *execution) isCheckpointed(id TaskID) bool {
+	if e.checkpoints == nil {
+		return false
+	}
+
+	fingerprint := e.flow.nodes[id].fingerprint
+	if fingerprint == "" {
+		return false
+	}
+
+	lastFingerprint, ok := e.checkpoints[id]
+	return ok && lastFingerprint == fingerprint
+}
+
+func (e *execution) loadCheckpoints(ctx context.Context) error {
+	if e.checkpointStore == nil {
+		return nil
+	}
+
+	checkpoints, err := e.checkpointStore.Load(ctx, e.flow.name)
+	if err != nil {
+		return fmt.Errorf("failed loading checkpoints of flow %q: %w", e.flow.name, err)
+	}
+
+	e.checkpoints = checkpoints
+	if e.checkpoints == nil {
+		e.checkpoints = make(Checkpoints)
+	}
+	return nil
 }
 
-func (e *execution) processTriggers(ctx context.Context, id TaskID) {
+// saveCheckpoints persists the current checkpoints. It is called whenever they change so that they are not lost if
+// the execution is canceled, e.g., because the context's deadline is exceeded. The write is not canceled together with
+// the execution.
+func (e *execution) saveCheckpoints(ctx context.Context) {
+	if e.checkpointStore == nil {
+		return
+	}
+
+	if err := e.checkpointStore.Save(context.WithoutCancel(ctx), e.flow.name, e.checkpoints); err != nil {
+		e.log.Error(err, "Failed saving checkpoints")
+	}
+}
+
+func (e *execution) persistCheckpoints(ctx context.Context, succeeded bool) {
+	if e.checkpointStore == nil {
+		return
+	}
+
+	// Checkpoints are only needed to resume a flow which did not succeed. Once the flow succeeded, the next run has to
+	// execute all tasks again.
+	if succeeded {
+		if err := e.checkpointStore.Delete(context.WithoutCancel(ctx), e.flow.name); err != nil {
+			e.log.Error(err, "Failed deleting checkpoints")
+		}
+		return
+	}
+
+	e.saveCheckpoints(ctx)
+}
+
+// processTriggers runs the targets of the given task once all their dependencies completed. If the task has a
+// fingerprint and was executed in this execution (or one of its dependencies was), the checkpoints of the targets are
+// not considered since their inputs might have changed. Tasks without a fingerprint are executed in every run, hence,
+// their execution alone does not invalidate the checkpoints of their targets.
+func (e *execution) processTriggers(ctx context.Context, id TaskID, executed bool) {
 	node := e.flow.nodes[id]
+	invalidate := (executed && node.fingerprint != "") || e.invalidated.Has(id)
 	for target := range node.targetIDs {
+		if invalidate {
+			e.invalidated.Insert(target)
+		}
 		e.triggerCounts[target]++
 		if e.triggerCounts[target] == e.flow.nodes[target].required {
 			e.runNode(ctx, target)
@@ -278,10 +428,13 @@ func (e *execution) reportProgress(ctx context.Context) {
 	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := e.startFlowSpan(ctx)
+	defer func() { endSpan(span, err) }()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -289,6 +442,10 @@ func (e *execution) run(ctx context.Context) error {
 		defer e.progressReporter.Stop()
 	}
 
+	if err := e.loadCheckpoints(ctx); err != nil {
+		return err
+	}
+
 	e.log.Info("Starting")
 	e.reportProgress(ctx)
 
@@ -307,22 +464,28 @@ func (e *execution) run(ctx context.Context) error {
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
 		result := <-e.done
 		e.reportTaskMetrics(result)
+		if !result.skipped && !result.checkpointed {
+			e.stats.Durations[result.TaskID] = result.duration
+			if result.Error == nil {
+				e.completed = append(e.completed, result.TaskID)
+			}
+		}
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
 			if cancelErr = ctx.Err(); cancelErr == nil {
-				e.processTriggers(ctx, result.TaskID)
+				e.processTriggers(ctx, result.TaskID, false)
 			}
 		} else {
 			if result.Error != nil {
 				e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(result.TaskID), result.Error))
-				e.updateFailure(result.TaskID)
+				e.updateFailure(ctx, result.TaskID)
 			} else {
-				e.updateSuccess(result.TaskID)
+				e.updateSuccess(ctx, result.TaskID, result.checkpointed)
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
 				if cancelErr = ctx.Err(); cancelErr == nil {
-					e.processTriggers(ctx, result.TaskID)
+					e.processTriggers(ctx, result.TaskID, !result.checkpointed)
 				}
 			}
 		}
@@ -331,9 +494,54 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Finished")
+	if cancelErr == nil && len(e.taskErrors) > 0 && e.compensateOnFailure {
+		e.compensate(ctx)
+	}
+	e.persistCheckpoints(ctx, cancelErr == nil && len(e.taskErrors) == 0)
 	return e.result(cancelErr)
 }
 
+// compensate runs the compensation functions of all tasks which succeeded in this execution. A task always completes
+// after all of its dependencies, hence, the reverse order of completion is a reverse topological order. This ensures
+// that a task is compensated before any of its dependencies.
+func (e *execution) compensate(ctx context.Context) {
+	e.log.Info("Compensating succeeded tasks")
+
+	for _, id := range slices.Backward(e.completed) {
+		node := e.flow.nodes[id]
+		if node.compensate == nil {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			e.log.Info("Stopped compensating since the context was canceled")
+			return
+		}
+
+		log := e.log.WithValues(logKeyTask, id)
+		log.Info("Compensating")
+
+		compensateCtx, span := e.startTaskSpan(ctx, id, attributeKeyCompensation.Bool(true))
+		err := node.compensate(compensateCtx)
+		endSpan(span, err)
+
+		if err != nil {
+			log.Error(err, "Compensation failed")
+			e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(id), fmt.Errorf("compensation of task %q failed: %w", id, err)))
+			continue
+		}
+
+		// The effects of the task were reverted, hence, it must be executed again in the next run. The same applies to all
+		// tasks depending on it, even if they succeeded in a previous run, since their inputs no longer exist.
+		if e.checkpoints != nil {
+			delete(e.checkpoints, id)
+			for dependent := range e.flow.nodes.dependents(id) {
+				delete(e.checkpoints, dependent)
+			}
+		}
+	}
+}
+
 func (e *execution) result(cancelErr error) error {
 	e.reportFlowMetrics()
 	if cancelErr != nil {
@@ -359,7 +567,7 @@ func (e *execution) reportTaskMetrics(r *nodeResult) {
 			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped, "true", "false")).
 			Observe(r.delay.Seconds())
 	}
-	if flowTaskDurationSeconds != nil && !r.skipped {
+	if flowTaskDurationSeconds != nil && !r.skipped && !r.checkpointed {
 		flowTaskDurationSeconds.WithLabelValues(e.flow.name, string(r.TaskID)).Observe(r.duration.Seconds())
 	}
 	if flowTaskResults != nil {
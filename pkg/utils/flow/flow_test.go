@@ -169,6 +169,244 @@ var _ = Describe("Flow", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(flow.WasCanceled(err)).To(BeTrue())
 		})
+
+		It("should report the durations of executed tasks", func() {
+			var (
+				lastStats *flow.Stats
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, SkipIf: true, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+			)
+
+			Expect(f.Run(ctx, flow.Opts{ProgressReporter: flow.NewImmediateProgressReporter(func(_ context.Context, stats *flow.Stats) {
+				lastStats = stats
+			})})).To(Succeed())
+
+			Expect(lastStats.Durations).To(HaveKey(flow.TaskID("x")))
+			Expect(lastStats.Durations).NotTo(HaveKey(flow.TaskID("y")))
+		})
+
+		Context("with compensation", func() {
+			var (
+				list *AtomicStringList
+				err1 error
+
+				mkListAppender func(value string, err error) flow.TaskFn
+			)
+
+			BeforeEach(func() {
+				list = NewAtomicStringList()
+				err1 = errors.New("err1")
+
+				mkListAppender = func(value string, err error) flow.TaskFn {
+					return func(_ context.Context) error {
+						list.Append(value)
+						return err
+					}
+				}
+			})
+
+			It("should compensate succeeded tasks in reverse order if a task fails", func() {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Compensate: mkListAppender("undo-x", nil)})
+					y = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil), Compensate: mkListAppender("undo-y", nil), Dependencies: flow.NewTaskIDs(x)})
+					z = g.Add(flow.Task{Name: "z", Fn: mkListAppender("z", nil), Dependencies: flow.NewTaskIDs(y)})
+					_ = g.Add(flow.Task{Name: "fail", Fn: mkListAppender("fail", err1), Compensate: mkListAppender("undo-fail", nil), Dependencies: flow.NewTaskIDs(z)})
+					_ = g.Add(flow.Task{Name: "skipped", Fn: mkListAppender("skipped", nil), Compensate: mkListAppender("undo-skipped", nil), SkipIf: true})
+					f = g.Compile()
+				)
+
+				err := f.Run(ctx, flow.Opts{CompensateOnFailure: true})
+				Expect(err).To(HaveOccurred())
+				Expect(flow.Causes(err).Errors).To(ConsistOf(err1))
+				Expect(list.Values()).To(Equal([]string{"x", "y", "z", "fail", "undo-y", "undo-x"}))
+			})
+
+			It("should not compensate if the option is not set", func() {
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Compensate: mkListAppender("undo-x", nil)})
+					_ = g.Add(flow.Task{Name: "fail", Fn: mkListAppender("fail", err1), Dependencies: flow.NewTaskIDs(x)})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{})).To(HaveOccurred())
+				Expect(list.Values()).To(Equal([]string{"x", "fail"}))
+			})
+
+			It("should not compensate if the flow succeeds", func() {
+				var (
+					g = flow.NewGraph("foo")
+					_ = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Compensate: mkListAppender("undo-x", nil)})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CompensateOnFailure: true})).To(Succeed())
+				Expect(list.Values()).To(Equal([]string{"x"}))
+			})
+
+			It("should continue compensating and report failed compensations", func() {
+				var (
+					err2 = errors.New("err2")
+
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Compensate: mkListAppender("undo-x", nil)})
+					y = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil), Compensate: mkListAppender("undo-y", err2), Dependencies: flow.NewTaskIDs(x)})
+					_ = g.Add(flow.Task{Name: "fail", Fn: mkListAppender("fail", err1), Dependencies: flow.NewTaskIDs(y)})
+					f = g.Compile()
+				)
+
+				err := f.Run(ctx, flow.Opts{CompensateOnFailure: true})
+				Expect(err).To(MatchError(ContainSubstring(`compensation of task "y" failed: err2`)))
+				Expect(flow.Causes(err).Errors).To(ConsistOf(err1, err2))
+				Expect(list.Values()).To(Equal([]string{"x", "y", "fail", "undo-y", "undo-x"}))
+			})
+
+			It("should remove the checkpoints of compensated tasks and of all tasks depending on them", func() {
+				var (
+					store = flow.NewInMemoryCheckpointStore()
+
+					g = flow.NewGraph("foo")
+					w = g.Add(flow.Task{Name: "w", Fn: mkListAppender("w", nil), Fingerprint: "1"})
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Fingerprint: "1", Compensate: mkListAppender("undo-x", nil), Dependencies: flow.NewTaskIDs(w)})
+					y = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil), Fingerprint: "1", Dependencies: flow.NewTaskIDs(x)})
+					z = g.Add(flow.Task{Name: "z", Fn: mkListAppender("z", nil), Fingerprint: "1", Dependencies: flow.NewTaskIDs(y)})
+					_ = g.Add(flow.Task{Name: "fail", Fn: mkListAppender("fail", err1), Dependencies: flow.NewTaskIDs(z)})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store, CompensateOnFailure: true})).To(HaveOccurred())
+				Expect(store.Load(ctx, "foo")).To(Equal(flow.Checkpoints{"w": "1"}))
+			})
+		})
+
+		Context("with checkpoint store", func() {
+			var (
+				store flow.CheckpointStore
+				list  *AtomicStringList
+				err1  error
+
+				mkListAppender func(value string, fail *bool) flow.TaskFn
+			)
+
+			BeforeEach(func() {
+				store = flow.NewInMemoryCheckpointStore()
+				list = NewAtomicStringList()
+				err1 = errors.New("err1")
+
+				mkListAppender = func(value string, fail *bool) flow.TaskFn {
+					return func(_ context.Context) error {
+						list.Append(value)
+						if fail != nil && *fail {
+							return err1
+						}
+						return nil
+					}
+				}
+			})
+
+			It("should skip tasks which succeeded in the previous run with an unchanged fingerprint", func() {
+				var (
+					fail = true
+
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Fingerprint: "x1"})
+					y = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil)})
+					_ = g.Add(flow.Task{Name: "z", Fn: mkListAppender("z", &fail), Fingerprint: "z1", Dependencies: flow.NewTaskIDs(x, y)})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store})).To(MatchError(ContainSubstring("err1")))
+				Expect(list.Values()).To(ConsistOf("x", "y", "z"))
+				Expect(store.Load(ctx, "foo")).To(Equal(flow.Checkpoints{"x": "x1"}))
+
+				fail = false
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("x", "y", "z", "y", "z"))
+				Expect(store.Load(ctx, "foo")).To(BeEmpty())
+			})
+
+			It("should execute tasks again whose fingerprint changed", func() {
+				Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "x1", "y": "y1"})).To(Succeed())
+
+				var (
+					g = flow.NewGraph("foo")
+					_ = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Fingerprint: "x1"})
+					_ = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil), Fingerprint: "y2"})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store})).To(Succeed())
+				Expect(list.Values()).To(ConsistOf("y"))
+			})
+
+			It("should execute tasks again whose dependencies were executed", func() {
+				Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "x1", "y": "y1", "z": "z1"})).To(Succeed())
+
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Fingerprint: "x2"})
+					y = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil), Fingerprint: "y1", Dependencies: flow.NewTaskIDs(x)})
+					_ = g.Add(flow.Task{Name: "z", Fn: mkListAppender("z", nil), Fingerprint: "z1", Dependencies: flow.NewTaskIDs(y)})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store})).To(Succeed())
+				Expect(list.Values()).To(Equal([]string{"x", "y", "z"}))
+			})
+
+			It("should not execute tasks again whose dependencies without a fingerprint were executed", func() {
+				Expect(store.Save(ctx, "foo", flow.Checkpoints{"y": "y1"})).To(Succeed())
+
+				var (
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil)})
+					y = g.Add(flow.Task{Name: "y", Fn: mkListAppender("y", nil), Fingerprint: "y1", Dependencies: flow.NewTaskIDs(x)})
+					_ = g.Add(flow.Task{Name: "z", Fn: mkListAppender("z", nil), Dependencies: flow.NewTaskIDs(y)})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store})).To(Succeed())
+				Expect(list.Values()).To(Equal([]string{"x", "z"}))
+			})
+
+			It("should persist the checkpoints of succeeded tasks if the execution is canceled", func() {
+				var (
+					cancelCtx, cancel = context.WithCancel(ctx)
+					cancelingStore    = &cancelAwareCheckpointStore{CheckpointStore: store}
+
+					g = flow.NewGraph("foo")
+					x = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", nil), Fingerprint: "x1"})
+					y = g.Add(flow.Task{Name: "y", Fn: func(context.Context) error { cancel(); return nil }, Fingerprint: "y1", Dependencies: flow.NewTaskIDs(x)})
+					_ = g.Add(flow.Task{Name: "z", Fn: mkListAppender("z", nil), Fingerprint: "z1", Dependencies: flow.NewTaskIDs(y)})
+					f = g.Compile()
+				)
+				defer cancel()
+
+				err := f.Run(cancelCtx, flow.Opts{CheckpointStore: cancelingStore})
+				Expect(flow.WasCanceled(err)).To(BeTrue())
+				Expect(list.Values()).To(Equal([]string{"x"}))
+				Expect(store.Load(ctx, "foo")).To(Equal(flow.Checkpoints{"x": "x1", "y": "y1"}))
+			})
+
+			It("should remove the checkpoint of a task which failed", func() {
+				Expect(store.Save(ctx, "foo", flow.Checkpoints{"x": "x1"})).To(Succeed())
+
+				var (
+					fail = true
+
+					g = flow.NewGraph("foo")
+					_ = g.Add(flow.Task{Name: "x", Fn: mkListAppender("x", &fail), Fingerprint: "x2"})
+					f = g.Compile()
+				)
+
+				Expect(f.Run(ctx, flow.Opts{CheckpointStore: store})).To(MatchError(ContainSubstring("err1")))
+				Expect(store.Load(ctx, "foo")).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("#Sequential", func() {
@@ -220,3 +458,15 @@ var _ = Describe("Flow", func() {
 		})
 	})
 })
+
+// cancelAwareCheckpointStore fails to save checkpoints with a canceled context like stores writing to the API server.
+type cancelAwareCheckpointStore struct {
+	flow.CheckpointStore
+}
+
+func (s *cancelAwareCheckpointStore) Save(ctx context.Context, flowName string, checkpoints flow.Checkpoints) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return s.CheckpointStore.Save(ctx, flowName, checkpoints)
+}
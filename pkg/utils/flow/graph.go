@@ -17,6 +17,14 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// Fingerprint is an optional fingerprint of all inputs of the task. If the Flow is run with a CheckpointStore and
+	// the task succeeded in a previous run with the same fingerprint, it is not executed again. Tasks without a
+	// fingerprint are always executed. Their execution does not invalidate the checkpoints of dependent tasks, hence,
+	// the fingerprint of a task must also cover the inputs it receives from dependencies without a fingerprint.
+	Fingerprint string
+	// Compensate is an optional function which reverts the effects of Fn. If the Flow is run with CompensateOnFailure
+	// and fails, the compensation functions of all tasks which succeeded in this run are executed in reverse order.
+	Compensate TaskFn
 }
 
 // Spec returns the TaskSpec of a task.
@@ -25,6 +33,8 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.Fingerprint,
+		t.Compensate,
 	}
 }
 
@@ -34,6 +44,8 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	Fingerprint  string
+	Compensate   TaskFn
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -91,6 +103,8 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.fingerprint = taskSpec.Fingerprint
+		node.compensate = taskSpec.Compensate
 		node.required = taskSpec.Dependencies.Len()
 	}
 
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DOT renders the graph in the DOT language of Graphviz.
+func (g *Graph) DOT() string {
+	return g.Compile().DOT(nil)
+}
+
+// Mermaid renders the graph as Mermaid flowchart.
+func (g *Graph) Mermaid() string {
+	return g.Compile().Mermaid(nil)
+}
+
+// DOT renders the flow in the DOT language of Graphviz. Skipped tasks are drawn with a dashed border. If stats are
+// given, the tasks are annotated with their durations and the critical path is highlighted.
+func (f *Flow) DOT(stats *Stats) string {
+	var (
+		out          strings.Builder
+		criticalPath = f.criticalPathSet(stats)
+	)
+
+	fmt.Fprintf(&out, "digraph %s {\n", quoteDOT(f.name))
+	out.WriteString("  rankdir=LR;\n")
+	out.WriteString("  node [shape=box];\n")
+
+	for _, id := range f.sortedTaskIDs() {
+		var attributes []string
+		attributes = append(attributes, "label="+quoteDOT(f.label(id, stats)))
+		if f.nodes[id].skip {
+			attributes = append(attributes, "style=dashed")
+		}
+		if criticalPath.Has(id) {
+			attributes = append(attributes, "color=red")
+		}
+		fmt.Fprintf(&out, "  %s [%s];\n", quoteDOT(string(id)), strings.Join(attributes, ", "))
+	}
+
+	for _, id := range f.sortedTaskIDs() {
+		for _, target := range f.nodes[id].targetIDs.List() {
+			var attributes string
+			if criticalPath.Has(id) && criticalPath.Has(target) {
+				attributes = " [color=red]"
+			}
+			fmt.Fprintf(&out, "  %s -> %s%s;\n", quoteDOT(string(id)), quoteDOT(string(target)), attributes)
+		}
+	}
+
+	out.WriteString("}\n")
+	return out.String()
+}
+
+// Mermaid renders the flow as Mermaid flowchart. Skipped tasks are drawn with a dashed border. If stats are given, the
+// tasks are annotated with their durations and the critical path is highlighted.
+func (f *Flow) Mermaid(stats *Stats) string {
+	var (
+		out          strings.Builder
+		criticalPath = f.criticalPathSet(stats)
+		ids          = f.sortedTaskIDs()
+		nodeIDs      = make(map[TaskID]string, len(ids))
+	)
+
+	fmt.Fprintf(&out, "---\ntitle: %s\n---\n", escapeMermaid(f.name))
+	out.WriteString("flowchart LR\n")
+	out.WriteString("  classDef skipped stroke-dasharray: 5 5\n")
+	out.WriteString("  classDef critical stroke:red,stroke-width:2px\n")
+
+	// Mermaid node IDs must not contain spaces or special characters, hence, generate them from the sorted task IDs.
+	for i, id := range ids {
+		nodeIDs[id] = fmt.Sprintf("task%d", i)
+	}
+
+	for _, id := range ids {
+		fmt.Fprintf(&out, "  %s[\"%s\"]\n", nodeIDs[id], escapeMermaid(f.label(id, stats)))
+		if f.nodes[id].skip {
+			fmt.Fprintf(&out, "  class %s skipped\n", nodeIDs[id])
+		}
+		if criticalPath.Has(id) {
+			fmt.Fprintf(&out, "  class %s critical\n", nodeIDs[id])
+		}
+	}
+
+	for _, id := range ids {
+		for _, target := range f.nodes[id].targetIDs.List() {
+			fmt.Fprintf(&out, "  %s --> %s\n", nodeIDs[id], nodeIDs[target])
+		}
+	}
+
+	return out.String()
+}
+
+// CriticalPath computes the path through the flow with the longest total duration based on the given task durations.
+// Tasks without a duration (e.g., skipped tasks) are considered to take no time. It returns the tasks on the critical
+// path in execution order as well as its total duration.
+func (f *Flow) CriticalPath(durations map[TaskID]time.Duration) ([]TaskID, time.Duration) {
+	var (
+		// total is the duration of the longest path ending with the respective task (including the task itself).
+		total = make(map[TaskID]time.Duration, len(f.nodes))
+		// predecessor is the preceding task on the longest path ending with the respective task.
+		predecessor = make(map[TaskID]TaskID, len(f.nodes))
+		required    = make(map[TaskID]int, len(f.nodes))
+		queue       []TaskID
+	)
+
+	for _, id := range f.sortedTaskIDs() {
+		required[id] = f.nodes[id].required
+		if required[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+
+	// Process the tasks in topological order, see Kahn's algorithm.
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		total[id] += durations[id]
+
+		for _, target := range f.nodes[id].targetIDs.List() {
+			if _, ok := predecessor[target]; !ok || total[id] > total[target] {
+				total[target] = total[id]
+				predecessor[target] = id
+			}
+
+			required[target]--
+			if required[target] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	var last TaskID
+	for _, id := range f.sortedTaskIDs() {
+		if last == "" || total[id] > total[last] {
+			last = id
+		}
+	}
+	if last == "" {
+		return nil, 0
+	}
+
+	path := []TaskID{last}
+	for id, ok := predecessor[last]; ok; id, ok = predecessor[id] {
+		path = append(path, id)
+	}
+	slices.Reverse(path)
+
+	return path, total[last]
+}
+
+func (f *Flow) criticalPathSet(stats *Stats) TaskIDs {
+	if stats == nil || len(stats.Durations) == 0 {
+		return NewTaskIDs()
+	}
+
+	path, _ := f.CriticalPath(stats.Durations)
+	return NewTaskIDs(TaskIDSlice(path))
+}
+
+func (f *Flow) label(id TaskID, stats *Stats) string {
+	if stats == nil {
+		return string(id)
+	}
+
+	if duration, ok := stats.Durations[id]; ok {
+		return fmt.Sprintf("%s (%s)", id, duration.Round(time.Millisecond))
+	}
+	return string(id)
+}
+
+func (f *Flow) sortedTaskIDs() TaskIDSlice {
+	ids := make(TaskIDs, len(f.nodes))
+	for id := range f.nodes {
+		ids.Insert(id)
+	}
+	return ids.List()
+}
+
+func quoteDOT(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func escapeMermaid(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
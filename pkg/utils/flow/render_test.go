@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Render", func() {
+	var (
+		g     *flow.Graph
+		f     *flow.Flow
+		stats *flow.Stats
+	)
+
+	BeforeEach(func() {
+		g = flow.NewGraph("foo")
+		var (
+			a = g.Add(flow.Task{Name: "a"})
+			b = g.Add(flow.Task{Name: "b", Dependencies: flow.NewTaskIDs(a)})
+			c = g.Add(flow.Task{Name: `c "quoted"`, Dependencies: flow.NewTaskIDs(a), SkipIf: true})
+			_ = g.Add(flow.Task{Name: "d", Dependencies: flow.NewTaskIDs(b, c)})
+		)
+		f = g.Compile()
+
+		stats = &flow.Stats{Durations: map[flow.TaskID]time.Duration{
+			"a": time.Second,
+			"b": 2 * time.Second,
+			"d": 500 * time.Millisecond,
+		}}
+	})
+
+	Describe("#DOT", func() {
+		It("should render the graph", func() {
+			Expect(g.DOT()).To(Equal(`digraph "foo" {
+  rankdir=LR;
+  node [shape=box];
+  "a" [label="a"];
+  "b" [label="b"];
+  "c \"quoted\"" [label="c \"quoted\"", style=dashed];
+  "d" [label="d"];
+  "a" -> "b";
+  "a" -> "c \"quoted\"";
+  "b" -> "d";
+  "c \"quoted\"" -> "d";
+}
+`))
+		})
+
+		It("should annotate the durations and highlight the critical path", func() {
+			Expect(f.DOT(stats)).To(Equal(`digraph "foo" {
+  rankdir=LR;
+  node [shape=box];
+  "a" [label="a (1s)", color=red];
+  "b" [label="b (2s)", color=red];
+  "c \"quoted\"" [label="c \"quoted\"", style=dashed];
+  "d" [label="d (500ms)", color=red];
+  "a" -> "b" [color=red];
+  "a" -> "c \"quoted\"";
+  "b" -> "d" [color=red];
+  "c \"quoted\"" -> "d";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the graph", func() {
+			Expect(g.Mermaid()).To(Equal(`---
+title: foo
+---
+flowchart LR
+  classDef skipped stroke-dasharray: 5 5
+  classDef critical stroke:red,stroke-width:2px
+  task0["a"]
+  task1["b"]
+  task2["c #quot;quoted#quot;"]
+  class task2 skipped
+  task3["d"]
+  task0 --> task1
+  task0 --> task2
+  task1 --> task3
+  task2 --> task3
+`))
+		})
+
+		It("should annotate the durations and highlight the critical path", func() {
+			Expect(f.Mermaid(stats)).To(ContainSubstring(`  task0["a (1s)"]
+  class task0 critical
+  task1["b (2s)"]
+  class task1 critical
+  task2["c #quot;quoted#quot;"]
+  class task2 skipped
+  task3["d (500ms)"]
+  class task3 critical
+`))
+		})
+	})
+
+	Describe("#CriticalPath", func() {
+		It("should compute the path with the longest total duration", func() {
+			path, duration := f.CriticalPath(stats.Durations)
+			Expect(path).To(Equal([]flow.TaskID{"a", "b", "d"}))
+			Expect(duration).To(Equal(3500 * time.Millisecond))
+		})
+
+		It("should take the longer branch", func() {
+			stats.Durations[`c "quoted"`] = 5 * time.Second
+
+			path, duration := f.CriticalPath(stats.Durations)
+			Expect(path).To(Equal([]flow.TaskID{"a", `c "quoted"`, "d"}))
+			Expect(duration).To(Equal(6500 * time.Millisecond))
+		})
+
+		It("should return nothing for an empty flow", func() {
+			path, duration := flow.NewGraph("empty").Compile().CriticalPath(nil)
+			Expect(path).To(BeEmpty())
+			Expect(duration).To(BeZero())
+		})
+	})
+})
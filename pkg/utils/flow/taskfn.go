@@ -42,8 +42,11 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
+		attempt := 0
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
+			attempt++
 			if err := t(ctx); err != nil {
+				recordRetry(ctx, attempt, err)
 				return retry.MinorError(err)
 			}
 			return retry.Ok()
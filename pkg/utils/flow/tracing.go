@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/gardener/gardener/pkg/utils/flow"
+
+	attributeKeyFlow         = attribute.Key("flow.name")
+	attributeKeyTask         = attribute.Key("flow.task")
+	attributeKeySkipped      = attribute.Key("flow.task.skipped")
+	attributeKeyCheckpointed = attribute.Key("flow.task.checkpointed")
+	attributeKeyAttempt      = attribute.Key("flow.task.attempt")
+	attributeKeyRetries      = attribute.Key("flow.task.retries")
+	attributeKeyCompensation = attribute.Key("flow.task.compensation")
+)
+
+func newTracer(tracerProvider trace.TracerProvider) trace.Tracer {
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	return tracerProvider.Tracer(tracerName)
+}
+
+func (e *execution) startFlowSpan(ctx context.Context) (context.Context, trace.Span) {
+	return e.tracer.Start(ctx, e.flow.name, trace.WithAttributes(attributeKeyFlow.String(e.flow.name)))
+}
+
+func (e *execution) startTaskSpan(ctx context.Context, id TaskID, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return e.tracer.Start(ctx, string(id), trace.WithAttributes(append(attributes, attributeKeyFlow.String(e.flow.name), attributeKeyTask.String(string(id)))...))
+}
+
+// endSpan records the given error (if any) and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	span.End()
+}
+
+// recordRetry adds an event for a failed attempt of a retried TaskFn to the span in the given context.
+func recordRetry(ctx context.Context, attempt int, err error) {
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("retry", trace.WithAttributes(attributeKeyAttempt.Int(attempt), attribute.String("error", err.Error())))
+	span.SetAttributes(attributeKeyRetries.Int(attempt))
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx            context.Context
+		spanRecorder   *tracetest.SpanRecorder
+		tracerProvider *sdktrace.TracerProvider
+
+		spanByName func(name string) sdktrace.ReadOnlySpan
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		spanRecorder = tracetest.NewSpanRecorder()
+		tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+
+		spanByName = func(name string) sdktrace.ReadOnlySpan {
+			for _, span := range spanRecorder.Ended() {
+				if span.Name() == name {
+					return span
+				}
+			}
+			Fail("span " + name + " not found")
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(tracerProvider.Shutdown(ctx)).To(Succeed())
+	})
+
+	It("should create a span for the flow with child spans for all tasks", func() {
+		var (
+			err1      = errors.New("err1")
+			taskSpanX trace.SpanContext
+
+			g = flow.NewGraph("foo")
+			x = g.Add(flow.Task{Name: "x", Fn: func(ctx context.Context) error {
+				taskSpanX = trace.SpanContextFromContext(ctx)
+				return nil
+			}})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+			_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(x)})
+			f = g.Compile()
+		)
+
+		Expect(f.Run(ctx, flow.Opts{TracerProvider: tracerProvider})).To(MatchError(ContainSubstring("err1")))
+		Expect(spanRecorder.Ended()).To(HaveLen(4))
+
+		flowSpan := spanByName("foo")
+		Expect(flowSpan.Parent().IsValid()).To(BeFalse())
+		Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+
+		spanX := spanByName("x")
+		Expect(spanX.Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+		Expect(spanX.SpanContext()).To(Equal(taskSpanX))
+		Expect(spanX.Status().Code).To(Equal(codes.Ok))
+
+		spanY := spanByName("y")
+		Expect(spanY.Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+		Expect(spanY.Attributes()).To(ContainElement(attribute.Bool("flow.task.skipped", true)))
+
+		spanZ := spanByName("z")
+		Expect(spanZ.Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+		Expect(spanZ.Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "err1"}))
+		Expect(spanZ.Events()).To(ContainElement(HaveField("Name", "exception")))
+	})
+
+	It("should record retries of the task function", func() {
+		var (
+			attempts int
+
+			g = flow.NewGraph("foo")
+			_ = g.Add(flow.Task{Name: "x", Fn: flow.TaskFn(func(_ context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return errors.New("not yet")
+				}
+				return nil
+			}).RetryUntilTimeout(time.Millisecond, time.Second)})
+			f = g.Compile()
+		)
+
+		Expect(f.Run(ctx, flow.Opts{TracerProvider: tracerProvider})).To(Succeed())
+
+		spanX := spanByName("x")
+		Expect(spanX.Status().Code).To(Equal(codes.Ok))
+		Expect(spanX.Attributes()).To(ContainElement(attribute.Int("flow.task.retries", 2)))
+		Expect(spanX.Events()).To(ConsistOf(
+			HaveField("Name", "retry"),
+			HaveField("Name", "retry"),
+		))
+	})
+})
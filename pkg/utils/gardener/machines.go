@@ -7,6 +7,7 @@ package gardener
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +29,8 @@ const (
 	MachineDeploymentKind = "MachineDeployment"
 	// NodeLeasePrefix describes the Prefix of the lease that this node is corresponding to
 	NodeLeasePrefix = "gardener-node-agent-"
+	// NodeRebootLeasePrefix describes the prefix of the leases used by gardener-node-agents to coordinate reboots
+	NodeRebootLeasePrefix = "gardener-node-reboot-"
 )
 
 // BuildOwnerToMachinesMap returns a map that associates `MachineSet` names to the given `machines`.
@@ -183,6 +186,17 @@ func NodeAgentLeaseName(nodeName string) string {
 	return NodeLeasePrefix + nodeName
 }
 
+// NodeRebootLeaseNamePrefix returns the prefix of the names of the Lease objects used for coordinating the reboots of
+// the nodes of the given worker pool.
+func NodeRebootLeaseNamePrefix(workerPoolName string) string {
+	return NodeRebootLeasePrefix + workerPoolName + "-"
+}
+
+// NodeRebootLeaseName returns the name of the Lease object for the given reboot slot of the given worker pool.
+func NodeRebootLeaseName(workerPoolName string, slot int) string {
+	return NodeRebootLeaseNamePrefix(workerPoolName) + strconv.Itoa(slot)
+}
+
 // IsMachineDeploymentStrategyManualInPlace checks whether the given strategy is InPlaceUpdate and orchestration type is Manual.
 func IsMachineDeploymentStrategyManualInPlace(strategy machinev1alpha1.MachineDeploymentStrategy) bool {
 	return strategy.Type == machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType && strategy.InPlaceUpdate != nil && strategy.InPlaceUpdate.OrchestrationType == machinev1alpha1.OrchestrationTypeManual
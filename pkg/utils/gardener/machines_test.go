@@ -191,6 +191,13 @@ var _ = Describe("Machines", func() {
 		})
 	})
 
+	Describe("#NodeRebootLeaseName", func() {
+		It("should return the expected name", func() {
+			Expect(NodeRebootLeaseNamePrefix("pool")).To(Equal("gardener-node-reboot-pool-"))
+			Expect(NodeRebootLeaseName("pool", 2)).To(Equal("gardener-node-reboot-pool-2"))
+		})
+	})
+
 	DescribeTable("#IsMachineDeploymentStrategyManualInPlace", func(strategy machinev1alpha1.MachineDeploymentStrategy, expected bool) {
 		Expect(IsMachineDeploymentStrategyManualInPlace(strategy)).To(Equal(expected))
 	},
@@ -33,6 +33,13 @@ import (
 // Deploy deploys the ShootState resource with the effective state for the given shoot into the garden
 // cluster.
 func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool) error {
+	return DeployFromNamespace(ctx, clock, gardenClient, seedClient, shoot, shoot.Status.TechnicalID, overwriteSpec)
+}
+
+// DeployFromNamespace deploys the ShootState resource with the effective state for the given shoot into the garden
+// cluster. Other than Deploy, the state is computed from the given namespace instead of the technical ID of the shoot.
+// This is needed for autonomous shoot clusters whose control plane runs in the kube-system namespace.
+func DeployFromNamespace(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, seedNamespace string, overwriteSpec bool) error {
 	shootState := &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      shoot.Name,
@@ -40,7 +47,7 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		},
 	}
 
-	spec, err := computeSpec(ctx, seedClient, shoot.Status.TechnicalID)
+	spec, err := computeSpec(ctx, seedClient, seedNamespace)
 	if err != nil {
 		return fmt.Errorf("failed computing spec of ShootState for shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
 	}
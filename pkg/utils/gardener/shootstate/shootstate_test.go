@@ -250,6 +250,14 @@ var _ = Describe("ShootState", func() {
 				expectedSpec.Resources = append(existingResourcesData, expectedSpec.Resources...)
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
+
+			It("should compute the expected spec from the given namespace", func() {
+				shoot.Status.TechnicalID = "kube-system"
+
+				Expect(DeployFromNamespace(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, seedNamespace, true)).To(Succeed())
+				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+				Expect(shootState.Spec).To(Equal(expectedSpec))
+			})
 		})
 	})
 
@@ -85,6 +85,30 @@ func (m *ManagedResource) WithSecretRefs(secretRefs []corev1.LocalObjectReferenc
 	return m
 }
 
+// WithConfigMapRef adds a reference with the given name to the ConfigMapRefs field.
+func (m *ManagedResource) WithConfigMapRef(configMapRefName string) *ManagedResource {
+	m.resource.Spec.ConfigMapRefs = append(m.resource.Spec.ConfigMapRefs, corev1.LocalObjectReference{Name: configMapRefName})
+	return m
+}
+
+// WithConfigMapRefs sets the ConfigMapRefs field.
+func (m *ManagedResource) WithConfigMapRefs(configMapRefs []corev1.LocalObjectReference) *ManagedResource {
+	m.resource.Spec.ConfigMapRefs = append(m.resource.Spec.ConfigMapRefs, configMapRefs...)
+	return m
+}
+
+// WithChunkedSource adds the given chunked source to the ChunkedSources field.
+func (m *ManagedResource) WithChunkedSource(chunkedSource resourcesv1alpha1.ChunkedSource) *ManagedResource {
+	m.resource.Spec.ChunkedSources = append(m.resource.Spec.ChunkedSources, chunkedSource)
+	return m
+}
+
+// WithOCIArtifact sets the OCIArtifact field.
+func (m *ManagedResource) WithOCIArtifact(artifact *resourcesv1alpha1.OCIArtifactSource) *ManagedResource {
+	m.resource.Spec.OCIArtifact = artifact
+	return m
+}
+
 // WithInjectedLabels sets the InjectLabels field.
 func (m *ManagedResource) WithInjectedLabels(labelsToInject map[string]string) *ManagedResource {
 	m.resource.Spec.InjectLabels = labelsToInject
@@ -115,6 +139,12 @@ func (m *ManagedResource) DeletePersistentVolumeClaims(v bool) *ManagedResource
 	return m
 }
 
+// WithApplyMode sets the ApplyMode field.
+func (m *ManagedResource) WithApplyMode(mode resourcesv1alpha1.ApplyMode) *ManagedResource {
+	m.resource.Spec.ApplyMode = &mode
+	return m
+}
+
 // Reconcile creates or updates the ManagedResource as well as marks all referenced secrets as garbage collectable.
 func (m *ManagedResource) Reconcile(ctx context.Context) error {
 	resource := &resourcesv1alpha1.ManagedResource{
@@ -297,6 +297,7 @@ var _ = Describe("Resource Manager", func() {
 					ForceOverwriteLabels(forceOverwriteLabels).
 					KeepObjects(keepObjects).
 					DeletePersistentVolumeClaims(deletePersistentVolumeClaims).
+					WithApplyMode(resourcesv1alpha1.ApplyModeServerSideApply).
 					Reconcile(ctx),
 			).To(Succeed())
 
@@ -319,6 +320,47 @@ var _ = Describe("Resource Manager", func() {
 					ForceOverwriteLabels:         ptr.To(forceOverwriteLabels),
 					KeepObjects:                  ptr.To(keepObjects),
 					DeletePersistentVolumeClaims: ptr.To(deletePersistentVolumeClaims),
+					ApplyMode:                    ptr.To(resourcesv1alpha1.ApplyModeServerSideApply),
+				},
+			}
+
+			Expect(references.InjectAnnotations(expectedMr)).To(Succeed())
+			Expect(mr).To(Equal(expectedMr))
+		})
+
+		It("should correctly create a managed resource with other sources", func() {
+			var (
+				configMapRefs = []corev1.LocalObjectReference{{Name: "cm1"}, {Name: "cm2"}}
+				chunkedSource = resourcesv1alpha1.ChunkedSource{Kind: "Secret", Names: []string{secret1.Name, secret2.Name}, Key: "data.yaml.br"}
+				ociArtifact   = &resourcesv1alpha1.OCIArtifactSource{
+					Repository: "example.com/manifests",
+					Digest:     "sha256:f5d5b6ee3e79ad1a2a1e4a6dd4e6bbd5f3c8d14fc66da3b0dcc0f4b6bd6a4e24",
+				}
+			)
+
+			Expect(
+				NewManagedResource(fakeClient).
+					WithNamespacedName(namespace, name).
+					WithConfigMapRef(configMapRefs[0].Name).
+					WithConfigMapRefs(configMapRefs[1:]).
+					WithChunkedSource(chunkedSource).
+					WithOCIArtifact(ociArtifact).
+					Reconcile(ctx),
+			).To(Succeed())
+
+			mr := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, mr)).To(Succeed())
+
+			expectedMr := &resourcesv1alpha1.ManagedResource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       namespace,
+					ResourceVersion: "1",
+				},
+				Spec: resourcesv1alpha1.ManagedResourceSpec{
+					ConfigMapRefs:  configMapRefs,
+					ChunkedSources: []resourcesv1alpha1.ChunkedSource{chunkedSource},
+					OCIArtifact:    ociArtifact,
 				},
 			}
 
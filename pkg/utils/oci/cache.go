@@ -4,36 +4,234 @@
 
 package oci
 
-import "sync"
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
 
-var defaultCache = newCache()
+const (
+	// DefaultCacheMaxSize is the default maximum size of the cache in bytes.
+	DefaultCacheMaxSize int64 = 256 << 20
+
+	temporaryFileInfix = ".tmp-"
+)
+
+var defaultCache cacher = newCache(DefaultCacheMaxSize)
 
 type cacher interface {
 	Get(key string) ([]byte, bool)
 	Set(key string, blob []byte)
 }
 
-func newCache() *cache {
-	return &cache{
-		items: map[string][]byte{},
+// Cache is a size-bounded key-value cache for pulled artifacts. If the total size of the items exceeds the maximum size,
+// the least recently used items are removed from the cache.
+// Optionally, the items are persisted in a directory so that they survive restarts.
+type Cache struct {
+	mu sync.Mutex
+
+	maxSize   int64
+	size      int64
+	directory string
+
+	// lru contains the items of the cache, the most recently used item is at the front.
+	lru   *list.List
+	items map[string]*list.Element
+}
+
+type cacheItem struct {
+	// id is the hash of the key of the item. It is also used as file name if the item is persisted.
+	id   string
+	blob []byte
+}
+
+func newCache(maxSize int64) *Cache {
+	return &Cache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		items:   map[string]*list.Element{},
 	}
 }
 
-// cache is a basic key-value cache i.e. a map protected by a mutex. Items are never removed from the cache.
-type cache struct {
-	mu    sync.RWMutex
-	items map[string][]byte
+// NewCache creates a new cache with the given maximum size in bytes. If a directory is given, the items are persisted
+// in this directory, and the items persisted by a previous cache are loaded.
+func NewCache(maxSize int64, directory string) (*Cache, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("maximum cache size must be positive")
+	}
+
+	c := newCache(maxSize)
+	if directory == "" {
+		return c, nil
+	}
+
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	c.directory = directory
+
+	if err := c.load(); err != nil {
+		return nil, fmt.Errorf("failed to load persisted cache items: %w", err)
+	}
+
+	return c, nil
 }
 
-func (c *cache) Get(key string) ([]byte, bool) {
-	c.mu.RLock()
-	blob, found := c.items[key]
-	c.mu.RUnlock()
-	return blob, found
+// load adds the items persisted in the directory to the cache. The modification time of the files is used to restore
+// the order of the items.
+func (c *Cache) load() error {
+	entries, err := os.ReadDir(c.directory)
+	if err != nil {
+		return err
+	}
+
+	type persistedItem struct {
+		id      string
+		modTime time.Time
+	}
+
+	var persistedItems []persistedItem
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if !isItemID(entry.Name()) {
+			if strings.Contains(entry.Name(), temporaryFileInfix) {
+				// left over from an interrupted write
+				_ = os.Remove(filepath.Join(c.directory, entry.Name()))
+			}
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		persistedItems = append(persistedItems, persistedItem{id: entry.Name(), modTime: info.ModTime()})
+	}
+
+	slices.SortFunc(persistedItems, func(a, b persistedItem) int {
+		return a.modTime.Compare(b.modTime)
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, item := range persistedItems {
+		blob, err := os.ReadFile(filepath.Join(c.directory, item.id))
+		if err != nil {
+			return err
+		}
+		c.add(item.id, blob)
+	}
+
+	return nil
 }
 
-func (c *cache) Set(key string, blob []byte) {
+// Get returns the item with the given key.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	id := itemID(key)
+
 	c.mu.Lock()
-	c.items[key] = blob
+	element, found := c.items[id]
+	if !found {
+		c.mu.Unlock()
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	blob := element.Value.(*cacheItem).blob
 	c.mu.Unlock()
+
+	if c.directory != "" {
+		// The modification time reflects the last usage of the item when the items are loaded after a restart. It is
+		// updated without holding the lock to not block other callers by file I/O. Errors are ignored since they only
+		// affect the order of eviction, e.g., if the item has been evicted concurrently.
+		now := time.Now()
+		_ = os.Chtimes(filepath.Join(c.directory, id), now, now)
+	}
+
+	return blob, true
+}
+
+// Set adds the item with the given key to the cache. Items which exceed the maximum size of the cache are not added.
+func (c *Cache) Set(key string, blob []byte) {
+	id := itemID(key)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.add(id, blob) || c.directory == "" {
+		return
+	}
+
+	// Errors are ignored since the item is still available in memory, it is only not retained across restarts.
+	_ = c.persist(id, blob)
+}
+
+// add adds the item with the given id to the cache and evicts the least recently used items if the maximum size is
+// exceeded. It returns false if the item is not added. The caller must hold the lock.
+func (c *Cache) add(id string, blob []byte) bool {
+	if int64(len(blob)) > c.maxSize {
+		return false
+	}
+
+	if element, found := c.items[id]; found {
+		c.remove(element)
+	}
+
+	c.items[id] = c.lru.PushFront(&cacheItem{id: id, blob: blob})
+	c.size += int64(len(blob))
+
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+
+	return true
+}
+
+// remove removes the given element from the cache, including the persisted item. The caller must hold the lock.
+func (c *Cache) remove(element *list.Element) {
+	item := c.lru.Remove(element).(*cacheItem)
+	delete(c.items, item.id)
+	c.size -= int64(len(item.blob))
+
+	if c.directory != "" {
+		_ = os.Remove(filepath.Join(c.directory, item.id))
+	}
+}
+
+// persist writes the item to a temporary file which is renamed afterwards, so that no partially written items are
+// loaded after a restart.
+func (c *Cache) persist(id string, blob []byte) error {
+	file, err := os.CreateTemp(c.directory, id+temporaryFileInfix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(blob); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filepath.Join(c.directory, id))
+}
+
+func itemID(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func isItemID(name string) bool {
+	decoded, err := hex.DecodeString(name)
+	return err == nil && len(decoded) == sha256.Size
 }
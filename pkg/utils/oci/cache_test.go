@@ -5,6 +5,9 @@
 package oci
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -13,7 +16,7 @@ var _ = Describe("cache", func() {
 	It("should store and retrieve values", func() {
 		key := "foo"
 		data := []byte("bar")
-		c := newCache()
+		c := newCache(DefaultCacheMaxSize)
 
 		_, found := c.Get(key)
 		Expect(found).To(BeFalse())
@@ -24,4 +27,103 @@ var _ = Describe("cache", func() {
 		Expect(found).To(BeTrue())
 		Expect(out).To(Equal(data))
 	})
+
+	It("should evict the least recently used values if the maximum size is exceeded", func() {
+		c := newCache(6)
+
+		c.Set("foo", []byte("12"))
+		c.Set("bar", []byte("34"))
+		c.Set("baz", []byte("56"))
+
+		_, found := c.Get("foo")
+		Expect(found).To(BeTrue())
+
+		c.Set("qux", []byte("78"))
+
+		_, found = c.Get("bar")
+		Expect(found).To(BeFalse())
+		for _, key := range []string{"foo", "baz", "qux"} {
+			_, found = c.Get(key)
+			Expect(found).To(BeTrue(), key)
+		}
+	})
+
+	It("should replace existing values", func() {
+		c := newCache(4)
+
+		c.Set("foo", []byte("12"))
+		c.Set("foo", []byte("3456"))
+
+		out, found := c.Get("foo")
+		Expect(found).To(BeTrue())
+		Expect(out).To(Equal([]byte("3456")))
+	})
+
+	It("should not store values exceeding the maximum size", func() {
+		c := newCache(2)
+
+		c.Set("foo", []byte("12"))
+		c.Set("bar", []byte("345"))
+
+		_, found := c.Get("bar")
+		Expect(found).To(BeFalse())
+		_, found = c.Get("foo")
+		Expect(found).To(BeTrue())
+	})
+
+	Describe("#NewCache", func() {
+		var directory string
+
+		BeforeEach(func() {
+			directory = GinkgoT().TempDir()
+		})
+
+		It("should fail if the maximum size is not positive", func() {
+			_, err := NewCache(0, "")
+			Expect(err).To(MatchError("maximum cache size must be positive"))
+		})
+
+		It("should restore persisted values", func() {
+			c, err := NewCache(4, directory)
+			Expect(err).NotTo(HaveOccurred())
+
+			c.Set("foo", []byte("12"))
+			c.Set("bar", []byte("34"))
+
+			c, err = NewCache(4, directory)
+			Expect(err).NotTo(HaveOccurred())
+
+			out, found := c.Get("foo")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("12")))
+			out, found = c.Get("bar")
+			Expect(found).To(BeTrue())
+			Expect(out).To(Equal([]byte("34")))
+		})
+
+		It("should remove evicted values from the directory", func() {
+			c, err := NewCache(2, directory)
+			Expect(err).NotTo(HaveOccurred())
+
+			c.Set("foo", []byte("12"))
+			c.Set("bar", []byte("34"))
+
+			entries, err := os.ReadDir(directory)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Name()).To(Equal(itemID("bar")))
+		})
+
+		It("should ignore unknown files and remove left over temporary files", func() {
+			Expect(os.WriteFile(filepath.Join(directory, "foo"), []byte("foo"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(directory, itemID("bar")+temporaryFileInfix+"123"), []byte("bar"), 0600)).To(Succeed())
+
+			c, err := NewCache(4, directory)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.lru.Len()).To(BeZero())
+
+			Expect(filepath.Join(directory, "foo")).To(BeAnExistingFile())
+			Expect(filepath.Join(directory, itemID("bar")+temporaryFileInfix+"123")).NotTo(BeAnExistingFile())
+		})
+	})
 })
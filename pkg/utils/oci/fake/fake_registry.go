@@ -54,6 +54,11 @@ func (r *Registry) Pull(ctx context.Context, ociRepo *gardencorev1.OCIRepository
 	return data, nil
 }
 
+// PullLayer implements oci.Interface. It ignores the media type and returns the artifact like Pull.
+func (r *Registry) PullLayer(ctx context.Context, ociRepo *gardencorev1.OCIRepository, _ string) ([]byte, error) {
+	return r.Pull(ctx, ociRepo)
+}
+
 // AddArtifact adds an artifact to the fake registry.
 func (r *Registry) AddArtifact(oci *gardencorev1.OCIRepository, data []byte) {
 	r.mu.Lock()
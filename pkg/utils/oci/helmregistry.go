@@ -38,25 +38,57 @@ type Interface interface {
 	// Pull from the repository and return the Helm chart.
 	// The context can be used to pass the pull secret namespace with the key ContextKeyPullSecretNamespace.
 	Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error)
+	// PullLayer pulls from the repository and returns the content of the layer with the given media type.
+	// The context can be used to pass the pull secret namespace with the key ContextKeyPullSecretNamespace.
+	PullLayer(ctx context.Context, oci *gardencorev1.OCIRepository, mediaType string) ([]byte, error)
 }
 
 // HelmRegistry can pull OCI Helm Charts.
 type HelmRegistry struct {
-	cache  cacher
-	client client.Client
+	cache    cacher
+	client   client.Client
+	verifier *CosignVerifier
+}
+
+// HelmRegistryOption is an option for creating a HelmRegistry.
+type HelmRegistryOption func(*HelmRegistry)
+
+// WithCache configures the cache for pulled artifacts. By default, a cache shared by all registries without this option
+// is used.
+func WithCache(cache *Cache) HelmRegistryOption {
+	return func(r *HelmRegistry) {
+		r.cache = cache
+	}
+}
+
+// WithSignatureVerification configures that the signatures of all pulled artifacts are verified with the given
+// verifier. Artifacts whose signatures cannot be verified are rejected with a SignatureVerificationError.
+func WithSignatureVerification(verifier *CosignVerifier) HelmRegistryOption {
+	return func(r *HelmRegistry) {
+		r.verifier = verifier
+	}
 }
 
 // NewHelmRegistry creates a new HelmRegistry.
 // The client is used to get pull secrets if needed.
-func NewHelmRegistry(c client.Client) *HelmRegistry {
-	return &HelmRegistry{
+func NewHelmRegistry(c client.Client, opts ...HelmRegistryOption) *HelmRegistry {
+	r := &HelmRegistry{
 		cache:  defaultCache,
 		client: c,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // Pull from the repository and return the compressed archive.
 func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
+	return r.PullLayer(ctx, oci, mediaTypeHelm)
+}
+
+// PullLayer pulls from the repository and returns the content of the layer with the given media type.
+func (r *HelmRegistry) PullLayer(ctx context.Context, oci *gardencorev1.OCIRepository, mediaType string) ([]byte, error) {
 	ref, err := buildRef(oci)
 	if err != nil {
 		return nil, err
@@ -84,31 +116,36 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 		remoteOpts = append(remoteOpts, remote.WithAuthFromKeychain(&keychain{pullSecret: string(secret.Data[corev1.DockerConfigJsonKey])}))
 	}
 
-	key, err := cacheKeyFromRef(ref, remoteOpts...)
+	digest, err := digestFromRef(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
 	}
-	if key != "" {
-		if blob, found := r.cache.Get(key); found {
-			return blob, nil
+
+	key := cacheKeyForLayer(digest.Name(), mediaType)
+	if r.verifier != nil {
+		// Verified artifacts are cached under a separate key, since the cache might be shared with registries without
+		// signature verification. Hence, artifacts found in the cache don't need to be verified again.
+		key = r.verifier.cacheKey(key)
+	}
+
+	if blob, found := r.cache.Get(key); found {
+		return blob, nil
+	}
+
+	if r.verifier != nil {
+		if err := r.verifier.Verify(digest, remoteOpts...); err != nil {
+			return nil, err
 		}
 	}
 
-	img, err := remote.Image(ref, remoteOpts...)
+	img, err := remote.Image(digest, remoteOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull artifact %s: %w", ref, err)
 	}
-	blob, err := extractHelmLayer(img)
-	if err != nil {
-		return nil, err
-	}
-
-	// construct cache key based on digest of the pulled artifact
-	digest, err := img.Digest()
+	blob, err := extractLayer(img, mediaType)
 	if err != nil {
 		return nil, err
 	}
-	key = ref.Context().Digest(digest.String()).Name()
 	r.cache.Set(key, blob)
 
 	return blob, nil
@@ -129,11 +166,11 @@ func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
 	return name.ParseReference(ref, opts...)
 }
 
-// cacheKeyFromRef returns "repo@sha256:digest". If the ref is not a digest, the remote repository is queried to
-// retrieve the digest pointed to by the ref.
-func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error) {
+// digestFromRef returns the reference "repo@sha256:digest" of the artifact, which is also used as cache key. If the ref
+// is not a digest, the remote repository is queried to retrieve the digest pointed to by the ref.
+func digestFromRef(ref name.Reference, opts ...remote.Option) (name.Digest, error) {
 	if ref, ok := ref.(name.Digest); ok {
-		return ref.Name(), nil
+		return ref.Context().Digest(ref.DigestStr()), nil
 	}
 
 	var digest gcrv1.Hash
@@ -143,14 +180,23 @@ func cacheKeyFromRef(ref name.Reference, opts ...remote.Option) (string, error)
 	} else {
 		rd, gErr := remote.Get(ref, opts...)
 		if gErr != nil {
-			return "", fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
+			return name.Digest{}, fmt.Errorf("failed get manifest from remote trying to determine digest: %w", errors.Join(gErr, hErr))
 		}
 		digest = rd.Digest
 	}
-	return ref.Context().Digest(digest.String()).Name(), nil
+	return ref.Context().Digest(digest.String()), nil
+}
+
+// cacheKeyForLayer returns the cache key for the layer with the given media type of the artifact with the given key.
+// Helm charts are cached with the key of the artifact.
+func cacheKeyForLayer(key, mediaType string) string {
+	if mediaType == mediaTypeHelm {
+		return key
+	}
+	return key + "#" + mediaType
 }
 
-func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
+func extractLayer(image gcrv1.Image, mediaType string) ([]byte, error) {
 	layers, err := image.Layers()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse layers: %w", err)
@@ -166,13 +212,16 @@ func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		if string(mt) == mediaTypeHelm {
+		if string(mt) == mediaType {
 			layer = l
 			break
 		}
 	}
 	if layer == nil {
-		return nil, fmt.Errorf("no helm layer found in artifact")
+		if mediaType == mediaTypeHelm {
+			return nil, fmt.Errorf("no helm layer found in artifact")
+		}
+		return nil, fmt.Errorf("no layer with media type %s found in artifact", mediaType)
 	}
 	blob, err := layer.Compressed()
 	if err != nil {
@@ -180,7 +229,7 @@ func extractHelmLayer(image gcrv1.Image) ([]byte, error) {
 	}
 	raw, err := io.ReadAll(blob)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read content of helm layer: %w", err)
+		return nil, fmt.Errorf("failed to read content of layer: %w", err)
 	}
 	return raw, nil
 }
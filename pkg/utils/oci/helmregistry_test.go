@@ -6,11 +6,23 @@ package oci
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"strings"
 
 	_ "github.com/distribution/distribution/v3/registry/storage/driver/inmemory"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -32,7 +44,7 @@ var _ = Describe("helmregistry", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rc = &recordingCache{cache: newCache()}
+		rc = &recordingCache{cache: newCache(DefaultCacheMaxSize)}
 		hr = &HelmRegistry{cache: rc}
 	})
 
@@ -107,6 +119,123 @@ var _ = Describe("helmregistry", func() {
 		Expect(rc.cacheHits).To(Equal(1))
 	})
 
+	It("should pull the layer with the given media type", func() {
+		out, err := hr.PullLayer(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To(registryAddress + "/charts/example"),
+			Digest:     ptr.To(exampleChartDigest),
+		}, mediaTypeHelm)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(rawChart))
+	})
+
+	It("should return error if the artifact has no layer with the given media type", func() {
+		oci := &gardencorev1.OCIRepository{
+			Repository: ptr.To(registryAddress + "/charts/example"),
+			Digest:     ptr.To(exampleChartDigest),
+		}
+		_, err := hr.Pull(ctx, oci)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = hr.PullLayer(ctx, oci, "application/vnd.example.manifests.v1+yaml")
+		Expect(err).To(MatchError("no layer with media type application/vnd.example.manifests.v1+yaml found in artifact"))
+		Expect(rc.cacheHits).To(Equal(0))
+	})
+
+	Context("signature verification", func() {
+		var (
+			signingKey *ecdsa.PrivateKey
+			oci        *gardencorev1.OCIRepository
+		)
+
+		BeforeEach(func() {
+			var err error
+			signingKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			verifier, err := NewCosignVerifier([]string{encodePublicKey(&signingKey.PublicKey)})
+			Expect(err).NotTo(HaveOccurred())
+			hr.verifier = verifier
+
+			oci = &gardencorev1.OCIRepository{
+				Repository: ptr.To(registryAddress + "/charts/example"),
+				Tag:        ptr.To("0.1.0"),
+			}
+		})
+
+		It("should pull the chart if the signature can be verified", func() {
+			pushSignature(registryAddress+"/charts/example", exampleChartDigest, exampleChartDigest, signingKey)
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+		})
+
+		It("should return error if the signature was created with another key", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(registryAddress+"/charts/example", exampleChartDigest, exampleChartDigest, otherKey)
+
+			_, err = hr.Pull(ctx, oci)
+			var verificationErr *SignatureVerificationError
+			Expect(errors.As(err, &verificationErr)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("signature cannot be verified with any of the public keys")))
+		})
+
+		It("should return error if the signature is for another digest", func() {
+			pushSignature(registryAddress+"/charts/example", exampleChartDigest, "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a", signingKey)
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("does not match digest of artifact")))
+		})
+
+		It("should return error if the artifact is not signed", func() {
+			chartRef, err := name.ParseReference(registryAddress + "/charts/example@" + exampleChartDigest)
+			Expect(err).NotTo(HaveOccurred())
+			chart, err := remote.Image(chartRef)
+			Expect(err).NotTo(HaveOccurred())
+			unsignedRef, err := name.ParseReference(registryAddress + "/charts/unsigned:0.1.0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remote.Write(unsignedRef, chart)).To(Succeed())
+
+			_, err = hr.Pull(ctx, &gardencorev1.OCIRepository{
+				Repository: ptr.To(registryAddress + "/charts/unsigned"),
+				Tag:        ptr.To("0.1.0"),
+			})
+			var verificationErr *SignatureVerificationError
+			Expect(errors.As(err, &verificationErr)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("failed to pull signature")))
+		})
+
+		It("should use the cache for verified artifacts without verifying them again", func() {
+			pushSignature(registryAddress+"/charts/example", exampleChartDigest, exampleChartDigest, signingKey)
+
+			_, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rc.cacheHits).To(Equal(0))
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(registryAddress+"/charts/example", exampleChartDigest, exampleChartDigest, otherKey)
+
+			out, err := hr.Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(rawChart))
+			Expect(rc.cacheHits).To(Equal(1))
+		})
+
+		It("should not use the cache before the signature is verified", func() {
+			_, err := (&HelmRegistry{cache: rc}).Pull(ctx, oci)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			pushSignature(registryAddress+"/charts/example", exampleChartDigest, exampleChartDigest, otherKey)
+
+			_, err = hr.Pull(ctx, oci)
+			Expect(err).To(MatchError(ContainSubstring("signature cannot be verified")))
+		})
+	})
+
 	It("should pull the chart with pull secret", func() {
 		hr := newHelmRegistryWithPullSecret(rc, registryAddress)
 
@@ -135,6 +264,30 @@ var _ = Describe("helmregistry", func() {
 	})
 })
 
+// pushSignature pushes a cosign signature of the given signed digest for the artifact with the given digest.
+func pushSignature(repository, digest, signedDigest string, key *ecdsa.PrivateKey) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, repository, signedDigest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	Expect(err).NotTo(HaveOccurred())
+
+	image, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json"),
+		Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	tag, err := name.NewTag(repository + ":" + strings.Replace(digest, ":", "-", 1) + cosignSignatureTagSuffix)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(remote.Write(tag, image)).To(Succeed())
+}
+
+func encodePublicKey(key *ecdsa.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
 func newHelmRegistryWithPullSecret(cache cacher, registryAddress string) *HelmRegistry {
 	return &HelmRegistry{
 		cache: cache,
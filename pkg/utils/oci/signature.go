@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+const (
+	// cosignSignatureAnnotation is the annotation of the layers of a cosign signature artifact which contains the
+	// base64 encoded signature of the layer content.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignSignatureTagSuffix is the suffix of the tag under which cosign stores the signatures of an artifact.
+	cosignSignatureTagSuffix = ".sig"
+)
+
+// SignatureVerificationError is returned if the signature of an artifact cannot be verified.
+type SignatureVerificationError struct {
+	// Artifact is the reference of the artifact.
+	Artifact string
+	// Err is the reason why the signature cannot be verified.
+	Err error
+}
+
+func (e *SignatureVerificationError) Error() string {
+	return fmt.Sprintf("failed to verify signature of artifact %s: %v", e.Artifact, e.Err)
+}
+
+func (e *SignatureVerificationError) Unwrap() error {
+	return e.Err
+}
+
+// CosignVerifier verifies the cosign signatures of artifacts with a set of public keys. An artifact is accepted if it
+// has a signature which can be verified with at least one of the keys.
+type CosignVerifier struct {
+	publicKeys []crypto.PublicKey
+	// id identifies the set of public keys. It is part of the cache keys of verified artifacts, so that they are only
+	// found in the cache by registries verifying with the same keys.
+	id string
+}
+
+// NewCosignVerifier creates a new CosignVerifier for the given PEM encoded public keys. ECDSA, RSA and Ed25519 keys are
+// supported.
+func NewCosignVerifier(publicKeys []string) (*CosignVerifier, error) {
+	if len(publicKeys) == 0 {
+		return nil, fmt.Errorf("at least one public key is required")
+	}
+
+	var (
+		v    = &CosignVerifier{}
+		hash = sha256.New()
+	)
+
+	for i, data := range publicKeys {
+		publicKey, err := ParsePublicKey([]byte(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key %d: %w", i, err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal public key %d: %w", i, err)
+		}
+
+		v.publicKeys = append(v.publicKeys, publicKey)
+		hash.Write(der)
+	}
+	v.id = hex.EncodeToString(hash.Sum(nil))
+
+	return v, nil
+}
+
+// ParsePublicKey parses a PEM encoded public key which can be used for the verification of cosign signatures.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// Verify verifies the cosign signature of the artifact with the given digest. The options are used to pull the
+// signature artifact, which is expected in the same repository as the artifact.
+func (v *CosignVerifier) Verify(digest name.Digest, opts ...remote.Option) error {
+	if err := v.verify(digest, opts...); err != nil {
+		return &SignatureVerificationError{Artifact: digest.Name(), Err: err}
+	}
+
+	return nil
+}
+
+// cacheKey returns the cache key for an artifact with the given key which has been verified by this verifier.
+func (v *CosignVerifier) cacheKey(key string) string {
+	return key + "#verified-" + v.id
+}
+
+func (v *CosignVerifier) verify(digest name.Digest, opts ...remote.Option) error {
+	signatureTag := digest.Context().Tag(strings.Replace(digest.DigestStr(), ":", "-", 1) + cosignSignatureTagSuffix)
+
+	signatures, err := remote.Image(signatureTag, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to pull signature %s: %w", signatureTag, err)
+	}
+	manifest, err := signatures.Manifest()
+	if err != nil {
+		return fmt.Errorf("failed to read manifest of signature %s: %w", signatureTag, err)
+	}
+
+	var errs []error
+	for _, descriptor := range manifest.Layers {
+		encodedSignature, ok := descriptor.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		layer, err := signatures.LayerByDigest(descriptor.Digest)
+		if err != nil {
+			return fmt.Errorf("failed to get layer %s of signature %s: %w", descriptor.Digest, signatureTag, err)
+		}
+		payload, err := readLayer(layer.Compressed)
+		if err != nil {
+			return fmt.Errorf("failed to read layer %s of signature %s: %w", descriptor.Digest, signatureTag, err)
+		}
+
+		if err := v.verifyPayload(digest, payload, encodedSignature); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("no signature found in %s", signatureTag)
+	}
+	return errors.Join(errs...)
+}
+
+// simpleSigningPayload is the payload signed by cosign, see
+// https://github.com/containers/image/blob/main/docs/containers-signature.5.md#json-data-format.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func (v *CosignVerifier) verifyPayload(digest name.Digest, payload []byte, encodedSignature string) error {
+	signature, err := base64.StdEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	if !v.verifySignature(payload, signature) {
+		return fmt.Errorf("signature cannot be verified with any of the public keys")
+	}
+
+	var p simpleSigningPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed to decode signed payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest.DigestStr() {
+		return fmt.Errorf("signed digest %q does not match digest of artifact %q", p.Critical.Image.DockerManifestDigest, digest.DigestStr())
+	}
+
+	return nil
+}
+
+func (v *CosignVerifier) verifySignature(payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+
+	for _, publicKey := range v.publicKeys {
+		switch key := publicKey.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, hash[:], signature) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, signature) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func readLayer(open func() (io.ReadCloser, error)) ([]byte, error) {
+	reader, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
@@ -5,10 +5,17 @@
 package secrets
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"math/big"
 	"net"
 	"os"
@@ -48,13 +55,30 @@ const (
 	PKCS8
 )
 
+// KeyAlgorithm is a string alias for the algorithms of private keys.
+type KeyAlgorithm string
+
+const (
+	// KeyAlgorithmRSA indicates that an RSA private key should be generated. This is the default.
+	KeyAlgorithmRSA KeyAlgorithm = "RSA"
+	// KeyAlgorithmECDSAP256 indicates that an ECDSA private key on the P-256 curve should be generated.
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	// KeyAlgorithmECDSAP384 indicates that an ECDSA private key on the P-384 curve should be generated.
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+	// KeyAlgorithmEd25519 indicates that an Ed25519 private key should be generated.
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+
+	// DefaultRSAKeySize is the default size of generated RSA private keys in bits.
+	DefaultRSAKeySize = 3072
+)
+
 const (
 	// allowedClockSkew is the offset to allow with regards to certificate creation/usage difference.
 	allowedClockSkew = 1 * time.Minute
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// By default, it contains a 3072-bit RSA private key.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -67,6 +91,12 @@ type CertificateSecretConfig struct {
 	SigningCA *Certificate
 	PKCS      int
 
+	// KeyAlgorithm is the algorithm of the generated private key. Defaults to KeyAlgorithmRSA.
+	KeyAlgorithm KeyAlgorithm
+	// KeySize is the size of the generated private key in bits. It is only considered for RSA keys and defaults to
+	// DefaultRSAKeySize.
+	KeySize int
+
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
@@ -82,7 +112,10 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	// PrivateKey is the private key of the certificate if it is an RSA key. It is nil for other key algorithms.
+	PrivateKey *rsa.PrivateKey
+	// Signer is the private key of the certificate for all key algorithms.
+	Signer        crypto.Signer
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -111,20 +144,20 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, err := s.generatePrivateKey()
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
 
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
-			privateKeySigner = s.SigningCA.PrivateKey
+			privateKeySigner = s.SigningCA.signer()
 		}
 
 		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
@@ -132,19 +165,12 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			return nil, err
 		}
 
-		var pk []byte
-		switch s.PKCS {
-		case PKCS1:
-			pk = utils.EncodePrivateKey(privateKey)
-		case PKCS8:
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+		pk, err := encodePrivateKey(privateKey, s.PKCS)
+		if err != nil {
+			return nil, err
 		}
 
-		certificateObj.PrivateKey = privateKey
+		certificateObj.setPrivateKey(privateKey)
 		certificateObj.PrivateKeyPEM = pk
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
@@ -153,6 +179,97 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+func (s *CertificateSecretConfig) generatePrivateKey() (crypto.Signer, error) {
+	switch s.KeyAlgorithm {
+	case "", KeyAlgorithmRSA:
+		keySize := DefaultRSAKeySize
+		if s.KeySize > 0 {
+			keySize = s.KeySize
+		}
+		return GenerateKey(rand.Reader, keySize)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyAlgorithmEd25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", s.KeyAlgorithm)
+	}
+}
+
+// encodePrivateKey encodes the given private key to the PEM format. RSA keys are encoded in the given PKCS format,
+// ECDSA keys are encoded in the SEC 1 format unless PKCS8 is requested, and Ed25519 keys are always encoded in the
+// PKCS8 format.
+func encodePrivateKey(privateKey crypto.Signer, pkcs int) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return utils.EncodePrivateKeyInPKCS8(key)
+		}
+		return utils.EncodePrivateKey(key), nil
+
+	case *ecdsa.PrivateKey:
+		if pkcs == PKCS8 {
+			return encodePrivateKeyInPKCS8(key)
+		}
+		bytes, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: bytes}), nil
+
+	case ed25519.PrivateKey:
+		return encodePrivateKeyInPKCS8(key)
+
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", privateKey)
+	}
+}
+
+func encodePrivateKeyInPKCS8(privateKey crypto.Signer) ([]byte, error) {
+	bytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: bytes}), nil
+}
+
+// decodePrivateKey decodes an RSA, ECDSA or Ed25519 private key in the PEM format. RSA keys might be encoded in the
+// PKCS1 or PKCS8 format (both with the "RSA PRIVATE KEY" type for backwards-compatibility).
+func decodePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("could not decode the PEM-encoded private key")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key, nil
+		}
+		return utils.DecodeRSAPrivateKeyFromPKCS8(data)
+
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -185,7 +302,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := decodePrivateKey(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -194,22 +311,43 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 		return nil, err
 	}
 
-	return &Certificate{
+	certificateObj := &Certificate{
 		Name: name,
 
-		PrivateKey:    privateKey,
 		PrivateKeyPEM: privateKeyPEM,
 
 		Certificate:    certificate,
 		CertificatePEM: certificatePEM,
-	}, nil
+	}
+	certificateObj.setPrivateKey(privateKey)
+
+	return certificateObj, nil
+}
+
+func (c *Certificate) setPrivateKey(privateKey crypto.Signer) {
+	c.Signer = privateKey
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		c.PrivateKey = rsaPrivateKey
+	}
+}
+
+// signer returns the private key of the certificate. It falls back to the PrivateKey field for certificates which
+// were not created by this package and hence only have an RSA key set.
+func (c *Certificate) signer() crypto.Signer {
+	if c.Signer != nil {
+		return c.Signer
+	}
+	if c.PrivateKey != nil {
+		return c.PrivateKey
+	}
+	return nil
 }
 
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
 // The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -227,7 +365,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             AdjustToClockSkew(now),
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
@@ -237,6 +375,11 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	// Key encipherment is only possible with RSA keys.
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -254,8 +397,8 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
 // the first. It also requires the corresponding private keys of both certificates. The created certificate
 // is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+func signCertificate(certificateTemplate *x509.Certificate, privateKey crypto.Signer, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, privateKey.Public(), privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,9 @@
 package secrets_test
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -37,6 +40,65 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.Certificate).NotTo(BeNil())
 				Expect(certificate.CA).To(BeNil())
 			})
+
+			It("should generate a certificate with an RSA key of the configured size", func() {
+				certificateConfig.KeySize = 2048
+
+				certificate, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(certificate.PrivateKey.N.BitLen()).To(Equal(2048))
+				Expect(certificate.Signer).To(Equal(certificate.PrivateKey))
+				Expect(certificate.Certificate.KeyUsage & x509.KeyUsageKeyEncipherment).NotTo(BeZero())
+			})
+
+			DescribeTable("should generate certificates with the configured key algorithm",
+				func(keyAlgorithm KeyAlgorithm, pkcs int, publicKeyAlgorithm x509.PublicKeyAlgorithm, pemType string) {
+					certificateConfig.KeyAlgorithm = keyAlgorithm
+					certificateConfig.PKCS = pkcs
+
+					ca, err := certificateConfig.GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ca.PrivateKey).To(BeNil())
+					Expect(string(ca.PrivateKeyPEM)).To(HavePrefix("-----BEGIN " + pemType + "-----"))
+
+					caCertificate, err := x509.ParseCertificate(decodePEM(ca.CertificatePEM))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(caCertificate.PublicKeyAlgorithm).To(Equal(publicKeyAlgorithm))
+					Expect(caCertificate.KeyUsage & x509.KeyUsageKeyEncipherment).To(BeZero())
+
+					By("Loading the generated certificate")
+					loaded, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(loaded.Signer).To(Equal(ca.Signer))
+
+					By("Signing a certificate with the loaded CA")
+					certificate, err := (&CertificateSecretConfig{
+						Name:       "server",
+						CommonName: "server",
+						CertType:   ServerCert,
+						SigningCA:  loaded,
+					}).GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+
+					serverCertificate, err := x509.ParseCertificate(decodePEM(certificate.CertificatePEM))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(serverCertificate.PublicKeyAlgorithm).To(Equal(x509.RSA))
+					Expect(serverCertificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+				},
+
+				Entry("ECDSA P-256", KeyAlgorithmECDSAP256, PKCS1, x509.ECDSA, "EC PRIVATE KEY"),
+				Entry("ECDSA P-256 in PKCS8", KeyAlgorithmECDSAP256, PKCS8, x509.ECDSA, "PRIVATE KEY"),
+				Entry("ECDSA P-384", KeyAlgorithmECDSAP384, PKCS1, x509.ECDSA, "EC PRIVATE KEY"),
+				Entry("Ed25519", KeyAlgorithmEd25519, PKCS1, x509.Ed25519, "PRIVATE KEY"),
+			)
+
+			It("should fail for unsupported key algorithms", func() {
+				certificateConfig.KeyAlgorithm = "foo"
+
+				_, err := certificateConfig.GenerateCertificate()
+				Expect(err).To(MatchError(`unsupported key algorithm "foo"`))
+			})
 		})
 	})
 
@@ -107,3 +169,9 @@ var _ = Describe("Certificate Secrets", func() {
 		})
 	})
 })
+
+func decodePEM(data []byte) []byte {
+	block, _ := pem.Decode(data)
+	Expect(block).NotTo(BeNil())
+	return block.Bytes
+}
@@ -6,6 +6,8 @@ package manager
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
 	"fmt"
 	"sort"
 	"strconv"
@@ -447,6 +449,31 @@ var _ = Describe("Generate", func() {
 				Expect(secret.Name).To(Equal(name))
 			})
 
+			It("should rotate a CA secret when the key algorithm changes even if the config checksum is ignored for its name", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName(), Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+				Expect(secret.Name).To(Equal(name))
+
+				By("Change key algorithm and generate again")
+				config.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP384
+				newSecret, err := m.Generate(ctx, config, IgnoreConfigChecksumForCASecretName(), Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, newSecret)
+				Expect(newSecret.Name).To(Equal(name + "-ecdsa-p384"))
+
+				cert, err := secretsutils.LoadCertificate("", newSecret.Data["ca.key"], newSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Signer).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+
+				By("Verify internal store reflects changes")
+				secretInfos, found := m.getFromStore(name)
+				Expect(found).To(BeTrue())
+				Expect(secretInfos.current.obj).To(Equal(newSecret))
+				Expect(secretInfos.old.obj).To(Equal(secret))
+			})
+
 			It("should rotate a CA secret and add old and new to the corresponding bundle", func() {
 				By("Generate new secret")
 				secret, err := m.Generate(ctx, config)
@@ -484,6 +511,36 @@ var _ = Describe("Generate", func() {
 				Expect(secretInfos.bundle.obj).NotTo(PointTo(Equal(oldBundleSecret)))
 			})
 
+			It("should rotate a CA secret when the key algorithm changes and add old and new to the corresponding bundle", func() {
+				By("Generate new secret")
+				secret, err := m.Generate(ctx, config, Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, secret)
+
+				By("Change key algorithm and generate again")
+				config.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP256
+				newSecret, err := m.Generate(ctx, config, Rotate(KeepOld))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, newSecret)
+				Expect(newSecret.Name).NotTo(Equal(secret.Name))
+
+				cert, err := secretsutils.LoadCertificate("", newSecret.Data["ca.key"], newSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.Signer).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+				Expect(cert.Certificate.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+
+				By("Verify internal store reflects changes")
+				secretInfos, found := m.getFromStore(name)
+				Expect(found).To(BeTrue())
+				Expect(secretInfos.current.obj).To(Equal(newSecret))
+				Expect(secretInfos.old.obj).To(Equal(secret))
+
+				By("Verify bundle contains old and new CA")
+				bundle := secretInfos.bundle.obj.Data["bundle.crt"]
+				Expect(bundle).To(ContainSubstring(string(secret.Data["ca.crt"])))
+				Expect(bundle).To(ContainSubstring(string(newSecret.Data["ca.crt"])))
+			})
+
 			DescribeTable("should rotate CA as configured",
 				func(validity *time.Duration, renewAfterValidityPercentage int, unchanged, renewed time.Duration) {
 					lastCommonName := config.CommonName
@@ -642,6 +699,30 @@ var _ = Describe("Generate", func() {
 				))
 			})
 
+			It("should sign certificates with keys of a different algorithm than the CA", func() {
+				caConfig.KeyAlgorithm = secretsutils.KeyAlgorithmECDSAP384
+				serverConfig.KeyAlgorithm = secretsutils.KeyAlgorithmEd25519
+
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig)
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+
+				ca, err := secretsutils.LoadCertificate("", caSecret.Data["ca.key"], caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, certificateConfig := range []*secretsutils.CertificateSecretConfig{serverConfig, clientConfig} {
+					By("Generate new " + certificateConfig.Name + " secret")
+					secret, err := m.Generate(ctx, certificateConfig, SignedByCA(caName))
+					Expect(err).NotTo(HaveOccurred())
+					expectSecretWasCreated(ctx, fakeClient, secret)
+
+					cert, err := secretsutils.LoadCertificate("", secret.Data["tls.key"], secret.Data["tls.crt"])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(cert.Certificate.CheckSignatureFrom(ca.Certificate)).To(Succeed())
+				}
+			})
+
 			It("should keep the same server cert even when the CA rotates", func() {
 				By("Generate new CA secret")
 				caSecret, err := m.Generate(ctx, caConfig)
@@ -7,6 +7,7 @@ package manager
 import (
 	"context"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -339,6 +340,10 @@ func computeSecretName(config secretsutils.ConfigInterface, labels map[string]st
 		if infix := labels[LabelKeyChecksumConfig] + labels[LabelKeyChecksumSigningCA]; len(infix) > 0 {
 			name += "-" + utils.ComputeSHA256Hex([]byte(infix))[:8]
 		}
+	} else if infix := keyInfix(cfg); len(infix) > 0 {
+		// The static name must still change when the private key is changed, otherwise the existing CA secret would be
+		// reused. The name only changes for non-default keys to stay backwards-compatible.
+		name += "-" + infix
 	}
 
 	if suffix := labels[LabelKeyLastRotationInitiationTime]; len(suffix) > 0 {
@@ -348,6 +353,20 @@ func computeSecretName(config secretsutils.ConfigInterface, labels map[string]st
 	return name
 }
 
+// keyInfix returns an infix for the name of a CA secret describing its private key. It is empty for the default
+// 3072-bit RSA keys.
+func keyInfix(config *secretsutils.CertificateSecretConfig) string {
+	switch config.KeyAlgorithm {
+	case "", secretsutils.KeyAlgorithmRSA:
+		if config.KeySize > 0 && config.KeySize != secretsutils.DefaultRSAKeySize {
+			return "rsa" + strconv.Itoa(config.KeySize)
+		}
+		return ""
+	default:
+		return strings.ToLower(string(config.KeyAlgorithm))
+	}
+}
+
 // Secret constructs a *corev1.Secret for the given metadata and data.
 func Secret(objectMeta metav1.ObjectMeta, data map[string][]byte) *corev1.Secret {
 	return &corev1.Secret{
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+var (
+	// envOTLPEndpoints are the environment variables of which at least one must be set to enable the export of traces
+	// via OTLP. See https://opentelemetry.io/docs/specs/otel/protocol/exporter/.
+	envOTLPEndpoints = []string{"OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"}
+
+	// LookupEnv is os.LookupEnv. Exposed for testing.
+	LookupEnv = os.LookupEnv
+)
+
+// ShutdownFunc flushes all pending spans and shuts down the exporter.
+type ShutdownFunc func(context.Context) error
+
+// Enabled returns true if an OTLP endpoint is configured via the environment.
+func Enabled() bool {
+	for _, env := range envOTLPEndpoints {
+		if value, ok := LookupEnv(env); ok && value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Setup configures the global TracerProvider to export traces via OTLP/gRPC if an OTLP endpoint is configured via the
+// standard OpenTelemetry environment variables (e.g., OTEL_EXPORTER_OTLP_ENDPOINT). Further settings like TLS, headers,
+// or sampling are read from the environment as well. If no endpoint is configured, the global no-op TracerProvider is
+// kept so that tracing does not cause any overhead.
+// The returned ShutdownFunc must be called before the process exits to flush pending spans.
+func Setup(ctx context.Context, log logr.Logger, serviceName string) (ShutdownFunc, error) {
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating resource: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetLogger(log.WithName("otel"))
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	log.Info("Exporting traces via OTLP")
+	return tracerProvider.Shutdown, nil
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	var env map[string]string
+
+	BeforeEach(func() {
+		env = map[string]string{}
+		DeferCleanup(test.WithVar(&LookupEnv, func(key string) (string, bool) {
+			value, ok := env[key]
+			return value, ok
+		}))
+	})
+
+	Describe("#Enabled", func() {
+		It("should return false if no endpoint is configured", func() {
+			Expect(Enabled()).To(BeFalse())
+		})
+
+		It("should return false if the endpoint is empty", func() {
+			env["OTEL_EXPORTER_OTLP_ENDPOINT"] = ""
+			Expect(Enabled()).To(BeFalse())
+		})
+
+		It("should return true if the generic endpoint is configured", func() {
+			env["OTEL_EXPORTER_OTLP_ENDPOINT"] = "http://localhost:4317"
+			Expect(Enabled()).To(BeTrue())
+		})
+
+		It("should return true if the traces endpoint is configured", func() {
+			env["OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"] = "http://localhost:4317"
+			Expect(Enabled()).To(BeTrue())
+		})
+	})
+
+	Describe("#Setup", func() {
+		It("should keep the global TracerProvider if tracing is not enabled", func() {
+			tracerProvider := otel.GetTracerProvider()
+
+			shutdown, err := Setup(context.Background(), logr.Discard(), "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otel.GetTracerProvider()).To(BeIdenticalTo(tracerProvider))
+			Expect(shutdown(context.Background())).To(Succeed())
+		})
+	})
+})
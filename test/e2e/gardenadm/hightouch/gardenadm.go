@@ -26,6 +26,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/bootstraptoken"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	. "github.com/gardener/gardener/test/e2e/gardenadm/common"
 )
@@ -184,10 +185,40 @@ var _ = Describe("gardenadm high-touch scenario tests", Label("gardenadm", "high
 		}, SpecTimeout(time.Minute))
 
 		It("should join as worker node", func(ctx SpecContext) {
-			_, stdErr, err := execute(ctx, 1, "gardenadm", "join")
+			By("Create bootstrap token")
+			bootstrapTokenSecret, err := bootstraptoken.ComputeBootstrapToken(ctx, shootClientSet.Client(), "e2e123", "e2e test for gardenadm join", time.Hour)
 			Expect(err).NotTo(HaveOccurred())
+			bootstrapToken := bootstraptoken.FromSecretData(bootstrapTokenSecret.Data)
+
+			By("Determine gardener-node-agent secret of worker pool")
+			secretList := &corev1.SecretList{}
+			Expect(shootClientSet.Client().List(ctx, secretList, client.InNamespace("kube-system"), client.MatchingLabels{
+				"gardener.cloud/role":        "operating-system-config",
+				"worker.gardener.cloud/pool": "worker",
+			})).To(Succeed())
+			Expect(secretList.Items).To(HaveLen(1))
+
+			By("Determine control plane address")
+			controlPlanePod := &corev1.Pod{}
+			Expect(RuntimeClient.Client().Get(ctx, client.ObjectKey{Name: machinePodName(0), Namespace: namespace}, controlPlanePod)).To(Succeed())
+
+			stdOut, _, err := execute(ctx, 1, "gardenadm", "join",
+				"--bootstrap-token", bootstrapToken,
+				"--ca-certificate", utils.EncodeBase64(shootClientSet.RESTConfig().CAData),
+				"--gardener-node-agent-secret-name", secretList.Items[0].Name,
+				controlPlanePod.Status.PodIP+":443",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(ctx, stdOut).Should(gbytes.Say("This node has joined the cluster successfully!"))
+		}, SpecTimeout(10*time.Minute))
 
-			Eventually(ctx, stdErr).Should(gbytes.Say("Not implemented either"))
+		It("should see the joined node", func(ctx SpecContext) {
+			Eventually(ctx, func(g Gomega) []corev1.Node {
+				nodeList := &corev1.NodeList{}
+				g.Expect(shootClientSet.Client().List(ctx, nodeList)).To(Succeed())
+				return nodeList.Items
+			}).Should(HaveLen(2))
 		}, SpecTimeout(time.Minute))
 	})
 })
@@ -5,21 +5,31 @@
 package mediumtouch
 
 import (
+	"os"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
 )
 
+// configDir contains the manifests rendered by `make gardenadm-medium-touch-up`.
+const configDir = "../../../example/gardenadm-local/medium-touch"
+
 var _ = Describe("gardenadm medium-touch scenario tests", Label("gardenadm", "medium-touch"), func() {
 	BeforeEach(OncePerOrdered, func(SpecContext) {
 		PrepareBinary()
 	}, NodeTimeout(time.Minute))
 
 	Describe("Prepare infrastructure and machines", Ordered, func() {
-		It("should bootstrap the machine pods", func(SpecContext) {
-			Eventually(RunAndWait("bootstrap").Err).Should(gbytes.Say("Not implemented as well"))
-		}, SpecTimeout(time.Minute))
+		It("should bootstrap the autonomous shoot cluster", func(SpecContext) {
+			Expect(configDir+"/config.yaml").To(BeAnExistingFile(), "run `make gardenadm-medium-touch-up` to render the manifests")
+
+			// The machines of provider-local run as pods in the KinD cluster, hence it is used as bootstrap cluster.
+			session := Run("bootstrap", "--config-dir", configDir, "--kubeconfig", os.Getenv("KUBECONFIG"))
+			Eventually(session, 15*time.Minute).Should(gexec.Exit(0))
+			Eventually(session.Out).Should(gbytes.Say("Your autonomous shoot cluster has been bootstrapped successfully!"))
+		}, SpecTimeout(20*time.Minute))
 	})
 })
@@ -25,7 +25,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
-	quotacontroller "github.com/gardener/gardener/pkg/controllermanager/controller/quota"
+	quotacontroller "github.com/gardener/gardener/pkg/controllermanager/controller/quota/quota"
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	gardenerenvtest "github.com/gardener/gardener/test/envtest"
@@ -569,6 +569,42 @@ units: {}
 		))
 	})
 
+	It("should not apply a new configuration while the node is held back", func() {
+		waitForUpdatedNodeAnnotationCloudConfig(node, oscSecret, utils.ComputeSHA256Hex(oscRaw))
+		oldOSCChecksum := utils.ComputeSHA256Hex(oscRaw)
+
+		By("Hold back new configurations on the node")
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, "node-agent.gardener.cloud/hold-operating-system-config", "true")
+		Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+		operatingSystemConfig.Spec.Files[0].Content.Inline.Data = "file1-new"
+
+		var err error
+		oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Update Secret containing the operating system config")
+		patch = client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+		oscSecret.Data["osc.yaml"] = oscRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		Consistently(func(g Gomega) map[string]string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			return node.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", oldOSCChecksum))
+		test.AssertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
+
+		By("Release the node")
+		patch = client.MergeFrom(node.DeepCopy())
+		delete(node.Annotations, "node-agent.gardener.cloud/hold-operating-system-config")
+		Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+		waitForUpdatedNodeAnnotationCloudConfig(node, oscSecret, utils.ComputeSHA256Hex(oscRaw))
+		test.AssertFileOnDisk(fakeFS, file1.Path, "file1-new", 0777)
+	})
+
 	It("should reconcile the configuration when there is a previous OSC", func() {
 		waitForUpdatedNodeAnnotationCloudConfig(node, oscSecret, utils.ComputeSHA256Hex(oscRaw))
 		waitForUpdatedNodeLabelKubernetesVersion(node, kubernetesVersion.String())
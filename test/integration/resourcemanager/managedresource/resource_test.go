@@ -1056,6 +1056,76 @@ var _ = Describe("ManagedResource controller tests", func() {
 		})
 	})
 
+	Describe("Server-side apply", func() {
+		BeforeEach(func() {
+			managedResource.Spec.ApplyMode = ptr.To(resourcesv1alpha1.ApplyModeServerSideApply)
+		})
+
+		It("should apply the resources with the field manager", func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"abc": "xyz"}))
+			Expect(configMap.Annotations).To(HaveKeyWithValue(resourcesv1alpha1.OriginAnnotation, managedResource.Namespace+"/"+managedResource.Name))
+			Expect(configMap.ManagedFields).To(ContainElement(And(
+				HaveField("Manager", "gardener-resource-manager"),
+				HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			)))
+		})
+
+		It("should report conflicts with fields owned by other field managers", func() {
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+			)
+
+			patch := client.MergeFrom(configMap.DeepCopy())
+			configMap.Data = map[string]string{"abc": "foo"}
+			Expect(testClient.Patch(ctx, configMap, patch, client.FieldOwner("other-controller"))).To(Succeed())
+
+			patch = client.MergeFrom(managedResource.DeepCopy())
+			metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+			Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				return managedResource.Status.Conditions
+			}).Should(
+				ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionFieldOwnershipConflict), WithMessageSubstrings(`conflict with "other-controller"`, ".data.abc")),
+			)
+
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"abc": "foo"}))
+		})
+
+		Context("object was updated by the resource manager before", func() {
+			BeforeEach(func() {
+				existingConfigMap := configMap.DeepCopy()
+				existingConfigMap.Data["old"] = "value"
+				Expect(testClient.Create(ctx, existingConfigMap, client.FieldOwner("gardener-resource-manager"))).To(Succeed())
+			})
+
+			It("should take over the ownership of the fields and remove fields which are no longer desired", func() {
+				Eventually(func(g Gomega) map[string]string {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					return configMap.Data
+				}).Should(Equal(map[string]string{"abc": "xyz"}))
+
+				Expect(configMap.ManagedFields).NotTo(ContainElement(And(
+					HaveField("Manager", "gardener-resource-manager"),
+					HaveField("Operation", metav1.ManagedFieldsOperationUpdate),
+				)))
+			})
+		})
+	})
+
 	Describe("Immutable resources", func() {
 		BeforeEach(func() {
 			configMap.Immutable = ptr.To(true)